@@ -100,6 +100,20 @@ func (blockchain *BlockChain) GetPrivacyTokenState(tokenID common.Hash, shardID
 	return statedb.GetPrivacyTokenState(blockchain.GetBestStateShard(shardID).GetCopiedTransactionStateDB(), tokenID)
 }
 
+// GetIndexedPrivacyTokenTxs returns transactions of a privacy token in all shards from the token tx index,
+// which is kept out of transaction state and can be rebuilt by reindex
+func (blockchain *BlockChain) GetIndexedPrivacyTokenTxs(tokenID common.Hash) ([]common.Hash, error) {
+	txs := []common.Hash{}
+	for _, shardID := range blockchain.GetShardIDs() {
+		shardTxs, err := rawdbv2.GetTokenTxs(blockchain.GetShardChainDatabase(byte(shardID)), tokenID)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, shardTxs...)
+	}
+	return txs, nil
+}
+
 func (blockchain *BlockChain) GetAllBridgeTokens() ([]common.Hash, []*rawdbv2.BridgeTokenInfo, error) {
 	bridgeTokenIDs := []common.Hash{}
 	allBridgeTokens := []*rawdbv2.BridgeTokenInfo{}
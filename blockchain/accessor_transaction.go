@@ -157,11 +157,25 @@ func (blockchain *BlockChain) ValidateResponseTransactionFromTxsWithMetadata(sha
 			return errors.Errorf("This block contains txs spam request reward. Number of spam: %v", len(shardBlock.Body.Transactions)-len(txsSpamRemoved))
 		}
 	}
+	// a reward is paid once in a block, either by an auto withdrawal or by a withdraw request
+	autoWithdrawnTable := autoWithdrawnTableFromTxs(shardBlock.Body.Transactions)
+	autoWithdrawnCount := 0
+	for _, tx := range shardBlock.Body.Transactions {
+		if tx.GetMetadataType() == metadata.AutoWithdrawRewardResponseMeta {
+			autoWithdrawnCount++
+		}
+	}
+	if autoWithdrawnCount != len(autoWithdrawnTable) {
+		return errors.Errorf("This block contains %v auto withdraw reward responses for %v receivers", autoWithdrawnCount, len(autoWithdrawnTable))
+	}
 	for _, tx := range shardBlock.Body.Transactions {
 		switch tx.GetMetadataType() {
 		case metadata.WithDrawRewardResponseMeta:
 			_, requesterRes, amountRes, coinID := tx.GetTransferData()
 			requester := getRequesterFromPKnCoinID(requesterRes, *coinID)
+			if autoWithdrawnTable[requester] {
+				return errors.Errorf("Reward of %v is already paid by auto withdrawal in this block", requester)
+			}
 			txReq, isExist := txRequestTable[requester]
 			if !isExist {
 				return errors.New("This response dont match with any request")
@@ -274,8 +288,14 @@ func (blockchain *BlockChain) InitTxSalaryByCoinID(
 // @Notice: change from body.Transaction -> transactions
 func (blockchain *BlockChain) BuildResponseTransactionFromTxsWithMetadata(view *ShardBestState, transactions []metadata.Transaction, blkProducerPrivateKey *privacy.PrivateKey, shardID byte) ([]metadata.Transaction, error) {
 	txRequestTable := reqTableFromReqTxs(transactions)
+	autoWithdrawnTable := autoWithdrawnTableFromTxs(transactions)
 	txsResponse := []metadata.Transaction{}
 	for key, value := range txRequestTable {
+		if autoWithdrawnTable[key] {
+			Logger.log.Infof("[Reward] - Reward of %v is paid by auto withdrawal, skip withdraw request %v", key, value.Hash().String())
+			delete(txRequestTable, key)
+			continue
+		}
 		txRes, err := blockchain.buildWithDrawTransactionResponse(view, &value, blkProducerPrivateKey, shardID)
 		if err != nil {
 			Logger.log.Errorf("Build Withdraw transactions response for tx %v return errors %v", value, err)
@@ -336,6 +356,26 @@ func (blockchain *BlockChain) GetListOutputCoinsByKeyset(keyset *incognitokey.Ke
 			return nil, err
 		}
 	}
+	return decryptOutputCoinsByKeyset(transactionStateDB, outCointsInBytes, keyset, shardID, tokenID), nil
+}
+
+//GetListOutputCoinsByKeysetAtHeight - same as GetListOutputCoinsByKeyset, with transaction state of the shard as of a block height
+func (blockchain *BlockChain) GetListOutputCoinsByKeysetAtHeight(keyset *incognitokey.KeySet, shardID byte, tokenID *common.Hash, height uint64) ([]*privacy.OutputCoin, error) {
+	if keyset == nil {
+		return nil, NewBlockChainError(GetListOutputCoinsByKeysetError, fmt.Errorf("invalid key set, got keyset %+v", keyset))
+	}
+	transactionStateDB, err := blockchain.GetShardTransactionStateDBByHeight(shardID, height)
+	if err != nil {
+		return nil, err
+	}
+	outCointsInBytes, err := statedb.GetOutcoinsByPubkey(transactionStateDB, *tokenID, keyset.PaymentAddress.Pk[:], shardID)
+	if err != nil {
+		return nil, err
+	}
+	return decryptOutputCoinsByKeyset(transactionStateDB, outCointsInBytes, keyset, shardID, tokenID), nil
+}
+
+func decryptOutputCoinsByKeyset(transactionStateDB *statedb.StateDB, outCointsInBytes [][]byte, keyset *incognitokey.KeySet, shardID byte, tokenID *common.Hash) []*privacy.OutputCoin {
 	// convert from []byte to object
 	outCoins := make([]*privacy.OutputCoin, 0)
 	for _, item := range outCointsInBytes {
@@ -354,7 +394,7 @@ func (blockchain *BlockChain) GetListOutputCoinsByKeyset(keyset *incognitokey.Ke
 			results = append(results, decryptedOut)
 		}
 	}
-	return results, nil
+	return results
 }
 
 // CreateAndSaveTxViewPointFromBlock - fetch data from block, put into txviewpoint variable and save into db
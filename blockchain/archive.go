@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// State of a past block is opened from the roots stored with the block (rawdbv2 roots hash records).
+// There is no pruning: state tries of every block inserted one by one are flushed to disk by any node.
+// Archive mode is only checked by batch insertion (see flushShardBatch): a non archive node syncing blocks in batch
+// drops state tries of the blocks no longer kept in multiview and does not record their roots, an archive node flushes them too.
+// So a node can answer state queries at any height only if it ran in archive mode whenever it synced in batch
+
+// IsArchiveMode returns true if the node keeps state tries of blocks inserted in batch
+func (blockchain *BlockChain) IsArchiveMode() bool {
+	return blockchain.config.ArchiveMode
+}
+
+// GetShardTransactionStateDBByHeight opens transaction state (coins, commitments, serial numbers...) of a shard as of a block height
+func (blockchain *BlockChain) GetShardTransactionStateDBByHeight(shardID byte, height uint64) (*statedb.StateDB, error) {
+	sRH, err := blockchain.GetShardRootsHashFromBlockHeight(shardID, height)
+	if err != nil {
+		return nil, blockchain.shardRootsNotFoundError(shardID, height, err)
+	}
+	return blockchain.openStateDBByHeight(sRH.TransactionStateDBRootHash, blockchain.GetShardChainDatabase(shardID), height)
+}
+
+// GetShardRewardStateDBByHeight opens committee reward state of a shard as of a block height
+func (blockchain *BlockChain) GetShardRewardStateDBByHeight(shardID byte, height uint64) (*statedb.StateDB, error) {
+	sRH, err := blockchain.GetShardRootsHashFromBlockHeight(shardID, height)
+	if err != nil {
+		return nil, blockchain.shardRootsNotFoundError(shardID, height, err)
+	}
+	return blockchain.openStateDBByHeight(sRH.RewardStateDBRootHash, blockchain.GetShardChainDatabase(shardID), height)
+}
+
+// GetBeaconFeatureStateDBByHeight opens feature state (pde, portal, bridge...) of beacon as of a block height
+func (blockchain *BlockChain) GetBeaconFeatureStateDBByHeight(height uint64) (*statedb.StateDB, error) {
+	bRH, err := blockchain.GetBeaconRootsHashFromBlockHeight(height)
+	if err != nil {
+		return nil, NewBlockChainError(GetStateByHeightError, fmt.Errorf("Roots of beacon height %+v not found, error %+v", height, err))
+	}
+	return blockchain.openStateDBByHeight(bRH.FeatureStateDBRootHash, blockchain.GetBeaconChainDatabase(), height)
+}
+
+func (blockchain *BlockChain) shardRootsNotFoundError(shardID byte, height uint64, err error) error {
+	if !blockchain.config.ArchiveMode {
+		return NewBlockChainError(GetStateByHeightError, fmt.Errorf("Roots of shard %+v height %+v not found, only archive node keeps state of blocks synced in batch, error %+v", shardID, height, err))
+	}
+	return NewBlockChainError(GetStateByHeightError, fmt.Errorf("Roots of shard %+v height %+v not found, error %+v", shardID, height, err))
+}
+
+func (blockchain *BlockChain) openStateDBByHeight(root common.Hash, db incdb.Database, height uint64) (*statedb.StateDB, error) {
+	stateDB, err := statedb.NewWithPrefixTrie(root, statedb.NewDatabaseAccessWarper(db))
+	if err != nil {
+		if !blockchain.config.ArchiveMode {
+			return nil, NewBlockChainError(GetStateByHeightError, fmt.Errorf("State %+v of height %+v is not available, only archive node keeps state of blocks synced in batch, error %+v", root.String(), height, err))
+		}
+		return nil, NewBlockChainError(GetStateByHeightError, fmt.Errorf("State %+v of height %+v is not available, error %+v", root.String(), height, err))
+	}
+	return stateDB, nil
+}
@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+func TestArchiveModeKeepsBatchState(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+
+	for _, archiveMode := range []bool{false, true} {
+		bc, beaconBlock := newRollbackTestChain(t)
+		bc.config.ArchiveMode = archiveMode
+		prevView := insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)
+		// blocks in sequential timeslots, each one finalize its previous block
+		batch := bc.GetShardChainDatabase(0).NewBatch()
+		views := []*ShardBestState{}
+		for height := uint64(2); height <= 5; height++ {
+			view := newRollbackTestView(t, bc, beaconBlock, prevView, int64(height), []metadata.Transaction{newRollbackTestTx(t)})
+			if err := bc.processStoreShardBlock(view, view.BestBlock, newCommitteeChange(), []*BeaconBlock{beaconBlock}, batch); err != nil {
+				t.Fatal(err)
+			}
+			views = append(views, view)
+			prevView = view
+		}
+		if _, _, err := bc.GetShardBlockByHashWithShardID(views[0].BestBlockHash, 0); err == nil {
+			t.Fatal("Expect batch block is not stored before the batch is flushed")
+		}
+		if err := bc.flushShardBatch(batch, 0, views, []*BeaconBlock{beaconBlock}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, view := range views {
+			if _, _, err := bc.GetShardBlockByHashWithShardID(view.BestBlockHash, 0); err != nil {
+				t.Fatalf("Expect batch block %v is stored after the batch is flushed", view.ShardHeight)
+			}
+			_, err := bc.GetShardTransactionStateDBByHeight(0, view.ShardHeight)
+			// final view (height 4) and best view (height 5) are kept in multiview
+			kept := archiveMode || view.ShardHeight >= 4
+			if kept && err != nil {
+				t.Errorf("Expect state of height %v is kept (archive mode %v), have %v", view.ShardHeight, archiveMode, err)
+			}
+			if !kept && (err == nil || !strings.Contains(err.Error(), "only archive node")) {
+				t.Errorf("Expect state of height %v is dropped by non archive node, have %v", view.ShardHeight, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// Reward receivers register an auto withdraw policy instead of sending withdraw reward requests:
+//	- policies are kept in beacon consensus state
+//	- at the first beacon block of an epoch, beacon emits a due instruction for each policy of which the interval is over
+//	- the shard of the receiver pays the PRV reward without AutoWithdrawRewardFee (a governable param) if it reaches the threshold,
+//	the fee is burnt as the response tx is created by the shard without tx fee
+
+func buildAutoWithdrawRewardInst(shardID byte, status string, content interface{}) ([][]string, error) {
+	contentBytes, err := json.Marshal(content)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return [][]string{{
+		strconv.Itoa(metadata.AutoWithdrawRewardPolicyMeta),
+		strconv.Itoa(int(shardID)),
+		status,
+		string(contentBytes),
+	}}, nil
+}
+
+// buildInstructionsForAutoWithdrawRewardPolicy accept all policies, the sender is checked by shard
+func (blockchain *BlockChain) buildInstructionsForAutoWithdrawRewardPolicy(contentStr string, shardID byte) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.AutoWithdrawRewardPolicyAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return buildAutoWithdrawRewardInst(shardID, common.AutoWithdrawRewardPolicyAcceptedChainStatus, metadata.AutoWithdrawRewardPolicyContent{
+		PaymentAddress: action.Meta.PaymentAddress,
+		Interval:       action.Meta.Interval,
+		Threshold:      action.Meta.Threshold,
+		TxReqID:        action.TxReqID,
+		ShardID:        shardID,
+	})
+}
+
+// buildAutoWithdrawRewardInstructions build due auto withdraw instructions in the first block of an epoch,
+// a policy with interval N is due at epochs multiple of N
+func (blockchain *BlockChain) buildAutoWithdrawRewardInstructions(beaconBestState *BeaconBestState, beaconHeight uint64) [][]string {
+	instructions := [][]string{}
+	if beaconHeight <= 1 || beaconHeight%blockchain.config.ChainParams.Epoch != 1 {
+		return instructions
+	}
+	epoch := beaconBestState.Epoch + 1
+	policies, err := statedb.GetAllAutoWithdrawPolicies(beaconBestState.consensusStateDB)
+	if err != nil {
+		Logger.log.Error(err)
+		return instructions
+	}
+	for _, policy := range policies {
+		if policy.Interval() != 0 && epoch%policy.Interval() != 0 {
+			continue
+		}
+		receiver := policy.Receiver()
+		shardID := common.GetShardIDFromLastByte(receiver.Pk[len(receiver.Pk)-1])
+		keyWallet := wallet.KeyWallet{}
+		keyWallet.KeySet.PaymentAddress = receiver
+		inst, err := buildAutoWithdrawRewardInst(shardID, common.AutoWithdrawRewardDueChainStatus, metadata.AutoWithdrawRewardContent{
+			PaymentAddress: keyWallet.Base58CheckSerialize(wallet.PaymentAddressType),
+			Threshold:      policy.Threshold(),
+			Fee:            blockchain.getGovernanceParam(beaconBestState, AutoWithdrawRewardFeeParam, beaconHeight),
+			Epoch:          epoch,
+		})
+		if err != nil {
+			Logger.log.Error(err)
+			continue
+		}
+		instructions = append(instructions, inst...)
+	}
+	return instructions
+}
+
+// processAutoWithdrawRewardInstructions store or remove auto withdraw policies of beacon block
+func (blockchain *BlockChain) processAutoWithdrawRewardInstructions(consensusStateDB *statedb.StateDB, beaconBlock *BeaconBlock) error {
+	for _, inst := range beaconBlock.Body.Instructions {
+		if len(inst) != 4 || inst[0] != strconv.Itoa(metadata.AutoWithdrawRewardPolicyMeta) || inst[2] != common.AutoWithdrawRewardPolicyAcceptedChainStatus {
+			continue
+		}
+		var content metadata.AutoWithdrawRewardPolicyContent
+		if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+			return err
+		}
+		keyWallet, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+		if err != nil {
+			return err
+		}
+		receiver := keyWallet.KeySet.PaymentAddress
+		if content.Interval == 0 && content.Threshold == 0 {
+			statedb.DeleteAutoWithdrawPolicy(consensusStateDB, receiver.Pk)
+			continue
+		}
+		if err := statedb.StoreAutoWithdrawPolicy(consensusStateDB, receiver, content.Interval, content.Threshold); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildAutoWithdrawRewardResponseTx pay the PRV reward of a due auto withdrawal, nothing is paid
+// if the reward is under the threshold or the fee, or if it is already paid in the block
+func (blockGenerator *BlockGenerator) buildAutoWithdrawRewardResponseTx(
+	contentStr string,
+	producerPrivateKey *privacy.PrivateKey,
+	shardID byte,
+	shardView *ShardBestState,
+	autoWithdrawnReceivers map[string]bool,
+) (metadata.Transaction, error) {
+	var content metadata.AutoWithdrawRewardContent
+	err := json.Unmarshal([]byte(contentStr), &content)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while unmarshaling auto withdraw reward content: %+v", err)
+		return nil, nil
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while deserializing auto withdraw reward payment address: %+v", err)
+		return nil, nil
+	}
+	receiverAddr := keyWallet.KeySet.PaymentAddress
+	if common.GetShardIDFromLastByte(receiverAddr.Pk[len(receiverAddr.Pk)-1]) != shardID || autoWithdrawnReceivers[content.PaymentAddress] {
+		return nil, nil
+	}
+	tempPublicKey := base58.Base58Check{}.Encode(receiverAddr.Pk, common.Base58Version)
+	reward, err := statedb.GetCommitteeReward(shardView.GetShardRewardStateDB(), tempPublicKey, common.PRVCoinID)
+	if err != nil {
+		return nil, err
+	}
+	if reward < content.Threshold || reward <= content.Fee {
+		return nil, nil
+	}
+	meta := metadata.NewAutoWithdrawRewardResponse(content.PaymentAddress, content.Epoch, content.Fee, metadata.AutoWithdrawRewardResponseMeta)
+	resTx := new(transaction.Tx)
+	err = resTx.InitTxSalary(
+		reward-content.Fee,
+		&receiverAddr,
+		producerPrivateKey,
+		shardView.GetCopiedTransactionStateDB(),
+		meta,
+	)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while initializing auto withdraw reward response tx: %+v", err)
+		return nil, nil
+	}
+	autoWithdrawnReceivers[content.PaymentAddress] = true
+	return resTx, nil
+}
@@ -0,0 +1,131 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+func TestAutoWithdrawRewardResponse(t *testing.T) {
+	receiver := newTestPaymentAddress("receiver")
+	receiverWallet := wallet.KeyWallet{}
+	receiverWallet.KeySet.PaymentAddress = receiver
+	receiverAddress := receiverWallet.Base58CheckSerialize(wallet.PaymentAddressType)
+	shardID := common.GetShardIDFromLastByte(receiver.Pk[len(receiver.Pk)-1])
+	producerPrivateKey := privacy.GeneratePrivateKey(common.HashB([]byte("producer")))
+	const fee = 10
+
+	newShardView := func(t *testing.T, reward uint64) *ShardBestState {
+		rewardStateDB := newTestStakingPoolStateDB(t)
+		err := statedb.AddCommitteeReward(rewardStateDB, base58.Base58Check{}.Encode(receiver.Pk, common.Base58Version), reward, common.PRVCoinID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// state of a view is committed, the reward is read from a copy
+		if _, err := rewardStateDB.Commit(true); err != nil {
+			t.Fatal(err)
+		}
+		return &ShardBestState{rewardStateDB: rewardStateDB, transactionStateDB: newTestStakingPoolStateDB(t)}
+	}
+	newDueInst := func(t *testing.T, threshold uint64) []string {
+		content, err := json.Marshal(metadata.AutoWithdrawRewardContent{
+			PaymentAddress: receiverAddress,
+			Threshold:      threshold,
+			Fee:            fee,
+			Epoch:          4,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []string{strconv.Itoa(metadata.AutoWithdrawRewardPolicyMeta), strconv.Itoa(int(shardID)), common.AutoWithdrawRewardDueChainStatus, string(content)}
+	}
+	// newResponseTx builds a response tx as a producer could, whatever the reward
+	newResponseTx := func(t *testing.T, shardView *ShardBestState, amount uint64) metadata.Transaction {
+		tx := new(transaction.Tx)
+		err := tx.InitTxSalary(amount, &receiver, &producerPrivateKey, shardView.GetCopiedTransactionStateDB(),
+			metadata.NewAutoWithdrawRewardResponse(receiverAddress, 4, fee, metadata.AutoWithdrawRewardResponseMeta))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	verify := func(tx metadata.Transaction, insts [][]string, instUsed []int, shardView *ShardBestState) (bool, error) {
+		return tx.GetMetadata().(*metadata.AutoWithdrawRewardResponse).VerifyMinerCreatedTxBeforeGettingInBlock(nil, nil, insts, instUsed, shardID, tx, nil, nil, shardView, nil)
+	}
+
+	t.Run("reward is paid without the fee", func(t *testing.T) {
+		shardView := newShardView(t, 1000)
+		insts := [][]string{newDueInst(t, 500)}
+		tx, err := (&BlockGenerator{}).buildAutoWithdrawRewardResponseTx(insts[0][3], &producerPrivateKey, shardID, shardView, map[string]bool{})
+		if err != nil || tx == nil {
+			t.Fatalf("buildAutoWithdrawRewardResponseTx() = %v, %v", tx, err)
+		}
+		if _, _, amount, _ := tx.GetTransferData(); amount != 1000-fee {
+			t.Errorf("response pays %v, want %v", amount, 1000-fee)
+		}
+		instUsed := []int{0}
+		if ok, err := verify(tx, insts, instUsed, shardView); !ok || err != nil {
+			t.Errorf("VerifyMinerCreatedTxBeforeGettingInBlock() = %v, %v", ok, err)
+		}
+		if instUsed[0] != 1 {
+			t.Error("due instruction should be used")
+		}
+	})
+
+	t.Run("amount and fee must add up to the reward", func(t *testing.T) {
+		shardView := newShardView(t, 1000)
+		insts := [][]string{newDueInst(t, 500)}
+		for _, amount := range []uint64{1000, 1000 - fee - 1, 1000 - fee + 1} {
+			if ok, err := verify(newResponseTx(t, shardView, amount), insts, []int{0}, shardView); ok || err == nil {
+				t.Errorf("VerifyMinerCreatedTxBeforeGettingInBlock() of amount %v = %v, %v, want an error", amount, ok, err)
+			}
+		}
+	})
+
+	t.Run("duplicate payout in the same block", func(t *testing.T) {
+		shardView := newShardView(t, 1000)
+		insts := [][]string{newDueInst(t, 500)}
+		autoWithdrawnReceivers := map[string]bool{}
+		tx, _ := (&BlockGenerator{}).buildAutoWithdrawRewardResponseTx(insts[0][3], &producerPrivateKey, shardID, shardView, autoWithdrawnReceivers)
+		if tx == nil {
+			t.Fatal("buildAutoWithdrawRewardResponseTx() should pay the reward")
+		}
+		if dup, _ := (&BlockGenerator{}).buildAutoWithdrawRewardResponseTx(insts[0][3], &producerPrivateKey, shardID, shardView, autoWithdrawnReceivers); dup != nil {
+			t.Error("buildAutoWithdrawRewardResponseTx() should not pay a receiver twice in a block")
+		}
+		instUsed := []int{0}
+		if ok, err := verify(tx, insts, instUsed, shardView); !ok || err != nil {
+			t.Fatalf("VerifyMinerCreatedTxBeforeGettingInBlock() = %v, %v", ok, err)
+		}
+		if ok, err := verify(newResponseTx(t, shardView, 1000-fee), insts, instUsed, shardView); ok || err == nil {
+			t.Errorf("VerifyMinerCreatedTxBeforeGettingInBlock() of a second payout = %v, %v, want an error", ok, err)
+		}
+	})
+
+	t.Run("reward below the threshold", func(t *testing.T) {
+		shardView := newShardView(t, 400)
+		insts := [][]string{newDueInst(t, 500)}
+		if tx, _ := (&BlockGenerator{}).buildAutoWithdrawRewardResponseTx(insts[0][3], &producerPrivateKey, shardID, shardView, map[string]bool{}); tx != nil {
+			t.Error("buildAutoWithdrawRewardResponseTx() should not pay a reward below the threshold")
+		}
+		if ok, err := verify(newResponseTx(t, shardView, 400-fee), insts, []int{0}, shardView); ok || err == nil {
+			t.Errorf("VerifyMinerCreatedTxBeforeGettingInBlock() = %v, %v, want an error", ok, err)
+		}
+	})
+
+	t.Run("reward not above the fee", func(t *testing.T) {
+		shardView := newShardView(t, fee)
+		insts := [][]string{newDueInst(t, 0)}
+		if tx, _ := (&BlockGenerator{}).buildAutoWithdrawRewardResponseTx(insts[0][3], &producerPrivateKey, shardID, shardView, map[string]bool{}); tx != nil {
+			t.Error("buildAutoWithdrawRewardResponseTx() should not pay a reward not above the fee")
+		}
+	})
+}
@@ -233,6 +233,14 @@ func (chain *BeaconChain) ValidatePreSignBlock(block common.BlockInterface) erro
 	return err
 }
 
+// PreValidateBlock verify beacon block without the view of its previous block, consensus run it while the previous block is being inserted
+func (chain *BeaconChain) PreValidateBlock(block common.BlockInterface) error {
+	if err := chain.Blockchain.config.ConsensusEngine.ValidateProducerSig(block, common.BlsConsensus); err != nil {
+		return err
+	}
+	return nil
+}
+
 // func (chain *BeaconChain) ValidateAndInsertBlock(block common.BlockInterface) error {
 // 	var beaconBestState BeaconBestState
 // 	beaconBlock := block.(*BeaconBlock)
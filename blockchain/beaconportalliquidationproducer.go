@@ -125,7 +125,7 @@ func (blockchain *BlockChain) checkAndBuildInstForCustodianLiquidation(
 	sort.Strings(sortedMatchedRedeemReqKeys)
 	for _, redeemReqKey := range sortedMatchedRedeemReqKeys {
 		redeemReq := currentPortalState.MatchedRedeemRequests[redeemReqKey]
-		if blockchain.checkBlockTimeIsReached(beaconHeight, redeemReq.GetBeaconHeight(), shardHeights[redeemReq.ShardID()], redeemReq.ShardHeight(), portalParams.TimeOutCustodianReturnPubToken) {
+		if blockchain.checkBlockTimeIsReached(beaconHeight, redeemReq.GetBeaconHeight(), shardHeights[redeemReq.ShardID()], redeemReq.ShardHeight(), portalParams.TimeOutCustodianReturnPubToken, portalParams) {
 			// get shardId of redeemer
 			redeemerKey, err := wallet.Base58CheckDeserialize(redeemReq.GetRedeemerAddress())
 			if err != nil {
@@ -356,8 +356,13 @@ func buildInstForExpiredPortingReqByPortingID(
 }
 
 // convertDurationTimeToBeaconBlocks returns number of beacon blocks corresponding to duration time
-func (blockchain *BlockChain) convertDurationTimeToBeaconBlocks(duration time.Duration) uint64 {
-	return uint64(duration.Seconds() / blockchain.config.ChainParams.MinBeaconBlockInterval.Seconds())
+// with the beacon block interval of portal params
+func (blockchain *BlockChain) convertDurationTimeToBeaconBlocks(duration time.Duration, portalParams PortalParams) uint64 {
+	beaconBlockInterval := portalParams.BeaconBlockInterval
+	if beaconBlockInterval == 0 {
+		beaconBlockInterval = blockchain.config.ChainParams.MinBeaconBlockInterval
+	}
+	return uint64(duration.Seconds() / beaconBlockInterval.Seconds())
 }
 
 // convertDurationTimeToShardBlocks returns number of shard blocks corresponding to duration time
@@ -366,8 +371,8 @@ func (blockchain *BlockChain) convertDurationTimeToShardBlocks(duration time.Dur
 }
 
 // convertDurationTimeToBeaconBlocks returns number of beacon blocks corresponding to duration time
-func (blockchain *BlockChain) checkBlockTimeIsReached(recentBeaconHeight, beaconHeight, recentShardHeight, shardHeight uint64, duration time.Duration) bool {
-	return (recentBeaconHeight+1)-beaconHeight >= blockchain.convertDurationTimeToBeaconBlocks(duration) &&
+func (blockchain *BlockChain) checkBlockTimeIsReached(recentBeaconHeight, beaconHeight, recentShardHeight, shardHeight uint64, duration time.Duration, portalParams PortalParams) bool {
+	return (recentBeaconHeight+1)-beaconHeight >= blockchain.convertDurationTimeToBeaconBlocks(duration, portalParams) &&
 		(recentShardHeight+1)-shardHeight >= blockchain.convertDurationTimeToShardBlocks(duration)
 }
 
@@ -385,7 +390,7 @@ func (blockchain *BlockChain) checkAndBuildInstForExpiredWaitingPortingRequest(
 	sort.Strings(sortedWaitingPortingReqKeys)
 	for _, portingReqKey := range sortedWaitingPortingReqKeys {
 		portingReq := currentPortalState.WaitingPortingRequests[portingReqKey]
-		if blockchain.checkBlockTimeIsReached(beaconHeight, portingReq.BeaconHeight(), shardHeights[portingReq.ShardID()], portingReq.ShardHeight(), portalParams.TimeOutWaitingPortingRequest) {
+		if blockchain.checkBlockTimeIsReached(beaconHeight, portingReq.BeaconHeight(), shardHeights[portingReq.ShardID()], portingReq.ShardHeight(), portalParams.TimeOutWaitingPortingRequest, portalParams) {
 			inst, err := buildInstForExpiredPortingReqByPortingID(
 				beaconHeight, currentPortalState, portingReqKey, portingReq, false)
 			if err != nil {
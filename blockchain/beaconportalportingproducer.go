@@ -177,7 +177,7 @@ func (p *portalPortingRequestProcessor) buildNewInsts(
 		actionData.Meta.PTokenId,
 		currentPortalState.CustodianPoolState,
 		currentPortalState.FinalExchangeRatesState,
-		bc.GetPortalParams(beaconHeight),
+		portalParams,
 	)
 	if err != nil || len(pickedCustodians) == 0 {
 		Logger.log.Errorf("Porting request: an error occurred while picking up custodians for the porting request: %+v", err)
@@ -9,7 +9,7 @@ import (
 	"strconv"
 )
 
-func (blockchain *BlockChain) processPortalInstructions(portalStateDB *statedb.StateDB, block *BeaconBlock) error {
+func (blockchain *BlockChain) processPortalInstructions(portalStateDB *statedb.StateDB, block *BeaconBlock, portalParams PortalParams) error {
 	// Note: should comment this code if you need to create local chain.
 	if blockchain.config.ChainParams.Net == Testnet && block.Header.Height < 1580600 {
 		return nil
@@ -21,8 +21,6 @@ func (blockchain *BlockChain) processPortalInstructions(portalStateDB *statedb.S
 		return nil
 	}
 
-	portalParams := blockchain.GetPortalParams(block.GetHeight())
-
 	// re-use update info of bridge
 	updatingInfoByTokenID := map[common.Hash]UpdatingInfo{}
 
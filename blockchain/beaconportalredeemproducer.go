@@ -379,7 +379,8 @@ func buildInstPickingCustodiansForTimeoutWaitingRedeem(
 func (blockchain *BlockChain) checkAndPickMoreCustodianForWaitingRedeemRequest(
 	beaconHeight uint64,
 	shardHeights map[byte]uint64,
-	currentPortalState *CurrentPortalState) ([][]string, error) {
+	currentPortalState *CurrentPortalState,
+	portalParams PortalParams) ([][]string, error) {
 	insts := [][]string{}
 	waitingRedeemKeys := []string{}
 	for key := range currentPortalState.WaitingRedeemRequests {
@@ -388,7 +389,7 @@ func (blockchain *BlockChain) checkAndPickMoreCustodianForWaitingRedeemRequest(
 	sort.Strings(waitingRedeemKeys)
 	for _, waitingRedeemKey := range waitingRedeemKeys {
 		waitingRedeem := currentPortalState.WaitingRedeemRequests[waitingRedeemKey]
-		if !blockchain.checkBlockTimeIsReached(beaconHeight, waitingRedeem.GetBeaconHeight(), shardHeights[waitingRedeem.ShardID()], waitingRedeem.ShardHeight(), blockchain.GetPortalParams(beaconHeight).TimeOutWaitingRedeemRequest) {
+		if !blockchain.checkBlockTimeIsReached(beaconHeight, waitingRedeem.GetBeaconHeight(), shardHeights[waitingRedeem.ShardID()], waitingRedeem.ShardHeight(), portalParams.TimeOutWaitingRedeemRequest, portalParams) {
 			continue
 		}
 
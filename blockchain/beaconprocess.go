@@ -194,7 +194,7 @@ func (blockchain *BlockChain) InsertBeaconBlock(beaconBlock *BeaconBlock, should
 	}
 
 	Logger.log.Infof("BEACON | Process Store Beacon Block Height %+v with hash %+v", beaconBlock.Header.Height, blockHash)
-	if err := blockchain.processStoreBeaconBlock(newBestState, beaconBlock, committeeChange); err != nil {
+	if err := blockchain.processStoreBeaconBlock(curView, newBestState, beaconBlock, committeeChange); err != nil {
 		return err
 	}
 
@@ -369,7 +369,7 @@ func (blockchain *BlockChain) verifyPreProcessingBeaconBlockForSigning(curView *
 	statefulActionsByShardID := map[byte][][]string{}
 	rewardForCustodianByEpoch := map[common.Hash]uint64{}
 
-	portalParams := blockchain.GetPortalParams(beaconBlock.GetHeight())
+	portalParams := blockchain.getPortalParams(curView, beaconBlock.GetHeight())
 
 	// Get Reward Instruction By Epoch
 	if beaconBlock.Header.Height%blockchain.config.ChainParams.Epoch == 1 {
@@ -924,6 +924,12 @@ func (beaconBestState *BeaconBestState) processInstruction(instruction []string,
 			}
 		}
 	}
+	if instruction[0] == SlashEquivocationAction {
+		beaconBestState.processSlashEquivocationInstruction(instruction, committeeChange)
+	}
+	if instruction[0] == strconv.Itoa(metadata.UnstakeRequestMeta) {
+		beaconBestState.processUnstakeInstruction(instruction, committeeChange)
+	}
 	if instruction[0] == SwapAction {
 		if common.IndexOfUint64(beaconBestState.BeaconHeight/blockchain.config.ChainParams.Epoch, blockchain.config.ChainParams.EpochBreakPointSwapNewKey) > -1 || len(instruction) == 7 {
 			err := beaconBestState.processSwapInstructionForKeyListV2(instruction, blockchain, committeeChange)
@@ -1331,6 +1337,7 @@ func (beaconBestState *BeaconBestState) processAutoStakingChange(committeeChange
 }
 
 func (blockchain *BlockChain) processStoreBeaconBlock(
+	curView *BeaconBestState,
 	newBestState *BeaconBestState,
 	beaconBlock *BeaconBlock,
 	committeeChange *committeeChange,
@@ -1423,41 +1430,14 @@ func (blockchain *BlockChain) processStoreBeaconBlock(
 	if beaconBlock.Header.Height%blockchain.config.ChainParams.Epoch == 2 {
 		statedb.RemoveRewardOfShardByEpoch(newBestState.rewardStateDB, beaconBlock.Header.Epoch-1)
 	}
-	err = blockchain.addShardRewardRequestToBeacon(beaconBlock, newBestState.rewardStateDB)
+	err = blockchain.addShardRewardRequestToBeacon(newBestState, beaconBlock, newBestState.rewardStateDB)
 	if err != nil {
 		return NewBlockChainError(UpdateDatabaseWithBlockRewardInfoError, err)
 	}
-	// execute, store
-	err = blockchain.processBridgeInstructions(newBestState.featureStateDB, beaconBlock)
-	if err != nil {
-		return NewBlockChainError(ProcessBridgeInstructionError, err)
-	}
-	// execute, store PDE instruction
-	err = blockchain.processPDEInstructions(newBestState.featureStateDB, beaconBlock)
-	if err != nil {
-		return NewBlockChainError(ProcessPDEInstructionError, err)
-	}
-	// Save result of BurningConfirm instruction to get proof later
-	metas := []string{ // Burning v2: sig on beacon only
-		strconv.Itoa(metadata.BurningConfirmMetaV2),
-		strconv.Itoa(metadata.BurningConfirmForDepositToSCMetaV2),
-	}
-	if err := blockchain.storeBurningConfirm(newBestState.featureStateDB, beaconBlock.Body.Instructions, beaconBlock.Header.Height, metas); err != nil {
-		return NewBlockChainError(StoreBurningConfirmError, err)
-	}
-
-	// execute, store Portal Instruction
-	//if (blockchain.config.ChainParams.Net == Mainnet) || (blockchain.config.ChainParams.Net == Testnet && beaconBlock.Header.Height > 1500000) {
-	err = blockchain.processPortalInstructions(newBestState.featureStateDB, beaconBlock)
+	// execute, store instructions of features
+	err = blockchain.processFeatureInstructions(newBestState, beaconBlock)
 	if err != nil {
-		return NewBlockChainError(ProcessPortalInstructionError, err)
-	}
-	//}
-
-	// execute, store Ralaying Instruction
-	err = blockchain.processRelayingInstructions(beaconBlock)
-	if err != nil {
-		return NewBlockChainError(ProcessPortalRelayingError, err)
+		return err
 	}
 
 	//store beacon block hash by index to consensus state db => mark this block hash is for this view at this height
@@ -1525,8 +1505,18 @@ func (blockchain *BlockChain) processStoreBeaconBlock(
 		return NewBlockChainError(StoreBeaconBlockError, err)
 	}
 
+	if err := storeTxReceiptEvents(batch, beaconBlock); err != nil {
+		return NewBlockChainError(StoreBeaconBlockError, err)
+	}
+
+	// validator stats are written with the block, so they always match the stored chain
+	if err := blockchain.storeBeaconValidatorStats(batch, curView, beaconBlock); err != nil {
+		Logger.log.Errorf("BEACON | Failed to store validator stats of block %+v with error: %+v", beaconBlock.Header.Height, err)
+	}
+
 	finalView := blockchain.BeaconChain.multiView.GetFinalView()
 
+	views := getViewsToFinalize(blockchain.BeaconChain.multiView, newBestState)
 	blockchain.BeaconChain.multiView.AddView(newBestState)
 
 	newFinalView := blockchain.BeaconChain.multiView.GetFinalView()
@@ -1539,20 +1529,24 @@ func (blockchain *BlockChain) processStoreBeaconBlock(
 		if err != nil {
 			return NewBlockChainError(StoreBeaconBlockError, err)
 		}
+		if view, ok := views[*storeBlock.Hash()]; ok {
+			if err := storePreviousBeaconBestState(batch, view.(*BeaconBestState)); err != nil {
+				return NewBlockChainError(StoreBeaconBlockError, err)
+			}
+		}
 		if storeBlock.GetHeight() == 1 {
 			break
 		}
 
 		finalizedBlocks = append(finalizedBlocks, storeBlock.(*BeaconBlock))
 		prevHash := storeBlock.GetPrevHash()
-		newFinalView = blockchain.BeaconChain.multiView.GetViewByHash(prevHash)
-		if newFinalView == nil {
+		if view, ok := views[prevHash]; ok {
+			storeBlock = view.GetBlock()
+		} else {
 			storeBlock, _, err = blockchain.GetBeaconBlockByHash(prevHash)
 			if err != nil {
 				panic("Database is corrupt")
 			}
-		} else {
-			storeBlock = newFinalView.GetBlock()
 		}
 	}
 
@@ -32,45 +32,8 @@ func (blockchain *BlockChain) collectStatefulActions(
 			Logger.log.Error(err)
 			continue
 		}
-		switch metaType {
-		case metadata.IssuingRequestMeta,
-			metadata.IssuingETHRequestMeta,
-			metadata.PDEContributionMeta,
-			metadata.PDETradeRequestMeta,
-			metadata.PDEWithdrawalRequestMeta,
-			metadata.PDEFeeWithdrawalRequestMeta,
-			metadata.PDEPRVRequiredContributionRequestMeta,
-			metadata.PDECrossPoolTradeRequestMeta,
-			metadata.PortalCustodianDepositMeta,
-			metadata.PortalRequestPortingMeta,
-			metadata.PortalUserRequestPTokenMeta,
-			metadata.PortalExchangeRatesMeta,
-			metadata.PortalUnlockOverRateCollateralsMeta,
-			metadata.RelayingBNBHeaderMeta,
-			metadata.RelayingBTCHeaderMeta,
-			metadata.PortalCustodianWithdrawRequestMeta,
-			metadata.PortalRedeemRequestMeta,
-			metadata.PortalRequestUnlockCollateralMeta,
-			metadata.PortalRequestUnlockCollateralMetaV3,
-			metadata.PortalLiquidateCustodianMeta,
-			metadata.PortalLiquidateCustodianMetaV3,
-			metadata.PortalRequestWithdrawRewardMeta,
-			metadata.PortalRedeemFromLiquidationPoolMeta,
-			metadata.PortalCustodianTopupMetaV2,
-			metadata.PortalCustodianTopupResponseMeta,
-			metadata.PortalReqMatchingRedeemMeta,
-			metadata.PortalTopUpWaitingPortingRequestMeta,
-			metadata.PortalCustodianDepositMetaV3,
-			metadata.PortalCustodianWithdrawRequestMetaV3,
-			metadata.PortalRedeemFromLiquidationPoolMetaV3,
-			metadata.PortalCustodianTopupMetaV3,
-			metadata.PortalTopUpWaitingPortingRequestMetaV3,
-			metadata.PortalRequestPortingMetaV3,
-			metadata.PortalRedeemRequestMetaV3:
+		if _, ok := getStatefulActionFeature(metaType); ok {
 			statefulInsts = append(statefulInsts, inst)
-
-		default:
-			continue
 		}
 	}
 	return statefulInsts
@@ -97,37 +60,15 @@ func (blockchain *BlockChain) buildStatefulInstructions(
 	beaconHeight uint64,
 	rewardForCustodianByEpoch map[common.Hash]uint64,
 	portalParams PortalParams) [][]string {
-	currentPDEState, err := InitCurrentPDEStateFromDB(featureStateDB, beaconHeight-1)
-	if err != nil {
-		Logger.log.Error(err)
-	}
-
-	currentPortalState, err := InitCurrentPortalStateFromDB(featureStateDB)
-	if err != nil {
-		Logger.log.Error(err)
-	}
-
-	pm := NewPortalManager()
-	relayingHeaderState, err := blockchain.InitRelayingHeaderChainStateFromDB()
-	if err != nil {
-		Logger.log.Error(err)
-	}
-
-	accumulatedValues := &metadata.AccumulatedValues{
-		UniqETHTxsUsed:   [][]byte{},
-		DBridgeTokenPair: map[string][]byte{},
-		CBridgeTokens:    []*common.Hash{},
-	}
+	features, builders := blockchain.newInstructionBuilders(&InstructionBuilderEnv{
+		BeaconBestState:           beaconBestState,
+		FeatureStateDB:            featureStateDB,
+		BeaconHeight:              beaconHeight,
+		RewardForCustodianByEpoch: rewardForCustodianByEpoch,
+		PortalParams:              portalParams,
+	})
 	instructions := [][]string{}
 
-	// pde instructions
-	pdeContributionActionsByShardID := map[byte][][]string{}
-	pdePRVRequiredContributionActionsByShardID := map[byte][][]string{}
-	pdeTradeActionsByShardID := map[byte][][]string{}
-	pdeCrossPoolTradeActionsByShardID := map[byte][][]string{}
-	pdeWithdrawalActionsByShardID := map[byte][][]string{}
-	pdeFeeWithdrawalActionsByShardID := map[byte][][]string{}
-
 	var keys []int
 	for k := range statefulActionsByShardID {
 		keys = append(keys, int(k))
@@ -141,95 +82,15 @@ func (blockchain *BlockChain) buildStatefulInstructions(
 			if err != nil {
 				continue
 			}
-			contentStr := action[1]
-			newInst := [][]string{}
-			switch metaType {
-			case metadata.IssuingRequestMeta:
-				newInst, err = blockchain.buildInstructionsForIssuingReq(beaconBestState, featureStateDB, contentStr, shardID, metaType, accumulatedValues)
-
-			case metadata.IssuingETHRequestMeta:
-				newInst, err = blockchain.buildInstructionsForIssuingETHReq(beaconBestState, featureStateDB, contentStr, shardID, metaType, accumulatedValues)
-
-			case metadata.PDEContributionMeta:
-				pdeContributionActionsByShardID = groupPDEActionsByShardID(
-					pdeContributionActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PDEPRVRequiredContributionRequestMeta:
-				pdePRVRequiredContributionActionsByShardID = groupPDEActionsByShardID(
-					pdePRVRequiredContributionActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PDETradeRequestMeta:
-				pdeTradeActionsByShardID = groupPDEActionsByShardID(
-					pdeTradeActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PDECrossPoolTradeRequestMeta:
-				pdeCrossPoolTradeActionsByShardID = groupPDEActionsByShardID(
-					pdeCrossPoolTradeActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PDEWithdrawalRequestMeta:
-				pdeWithdrawalActionsByShardID = groupPDEActionsByShardID(
-					pdeWithdrawalActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PDEFeeWithdrawalRequestMeta:
-				pdeFeeWithdrawalActionsByShardID = groupPDEActionsByShardID(
-					pdeFeeWithdrawalActionsByShardID,
-					action,
-					shardID,
-				)
-			case metadata.PortalCustodianDepositMeta:
-				pm.portalInstructions[metadata.PortalCustodianDepositMeta].putAction(action, shardID)
-			case metadata.PortalRequestPortingMeta, metadata.PortalRequestPortingMetaV3:
-				pm.portalInstructions[metadata.PortalRequestPortingMeta].putAction(action, shardID)
-			case metadata.PortalUserRequestPTokenMeta:
-				pm.portalInstructions[metadata.PortalUserRequestPTokenMeta].putAction(action, shardID)
-			case metadata.PortalExchangeRatesMeta:
-				pm.portalInstructions[metadata.PortalExchangeRatesMeta].putAction(action, shardID)
-			case metadata.PortalCustodianWithdrawRequestMeta:
-				pm.portalInstructions[metadata.PortalCustodianWithdrawRequestMeta].putAction(action, shardID)
-			case metadata.PortalUnlockOverRateCollateralsMeta:
-				pm.portalInstructions[metadata.PortalUnlockOverRateCollateralsMeta].putAction(action, shardID)
-
-			case metadata.PortalRedeemRequestMeta, metadata.PortalRedeemRequestMetaV3:
-				pm.portalInstructions[metadata.PortalRedeemRequestMeta].putAction(action, shardID)
-			case metadata.PortalRequestUnlockCollateralMeta, metadata.PortalRequestUnlockCollateralMetaV3:
-				pm.portalInstructions[metadata.PortalRequestUnlockCollateralMeta].putAction(action, shardID)
-			case metadata.PortalRequestWithdrawRewardMeta:
-				pm.portalInstructions[metadata.PortalRequestWithdrawRewardMeta].putAction(action, shardID)
-			case metadata.PortalRedeemFromLiquidationPoolMetaV3:
-				pm.portalInstructions[metadata.PortalRedeemFromLiquidationPoolMetaV3].putAction(action, shardID)
-			case metadata.PortalCustodianTopupMetaV2:
-				pm.portalInstructions[metadata.PortalCustodianTopupMetaV2].putAction(action, shardID)
-			case metadata.PortalReqMatchingRedeemMeta:
-				pm.portalInstructions[metadata.PortalReqMatchingRedeemMeta].putAction(action, shardID)
-			case metadata.PortalTopUpWaitingPortingRequestMeta:
-				pm.portalInstructions[metadata.PortalTopUpWaitingPortingRequestMeta].putAction(action, shardID)
-			case metadata.PortalCustodianTopupMetaV3:
-				pm.portalInstructions[metadata.PortalCustodianTopupMetaV3].putAction(action, shardID)
-			case metadata.PortalTopUpWaitingPortingRequestMetaV3:
-				pm.portalInstructions[metadata.PortalTopUpWaitingPortingRequestMetaV3].putAction(action, shardID)
-
-			case metadata.PortalCustodianDepositMetaV3:
-				pm.portalInstructions[metadata.PortalCustodianDepositMetaV3].putAction(action, shardID)
-			case metadata.PortalCustodianWithdrawRequestMetaV3:
-				pm.portalInstructions[metadata.PortalCustodianWithdrawRequestMetaV3].putAction(action, shardID)
-
-			case metadata.RelayingBNBHeaderMeta:
-				pm.relayingChains[metadata.RelayingBNBHeaderMeta].putAction(action)
-			case metadata.RelayingBTCHeaderMeta:
-				pm.relayingChains[metadata.RelayingBTCHeaderMeta].putAction(action)
-			default:
+			feature, ok := getStatefulActionFeature(metaType)
+			if !ok {
 				continue
 			}
+			builder, ok := builders[feature.Name()]
+			if !ok {
+				continue
+			}
+			newInst, err := builder.PutAction(metaType, action, shardID)
 			if err != nil {
 				Logger.log.Error(err)
 				continue
@@ -240,48 +101,21 @@ func (blockchain *BlockChain) buildStatefulInstructions(
 		}
 	}
 
-	pdeInsts, err := blockchain.handlePDEInsts(
-		beaconHeight-1, currentPDEState,
-		pdeContributionActionsByShardID,
-		pdePRVRequiredContributionActionsByShardID,
-		pdeTradeActionsByShardID,
-		pdeCrossPoolTradeActionsByShardID,
-		pdeWithdrawalActionsByShardID,
-		pdeFeeWithdrawalActionsByShardID,
-	)
-
-	if err != nil {
-		Logger.log.Error(err)
-		return instructions
-	}
-	if len(pdeInsts) > 0 {
-		instructions = append(instructions, pdeInsts...)
-	}
-
-	// handle portal instructions
-	portalInsts, err := blockchain.handlePortalInsts(
-		featureStateDB,
-		beaconHeight-1,
-		currentPortalState,
-		rewardForCustodianByEpoch,
-		portalParams,
-		pm,
-	)
-
-	if err != nil {
-		Logger.log.Error(err)
-		return instructions
-	}
-	if len(portalInsts) > 0 {
-		instructions = append(instructions, portalInsts...)
-	}
-
-	// handle relaying instructions
-	relayingInsts := blockchain.handleRelayingInsts(relayingHeaderState, pm)
-	if len(relayingInsts) > 0 {
-		instructions = append(instructions, relayingInsts...)
+	// a feature failing to build stops the following ones
+	for _, feature := range features {
+		builder, ok := builders[feature.Name()]
+		if !ok {
+			continue
+		}
+		newInsts, err := builder.Build()
+		if err != nil {
+			Logger.log.Error(err)
+			return instructions
+		}
+		if len(newInsts) > 0 {
+			instructions = append(instructions, newInsts...)
+		}
 	}
-
 	return instructions
 }
 
@@ -698,6 +532,7 @@ func (blockchain *BlockChain) handlePortalInsts(
 		beaconHeight,
 		shardHeights,
 		currentPortalState,
+		portalParams,
 	)
 	if err != nil {
 		Logger.log.Error(err)
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/incognitochain/incognito-chain/blockchain/btc"
@@ -61,6 +62,7 @@ type Config struct {
 	Server            Server
 	ConsensusEngine   ConsensusEngine
 	Highway           Highway
+	ArchiveMode       bool // keep state tries of blocks inserted in batch, other blocks always keep them
 
 	relayShardLck sync.Mutex
 }
@@ -72,9 +74,7 @@ func NewBlockChain(config *Config, isTest bool) *BlockChain {
 	bc.IsTest = isTest
 	bc.beaconViewCache, _ = lru.New(100)
 	bc.cQuitSync = make(chan struct{})
-	bc.GetBeaconBestState().Params = make(map[string]string)
-	bc.GetBeaconBestState().ShardCommittee = make(map[byte][]incognitokey.CommitteePublicKey)
-	bc.GetBeaconBestState().ShardPendingValidator = make(map[byte][]incognitokey.CommitteePublicKey)
+	// the beacon chain and its best state are created by Init
 	return bc
 }
 
@@ -89,6 +89,10 @@ func (blockchain *BlockChain) Init(config *Config) error {
 	if config.ChainParams == nil {
 		return NewBlockChainError(UnExpectedError, errors.New("Chain parameters is not config"))
 	}
+	// features registered by other modules must be consistent with their metadata types
+	if err := validateFeatures(); err != nil {
+		return NewBlockChainError(UnExpectedError, err)
+	}
 	blockchain.config = *config
 	blockchain.config.IsBlockGenStarted = false
 	blockchain.IsTest = false
@@ -186,7 +190,7 @@ func (blockchain *BlockChain) InitShardState(shardID byte) error {
 	committeeChange := newCommitteeChange()
 	committeeChange.shardCommitteeAdded[shardID] = initShardState.GetShardCommittee()
 
-	err = blockchain.processStoreShardBlock(initShardState, &initShardBlock, committeeChange, []*BeaconBlock{genesisBeaconBlock})
+	err = blockchain.processStoreShardBlock(initShardState, &initShardBlock, committeeChange, []*BeaconBlock{genesisBeaconBlock}, nil)
 	if err != nil {
 		return err
 	}
@@ -630,8 +634,29 @@ func (blockchain *BlockChain) GetConfig() *Config {
 	return &blockchain.config
 }
 
-// GetPortalParams returns portal params in beaconheight
+// GetPortalParams returns portal params in beaconheight with the params activated by governance in the beacon best state
 func (blockchain *BlockChain) GetPortalParams(beaconHeight uint64) PortalParams {
+	return blockchain.getPortalParams(blockchain.getBestBeaconView(), beaconHeight)
+}
+
+// getPortalParams returns portal params in beaconheight, ratios, timeouts, fees and the beacon block interval
+// activated by governance in the beacon view of the block being processed replace the ones of chain params
+func (blockchain *BlockChain) getPortalParams(beaconView *BeaconBestState, beaconHeight uint64) PortalParams {
+	portalParams := blockchain.getChainPortalParams(beaconHeight)
+	portalParams.TP120 = blockchain.getGovernanceParam(beaconView, PortalTP120Param, beaconHeight)
+	portalParams.TP130 = blockchain.getGovernanceParam(beaconView, PortalTP130Param, beaconHeight)
+	portalParams.MinPercentLockedCollateral = blockchain.getGovernanceParam(beaconView, PortalMinPercentLockedCollateralParam, beaconHeight)
+	portalParams.MaxPercentLiquidatedCollateralAmount = blockchain.getGovernanceParam(beaconView, PortalMaxPercentLiquidatedCollateralParam, beaconHeight)
+	portalParams.TimeOutCustodianReturnPubToken = time.Duration(blockchain.getGovernanceParam(beaconView, PortalTimeOutCustodianReturnPubTokenParam, beaconHeight)) * time.Second
+	portalParams.TimeOutWaitingPortingRequest = time.Duration(blockchain.getGovernanceParam(beaconView, PortalTimeOutWaitingPortingRequestParam, beaconHeight)) * time.Second
+	portalParams.TimeOutWaitingRedeemRequest = time.Duration(blockchain.getGovernanceParam(beaconView, PortalTimeOutWaitingRedeemRequestParam, beaconHeight)) * time.Second
+	portalParams.MinPortalFee = blockchain.getGovernanceParam(beaconView, PortalMinPortalFeeParam, beaconHeight)
+	portalParams.BeaconBlockInterval = time.Duration(blockchain.getGovernanceParam(beaconView, MinBeaconBlockIntervalParam, beaconHeight)) * time.Second
+	return portalParams
+}
+
+// getChainPortalParams returns portal params of chain params in beaconheight
+func (blockchain *BlockChain) getChainPortalParams(beaconHeight uint64) PortalParams {
 	portalParamMap := blockchain.GetConfig().ChainParams.PortalParams
 	// only has one value - default value
 	if len(portalParamMap) == 1 {
@@ -728,13 +753,13 @@ func (blockchain *BlockChain) GetBeaconViewStateDataFromBlockHash(blockHash comm
 }
 
 // GetFixedRandomForShardIDCommitment returns the fixed randomness for shardID commitments
-// if bc height is greater than or equal to BCHeightBreakPointFixRandShardCM
+// if NewZKPFeature is active at bc height
 // otherwise, return nil
 func (blockchain *BlockChain) GetFixedRandomForShardIDCommitment(beaconHeight uint64) *privacy.Scalar {
 	if beaconHeight == 0 {
 		beaconHeight = blockchain.GetBeaconBestState().GetHeight()
 	}
-	if beaconHeight >= blockchain.GetConfig().ChainParams.BCHeightBreakPointNewZKP {
+	if blockchain.IsFeatureActive(common.NewZKPFeature, beaconHeight) {
 		return privacy.FixedRandomnessShardID
 	}
 
@@ -746,7 +771,7 @@ func (blockchain *BlockChain) IsAfterNewZKPCheckPoint(beaconHeight uint64) bool
 		beaconHeight = blockchain.GetBeaconBestState().GetHeight()
 	}
 
-	return beaconHeight >= blockchain.GetConfig().ChainParams.BCHeightBreakPointNewZKP
+	return blockchain.IsFeatureActive(common.NewZKPFeature, beaconHeight)
 }
 
 func (s *BlockChain) GetChainParams() *Params {
@@ -0,0 +1,646 @@
+package blockchain
+
+import (
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// Built-in features are registered in this order, which is the order of the instructions they build
+// after all actions of a beacon block and the order they process instructions of a beacon block
+func init() {
+	mustRegisterFeature(&bridgeFeature{FeatureBase: NewFeatureBase(metadata.BridgeFeature)})
+	mustRegisterFeature(&slashFeature{FeatureBase: NewFeatureBase(metadata.SlashFeature)})
+	mustRegisterFeature(&stakingFeature{FeatureBase: NewFeatureBase(metadata.StakingFeature)})
+	mustRegisterFeature(&rewardFeature{FeatureBase: NewFeatureBase(metadata.RewardFeature)})
+	mustRegisterFeature(&pdeFeature{FeatureBase: NewFeatureBase(metadata.PDEFeature)})
+	mustRegisterFeature(&portalFeature{FeatureBase: NewFeatureBase(metadata.PortalFeature)})
+	mustRegisterFeature(&relayingFeature{FeatureBase: NewFeatureBase(metadata.RelayingFeature)})
+	mustRegisterFeature(&governanceFeature{FeatureBase: NewFeatureBase(metadata.GovernanceFeature)})
+}
+
+// ============================= Bridge =======================================
+type bridgeFeature struct {
+	*FeatureBase
+}
+
+func (feature bridgeFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.IssuingRequestMeta,
+		metadata.IssuingETHRequestMeta,
+	}
+}
+
+type bridgeInstructionBuilder struct {
+	blockchain        *BlockChain
+	env               *InstructionBuilderEnv
+	accumulatedValues *metadata.AccumulatedValues
+}
+
+func (feature bridgeFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return &bridgeInstructionBuilder{
+		blockchain: blockchain,
+		env:        env,
+		accumulatedValues: &metadata.AccumulatedValues{
+			UniqETHTxsUsed:   [][]byte{},
+			DBridgeTokenPair: map[string][]byte{},
+			CBridgeTokens:    []*common.Hash{},
+		},
+	}
+}
+
+func (builder *bridgeInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	switch metaType {
+	case metadata.IssuingRequestMeta:
+		return builder.blockchain.buildInstructionsForIssuingReq(builder.env.BeaconBestState, builder.env.FeatureStateDB, action[1], shardID, metaType, builder.accumulatedValues)
+	case metadata.IssuingETHRequestMeta:
+		return builder.blockchain.buildInstructionsForIssuingETHReq(builder.env.BeaconBestState, builder.env.FeatureStateDB, action[1], shardID, metaType, builder.accumulatedValues)
+	}
+	return nil, nil
+}
+
+func (builder *bridgeInstructionBuilder) Build() ([][]string, error) {
+	return nil, nil
+}
+
+func (feature bridgeFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	err := blockchain.processBridgeInstructions(newBestState.featureStateDB, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessBridgeInstructionError, err)
+	}
+	// Save result of BurningConfirm instruction to get proof later
+	metas := []string{ // Burning v2: sig on beacon only
+		strconv.Itoa(metadata.BurningConfirmMetaV2),
+		strconv.Itoa(metadata.BurningConfirmForDepositToSCMetaV2),
+	}
+	if err := blockchain.storeBurningConfirm(newBestState.featureStateDB, beaconBlock.Body.Instructions, beaconBlock.Header.Height, metas); err != nil {
+		return NewBlockChainError(StoreBurningConfirmError, err)
+	}
+	return nil
+}
+
+type bridgeResponseBuilder struct {
+	blockGenerator *BlockGenerator
+	env            *ResponseBuilderEnv
+}
+
+func (feature bridgeFeature) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return &bridgeResponseBuilder{blockGenerator: blockGenerator, env: env}
+}
+
+func (builder *bridgeResponseBuilder) BuildResponseTx(metaType int, l []string) (metadata.Transaction, error) {
+	if len(l) < 4 || l[2] != "accepted" {
+		return nil, nil
+	}
+	env := builder.env
+	switch metaType {
+	case metadata.IssuingETHRequestMeta:
+		return builder.blockGenerator.buildETHIssuanceTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+	case metadata.IssuingRequestMeta:
+		return builder.blockGenerator.buildIssuanceTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+	}
+	return nil, nil
+}
+
+// ============================= Slash =======================================
+type slashFeature struct {
+	*FeatureBase
+}
+
+func (feature slashFeature) StatefulActionTypes() []int {
+	return []int{metadata.SlashEquivocationRequestMeta}
+}
+
+type slashInstructionBuilder struct {
+	blockchain        *BlockChain
+	env               *InstructionBuilderEnv
+	slashedPublicKeys map[string]bool
+}
+
+func (feature slashFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return &slashInstructionBuilder{
+		blockchain:        blockchain,
+		env:               env,
+		slashedPublicKeys: map[string]bool{},
+	}
+}
+
+func (builder *slashInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	return builder.blockchain.buildInstructionsForSlashEquivocationReq(builder.env.BeaconBestState, action[1], builder.slashedPublicKeys)
+}
+
+func (builder *slashInstructionBuilder) Build() ([][]string, error) {
+	return nil, nil
+}
+
+// ============================= Staking =======================================
+type stakingFeature struct {
+	*FeatureBase
+}
+
+func (feature stakingFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.StakingPoolRegisterMeta,
+		metadata.StakingPoolDelegateMeta,
+		metadata.UnstakeRequestMeta,
+		metadata.ShardStakingMeta,
+		metadata.BeaconStakingMeta,
+	}
+}
+
+type stakingInstructionBuilder struct {
+	blockchain      *BlockChain
+	env             *InstructionBuilderEnv
+	unstakedAmounts map[string]uint64
+}
+
+func (feature stakingFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return &stakingInstructionBuilder{
+		blockchain:      blockchain,
+		env:             env,
+		unstakedAmounts: map[string]uint64{},
+	}
+}
+
+func (builder *stakingInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	switch metaType {
+	case metadata.StakingPoolRegisterMeta:
+		return builder.blockchain.buildInstructionsForStakingPoolRegisterReq(builder.env.BeaconBestState, action[1], shardID, metaType)
+	case metadata.StakingPoolDelegateMeta:
+		return builder.blockchain.buildInstructionsForStakingPoolDelegateReq(builder.env.BeaconBestState, action[1], shardID, metaType)
+	case metadata.UnstakeRequestMeta:
+		return builder.blockchain.buildInstructionsForUnstakeReq(builder.env.BeaconBestState, action[1], shardID, builder.unstakedAmounts)
+	case metadata.ShardStakingMeta, metadata.BeaconStakingMeta:
+		return buildInstructionsForStakingAmount(action[1], shardID, metaType)
+	}
+	return nil, nil
+}
+
+// Build release unbonding stakes
+func (builder *stakingInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.buildUnbondingReleaseInstructions(builder.env.BeaconBestState), nil
+}
+
+func (feature stakingFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// store staking pools and delegations
+	err := blockchain.processStakingPoolInstructions(newBestState.consensusStateDB, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessStakingPoolInstructionError, err)
+	}
+	// store unbonding stakes
+	err = blockchain.processUnstakeInstructions(newBestState, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessUnstakeInstructionError, err)
+	}
+	// store staking amounts of new stakers
+	err = processStakingAmountInstructions(newBestState.consensusStateDB, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessStakingAmountInstructionError, err)
+	}
+	return nil
+}
+
+type stakingResponseBuilder struct {
+	blockGenerator *BlockGenerator
+	env            *ResponseBuilderEnv
+}
+
+func (feature stakingFeature) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return &stakingResponseBuilder{blockGenerator: blockGenerator, env: env}
+}
+
+func (builder *stakingResponseBuilder) BuildResponseTx(metaType int, l []string) (metadata.Transaction, error) {
+	if metaType == metadata.UnstakeRequestMeta && len(l) >= 4 && l[2] == common.UnstakeReleasedChainStatus {
+		return builder.blockGenerator.buildUnstakeResponseTx(l[3], builder.env.ProducerPrivateKey, builder.env.ShardID, builder.env.ShardView)
+	}
+	if metaType == metadata.StakingPoolDelegateMeta && len(l) >= 4 && l[2] == common.StakingPoolRequestRejectedChainStatus {
+		return builder.blockGenerator.buildStakingPoolDelegateRefundTx(l[3], builder.env.ProducerPrivateKey, builder.env.ShardID, builder.env.ShardView)
+	}
+	return nil, nil
+}
+
+// ============================= Reward =======================================
+type rewardFeature struct {
+	*FeatureBase
+}
+
+func (feature rewardFeature) StatefulActionTypes() []int {
+	return []int{metadata.AutoWithdrawRewardPolicyMeta}
+}
+
+type rewardInstructionBuilder struct {
+	blockchain *BlockChain
+	env        *InstructionBuilderEnv
+}
+
+func (feature rewardFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return &rewardInstructionBuilder{blockchain: blockchain, env: env}
+}
+
+func (builder *rewardInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	return builder.blockchain.buildInstructionsForAutoWithdrawRewardPolicy(action[1], shardID)
+}
+
+// Build withdraw rewards of due auto withdraw policies
+func (builder *rewardInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.buildAutoWithdrawRewardInstructions(builder.env.BeaconBestState, builder.env.BeaconHeight), nil
+}
+
+func (feature rewardFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// store auto withdraw reward policies
+	err := blockchain.processAutoWithdrawRewardInstructions(newBestState.consensusStateDB, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessAutoWithdrawRewardInstructionError, err)
+	}
+	return nil
+}
+
+type rewardResponseBuilder struct {
+	blockGenerator         *BlockGenerator
+	env                    *ResponseBuilderEnv
+	autoWithdrawnReceivers map[string]bool
+}
+
+func (feature rewardFeature) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return &rewardResponseBuilder{
+		blockGenerator:         blockGenerator,
+		env:                    env,
+		autoWithdrawnReceivers: make(map[string]bool),
+	}
+}
+
+func (builder *rewardResponseBuilder) BuildResponseTx(metaType int, l []string) (metadata.Transaction, error) {
+	if metaType == metadata.AutoWithdrawRewardPolicyMeta && len(l) >= 4 && l[2] == common.AutoWithdrawRewardDueChainStatus {
+		return builder.blockGenerator.buildAutoWithdrawRewardResponseTx(l[3], builder.env.ProducerPrivateKey, builder.env.ShardID, builder.env.ShardView, builder.autoWithdrawnReceivers)
+	}
+	return nil, nil
+}
+
+// ============================= PDE =======================================
+type pdeFeature struct {
+	*FeatureBase
+}
+
+func (feature pdeFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.PDEContributionMeta,
+		metadata.PDETradeRequestMeta,
+		metadata.PDEWithdrawalRequestMeta,
+		metadata.PDEFeeWithdrawalRequestMeta,
+		metadata.PDEPRVRequiredContributionRequestMeta,
+		metadata.PDECrossPoolTradeRequestMeta,
+	}
+}
+
+type pdeInstructionBuilder struct {
+	blockchain        *BlockChain
+	env               *InstructionBuilderEnv
+	currentPDEState   *CurrentPDEState
+	actionsByMetaType map[int]map[byte][][]string
+}
+
+func (feature pdeFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	currentPDEState, err := InitCurrentPDEStateFromDB(env.FeatureStateDB, env.BeaconHeight-1)
+	if err != nil {
+		Logger.log.Error(err)
+	}
+	actionsByMetaType := map[int]map[byte][][]string{}
+	for _, metaType := range feature.StatefulActionTypes() {
+		actionsByMetaType[metaType] = map[byte][][]string{}
+	}
+	return &pdeInstructionBuilder{
+		blockchain:        blockchain,
+		env:               env,
+		currentPDEState:   currentPDEState,
+		actionsByMetaType: actionsByMetaType,
+	}
+}
+
+func (builder *pdeInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	builder.actionsByMetaType[metaType] = groupPDEActionsByShardID(builder.actionsByMetaType[metaType], action, shardID)
+	return nil, nil
+}
+
+func (builder *pdeInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.handlePDEInsts(
+		builder.env.BeaconHeight-1, builder.currentPDEState,
+		builder.actionsByMetaType[metadata.PDEContributionMeta],
+		builder.actionsByMetaType[metadata.PDEPRVRequiredContributionRequestMeta],
+		builder.actionsByMetaType[metadata.PDETradeRequestMeta],
+		builder.actionsByMetaType[metadata.PDECrossPoolTradeRequestMeta],
+		builder.actionsByMetaType[metadata.PDEWithdrawalRequestMeta],
+		builder.actionsByMetaType[metadata.PDEFeeWithdrawalRequestMeta],
+	)
+}
+
+func (feature pdeFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// execute, store PDE instruction
+	err := blockchain.processPDEInstructions(newBestState.featureStateDB, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessPDEInstructionError, err)
+	}
+	return nil
+}
+
+type pdeResponseBuilder struct {
+	blockGenerator *BlockGenerator
+	env            *ResponseBuilderEnv
+}
+
+func (feature pdeFeature) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return &pdeResponseBuilder{blockGenerator: blockGenerator, env: env}
+}
+
+func (builder *pdeResponseBuilder) BuildResponseTx(metaType int, l []string) (metadata.Transaction, error) {
+	if len(l) < 4 {
+		return nil, nil
+	}
+	blockGenerator := builder.blockGenerator
+	env := builder.env
+	switch metaType {
+	case metadata.PDETradeRequestMeta:
+		return blockGenerator.buildPDETradeIssuanceTx(l[2], l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+	case metadata.PDECrossPoolTradeRequestMeta:
+		return blockGenerator.buildPDECrossPoolTradeIssuanceTx(l[2], l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+	case metadata.PDEWithdrawalRequestMeta:
+		if l[2] == common.PDEWithdrawalAcceptedChainStatus {
+			return blockGenerator.buildPDEWithdrawalTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+		}
+	case metadata.PDEFeeWithdrawalRequestMeta:
+		if l[2] == common.PDEFeeWithdrawalAcceptedChainStatus {
+			return blockGenerator.buildPDEFeeWithdrawalTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+		}
+	case metadata.PDEContributionMeta, metadata.PDEPRVRequiredContributionRequestMeta:
+		if l[2] == common.PDEContributionRefundChainStatus {
+			return blockGenerator.buildPDERefundContributionTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+		} else if l[2] == common.PDEContributionMatchedNReturnedChainStatus {
+			return blockGenerator.buildPDEMatchedNReturnedContributionTx(l[3], env.ProducerPrivateKey, env.ShardID, env.ShardView, env.BeaconView)
+		}
+	}
+	return nil, nil
+}
+
+// ============================= Portal =======================================
+type portalFeature struct {
+	*FeatureBase
+}
+
+// StatefulActionTypes liquidation of custodians and V1 redeem from liquidation pool are not built from actions
+func (feature portalFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.PortalCustodianDepositMeta,
+		metadata.PortalRequestPortingMeta,
+		metadata.PortalUserRequestPTokenMeta,
+		metadata.PortalExchangeRatesMeta,
+		metadata.PortalUnlockOverRateCollateralsMeta,
+		metadata.PortalCustodianWithdrawRequestMeta,
+		metadata.PortalRedeemRequestMeta,
+		metadata.PortalRequestUnlockCollateralMeta,
+		metadata.PortalRequestUnlockCollateralMetaV3,
+		metadata.PortalRequestWithdrawRewardMeta,
+		metadata.PortalCustodianTopupMetaV2,
+		metadata.PortalReqMatchingRedeemMeta,
+		metadata.PortalTopUpWaitingPortingRequestMeta,
+		metadata.PortalCustodianDepositMetaV3,
+		metadata.PortalCustodianWithdrawRequestMetaV3,
+		metadata.PortalRedeemFromLiquidationPoolMetaV3,
+		metadata.PortalCustodianTopupMetaV3,
+		metadata.PortalTopUpWaitingPortingRequestMetaV3,
+		metadata.PortalRequestPortingMetaV3,
+		metadata.PortalRedeemRequestMetaV3,
+	}
+}
+
+type portalInstructionBuilder struct {
+	blockchain         *BlockChain
+	env                *InstructionBuilderEnv
+	currentPortalState *CurrentPortalState
+	pm                 *portalManager
+}
+
+func (feature portalFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	currentPortalState, err := InitCurrentPortalStateFromDB(env.FeatureStateDB)
+	if err != nil {
+		Logger.log.Error(err)
+	}
+	return &portalInstructionBuilder{
+		blockchain:         blockchain,
+		env:                env,
+		currentPortalState: currentPortalState,
+		pm:                 NewPortalManager(),
+	}
+}
+
+// PutAction V3 requests of porting, redeem and unlock collateral are processed with V2 requests
+func (builder *portalInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	switch metaType {
+	case metadata.PortalRequestPortingMetaV3:
+		metaType = metadata.PortalRequestPortingMeta
+	case metadata.PortalRedeemRequestMetaV3:
+		metaType = metadata.PortalRedeemRequestMeta
+	case metadata.PortalRequestUnlockCollateralMetaV3:
+		metaType = metadata.PortalRequestUnlockCollateralMeta
+	}
+	if processor, ok := builder.pm.portalInstructions[metaType]; ok {
+		processor.putAction(action, shardID)
+	}
+	return nil, nil
+}
+
+func (builder *portalInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.handlePortalInsts(
+		builder.env.FeatureStateDB,
+		builder.env.BeaconHeight-1,
+		builder.currentPortalState,
+		builder.env.RewardForCustodianByEpoch,
+		builder.env.PortalParams,
+		builder.pm,
+	)
+}
+
+func (feature portalFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// execute, store Portal Instruction
+	err := blockchain.processPortalInstructions(newBestState.featureStateDB, beaconBlock, blockchain.getPortalParams(newBestState, beaconBlock.GetHeight()))
+	if err != nil {
+		return NewBlockChainError(ProcessPortalInstructionError, err)
+	}
+	return nil
+}
+
+type portalResponseBuilder struct {
+	blockGenerator *BlockGenerator
+	env            *ResponseBuilderEnv
+}
+
+func (feature portalFeature) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return &portalResponseBuilder{blockGenerator: blockGenerator, env: env}
+}
+
+func (builder *portalResponseBuilder) BuildResponseTx(metaType int, l []string) (metadata.Transaction, error) {
+	if len(l) < 4 {
+		return nil, nil
+	}
+	curView := builder.env.ShardView
+	producerPrivateKey := builder.env.ProducerPrivateKey
+	shardID := builder.env.ShardID
+	switch metaType {
+	case metadata.PortalRequestPortingMeta, metadata.PortalRequestPortingMetaV3:
+		if l[2] == common.PortalPortingRequestRejectedChainStatus {
+			return curView.buildPortalRefundPortingFeeTx(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalCustodianDepositMeta:
+		if l[2] == common.PortalCustodianDepositRefundChainStatus {
+			return curView.buildPortalRefundCustodianDepositTx(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalUserRequestPTokenMeta:
+		if l[2] == common.PortalReqPTokensAcceptedChainStatus {
+			return curView.buildPortalAcceptedRequestPTokensTx(builder.blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
+		}
+		//custodian withdraw
+	case metadata.PortalCustodianWithdrawRequestMeta:
+		if l[2] == common.PortalCustodianWithdrawRequestAcceptedChainStatus {
+			return curView.buildPortalCustodianWithdrawRequest(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalRedeemRequestMeta, metadata.PortalRedeemRequestMetaV3:
+		if l[2] == common.PortalRedeemRequestRejectedChainStatus || l[2] == common.PortalRedeemReqCancelledByLiquidationChainStatus {
+			return curView.buildPortalRejectedRedeemRequestTx(builder.blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
+		}
+		//liquidation: redeem ptoken
+	case metadata.PortalRedeemFromLiquidationPoolMeta:
+		if l[2] == common.PortalRedeemFromLiquidationPoolSuccessChainStatus {
+			return curView.buildPortalRedeemLiquidateExchangeRatesRequestTx(l[3], producerPrivateKey, shardID)
+		} else if l[2] == common.PortalRedeemFromLiquidationPoolRejectedChainStatus {
+			return curView.buildPortalRefundRedeemLiquidateExchangeRatesTx(builder.blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalLiquidateCustodianMeta, metadata.PortalLiquidateCustodianMetaV3:
+		if l[2] == common.PortalLiquidateCustodianSuccessChainStatus {
+			return curView.buildPortalLiquidateCustodianResponseTx(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalRequestWithdrawRewardMeta:
+		if l[2] == common.PortalReqWithdrawRewardAcceptedChainStatus {
+			return curView.buildPortalAcceptedWithdrawRewardTx(builder.blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
+		}
+		//liquidation: custodian deposit
+	case metadata.PortalCustodianTopupMeta:
+		if l[2] == common.PortalCustodianTopupRejectedChainStatus {
+			return curView.buildPortalLiquidationCustodianDepositReject(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalCustodianTopupMetaV2:
+		if l[2] == common.PortalCustodianTopupRejectedChainStatus {
+			return curView.buildPortalLiquidationCustodianDepositRejectV2(l[3], producerPrivateKey, shardID)
+		}
+	case metadata.PortalTopUpWaitingPortingRequestMeta:
+		if l[2] == common.PortalTopUpWaitingPortingRejectedChainStatus {
+			return curView.buildPortalRejectedTopUpWaitingPortingTx(l[3], producerPrivateKey, shardID)
+		}
+	//redeem from liquidation pool
+	case metadata.PortalRedeemFromLiquidationPoolMetaV3:
+		if l[2] == common.PortalRedeemFromLiquidationPoolSuccessChainStatus {
+			return curView.buildPortalRedeemLiquidateExchangeRatesRequestTxV3(l[3], producerPrivateKey, shardID)
+		} else if l[2] == common.PortalRedeemFromLiquidationPoolRejectedChainStatus {
+			return curView.buildPortalRefundRedeemLiquidateExchangeRatesTxV3(builder.blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
+		}
+	}
+	return nil, nil
+}
+
+// ============================= Relaying =======================================
+type relayingFeature struct {
+	*FeatureBase
+}
+
+func (feature relayingFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.RelayingBNBHeaderMeta,
+		metadata.RelayingBTCHeaderMeta,
+	}
+}
+
+type relayingInstructionBuilder struct {
+	blockchain          *BlockChain
+	relayingHeaderState *RelayingHeaderChainState
+	pm                  *portalManager
+}
+
+func (feature relayingFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	relayingHeaderState, err := blockchain.InitRelayingHeaderChainStateFromDB()
+	if err != nil {
+		Logger.log.Error(err)
+	}
+	return &relayingInstructionBuilder{
+		blockchain:          blockchain,
+		relayingHeaderState: relayingHeaderState,
+		pm:                  NewPortalManager(),
+	}
+}
+
+func (builder *relayingInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	if chain, ok := builder.pm.relayingChains[metaType]; ok {
+		chain.putAction(action)
+	}
+	return nil, nil
+}
+
+func (builder *relayingInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.handleRelayingInsts(builder.relayingHeaderState, builder.pm), nil
+}
+
+func (feature relayingFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// execute, store Ralaying Instruction
+	err := blockchain.processRelayingInstructions(beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessPortalRelayingError, err)
+	}
+	return nil
+}
+
+// ============================= Governance =======================================
+type governanceFeature struct {
+	*FeatureBase
+}
+
+func (feature governanceFeature) StatefulActionTypes() []int {
+	return []int{
+		metadata.ParamChangeProposalMeta,
+		metadata.ParamChangeVoteMeta,
+	}
+}
+
+type governanceInstructionBuilder struct {
+	blockchain *BlockChain
+	env        *InstructionBuilderEnv
+	votedKeys  map[string]bool
+}
+
+func (feature governanceFeature) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return &governanceInstructionBuilder{
+		blockchain: blockchain,
+		env:        env,
+		votedKeys:  map[string]bool{},
+	}
+}
+
+func (builder *governanceInstructionBuilder) PutAction(metaType int, action []string, shardID byte) ([][]string, error) {
+	switch metaType {
+	case metadata.ParamChangeProposalMeta:
+		return builder.blockchain.buildInstructionsForParamChangeProposal(builder.env.BeaconBestState, action[1], shardID, builder.env.BeaconHeight)
+	case metadata.ParamChangeVoteMeta:
+		return builder.blockchain.buildInstructionsForParamChangeVote(builder.env.BeaconBestState, action[1], shardID, builder.env.BeaconHeight, builder.votedKeys)
+	}
+	return nil, nil
+}
+
+// Build tally proposals of which voting ended and activate approved proposals
+func (builder *governanceInstructionBuilder) Build() ([][]string, error) {
+	return builder.blockchain.buildGovernanceProposalInstructions(builder.env.BeaconBestState, builder.env.BeaconHeight), nil
+}
+
+func (feature governanceFeature) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	// store proposals, votes and activated params
+	err := blockchain.processGovernanceInstructions(newBestState, beaconBlock)
+	if err != nil {
+		return NewBlockChainError(ProcessGovernanceInstructionError, err)
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ const (
 	TransactionBatchSize          = 30
 	SpareTime                     = 1000             // in mili-second
 	DefaultMaxBlockSyncTime       = 30 * time.Second // in second
+	MaxRollbackBlocks             = 1000             // number of latest finalized best states kept to roll back chain
+	ReindexCheckpointInterval     = 1000             // number of blocks reindexed between two checkpoints
 )
 
 // burning addresses
@@ -43,6 +45,7 @@ const (
 	MainnetDefaultPort      = "9333"
 	MainnetGenesisBlockTime = "2019-10-29T00:00:00.000Z"
 	MainnetEpoch            = 350
+	MainnetUnbondingPeriod  = 4
 	MainnetRandomTime       = 175
 	MainnetOffset           = 4
 	MainnetSwapOffset       = 4
@@ -62,6 +65,9 @@ const (
 
 	//board and proposal parameters
 	MainnetBasicReward = 1386666000 //1.386666 PRV
+	MainnetAutoWithdrawRewardFee = 100        //nano PRV
+	MainnetGovernanceVotingPeriod    = 15120 // beacon blocks, a week
+	MainnetGovernanceApprovalPercent = 67
 	//MainETHContractAddressStr = "0x0261DB5AfF8E5eC99fBc8FBBA5D4B9f8EcD44ec7" // v2-main - mainnet, branch master-temp-B-deploy, support erc20 with decimals > 18
 	//MainETHContractAddressStr               = "0x3c8ec94213f09A1575f773470830124dfb40042e"                                                              // v3-main - mainnet
 	//MainETHContractAddressStr               = "0x6CC3873C3ca91cf5500DaD8B1A2c620B4f20507c"                                                              // v4-main - mainnet
@@ -105,6 +111,7 @@ const (
 	TestnetDefaultPort      = "9444"
 	TestnetGenesisBlockTime = "2019-11-29T00:00:00.000Z"
 	TestnetEpoch            = 100
+	TestnetUnbondingPeriod  = 2
 	TestnetRandomTime       = 50
 	TestnetOffset           = 1
 	TestnetSwapOffset       = 1
@@ -124,6 +131,9 @@ const (
 
 	//board and proposal parameters
 	TestnetBasicReward                      = 400000000 //40 mili PRV
+	TestnetAutoWithdrawRewardFee            = 100       //nano PRV
+	TestnetGovernanceVotingPeriod           = 100       // beacon blocks
+	TestnetGovernanceApprovalPercent        = 67
 	TestnetETHContractAddressStr            = "0xE0D5e7217c6C4bc475404b26d763fAD3F14D2b86"
 	TestnetIncognitoDAOAddress              = "12S5Lrs1XeQLbqN4ySyKtjAjd2d7sBP2tjFijzmp6avrrkQCNFMpkXm3FPzj2Wcu2ZNqJEmh9JriVuRErVwhuQnLmWSaggobEWsBEci" // community fund
 	TestnetCentralizedWebsitePaymentAddress = "12S5Lrs1XeQLbqN4ySyKtjAjd2d7sBP2tjFijzmp6avrrkQCNFMpkXm3FPzj2Wcu2ZNqJEmh9JriVuRErVwhuQnLmWSaggobEWsBEci"
@@ -147,6 +157,7 @@ const (
 	Testnet2DefaultPort      = "9444"
 	Testnet2GenesisBlockTime = "2020-08-11T00:00:00.000Z"
 	Testnet2Epoch            = 100
+	Testnet2UnbondingPeriod  = 2
 	Testnet2RandomTime       = 50
 	Testnet2Offset           = 1
 	Testnet2SwapOffset       = 1
@@ -166,6 +177,9 @@ const (
 
 	//board and proposal parameters
 	Testnet2BasicReward                      = 400000000 //40 mili PRV
+	Testnet2AutoWithdrawRewardFee            = 100       //nano PRV
+	Testnet2GovernanceVotingPeriod           = 100       // beacon blocks
+	Testnet2GovernanceApprovalPercent        = 67
 	Testnet2ETHContractAddressStr            = "0x7c7e371D1e25771f2242833C1A354dCE846f3ec8"
 	Testnet2IncognitoDAOAddress              = "12S5Lrs1XeQLbqN4ySyKtjAjd2d7sBP2tjFijzmp6avrrkQCNFMpkXm3FPzj2Wcu2ZNqJEmh9JriVuRErVwhuQnLmWSaggobEWsBEci" // community fund
 	Testnet2CentralizedWebsitePaymentAddress = "12S5Lrs1XeQLbqN4ySyKtjAjd2d7sBP2tjFijzmp6avrrkQCNFMpkXm3FPzj2Wcu2ZNqJEmh9JriVuRErVwhuQnLmWSaggobEWsBEci"
@@ -310,14 +324,18 @@ func ReadKey(v1, v2 []byte) {
 // -------------- FOR INSTRUCTION --------------
 // Action for instruction
 const (
-	SetAction     = "set"
-	SwapAction    = "swap"
-	RandomAction  = "random"
-	StakeAction   = "stake"
-	AssignAction  = "assign"
-	StopAutoStake = "stopautostake"
+	SetAction               = "set"
+	SwapAction              = "swap"
+	RandomAction            = "random"
+	StakeAction             = "stake"
+	AssignAction            = "assign"
+	StopAutoStake           = "stopautostake"
+	SlashEquivocationAction = "slashequivocation"
 )
 
+// number of epoches an equivocating validator stays in producers black list
+const EquivocationPunishedEpoches = uint8(255)
+
 var (
 	shardInsertBlockTimer                  = metrics.NewRegisteredTimer("shard/insert", nil)
 	shardVerifyPreprocesingTimer           = metrics.NewRegisteredTimer("shard/verify/preprocessing", nil)
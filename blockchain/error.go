@@ -178,6 +178,19 @@ const (
 	GetShardBlockHeightByHashError
 	GetShardBlockByHashError
 	ResponsedTransactionFromBeaconInstructionsError
+	InsertShardBatchBlockError
+	RollbackChainError
+	ReindexChainError
+	GetStateByHeightError
+	GetTxHistoryError
+	GetTxReceiptError
+	ProcessStakingPoolInstructionError
+	ProcessUnstakeInstructionError
+	ProcessAutoWithdrawRewardInstructionError
+	GetValidatorStatsError
+	ProcessGovernanceInstructionError
+	GetGovernanceError
+	ProcessStakingAmountInstructionError
 )
 
 var ErrCodeMessage = map[int]struct {
@@ -343,6 +356,19 @@ var ErrCodeMessage = map[int]struct {
 	GetShardBlockHeightByHashError:                    {-1155, "Get Shard Block Height By Hash Error"},
 	GetShardBlockByHashError:                          {-1156, "Get Shard Block By Hash Error"},
 	ShardStakingTxRootHashError:                       {-1157, "Build Shard StakingTX error"},
+	InsertShardBatchBlockError:                        {-1158, "Insert Shard Batch Block Error"},
+	RollbackChainError:                                {-1159, "Rollback Chain Error"},
+	ReindexChainError:                                 {-1160, "Reindex Chain Error"},
+	GetStateByHeightError:                             {-1161, "Get State By Height Error"},
+	GetTxHistoryError:                                 {-1162, "Get Tx History Error"},
+	GetTxReceiptError:                                 {-1163, "Get Tx Receipt Error"},
+	ProcessStakingPoolInstructionError:                {-1164, "Process Staking Pool Instruction Error"},
+	ProcessUnstakeInstructionError:                    {-1165, "Process Unstake Instruction Error"},
+	ProcessAutoWithdrawRewardInstructionError:         {-1166, "Process Auto Withdraw Reward Instruction Error"},
+	GetValidatorStatsError:                            {-1167, "Get Validator Stats Error"},
+	ProcessGovernanceInstructionError:                 {-1168, "Process Governance Instruction Error"},
+	GetGovernanceError:                                {-1169, "Get Governance Error"},
+	ProcessStakingAmountInstructionError:              {-1170, "Process Staking Amount Instruction Error"},
 	GetListOutputCoinsByKeysetError:                   {-2000, "Get List Output Coins By Keyset Error"},
 	GetTotalLockedCollateralError:                     {-3000, "Get Total Locked Collateral Error"},
 	ResponsedTransactionFromBeaconInstructionsError:   {-3100, "Build Transaction Response From Beacon Instructions Error"},
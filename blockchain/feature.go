@@ -0,0 +1,116 @@
+package blockchain
+
+import (
+	"sort"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// Protocol changes are switched on by features instead of ad-hoc breakpoints:
+//	- ChainParams.FeatureActivationHeights has the beacon height from which each feature is active on a network,
+//	switches defined by epoch are active from the first beacon height of the epoch
+//	- blockchain, consensus and metadata (through ChainRetriever) query IsFeatureActive with a beacon height
+//	or IsFeatureActiveAtEpoch with an epoch
+//	- a feature without activation height is never active
+
+var featureDescriptions = map[common.Feature]string{
+	common.ConsensusV2Feature:        "bls bft v2 consensus",
+	common.BurnAddressV2Feature:      "new burning address",
+	common.ETHRemoveBridgeSigFeature: "burning requests v2, no bridge swap confirm instruction",
+	common.NewZKPFeature:             "new zero knowledge proofs",
+	common.PortalV3Feature:           "portal v3 requests",
+}
+
+// activation heights of these features are governable params
+var featureGovernanceParams = map[common.Feature]string{
+	common.PortalV3Feature: BCHeightBreakPointPortalV3Param,
+}
+
+// getFirstBeaconHeightOfEpoch returns 0 for epoch 0
+func getFirstBeaconHeightOfEpoch(epoch uint64, epochLength uint64) uint64 {
+	if epoch == 0 {
+		return 0
+	}
+	return (epoch-1)*epochLength + 1
+}
+
+// getFeatureActivationHeight returns the beacon height from which a feature is active, false if it has none,
+// governable activation heights are read at beaconHeight from a beacon view as getGovernanceParam
+func (blockchain *BlockChain) getFeatureActivationHeight(beaconView *BeaconBestState, feature common.Feature, beaconHeight uint64) (uint64, bool) {
+	if param, ok := featureGovernanceParams[feature]; ok {
+		return blockchain.getGovernanceParam(beaconView, param, beaconHeight), true
+	}
+	height, ok := blockchain.config.ChainParams.FeatureActivationHeights[feature]
+	return height, ok
+}
+
+// GetFeatureActivationHeight returns the beacon height from which a feature is active at the beacon best state,
+// false if it has none
+func (blockchain *BlockChain) GetFeatureActivationHeight(feature common.Feature) (uint64, bool) {
+	beaconView := blockchain.getBestBeaconView()
+	if beaconView == nil {
+		return blockchain.getFeatureActivationHeight(nil, feature, 0)
+	}
+	return blockchain.getFeatureActivationHeight(beaconView, feature, beaconView.BeaconHeight)
+}
+
+// IsFeatureActive reads governable activation heights from the beacon best state, blocks and txs are checked
+// with IsFeatureActiveInView
+func (blockchain *BlockChain) IsFeatureActive(feature common.Feature, beaconHeight uint64) bool {
+	var beaconView *BeaconBestState
+	if _, ok := featureGovernanceParams[feature]; ok {
+		beaconView = blockchain.getBestBeaconView()
+	}
+	activationHeight, ok := blockchain.getFeatureActivationHeight(beaconView, feature, beaconHeight)
+	return ok && beaconHeight >= activationHeight
+}
+
+// IsFeatureActiveInView reads governable activation heights from the beacon view of the block being processed
+// (at least at beaconHeight-1) instead of the beacon best state which may be on another fork
+func (blockchain *BlockChain) IsFeatureActiveInView(feature common.Feature, beaconViewRetriever metadata.BeaconViewRetriever, beaconHeight uint64) bool {
+	beaconView, ok := beaconViewRetriever.(*BeaconBestState)
+	if !ok || beaconView == nil {
+		return blockchain.IsFeatureActive(feature, beaconHeight)
+	}
+	activationHeight, ok := blockchain.getFeatureActivationHeight(beaconView, feature, beaconHeight)
+	return ok && beaconHeight >= activationHeight
+}
+
+// IsFeatureActiveAtEpoch checks a feature is active at the first beacon height of an epoch
+func (blockchain *BlockChain) IsFeatureActiveAtEpoch(feature common.Feature, epoch uint64) bool {
+	return blockchain.IsFeatureActive(feature, getFirstBeaconHeightOfEpoch(epoch, blockchain.config.ChainParams.Epoch))
+}
+
+// FeatureInfo is a feature at the height of the beacon best state
+type FeatureInfo struct {
+	Name             common.Feature
+	Description      string
+	ActivationHeight uint64
+	IsActive         bool
+}
+
+// GetFeatures returns features with an activation height in order of activation height then name
+func (blockchain *BlockChain) GetFeatures() []FeatureInfo {
+	beaconHeight := blockchain.GetBeaconBestState().BeaconHeight
+	res := []FeatureInfo{}
+	for feature, description := range featureDescriptions {
+		activationHeight, ok := blockchain.GetFeatureActivationHeight(feature)
+		if !ok {
+			continue
+		}
+		res = append(res, FeatureInfo{
+			Name:             feature,
+			Description:      description,
+			ActivationHeight: activationHeight,
+			IsActive:         beaconHeight >= activationHeight,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ActivationHeight != res[j].ActivationHeight {
+			return res[i].ActivationHeight < res[j].ActivationHeight
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,180 @@
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// Feature is a module built on metadata txs (bridge, PDE, portal, ...), it registers its metadata types
+// with metadata.RegisterMetadataTypes under its name. Beacon and shard block processing dispatch
+// the actions, instructions and response txs of these metadata types to it:
+//	- shard actions of StatefulActionTypes are put to the InstructionBuilder of each new beacon block
+//	- beacon instructions are stored by ProcessInstructions when a beacon block is inserted
+//	- beacon instructions of its metadata types are answered by the ResponseBuilder of each new shard block
+type Feature interface {
+	Name() string
+	StatefulActionTypes() []int
+	NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder
+	ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error
+	NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder
+}
+
+// InstructionBuilder builds the instructions of a feature for a new beacon block
+type InstructionBuilder interface {
+	// PutAction is called for each stateful action of the feature in order of shards, instructions may be built at once
+	PutAction(metaType int, action []string, shardID byte) ([][]string, error)
+	// Build returns the instructions built from all actions, features build in order of registration
+	Build() ([][]string, error)
+}
+
+// ResponseBuilder builds the response txs of a feature for a new shard block
+type ResponseBuilder interface {
+	// BuildResponseTx returns nil if the instruction has no response tx
+	BuildResponseTx(metaType int, inst []string) (metadata.Transaction, error)
+}
+
+type InstructionBuilderEnv struct {
+	BeaconBestState           *BeaconBestState
+	FeatureStateDB            *statedb.StateDB
+	BeaconHeight              uint64
+	RewardForCustodianByEpoch map[common.Hash]uint64
+	PortalParams              PortalParams
+}
+
+type ResponseBuilderEnv struct {
+	ShardView          *ShardBestState
+	BeaconView         *BeaconBestState
+	ProducerPrivateKey *privacy.PrivateKey
+	ShardID            byte
+}
+
+// FeatureBase builds and processes nothing, features embed it to implement only what they need
+type FeatureBase struct {
+	name string
+}
+
+func NewFeatureBase(name string) *FeatureBase {
+	return &FeatureBase{name: name}
+}
+
+func (feature FeatureBase) Name() string {
+	return feature.name
+}
+
+func (feature FeatureBase) StatefulActionTypes() []int {
+	return []int{}
+}
+
+func (feature FeatureBase) NewInstructionBuilder(blockchain *BlockChain, env *InstructionBuilderEnv) InstructionBuilder {
+	return nil
+}
+
+func (feature FeatureBase) ProcessInstructions(blockchain *BlockChain, newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	return nil
+}
+
+func (feature FeatureBase) NewResponseBuilder(blockGenerator *BlockGenerator, env *ResponseBuilderEnv) ResponseBuilder {
+	return nil
+}
+
+var featureRegistry = struct {
+	sync.RWMutex
+	features      []Feature
+	byName        map[string]Feature
+	statefulTypes map[int]Feature
+}{
+	features:      []Feature{},
+	byName:        map[string]Feature{},
+	statefulTypes: map[int]Feature{},
+}
+
+// RegisterFeature registers a feature after the registered ones, it fails if the name or
+// one of the stateful action types is already registered
+func RegisterFeature(feature Feature) error {
+	featureRegistry.Lock()
+	defer featureRegistry.Unlock()
+	if _, ok := featureRegistry.byName[feature.Name()]; ok {
+		return fmt.Errorf("feature %+v is already registered", feature.Name())
+	}
+	for _, metaType := range feature.StatefulActionTypes() {
+		if registered, ok := featureRegistry.statefulTypes[metaType]; ok {
+			return fmt.Errorf("stateful action type %+v of feature %+v is already registered by feature %+v", metaType, feature.Name(), registered.Name())
+		}
+	}
+	featureRegistry.features = append(featureRegistry.features, feature)
+	featureRegistry.byName[feature.Name()] = feature
+	for _, metaType := range feature.StatefulActionTypes() {
+		featureRegistry.statefulTypes[metaType] = feature
+	}
+	return nil
+}
+
+func mustRegisterFeature(feature Feature) {
+	if err := RegisterFeature(feature); err != nil {
+		panic(err)
+	}
+}
+
+func getFeatures() []Feature {
+	featureRegistry.RLock()
+	defer featureRegistry.RUnlock()
+	return append([]Feature{}, featureRegistry.features...)
+}
+
+func getStatefulActionFeature(metaType int) (Feature, bool) {
+	featureRegistry.RLock()
+	defer featureRegistry.RUnlock()
+	feature, ok := featureRegistry.statefulTypes[metaType]
+	return feature, ok
+}
+
+// validateFeatures checks that stateful action types of features are metadata types registered by them
+func validateFeatures() error {
+	for _, feature := range getFeatures() {
+		for _, metaType := range feature.StatefulActionTypes() {
+			name, ok := metadata.GetMetadataFeature(metaType)
+			if !ok || name != feature.Name() {
+				return fmt.Errorf("stateful action type %+v of feature %+v is registered by metadata feature %+v", metaType, feature.Name(), name)
+			}
+		}
+	}
+	return nil
+}
+
+// newInstructionBuilders returns builders of registered features in order of registration
+func (blockchain *BlockChain) newInstructionBuilders(env *InstructionBuilderEnv) ([]Feature, map[string]InstructionBuilder) {
+	features := getFeatures()
+	builders := map[string]InstructionBuilder{}
+	for _, feature := range features {
+		if builder := feature.NewInstructionBuilder(blockchain, env); builder != nil {
+			builders[feature.Name()] = builder
+		}
+	}
+	return features, builders
+}
+
+// newResponseBuilders returns builders of registered features by name
+func (blockGenerator *BlockGenerator) newResponseBuilders(env *ResponseBuilderEnv) map[string]ResponseBuilder {
+	builders := map[string]ResponseBuilder{}
+	for _, feature := range getFeatures() {
+		if builder := feature.NewResponseBuilder(blockGenerator, env); builder != nil {
+			builders[feature.Name()] = builder
+		}
+	}
+	return builders
+}
+
+// processFeatureInstructions stores instructions of a beacon block by registered features in order of registration
+func (blockchain *BlockChain) processFeatureInstructions(newBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	for _, feature := range getFeatures() {
+		if err := feature.ProcessInstructions(blockchain, newBestState, beaconBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+type testFeature struct {
+	*FeatureBase
+	statefulTypes []int
+}
+
+func (feature testFeature) StatefulActionTypes() []int {
+	return feature.statefulTypes
+}
+
+// restoreFeatureRegistry restores the builtin features after a test registered its own
+func restoreFeatureRegistry(t *testing.T) {
+	featureRegistry.Lock()
+	features := append([]Feature{}, featureRegistry.features...)
+	byName := map[string]Feature{}
+	for name, feature := range featureRegistry.byName {
+		byName[name] = feature
+	}
+	statefulTypes := map[int]Feature{}
+	for metaType, feature := range featureRegistry.statefulTypes {
+		statefulTypes[metaType] = feature
+	}
+	featureRegistry.Unlock()
+	t.Cleanup(func() {
+		featureRegistry.Lock()
+		defer featureRegistry.Unlock()
+		featureRegistry.features = features
+		featureRegistry.byName = byName
+		featureRegistry.statefulTypes = statefulTypes
+	})
+}
+
+func TestRegisterFeature(t *testing.T) {
+	const (
+		testFeatureName  = "testfeature"
+		testMetaType     = 10101
+		conflictMetaType = 10102
+	)
+	if err := validateFeatures(); err != nil {
+		t.Fatalf("validateFeatures() of builtin features error = %v", err)
+	}
+	err := metadata.RegisterMetadataTypes(testFeatureName, map[int]func() metadata.Metadata{
+		testMetaType: func() metadata.Metadata { return &metadata.AutoWithdrawRewardPolicy{} },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		restoreFeatureRegistry(t)
+		feature := testFeature{FeatureBase: NewFeatureBase(testFeatureName), statefulTypes: []int{testMetaType}}
+		if err := RegisterFeature(feature); err != nil {
+			t.Fatalf("RegisterFeature() error = %v", err)
+		}
+		features := getFeatures()
+		if features[len(features)-1].Name() != testFeatureName {
+			t.Errorf("feature %v should be registered after the builtin features", testFeatureName)
+		}
+		if registered, ok := getStatefulActionFeature(testMetaType); !ok || registered.Name() != testFeatureName {
+			t.Errorf("getStatefulActionFeature() = %v, %v, want %v", registered, ok, testFeatureName)
+		}
+		if err := validateFeatures(); err != nil {
+			t.Errorf("validateFeatures() error = %v", err)
+		}
+	})
+
+	conflicts := []struct {
+		name    string
+		feature Feature
+	}{
+		{
+			name:    "name of a builtin feature",
+			feature: testFeature{FeatureBase: NewFeatureBase(metadata.StakingFeature)},
+		},
+		{
+			name:    "stateful type of a builtin feature",
+			feature: testFeature{FeatureBase: NewFeatureBase(testFeatureName), statefulTypes: []int{testMetaType, metadata.StakingPoolRegisterMeta}},
+		},
+	}
+	for _, tt := range conflicts {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreFeatureRegistry(t)
+			count := len(getFeatures())
+			if err := RegisterFeature(tt.feature); err == nil {
+				t.Fatal("RegisterFeature() should fail")
+			}
+			if len(getFeatures()) != count {
+				t.Error("a failed registration should not register the feature")
+			}
+			if _, ok := getStatefulActionFeature(testMetaType); ok {
+				t.Error("a failed registration should not register stateful types")
+			}
+			if registered, _ := getStatefulActionFeature(metadata.StakingPoolRegisterMeta); registered.Name() != metadata.StakingFeature {
+				t.Errorf("stateful type %v is registered by %v, want %v", metadata.StakingPoolRegisterMeta, registered.Name(), metadata.StakingFeature)
+			}
+		})
+	}
+
+	invalids := []struct {
+		name    string
+		feature Feature
+	}{
+		{
+			name:    "stateful type not registered in metadata",
+			feature: testFeature{FeatureBase: NewFeatureBase(testFeatureName), statefulTypes: []int{conflictMetaType}},
+		},
+		{
+			name:    "stateful type registered by another metadata feature",
+			feature: testFeature{FeatureBase: NewFeatureBase("otherfeature"), statefulTypes: []int{testMetaType}},
+		},
+	}
+	for _, tt := range invalids {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreFeatureRegistry(t)
+			if err := RegisterFeature(tt.feature); err != nil {
+				t.Fatalf("RegisterFeature() error = %v", err)
+			}
+			if err := validateFeatures(); err == nil {
+				t.Error("validateFeatures() should fail")
+			}
+		})
+	}
+}
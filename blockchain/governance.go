@@ -0,0 +1,536 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// Chain params can be changed by stakers without a hard fork:
+//	- a staker proposes new values of governable params and the beacon height to activate them
+//	- stakers vote on the proposal for ChainParams.GovernanceVotingPeriod beacon blocks
+//	- at the end of voting, the proposal is approved if the stake of approving voters is at least
+//	GovernanceApprovalPercent of the total stake (staked amount of all stakers and delegations to their pools)
+//	- the block before the activation height activates the params of an approved proposal in beacon consensus state,
+//	blockchain code reads them by beacon height instead of ChainParams
+
+// Governable chain params, durations are in seconds
+const (
+	StakingAmountShardParam                   = "StakingAmountShard"
+	UnbondingPeriodParam                      = "UnbondingPeriod"
+	AutoWithdrawRewardFeeParam                = "AutoWithdrawRewardFee"
+	MinBeaconBlockIntervalParam               = "MinBeaconBlockInterval"
+	BCHeightBreakPointPortalV3Param           = "BCHeightBreakPointPortalV3"
+	PortalTP120Param                          = "PortalTP120"
+	PortalTP130Param                          = "PortalTP130"
+	PortalMinPercentLockedCollateralParam     = "PortalMinPercentLockedCollateral"
+	PortalMaxPercentLiquidatedCollateralParam = "PortalMaxPercentLiquidatedCollateral"
+	PortalTimeOutCustodianReturnPubTokenParam = "PortalTimeOutCustodianReturnPubToken"
+	PortalTimeOutWaitingPortingRequestParam   = "PortalTimeOutWaitingPortingRequest"
+	PortalTimeOutWaitingRedeemRequestParam    = "PortalTimeOutWaitingRedeemRequest"
+	PortalMinPortalFeeParam                   = "PortalMinPortalFee"
+	GovernanceVotingPeriodParam               = "GovernanceVotingPeriod"
+	GovernanceApprovalPercentParam            = "GovernanceApprovalPercent"
+)
+
+type governanceParam struct {
+	minValue     uint64
+	maxValue     uint64 // 0 is no limit
+	defaultValue func(blockchain *BlockChain, beaconHeight uint64) uint64
+}
+
+var governanceParams = map[string]governanceParam{
+	StakingAmountShardParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.StakingAmountShard
+	}},
+	UnbondingPeriodParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.UnbondingPeriod
+	}},
+	AutoWithdrawRewardFeeParam: {defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.AutoWithdrawRewardFee
+	}},
+	MinBeaconBlockIntervalParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return uint64(blockchain.config.ChainParams.MinBeaconBlockInterval / time.Second)
+	}},
+	BCHeightBreakPointPortalV3Param: {defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.FeatureActivationHeights[common.PortalV3Feature]
+	}},
+	PortalTP120Param: {minValue: 100, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.getChainPortalParams(beaconHeight).TP120
+	}},
+	PortalTP130Param: {minValue: 100, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.getChainPortalParams(beaconHeight).TP130
+	}},
+	PortalMinPercentLockedCollateralParam: {minValue: 100, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.getChainPortalParams(beaconHeight).MinPercentLockedCollateral
+	}},
+	PortalMaxPercentLiquidatedCollateralParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.getChainPortalParams(beaconHeight).MaxPercentLiquidatedCollateralAmount
+	}},
+	PortalTimeOutCustodianReturnPubTokenParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return uint64(blockchain.getChainPortalParams(beaconHeight).TimeOutCustodianReturnPubToken / time.Second)
+	}},
+	PortalTimeOutWaitingPortingRequestParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return uint64(blockchain.getChainPortalParams(beaconHeight).TimeOutWaitingPortingRequest / time.Second)
+	}},
+	PortalTimeOutWaitingRedeemRequestParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return uint64(blockchain.getChainPortalParams(beaconHeight).TimeOutWaitingRedeemRequest / time.Second)
+	}},
+	PortalMinPortalFeeParam: {defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.getChainPortalParams(beaconHeight).MinPortalFee
+	}},
+	GovernanceVotingPeriodParam: {minValue: 1, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.GovernanceVotingPeriod
+	}},
+	GovernanceApprovalPercentParam: {minValue: 51, maxValue: 100, defaultValue: func(blockchain *BlockChain, beaconHeight uint64) uint64 {
+		return blockchain.config.ChainParams.GovernanceApprovalPercent
+	}},
+}
+
+func validateGovernanceParam(name string, value uint64) error {
+	param, ok := governanceParams[name]
+	if !ok {
+		return fmt.Errorf("param %+v is not governable", name)
+	}
+	if value < param.minValue || (param.maxValue != 0 && value > param.maxValue) {
+		return fmt.Errorf("value %+v of param %+v is out of range", value, name)
+	}
+	return nil
+}
+
+// getGovernanceParam returns the value of a governable param at a beacon height, activated by governance
+// in a beacon view or the value of ChainParams. The view must be at least at beaconHeight-1
+// as params are activated by the block before their activation height
+func (blockchain *BlockChain) getGovernanceParam(beaconView *BeaconBestState, name string, beaconHeight uint64) uint64 {
+	if value, ok := getGovernanceParamValue(beaconView, name, beaconHeight); ok {
+		return value
+	}
+	return governanceParams[name].defaultValue(blockchain, beaconHeight)
+}
+
+func getGovernanceParamValue(beaconView *BeaconBestState, name string, beaconHeight uint64) (uint64, bool) {
+	if beaconView == nil {
+		return 0, false
+	}
+	return getGovernanceParamValueByStateDB(beaconView.consensusStateDB, name, beaconHeight)
+}
+
+// getGovernanceParamByStateDB returns the value of a governable param at a beacon height, activated by governance
+// in a beacon consensus state or the value of ChainParams
+func (blockchain *BlockChain) getGovernanceParamByStateDB(consensusStateDB *statedb.StateDB, name string, beaconHeight uint64) uint64 {
+	if value, ok := getGovernanceParamValueByStateDB(consensusStateDB, name, beaconHeight); ok {
+		return value
+	}
+	return governanceParams[name].defaultValue(blockchain, beaconHeight)
+}
+
+func getGovernanceParamValueByStateDB(consensusStateDB *statedb.StateDB, name string, beaconHeight uint64) (uint64, bool) {
+	if consensusStateDB == nil {
+		return 0, false
+	}
+	param, has, err := statedb.GetGovernanceParam(consensusStateDB, name)
+	if err != nil {
+		Logger.log.Error(err)
+		return 0, false
+	}
+	if !has {
+		return 0, false
+	}
+	return param.ValueAt(beaconHeight)
+}
+
+// getBestBeaconView returns nil before the beacon chain is initialized
+func (blockchain *BlockChain) getBestBeaconView() *BeaconBestState {
+	if blockchain.BeaconChain == nil || blockchain.BeaconChain.multiView == nil {
+		return nil
+	}
+	beaconView, _ := blockchain.BeaconChain.multiView.GetBestView().(*BeaconBestState)
+	return beaconView
+}
+
+// getCurrentGovernanceParam returns the value of a governable param at the height of the beacon best state
+func (blockchain *BlockChain) getCurrentGovernanceParam(name string) uint64 {
+	beaconView := blockchain.getBestBeaconView()
+	if beaconView == nil {
+		return blockchain.getGovernanceParam(nil, name, 0)
+	}
+	return blockchain.getGovernanceParam(beaconView, name, beaconView.BeaconHeight)
+}
+
+// getGovernanceStakes returns the stake of each staker of a beacon view: the amount of its staking tx and
+// the delegations to its staking pool, unstaked validators have no stake. Stakers staked before staking amounts
+// were recorded count with the staking amount param (beacon stakers stake 3 times shard stakers)
+func (blockchain *BlockChain) getGovernanceStakes(beaconBestState *BeaconBestState, beaconHeight uint64) (map[string]uint64, error) {
+	stakingAmountShard := blockchain.getGovernanceParam(beaconBestState, StakingAmountShardParam, beaconHeight)
+	beaconStakers := []incognitokey.CommitteePublicKey{}
+	beaconStakers = append(beaconStakers, beaconBestState.BeaconCommittee...)
+	beaconStakers = append(beaconStakers, beaconBestState.BeaconPendingValidator...)
+	beaconStakers = append(beaconStakers, beaconBestState.CandidateBeaconWaitingForCurrentRandom...)
+	beaconStakers = append(beaconStakers, beaconBestState.CandidateBeaconWaitingForNextRandom...)
+	beaconStakersStr, err := incognitokey.CommitteeKeyListToString(beaconStakers)
+	if err != nil {
+		return nil, err
+	}
+	stakes := map[string]uint64{}
+	for _, staker := range beaconBestState.getAllCommitteeValidatorCandidateFlattenList() {
+		if _, ok := stakes[staker]; ok {
+			continue
+		}
+		if _, has, err := statedb.GetValidatorUnbonding(beaconBestState.consensusStateDB, staker); err != nil {
+			return nil, err
+		} else if has {
+			continue
+		}
+		stake := uint64(0)
+		stakerInfo, has, err := statedb.GetStakerInfo(beaconBestState.consensusStateDB, staker)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			stake = stakerInfo.StakingAmount()
+		}
+		if stake == 0 {
+			stake = stakingAmountShard
+			if common.IndexOfStr(staker, beaconStakersStr) > -1 {
+				stake = stakingAmountShard * 3
+			}
+		}
+		pool, has, err := statedb.GetStakingPool(beaconBestState.consensusStateDB, staker)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			stake += pool.TotalDelegated()
+		}
+		stakes[staker] = stake
+	}
+	return stakes, nil
+}
+
+// buildInstructionsForStakingAmount pass the amount of a staking tx to beacon consensus state,
+// it is stored if beacon accepted the stake of this tx
+func buildInstructionsForStakingAmount(contentStr string, shardID byte, metaType int) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.StakingAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return buildGovernanceInst(metaType, shardID, common.StakingAmountStakedChainStatus, metadata.StakingAmountContent{
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		TxReqID:            action.TxReqID,
+		StakingAmount:      action.Amount,
+		ShardID:            shardID,
+	})
+}
+
+// processStakingAmountInstructions record staking amounts of beacon block in staker infos of the staking txs,
+// staker infos of the block are stored before features process instructions
+func processStakingAmountInstructions(consensusStateDB *statedb.StateDB, beaconBlock *BeaconBlock) error {
+	for _, inst := range beaconBlock.Body.Instructions {
+		if len(inst) != 4 || inst[2] != common.StakingAmountStakedChainStatus {
+			continue
+		}
+		if inst[0] != strconv.Itoa(metadata.ShardStakingMeta) && inst[0] != strconv.Itoa(metadata.BeaconStakingMeta) {
+			continue
+		}
+		var content metadata.StakingAmountContent
+		if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+			return err
+		}
+		stored, err := statedb.StoreStakingAmount(consensusStateDB, content.CommitteePublicKey, content.TxReqID, content.StakingAmount)
+		if err != nil {
+			return err
+		}
+		if !stored {
+			Logger.log.Warnf("WARNING: staking amount of %+v, stake of tx %+v not found", content.CommitteePublicKey, content.TxReqID.String())
+		}
+	}
+	return nil
+}
+
+func buildGovernanceInst(metaType int, shardID byte, status string, content interface{}) ([][]string, error) {
+	contentBytes, err := json.Marshal(content)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return [][]string{{
+		strconv.Itoa(metaType),
+		strconv.Itoa(int(shardID)),
+		status,
+		string(contentBytes),
+	}}, nil
+}
+
+// buildInstructionsForParamChangeProposal accept the proposal of a staker if its params are governable
+// and it is activated after the end of voting
+func (blockchain *BlockChain) buildInstructionsForParamChangeProposal(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	shardID byte,
+	beaconHeight uint64,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.ParamChangeProposalAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	votingPeriod := blockchain.getGovernanceParam(beaconBestState, GovernanceVotingPeriodParam, beaconHeight)
+	content := metadata.ParamChangeProposalContent{
+		ProposalID:         action.TxReqID,
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		Params:             action.Meta.Params,
+		ActivationHeight:   action.Meta.ActivationHeight,
+		VotingEndHeight:    beaconHeight + votingPeriod,
+		ShardID:            shardID,
+	}
+	if common.IndexOfStr(action.Meta.CommitteePublicKey, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+		Logger.log.Warnf("WARNING: param change proposal %+v, %+v not found in any committee list", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildGovernanceInst(metadata.ParamChangeProposalMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	if len(action.Meta.Params) == 0 {
+		Logger.log.Warnf("WARNING: param change proposal %+v changes no param", action.TxReqID.String())
+		return buildGovernanceInst(metadata.ParamChangeProposalMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	for name, value := range action.Meta.Params {
+		if err := validateGovernanceParam(name, value); err != nil {
+			Logger.log.Warnf("WARNING: param change proposal %+v, %+v", action.TxReqID.String(), err)
+			return buildGovernanceInst(metadata.ParamChangeProposalMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+		}
+	}
+	if action.Meta.ActivationHeight <= content.VotingEndHeight+1 {
+		Logger.log.Warnf("WARNING: param change proposal %+v, activation height %+v is not after the end of voting %+v", action.TxReqID.String(), action.Meta.ActivationHeight, content.VotingEndHeight)
+		return buildGovernanceInst(metadata.ParamChangeProposalMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	return buildGovernanceInst(metadata.ParamChangeProposalMeta, shardID, common.GovernanceRequestAcceptedChainStatus, content)
+}
+
+// buildInstructionsForParamChangeVote accept the first vote of a staker on a proposal in voting
+func (blockchain *BlockChain) buildInstructionsForParamChangeVote(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	shardID byte,
+	beaconHeight uint64,
+	votedKeys map[string]bool,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.ParamChangeVoteAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	content := metadata.ParamChangeVoteContent{
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		ProposalID:         action.Meta.ProposalID,
+		Approve:            action.Meta.Approve,
+		TxReqID:            action.TxReqID,
+		ShardID:            shardID,
+	}
+	proposal, has, err := statedb.GetGovernanceProposal(beaconBestState.consensusStateDB, action.Meta.ProposalID)
+	if err != nil || !has || proposal.Status() != common.GovernanceProposalVotingStatus || beaconHeight > proposal.VotingEndHeight() {
+		Logger.log.Warnf("WARNING: param change vote %+v, proposal %+v not found in voting, error %+v", action.TxReqID.String(), action.Meta.ProposalID.String(), err)
+		return buildGovernanceInst(metadata.ParamChangeVoteMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	if common.IndexOfStr(action.Meta.CommitteePublicKey, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+		Logger.log.Warnf("WARNING: param change vote %+v, %+v not found in any committee list", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildGovernanceInst(metadata.ParamChangeVoteMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	votedKey := action.Meta.ProposalID.String() + action.Meta.CommitteePublicKey
+	if _, ok := proposal.Votes()[action.Meta.CommitteePublicKey]; ok || votedKeys[votedKey] {
+		Logger.log.Warnf("WARNING: param change vote %+v, %+v already voted on proposal %+v", action.TxReqID.String(), action.Meta.CommitteePublicKey, action.Meta.ProposalID.String())
+		return buildGovernanceInst(metadata.ParamChangeVoteMeta, shardID, common.GovernanceRequestRejectedChainStatus, content)
+	}
+	votedKeys[votedKey] = true
+	return buildGovernanceInst(metadata.ParamChangeVoteMeta, shardID, common.GovernanceRequestAcceptedChainStatus, content)
+}
+
+// buildGovernanceProposalInstructions tally proposals of which voting ended and activate approved proposals
+// the block before their activation height
+func (blockchain *BlockChain) buildGovernanceProposalInstructions(beaconBestState *BeaconBestState, beaconHeight uint64) [][]string {
+	instructions := [][]string{}
+	proposals, err := statedb.GetAllGovernanceProposals(beaconBestState.consensusStateDB)
+	if err != nil {
+		Logger.log.Error(err)
+		return instructions
+	}
+	var stakes map[string]uint64
+	for _, proposal := range proposals {
+		status := proposal.Status()
+		content := metadata.ParamChangeProposalContent{
+			ProposalID:         proposal.ProposalID(),
+			CommitteePublicKey: proposal.Proposer(),
+			Params:             proposal.Params(),
+			ActivationHeight:   proposal.ActivationHeight(),
+			VotingEndHeight:    proposal.VotingEndHeight(),
+			ApprovedStake:      proposal.ApprovedStake(),
+			TotalStake:         proposal.TotalStake(),
+		}
+		if status == common.GovernanceProposalVotingStatus && beaconHeight > proposal.VotingEndHeight() {
+			if stakes == nil {
+				stakes, err = blockchain.getGovernanceStakes(beaconBestState, beaconHeight)
+				if err != nil {
+					Logger.log.Error(err)
+					return instructions
+				}
+			}
+			content.ApprovedStake, content.TotalStake = 0, 0
+			for _, stake := range stakes {
+				content.TotalStake += stake
+			}
+			for staker, approve := range proposal.Votes() {
+				if approve {
+					content.ApprovedStake += stakes[staker]
+				}
+			}
+			approvalPercent := blockchain.getGovernanceParam(beaconBestState, GovernanceApprovalPercentParam, beaconHeight)
+			status = common.GovernanceProposalDeclinedChainStatus
+			if content.TotalStake > 0 && content.ApprovedStake*100 >= content.TotalStake*approvalPercent {
+				status = common.GovernanceProposalApprovedChainStatus
+			}
+			inst, err := buildGovernanceInst(metadata.ParamChangeProposalMeta, content.ShardID, status, content)
+			if err != nil {
+				Logger.log.Error(err)
+				continue
+			}
+			instructions = append(instructions, inst...)
+		}
+		if status == common.GovernanceProposalApprovedChainStatus && beaconHeight+1 >= proposal.ActivationHeight() {
+			inst, err := buildGovernanceInst(metadata.ParamChangeProposalMeta, content.ShardID, common.GovernanceProposalActivatedChainStatus, content)
+			if err != nil {
+				Logger.log.Error(err)
+				continue
+			}
+			instructions = append(instructions, inst...)
+		}
+	}
+	return instructions
+}
+
+// processGovernanceInstructions store proposals, votes and activated params of beacon block
+func (blockchain *BlockChain) processGovernanceInstructions(beaconBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	consensusStateDB := beaconBestState.consensusStateDB
+	for _, inst := range beaconBlock.Body.Instructions {
+		if len(inst) != 4 || inst[2] == common.GovernanceRequestRejectedChainStatus {
+			continue
+		}
+		switch inst[0] {
+		case strconv.Itoa(metadata.ParamChangeProposalMeta):
+			var content metadata.ParamChangeProposalContent
+			if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+				return err
+			}
+			if inst[2] == common.GovernanceRequestAcceptedChainStatus {
+				proposal := statedb.NewGovernanceProposalStateWithValue(content.ProposalID, content.CommitteePublicKey, content.Params, content.ActivationHeight, content.VotingEndHeight, common.GovernanceProposalVotingStatus)
+				if err := statedb.StoreGovernanceProposal(consensusStateDB, proposal); err != nil {
+					return err
+				}
+				continue
+			}
+			proposal, has, err := statedb.GetGovernanceProposal(consensusStateDB, content.ProposalID)
+			if err != nil {
+				return err
+			}
+			if !has {
+				return fmt.Errorf("proposal %+v not found", content.ProposalID.String())
+			}
+			switch inst[2] {
+			case common.GovernanceProposalApprovedChainStatus, common.GovernanceProposalDeclinedChainStatus:
+				proposal.SetApprovedStake(content.ApprovedStake)
+				proposal.SetTotalStake(content.TotalStake)
+			case common.GovernanceProposalActivatedChainStatus:
+				for name, value := range proposal.Params() {
+					err := statedb.AddGovernanceParamActivation(consensusStateDB, name, statedb.GovernanceParamActivation{
+						Height:     proposal.ActivationHeight(),
+						Value:      value,
+						ProposalID: proposal.ProposalID(),
+					})
+					if err != nil {
+						return err
+					}
+				}
+				if interval, ok := proposal.Params()[MinBeaconBlockIntervalParam]; ok {
+					beaconBestState.BlockInterval = time.Duration(interval) * time.Second
+				}
+			}
+			proposal.SetStatus(inst[2])
+			if err := statedb.StoreGovernanceProposal(consensusStateDB, proposal); err != nil {
+				return err
+			}
+		case strconv.Itoa(metadata.ParamChangeVoteMeta):
+			var content metadata.ParamChangeVoteContent
+			if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+				return err
+			}
+			proposal, has, err := statedb.GetGovernanceProposal(consensusStateDB, content.ProposalID)
+			if err != nil {
+				return err
+			}
+			if !has {
+				return fmt.Errorf("proposal %+v not found", content.ProposalID.String())
+			}
+			proposal.Votes()[content.CommitteePublicKey] = content.Approve
+			if err := statedb.StoreGovernanceProposal(consensusStateDB, proposal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GovernanceParamInfo is a governable param at the height of the beacon best state
+type GovernanceParamInfo struct {
+	Name         string
+	DefaultValue uint64
+	Value        uint64
+	Activations  []statedb.GovernanceParamActivation
+}
+
+// GetGovernanceParams returns governable params in order of name
+func (blockchain *BlockChain) GetGovernanceParams() ([]GovernanceParamInfo, error) {
+	beaconBestState := blockchain.GetBeaconBestState()
+	beaconHeight := beaconBestState.BeaconHeight
+	res := []GovernanceParamInfo{}
+	for name, param := range governanceParams {
+		info := GovernanceParamInfo{
+			Name:         name,
+			DefaultValue: param.defaultValue(blockchain, beaconHeight),
+			Value:        blockchain.getGovernanceParam(beaconBestState, name, beaconHeight),
+			Activations:  []statedb.GovernanceParamActivation{},
+		}
+		paramState, has, err := statedb.GetGovernanceParam(beaconBestState.consensusStateDB, name)
+		if err != nil {
+			return nil, NewBlockChainError(GetGovernanceError, err)
+		}
+		if has {
+			info.Activations = paramState.Activations()
+		}
+		res = append(res, info)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+// GetCurrentGovernanceParam returns the value of a governable param at the height of the beacon best state
+func (blockchain *BlockChain) GetCurrentGovernanceParam(name string) uint64 {
+	return blockchain.getCurrentGovernanceParam(name)
+}
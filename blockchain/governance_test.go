@@ -0,0 +1,378 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// testGovernanceChain has shard stakers alice, bob and carol and beacon staker dave. Alice and bob recorded
+// the amount of their staking tx, carol and dave staked before staking amounts were recorded and carol
+// has a delegation of 500 to its pool: the stakes are alice 1000, bob 3000, carol 1500 and dave 3000
+type testGovernanceChain struct {
+	bc                      *BlockChain
+	view                    *BeaconBestState
+	alice, bob, carol, dave string
+	nonStaker               string
+	aliceTx, bobTx, carolTx common.Hash
+}
+
+func newTestGovernanceChain(t *testing.T) *testGovernanceChain {
+	c := &testGovernanceChain{
+		alice:     newTestEquivocationSigner(t, "alice").publicKey,
+		bob:       newTestEquivocationSigner(t, "bob").publicKey,
+		carol:     newTestEquivocationSigner(t, "carol").publicKey,
+		dave:      newTestEquivocationSigner(t, "dave").publicKey,
+		nonStaker: newTestEquivocationSigner(t, "eve").publicKey,
+		aliceTx:   common.HashH([]byte("alice staking")),
+		bobTx:     common.HashH([]byte("bob staking")),
+		carolTx:   common.HashH([]byte("carol staking")),
+	}
+	c.bc = &BlockChain{config: Config{ChainParams: &Params{
+		StakingAmountShard:        1000,
+		UnbondingPeriod:           10,
+		GovernanceVotingPeriod:    10,
+		GovernanceApprovalPercent: 67,
+		PortalParams:              map[uint64]PortalParams{0: {TP120: 120, TP130: 130}},
+		FeatureActivationHeights:  map[common.Feature]uint64{common.PortalV3Feature: 100},
+	}}}
+	shardCommittee, err := incognitokey.CommitteeBase58KeyListToStruct([]string{c.alice, c.bob, c.carol})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconCommittee, err := incognitokey.CommitteeBase58KeyListToStruct([]string{c.dave})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateDB := newTestStakingPoolStateDB(t)
+	rewardReceiver := map[string]privacy.PaymentAddress{}
+	autoStaking := map[string]bool{}
+	stakingTx := map[string]common.Hash{}
+	for i, committee := range append(append([]incognitokey.CommitteePublicKey{}, shardCommittee...), beaconCommittee...) {
+		committeeStr, _ := committee.ToBase58()
+		rewardReceiver[committee.GetIncKeyBase58()] = newTestPaymentAddress(committeeStr)
+		autoStaking[committeeStr] = true
+		stakingTx[committeeStr] = []common.Hash{c.aliceTx, c.bobTx, c.carolTx, common.HashH([]byte("dave staking"))}[i]
+	}
+	if err := statedb.StoreStakerInfo(stateDB, append(append([]incognitokey.CommitteePublicKey{}, shardCommittee...), beaconCommittee...), rewardReceiver, autoStaking, stakingTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := statedb.StoreStakingPool(stateDB, c.carol, newTestPaymentAddress("operator"), 0, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := statedb.AddDelegation(stateDB, c.carol, newTestPaymentAddress("delegator"), 500); err != nil {
+		t.Fatal(err)
+	}
+	c.view = &BeaconBestState{
+		BeaconHeight:     5,
+		ShardCommittee:   map[byte][]incognitokey.CommitteePublicKey{0: shardCommittee},
+		BeaconCommittee:  beaconCommittee,
+		consensusStateDB: stateDB,
+	}
+	insts := [][]string{}
+	for _, staking := range []struct {
+		committeePublicKey string
+		txReqID            common.Hash
+		amount             uint64
+		metaType           int
+	}{
+		{c.alice, c.aliceTx, 1000, metadata.ShardStakingMeta},
+		{c.bob, c.bobTx, 3000, metadata.ShardStakingMeta},
+		// a rejected stake of carol, the staker info keeps the tx of the first stake
+		{c.carol, common.HashH([]byte("carol second staking")), 5000, metadata.ShardStakingMeta},
+	} {
+		inst, err := buildInstructionsForStakingAmount(encodeTestAction(t, metadata.StakingAction{
+			Meta:    metadata.StakingMetadata{CommitteePublicKey: staking.committeePublicKey},
+			TxReqID: staking.txReqID,
+			Amount:  staking.amount,
+		}), 0, staking.metaType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		insts = append(insts, inst...)
+	}
+	if err := processStakingAmountInstructions(stateDB, &BeaconBlock{Body: BeaconBody{Instructions: insts}}); err != nil {
+		t.Fatal(err)
+	}
+	c.commit(t)
+	return c
+}
+
+func encodeTestAction(t *testing.T, action interface{}) string {
+	actionBytes, err := json.Marshal(action)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(actionBytes)
+}
+
+// commit the state of the view as proposals are read by prefix from the committed trie
+func (c *testGovernanceChain) commit(t *testing.T) {
+	if _, err := c.view.consensusStateDB.Commit(true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (c *testGovernanceChain) process(t *testing.T, insts [][]string) {
+	if err := c.bc.processGovernanceInstructions(c.view, &BeaconBlock{Body: BeaconBody{Instructions: insts}}); err != nil {
+		t.Fatal(err)
+	}
+	c.commit(t)
+}
+
+func (c *testGovernanceChain) propose(t *testing.T, proposer string, params map[string]uint64, activationHeight uint64, beaconHeight uint64) []string {
+	insts, err := c.bc.buildInstructionsForParamChangeProposal(c.view, encodeTestAction(t, metadata.ParamChangeProposalAction{
+		Meta:    metadata.ParamChangeProposal{CommitteePublicKey: proposer, Params: params, ActivationHeight: activationHeight},
+		TxReqID: common.HashH([]byte(proposer + " proposal")),
+	}), 0, beaconHeight)
+	if err != nil || len(insts) != 1 {
+		t.Fatalf("buildInstructionsForParamChangeProposal() = %v, %v", insts, err)
+	}
+	return insts[0]
+}
+
+func (c *testGovernanceChain) vote(t *testing.T, voter string, proposalID common.Hash, approve bool, beaconHeight uint64, votedKeys map[string]bool) []string {
+	insts, err := c.bc.buildInstructionsForParamChangeVote(c.view, encodeTestAction(t, metadata.ParamChangeVoteAction{
+		Meta:    metadata.ParamChangeVote{CommitteePublicKey: voter, ProposalID: proposalID, Approve: approve},
+		TxReqID: common.HashH([]byte(voter + " vote")),
+	}), 0, beaconHeight, votedKeys)
+	if err != nil || len(insts) != 1 {
+		t.Fatalf("buildInstructionsForParamChangeVote() = %v, %v", insts, err)
+	}
+	return insts[0]
+}
+
+func TestGovernanceStakes(t *testing.T) {
+	c := newTestGovernanceChain(t)
+	stakerInfo, has, err := statedb.GetStakerInfo(c.view.consensusStateDB, c.carol)
+	if err != nil || !has {
+		t.Fatalf("GetStakerInfo() = %v, %v", has, err)
+	}
+	if stakerInfo.StakingAmount() != 0 {
+		t.Errorf("staking amount of a rejected stake is recorded: %v", stakerInfo.StakingAmount())
+	}
+	stakes, err := c.bc.getGovernanceStakes(c.view, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]uint64{c.alice: 1000, c.bob: 3000, c.carol: 1500, c.dave: 3000}
+	if len(stakes) != len(want) {
+		t.Errorf("getGovernanceStakes() = %v, want %v", stakes, want)
+	}
+	for staker, stake := range want {
+		if stakes[staker] != stake {
+			t.Errorf("stake of %v = %v, want %v", staker, stakes[staker], stake)
+		}
+	}
+}
+
+func TestParamChangeProposal(t *testing.T) {
+	c := newTestGovernanceChain(t)
+	params := map[string]uint64{StakingAmountShardParam: 2000}
+	for _, tc := range []struct {
+		name             string
+		proposer         string
+		params           map[string]uint64
+		activationHeight uint64
+	}{
+		{"proposer is not a staker", c.nonStaker, params, 20},
+		{"no param", c.alice, map[string]uint64{}, 20},
+		{"param is not governable", c.alice, map[string]uint64{"Epoch": 10}, 20},
+		{"value out of range", c.alice, map[string]uint64{GovernanceApprovalPercentParam: 50}, 20},
+		{"activation before the end of voting", c.alice, params, 16},
+	} {
+		if inst := c.propose(t, tc.proposer, tc.params, tc.activationHeight, 5); inst[2] != common.GovernanceRequestRejectedChainStatus {
+			t.Errorf("%v: proposal is %v, want rejected", tc.name, inst[2])
+		}
+	}
+
+	inst := c.propose(t, c.alice, params, 20, 5)
+	if inst[2] != common.GovernanceRequestAcceptedChainStatus {
+		t.Fatalf("proposal is %v, want accepted", inst[2])
+	}
+	c.process(t, [][]string{inst})
+	proposal, has, err := statedb.GetGovernanceProposal(c.view.consensusStateDB, common.HashH([]byte(c.alice+" proposal")))
+	if err != nil || !has {
+		t.Fatalf("GetGovernanceProposal() = %v, %v", has, err)
+	}
+	if proposal.Status() != common.GovernanceProposalVotingStatus || proposal.VotingEndHeight() != 15 || proposal.ActivationHeight() != 20 {
+		t.Errorf("proposal is %v until %v activated at %v, want voting until 15 activated at 20", proposal.Status(), proposal.VotingEndHeight(), proposal.ActivationHeight())
+	}
+}
+
+func TestParamChangeVote(t *testing.T) {
+	c := newTestGovernanceChain(t)
+	c.process(t, [][]string{c.propose(t, c.alice, map[string]uint64{StakingAmountShardParam: 2000}, 20, 5)})
+	proposalID := common.HashH([]byte(c.alice + " proposal"))
+
+	votedKeys := map[string]bool{}
+	inst := c.vote(t, c.bob, proposalID, true, 6, votedKeys)
+	if inst[2] != common.GovernanceRequestAcceptedChainStatus {
+		t.Fatalf("vote is %v, want accepted", inst[2])
+	}
+	if dup := c.vote(t, c.bob, proposalID, false, 6, votedKeys); dup[2] != common.GovernanceRequestRejectedChainStatus {
+		t.Errorf("second vote in a block is %v, want rejected", dup[2])
+	}
+	c.process(t, [][]string{inst})
+	proposal, _, err := statedb.GetGovernanceProposal(c.view.consensusStateDB, proposalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approve, ok := proposal.Votes()[c.bob]; !ok || !approve {
+		t.Errorf("vote of bob is %v, %v, want approved", approve, ok)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		voter        string
+		proposalID   common.Hash
+		beaconHeight uint64
+	}{
+		{"staker already voted", c.bob, proposalID, 7},
+		{"voter is not a staker", c.nonStaker, proposalID, 7},
+		{"proposal not found", c.carol, common.HashH([]byte("unknown proposal")), 7},
+		{"voting ended", c.carol, proposalID, 16},
+	} {
+		if inst := c.vote(t, tc.voter, tc.proposalID, true, tc.beaconHeight, map[string]bool{}); inst[2] != common.GovernanceRequestRejectedChainStatus {
+			t.Errorf("%v: vote is %v, want rejected", tc.name, inst[2])
+		}
+	}
+	if inst := c.vote(t, c.carol, proposalID, true, 15, map[string]bool{}); inst[2] != common.GovernanceRequestAcceptedChainStatus {
+		t.Errorf("vote at the end of voting is %v, want accepted", inst[2])
+	}
+}
+
+func TestGovernanceProposalTallyAndActivation(t *testing.T) {
+	c := newTestGovernanceChain(t)
+	proposalID := common.HashH([]byte(c.alice + " proposal"))
+	declinedID := common.HashH([]byte(c.carol + " proposal"))
+	c.process(t, [][]string{
+		c.propose(t, c.alice, map[string]uint64{StakingAmountShardParam: 2000, BCHeightBreakPointPortalV3Param: 30, PortalTP120Param: 150}, 20, 5),
+		c.propose(t, c.carol, map[string]uint64{UnbondingPeriodParam: 100}, 30, 5),
+	})
+	// bob and dave approve with 6000 of 8500 staked, a vote weighted by the staking amount param
+	// would decline with 4000 of 6500
+	votedKeys := map[string]bool{}
+	c.process(t, [][]string{
+		c.vote(t, c.bob, proposalID, true, 6, votedKeys),
+		c.vote(t, c.dave, proposalID, true, 6, votedKeys),
+		c.vote(t, c.carol, proposalID, false, 6, votedKeys),
+		c.vote(t, c.carol, declinedID, true, 6, votedKeys),
+	})
+
+	if insts := c.bc.buildGovernanceProposalInstructions(c.view, 15); len(insts) != 0 {
+		t.Fatalf("buildGovernanceProposalInstructions() in voting = %v", insts)
+	}
+	insts := c.bc.buildGovernanceProposalInstructions(c.view, 16)
+	if len(insts) != 2 {
+		t.Fatalf("buildGovernanceProposalInstructions() at the end of voting = %v", insts)
+	}
+	wantStatus := map[common.Hash]string{proposalID: common.GovernanceProposalApprovedChainStatus, declinedID: common.GovernanceProposalDeclinedChainStatus}
+	wantApprovedStake := map[common.Hash]uint64{proposalID: 6000, declinedID: 1500}
+	for _, inst := range insts {
+		var content metadata.ParamChangeProposalContent
+		if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+			t.Fatal(err)
+		}
+		if inst[2] != wantStatus[content.ProposalID] {
+			t.Errorf("proposal %v is %v, want %v", content.ProposalID.String(), inst[2], wantStatus[content.ProposalID])
+		}
+		if content.ApprovedStake != wantApprovedStake[content.ProposalID] || content.TotalStake != 8500 {
+			t.Errorf("proposal %v approved by %v of %v, want %v of 8500", content.ProposalID.String(), content.ApprovedStake, content.TotalStake, wantApprovedStake[content.ProposalID])
+		}
+	}
+	c.process(t, insts)
+
+	if insts := c.bc.buildGovernanceProposalInstructions(c.view, 18); len(insts) != 0 {
+		t.Fatalf("buildGovernanceProposalInstructions() before activation = %v", insts)
+	}
+	insts = c.bc.buildGovernanceProposalInstructions(c.view, 19)
+	if len(insts) != 1 || insts[0][2] != common.GovernanceProposalActivatedChainStatus {
+		t.Fatalf("buildGovernanceProposalInstructions() the block before activation = %v", insts)
+	}
+	c.process(t, insts)
+	c.view.BeaconHeight = 19
+	if insts := c.bc.buildGovernanceProposalInstructions(c.view, 20); len(insts) != 0 {
+		t.Errorf("buildGovernanceProposalInstructions() after activation = %v", insts)
+	}
+	proposal, _, err := statedb.GetGovernanceProposal(c.view.consensusStateDB, proposalID)
+	if err != nil || proposal.Status() != common.GovernanceProposalActivatedChainStatus {
+		t.Errorf("proposal is %v, %v, want activated", proposal.Status(), err)
+	}
+
+	// params are read from the view of the block being processed, a view of another fork has the chain params
+	otherView := &BeaconBestState{BeaconHeight: 19, consensusStateDB: newTestStakingPoolStateDB(t)}
+	for _, tc := range []struct {
+		name                 string
+		view                 *BeaconBestState
+		beaconHeight         uint64
+		stakingAmountShard   uint64
+		tp120                uint64
+		portalV3IsActiveAt30 bool
+	}{
+		{"before activation", c.view, 19, 1000, 120, true},
+		{"after activation", c.view, 20, 2000, 150, true},
+		{"other fork", otherView, 20, 1000, 120, false},
+	} {
+		if got := c.bc.GetStakingAmountShard(tc.view, tc.beaconHeight); got != tc.stakingAmountShard {
+			t.Errorf("%v: GetStakingAmountShard() = %v, want %v", tc.name, got, tc.stakingAmountShard)
+		}
+		if got := c.bc.getPortalParams(tc.view, tc.beaconHeight).TP120; got != tc.tp120 {
+			t.Errorf("%v: getPortalParams().TP120 = %v, want %v", tc.name, got, tc.tp120)
+		}
+		if got := c.bc.IsFeatureActiveInView(common.PortalV3Feature, tc.view, 30); got != tc.portalV3IsActiveAt30 {
+			t.Errorf("%v: IsFeatureActiveInView(PortalV3, 30) = %v, want %v", tc.name, got, tc.portalV3IsActiveAt30)
+		}
+	}
+	if c.bc.IsFeatureActiveInView(common.PortalV3Feature, c.view, 29) {
+		t.Error("IsFeatureActiveInView(PortalV3, 29) = true, want false")
+	}
+}
+
+func TestGovernedBeaconBlockInterval(t *testing.T) {
+	bc := &BlockChain{config: Config{ChainParams: &Params{
+		MinBeaconBlockInterval: 40 * time.Second,
+		MinShardBlockInterval:  40 * time.Second,
+		BasicReward:            1000,
+		PortalParams:           map[uint64]PortalParams{0: {TimeOutWaitingPortingRequest: 400 * time.Second}},
+	}}}
+	// governance halves the block interval at height 10
+	stateDB := newTestStakingPoolStateDB(t)
+	if err := statedb.AddGovernanceParamActivation(stateDB, MinBeaconBlockIntervalParam, statedb.GovernanceParamActivation{Height: 10, Value: 20}); err != nil {
+		t.Fatal(err)
+	}
+	view := &BeaconBestState{BeaconHeight: 10, consensusStateDB: stateDB}
+
+	// a block of the second year at 40 seconds per block is still in the first year at 20 seconds per block
+	blkHeight := getNoBlkPerYear(40) + 1
+	if got := bc.getRewardAmount(view, 9, blkHeight); got != 910 {
+		t.Errorf("reward before the interval change = %v, want %v", got, 910)
+	}
+	if got := bc.getRewardAmount(view, 10, blkHeight); got != 1000 {
+		t.Errorf("reward after the interval change = %v, want %v", got, 1000)
+	}
+
+	// the porting timeout of 400 seconds is 10 beacon blocks before the change and 20 after it
+	for _, tc := range []struct {
+		beaconHeight uint64
+		blocks       uint64
+	}{{9, 10}, {10, 20}} {
+		portalParams := bc.getPortalParams(view, tc.beaconHeight)
+		if got := bc.convertDurationTimeToBeaconBlocks(portalParams.TimeOutWaitingPortingRequest, portalParams); got != tc.blocks {
+			t.Errorf("timeout at beacon height %v = %v blocks, want %v", tc.beaconHeight, got, tc.blocks)
+		}
+		if bc.checkBlockTimeIsReached(100, 100-tc.blocks+2, 100, 90, portalParams.TimeOutWaitingPortingRequest, portalParams) {
+			t.Errorf("timeout at beacon height %v is reached one block early", tc.beaconHeight)
+		}
+		if !bc.checkBlockTimeIsReached(100, 100-tc.blocks+1, 100, 90, portalParams.TimeOutWaitingPortingRequest, portalParams) {
+			t.Errorf("timeout at beacon height %v is not reached after %v blocks", tc.beaconHeight, tc.blocks)
+		}
+	}
+}
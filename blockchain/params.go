@@ -30,6 +30,7 @@ type PortalParams struct {
 	SupportedCollateralTokens            []PortalCollateral
 	MinPortalFee                         uint64 // nano PRV
 	MinUnlockOverRateCollaterals         uint64
+	BeaconBlockInterval                  time.Duration // timeouts are converted to beacon blocks with it, set by governance
 }
 
 /*
@@ -56,7 +57,11 @@ type Params struct {
 	GenesisBeaconBlock               *BeaconBlock // GenesisBlock defines the first block of the chain.
 	GenesisShardBlock                *ShardBlock  // GenesisBlock defines the first block of the chain.
 	BasicReward                      uint64
+	AutoWithdrawRewardFee            uint64 // fee taken from the reward of each auto withdrawal
 	Epoch                            uint64
+	UnbondingPeriod                  uint64 // number of epochs an unstaked stake is locked (and slashable) before being returned
+	GovernanceVotingPeriod           uint64 // number of beacon blocks stakers vote on a param change proposal
+	GovernanceApprovalPercent        uint64 // percent of the total stake which must approve a param change proposal
 	RandomTime                       uint64
 	SlashLevels                      []SlashLevel
 	EthContractAddressStr            string // smart contract of ETH for bridge
@@ -67,9 +72,7 @@ type Params struct {
 	CheckForce                       bool   // true on testnet and false on mainnet
 	ChainVersion                     string
 	AssignOffset                     int
-	ConsensusV2Epoch                 uint64
 	Timeslot                         uint64
-	BeaconHeightBreakPointBurnAddr   uint64
 	BNBRelayingHeaderChainID         string
 	BTCRelayingHeaderChainID         string
 	BTCDataFolderName                string
@@ -83,10 +86,8 @@ type Params struct {
 	IsBackup                         bool
 	PreloadAddress                   string
 	ReplaceStakingTxHeight           uint64
-	ETHRemoveBridgeSigEpoch          uint64
-	BCHeightBreakPointNewZKP         uint64
 	PortalETHContractAddressStr      string // smart contract of ETH for portal
-	BCHeightBreakPointPortalV3       uint64
+	FeatureActivationHeights         map[common.Feature]uint64 // beacon height from which a feature is active, see IsFeatureActive
 }
 
 type GenesisParams struct {
@@ -210,7 +211,11 @@ func SetupParam() {
 		MaxBeaconBlockCreation:           TestNetMaxBeaconBlkCreation,
 		NumberOfFixedBlockValidators:     4,
 		BasicReward:                      TestnetBasicReward,
+		AutoWithdrawRewardFee:            TestnetAutoWithdrawRewardFee,
 		Epoch:                            TestnetEpoch,
+		UnbondingPeriod:                  TestnetUnbondingPeriod,
+		GovernanceVotingPeriod:           TestnetGovernanceVotingPeriod,
+		GovernanceApprovalPercent:        TestnetGovernanceApprovalPercent,
 		RandomTime:                       TestnetRandomTime,
 		Offset:                           TestnetOffset,
 		AssignOffset:                     TestnetAssignOffset,
@@ -225,9 +230,7 @@ func SetupParam() {
 		},
 		CheckForce:                     false,
 		ChainVersion:                   "version-chain-test.json",
-		ConsensusV2Epoch:               16930,
 		Timeslot:                       10,
-		BeaconHeightBreakPointBurnAddr: 250000,
 		BNBRelayingHeaderChainID:       TestnetBNBChainID,
 		BTCRelayingHeaderChainID:       TestnetBTCChainID,
 		BTCDataFolderName:              TestnetBTCDataFolderName,
@@ -259,11 +262,15 @@ func SetupParam() {
 		ReplaceStakingTxHeight:      1,
 		IsBackup:                    false,
 		PreloadAddress:              "",
-		BCHeightBreakPointNewZKP:    2300000, //TODO: change this value when deployed testnet
-		ETHRemoveBridgeSigEpoch:     21920,
 
 		PortalETHContractAddressStr: "0x6D53de7aFa363F779B5e125876319695dC97171E", // todo: update sc address
-		BCHeightBreakPointPortalV3:  30158,
+		FeatureActivationHeights: map[common.Feature]uint64{
+			common.ConsensusV2Feature:        getFirstBeaconHeightOfEpoch(16930, TestnetEpoch),
+			common.BurnAddressV2Feature:      250001,
+			common.ETHRemoveBridgeSigFeature: getFirstBeaconHeightOfEpoch(21920, TestnetEpoch),
+			common.NewZKPFeature:             2300000, //TODO: change this value when deployed testnet
+			common.PortalV3Feature:           30158,
+		},
 	}
 	// END TESTNET
 
@@ -302,7 +309,11 @@ func SetupParam() {
 		MaxBeaconBlockCreation:           TestNet2MaxBeaconBlkCreation,
 		NumberOfFixedBlockValidators:     4,
 		BasicReward:                      Testnet2BasicReward,
+		AutoWithdrawRewardFee:            Testnet2AutoWithdrawRewardFee,
 		Epoch:                            Testnet2Epoch,
+		UnbondingPeriod:                  Testnet2UnbondingPeriod,
+		GovernanceVotingPeriod:           Testnet2GovernanceVotingPeriod,
+		GovernanceApprovalPercent:        Testnet2GovernanceApprovalPercent,
 		RandomTime:                       Testnet2RandomTime,
 		Offset:                           Testnet2Offset,
 		AssignOffset:                     Testnet2AssignOffset,
@@ -317,9 +328,7 @@ func SetupParam() {
 		},
 		CheckForce:                     false,
 		ChainVersion:                   "version-chain-test-2.json",
-		ConsensusV2Epoch:               15290,
 		Timeslot:                       10,
-		BeaconHeightBreakPointBurnAddr: 1,
 		BNBRelayingHeaderChainID:       Testnet2BNBChainID,
 		BTCRelayingHeaderChainID:       Testnet2BTCChainID,
 		BTCDataFolderName:              Testnet2BTCDataFolderName,
@@ -350,10 +359,14 @@ func SetupParam() {
 		ReplaceStakingTxHeight:      1,
 		IsBackup:                    false,
 		PreloadAddress:              "",
-		BCHeightBreakPointNewZKP:    1148608, //TODO: change this value when deployed testnet2
-		ETHRemoveBridgeSigEpoch:     2085,
 		PortalETHContractAddressStr: "0xF7befD2806afD96D3aF76471cbCa1cD874AA1F46",   // todo: update sc address
-		BCHeightBreakPointPortalV3:  1328816,
+		FeatureActivationHeights: map[common.Feature]uint64{
+			common.ConsensusV2Feature:        getFirstBeaconHeightOfEpoch(15290, Testnet2Epoch),
+			common.BurnAddressV2Feature:      2,
+			common.ETHRemoveBridgeSigFeature: getFirstBeaconHeightOfEpoch(2085, Testnet2Epoch),
+			common.NewZKPFeature:             1148608, //TODO: change this value when deployed testnet2
+			common.PortalV3Feature:           1328816,
+		},
 	}
 	// END TESTNET-2
 
@@ -390,7 +403,11 @@ func SetupParam() {
 		MaxBeaconBlockCreation:           MainnetMaxBeaconBlkCreation,
 		NumberOfFixedBlockValidators:     22,
 		BasicReward:                      MainnetBasicReward,
+		AutoWithdrawRewardFee:            MainnetAutoWithdrawRewardFee,
 		Epoch:                            MainnetEpoch,
+		UnbondingPeriod:                  MainnetUnbondingPeriod,
+		GovernanceVotingPeriod:           MainnetGovernanceVotingPeriod,
+		GovernanceApprovalPercent:        MainnetGovernanceApprovalPercent,
 		RandomTime:                       MainnetRandomTime,
 		Offset:                           MainnetOffset,
 		SwapOffset:                       MainnetSwapOffset,
@@ -405,9 +422,7 @@ func SetupParam() {
 		},
 		CheckForce:                     false,
 		ChainVersion:                   "version-chain-main.json",
-		ConsensusV2Epoch:               3071,
 		Timeslot:                       40,
-		BeaconHeightBreakPointBurnAddr: 150500,
 		BNBRelayingHeaderChainID:       MainnetBNBChainID,
 		BTCRelayingHeaderChainID:       MainnetBTCChainID,
 		BTCDataFolderName:              MainnetBTCDataFolderName,
@@ -438,10 +453,14 @@ func SetupParam() {
 		ReplaceStakingTxHeight:      559380,
 		IsBackup:                    false,
 		PreloadAddress:              "",
-		BCHeightBreakPointNewZKP:    934858,
-		ETHRemoveBridgeSigEpoch:     1973,
 		PortalETHContractAddressStr: "", // todo: update sc address
-		BCHeightBreakPointPortalV3:  40, // todo: should update before deploying
+		FeatureActivationHeights: map[common.Feature]uint64{
+			common.ConsensusV2Feature:        getFirstBeaconHeightOfEpoch(3071, MainnetEpoch),
+			common.BurnAddressV2Feature:      150501,
+			common.ETHRemoveBridgeSigFeature: getFirstBeaconHeightOfEpoch(1973, MainnetEpoch),
+			common.NewZKPFeature:             934858,
+			common.PortalV3Feature:           40, // todo: should update before deploying
+		},
 	}
 	if IsTestNet {
 		if !IsTestNet2 {
@@ -3037,7 +3037,7 @@ func (s *PortalTestSuiteV3) TestAutoPickMoreCustodiansForWRedeemRequest() {
 	// build test cases
 	expectedResult := buildExpectedResultPickMoreCustodianForWRequestRedeem()
 
-	newInsts, err := s.blockChain.checkAndPickMoreCustodianForWaitingRedeemRequest(beaconHeight, shardHeights, &s.currentPortalStateForProducer)
+	newInsts, err := s.blockChain.checkAndPickMoreCustodianForWaitingRedeemRequest(beaconHeight, shardHeights, &s.currentPortalStateForProducer, s.blockChain.GetPortalParams(0))
 	s.Equal(nil, err)
 
 	// process new instructions
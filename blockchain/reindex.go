@@ -0,0 +1,436 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge"
+	"github.com/incognitochain/incognito-chain/transaction"
+)
+
+// Secondary indexes which can be rebuilt from stored blocks
+//	- txhash: transaction hash to block hash and index in block, from shard blocks
+//	- txpubkey: receiver public key to transaction hashes, from shard blocks
+//	- tokentx: token ID to transaction hashes of privacy tokens, from shard blocks
+//	- txhistory: in and out transactions of public keys, from shard blocks
+//	- txreceipt: receipt responses of request transactions from shard blocks, receipt events from beacon blocks
+//	- crossshard: cross shard next height links, from beacon blocks
+// Token transactions are also recorded in transaction state, which is committed by block roots, so it is not rebuilt here.
+// An index rebuilt from the beginning is cleared first, stale keys of blocks not in the chain anymore are removed with it
+const (
+	ReindexTxHash        = "txhash"
+	ReindexTxByPublicKey = "txpubkey"
+	ReindexTokenTx       = "tokentx"
+	ReindexTxHistory     = "txhistory"
+	ReindexTxReceipt     = "txreceipt"
+	ReindexCrossShard    = "crossshard"
+)
+
+var ShardReindexList = []string{ReindexTxHash, ReindexTxByPublicKey, ReindexTokenTx, ReindexTxHistory, ReindexTxReceipt}
+var BeaconReindexList = []string{ReindexCrossShard, ReindexTxReceipt}
+
+// ReindexResult describes indexes rebuilt from finalized blocks of a chain
+type ReindexResult struct {
+	ChainID     int // -1 for beacon
+	Indexes     []string
+	FromHeight  uint64
+	ToHeight    uint64
+	Interrupted bool
+}
+
+// reindexCheckpoint is the last finalized block an index is rebuilt to,
+// cross shard index also keeps the last cross shard state at that block to resume
+type reindexCheckpoint struct {
+	Height              uint64
+	BlockHash           common.Hash
+	LastCrossShardState map[byte]map[byte]uint64 `json:",omitempty"`
+}
+
+// ReindexShardChain rebuilds shard secondary indexes in place from finalized shard blocks, then from blocks of views
+// not finalized yet. The node must not be running.
+// Each index resumes from its checkpoint unless restart is set, the walk stops at the next checkpoint when interrupt is closed
+func (blockchain *BlockChain) ReindexShardChain(shardID byte, indexes []string, restart bool, interrupt <-chan struct{}) (*ReindexResult, error) {
+	if err := checkReindexList(indexes, ShardReindexList); err != nil {
+		return nil, NewBlockChainError(ReindexChainError, err)
+	}
+	chain := blockchain.ShardChain[shardID]
+	chain.insertLock.Lock()
+	defer chain.insertLock.Unlock()
+	db := blockchain.GetShardChainDatabase(shardID)
+
+	getBlockHash := func(height uint64) (*common.Hash, error) {
+		return rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, height)
+	}
+	indexPrefix := func(index string) []byte {
+		switch index {
+		case ReindexTxHash:
+			return rawdbv2.GetTransactionHashIndexPrefix()
+		case ReindexTxByPublicKey:
+			return rawdbv2.GetTxByPublicKeyIndexPrefix()
+		case ReindexTokenTx:
+			return rawdbv2.GetTokenTxIndexPrefix()
+		case ReindexTxHistory:
+			return rawdbv2.GetTxHistoryIndexPrefix()
+		case ReindexTxReceipt:
+			return rawdbv2.GetTxReceiptResponseIndexPrefix()
+		}
+		return nil
+	}
+	process := func(batch incdb.KeyValueWriter, blockHash common.Hash, indexes []string, checkpoints map[string]*reindexCheckpoint) error {
+		block, _, err := blockchain.GetShardBlockByHashWithShardID(blockHash, shardID)
+		if err != nil {
+			return err
+		}
+		return reindexShardBlock(batch, block, indexes)
+	}
+	finalHeight := chain.GetFinalView().GetHeight()
+	return reindexChain(db, int(shardID), finalHeight, getPendingBlockHashes(chain.multiView, finalHeight), indexes, restart, interrupt, getBlockHash, indexPrefix, process)
+}
+
+// ReindexBeaconChain rebuilds beacon secondary indexes in place from finalized beacon blocks, then from blocks of views
+// not finalized yet. The node must not be running.
+// Each index resumes from its checkpoint unless restart is set, the walk stops at the next checkpoint when interrupt is closed
+func (blockchain *BlockChain) ReindexBeaconChain(indexes []string, restart bool, interrupt <-chan struct{}) (*ReindexResult, error) {
+	if err := checkReindexList(indexes, BeaconReindexList); err != nil {
+		return nil, NewBlockChainError(ReindexChainError, err)
+	}
+	chain := blockchain.BeaconChain
+	chain.insertLock.Lock()
+	defer chain.insertLock.Unlock()
+	db := blockchain.GetBeaconChainDatabase()
+
+	getBlockHash := func(height uint64) (*common.Hash, error) {
+		return rawdbv2.GetFinalizedBeaconBlockHashByIndex(db, height)
+	}
+	indexPrefix := func(index string) []byte {
+		switch index {
+		case ReindexCrossShard:
+			return rawdbv2.GetCrossShardNextHeightIndexPrefix()
+		case ReindexTxReceipt:
+			return rawdbv2.GetTxReceiptEventIndexPrefix()
+		}
+		return nil
+	}
+	process := func(batch incdb.KeyValueWriter, blockHash common.Hash, indexes []string, checkpoints map[string]*reindexCheckpoint) error {
+		block, _, err := blockchain.GetBeaconBlockByHash(blockHash)
+		if err != nil {
+			return err
+		}
+		for _, index := range indexes {
+			switch index {
+			case ReindexCrossShard:
+				checkpoint := checkpoints[ReindexCrossShard]
+				if checkpoint.LastCrossShardState == nil {
+					checkpoint.LastCrossShardState = make(map[byte]map[byte]uint64)
+				}
+				if err := reindexCrossShardNextHeights(batch, block, checkpoint.LastCrossShardState); err != nil {
+					return err
+				}
+			case ReindexTxReceipt:
+				if err := storeTxReceiptEvents(batch, block); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	finalHeight := chain.GetFinalView().GetHeight()
+	return reindexChain(db, -1, finalHeight, getPendingBlockHashes(chain.multiView, finalHeight), indexes, restart, interrupt, getBlockHash, indexPrefix, process)
+}
+
+// getPendingBlockHashes returns hashes of blocks of views above the final view, ordered by height
+func getPendingBlockHashes(multiView *multiview.MultiView, finalHeight uint64) []common.Hash {
+	views := []multiview.View{}
+	for _, view := range multiView.GetAllViewsWithBFS() {
+		if view.GetHeight() > finalHeight {
+			views = append(views, view)
+		}
+	}
+	sort.SliceStable(views, func(i, j int) bool {
+		return views[i].GetHeight() < views[j].GetHeight()
+	})
+	hashes := []common.Hash{}
+	for _, view := range views {
+		hashes = append(hashes, *view.GetHash())
+	}
+	return hashes
+}
+
+func checkReindexList(indexes []string, supported []string) error {
+	if len(indexes) == 0 {
+		return fmt.Errorf("No index to rebuild, supported indexes %+v", supported)
+	}
+	for _, index := range indexes {
+		if common.IndexOfStr(index, supported) < 0 {
+			return fmt.Errorf("Index %+v can not be rebuilt, supported indexes %+v", index, supported)
+		}
+	}
+	return nil
+}
+
+// reindexChain walks finalized blocks of a chain from the lowest checkpoint of indexes to finalHeight,
+// process is called for each block with the indexes not rebuilt at its height yet.
+// Indexes and checkpoints are written in one batch every ReindexCheckpointInterval blocks.
+// An index without checkpoint is cleared by its prefix before the walk, unless indexPrefix is nil. Once finalHeight is reached, process is called
+// for pendingBlockHashes with the rebuilt indexes indexed when a block is stored, their checkpoints are not moved.
+func reindexChain(
+	db incdb.Database,
+	chainID int,
+	finalHeight uint64,
+	pendingBlockHashes []common.Hash,
+	indexes []string,
+	restart bool,
+	interrupt <-chan struct{},
+	getBlockHash func(height uint64) (*common.Hash, error),
+	indexPrefix func(index string) []byte,
+	process func(batch incdb.KeyValueWriter, blockHash common.Hash, indexes []string, checkpoints map[string]*reindexCheckpoint) error,
+) (*ReindexResult, error) {
+	chainName := "BEACON"
+	if chainID != -1 {
+		chainName = fmt.Sprintf("SHARD %+v", chainID)
+	}
+
+	// load checkpoints, an index restarts if its checkpoint is not on the finalized chain anymore (chain rolled back or resynced)
+	checkpoints := make(map[string]*reindexCheckpoint)
+	fromHeight := finalHeight
+	for _, index := range indexes {
+		checkpoint := &reindexCheckpoint{}
+		if !restart {
+			data, err := rawdbv2.GetReindexCheckpoint(db, index, chainID)
+			if err != nil {
+				return nil, NewBlockChainError(ReindexChainError, err)
+			}
+			if data != nil {
+				if err := json.Unmarshal(data, checkpoint); err != nil {
+					return nil, NewBlockChainError(ReindexChainError, err)
+				}
+				if hash, err := getBlockHash(checkpoint.Height); err != nil || checkpoint.Height > finalHeight || *hash != checkpoint.BlockHash {
+					Logger.log.Warnf("%v | Reindex %v checkpoint at height %+v is not on finalized chain, restart from beginning", chainName, index, checkpoint.Height)
+					checkpoint = &reindexCheckpoint{}
+				}
+			}
+		}
+		checkpoints[index] = checkpoint
+		if checkpoint.Height < fromHeight {
+			fromHeight = checkpoint.Height
+		}
+	}
+	result := &ReindexResult{ChainID: chainID, Indexes: indexes, FromHeight: fromHeight + 1, ToHeight: fromHeight}
+	if fromHeight == finalHeight {
+		Logger.log.Infof("%v | Reindex %+v already up to finalized height %+v", chainName, indexes, finalHeight)
+		return result, nil
+	}
+
+	// indexes rebuilt from the beginning are cleared, the checkpoint is deleted first so an index partly cleared
+	// is cleared again if reindex stops in the middle
+	pendingIndexes := []string{}
+	for _, index := range indexes {
+		if checkpoints[index].Height == finalHeight {
+			continue
+		}
+		if index != ReindexCrossShard && index != ReindexTxHistory {
+			pendingIndexes = append(pendingIndexes, index)
+		}
+		if checkpoints[index].Height > 0 || indexPrefix == nil {
+			continue
+		}
+		Logger.log.Infof("%v | Reindex %v is rebuilt from beginning, clear index", chainName, index)
+		if err := rawdbv2.DeleteReindexCheckpoint(db, index, chainID); err != nil {
+			return nil, NewBlockChainError(ReindexChainError, err)
+		}
+		if err := rawdbv2.DeleteIndex(db, indexPrefix(index)); err != nil {
+			return nil, NewBlockChainError(ReindexChainError, err)
+		}
+	}
+
+	Logger.log.Infof("%v | Reindex %+v from height %+v to %+v", chainName, indexes, fromHeight+1, finalHeight)
+	batch := db.NewBatch()
+	writeCheckpoints := func() error {
+		for index, checkpoint := range checkpoints {
+			data, err := json.Marshal(checkpoint)
+			if err != nil {
+				return err
+			}
+			if err := rawdbv2.StoreReindexCheckpoint(batch, index, chainID, data); err != nil {
+				return err
+			}
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	}
+
+	startTime := time.Now()
+	for height := fromHeight + 1; height <= finalHeight; height++ {
+		select {
+		case <-interrupt:
+			result.Interrupted = true
+		default:
+		}
+		if result.Interrupted {
+			break
+		}
+		hash, err := getBlockHash(height)
+		if err != nil {
+			return result, NewBlockChainError(ReindexChainError, err)
+		}
+		pending := []string{}
+		for _, index := range indexes {
+			if checkpoints[index].Height < height {
+				pending = append(pending, index)
+			}
+		}
+		if err := process(batch, *hash, pending, checkpoints); err != nil {
+			return result, NewBlockChainError(ReindexChainError, fmt.Errorf("Reindex block %+v at height %+v failed: %+v", hash.String(), height, err))
+		}
+		for _, index := range pending {
+			checkpoints[index].Height = height
+			checkpoints[index].BlockHash = *hash
+		}
+		result.ToHeight = height
+
+		if done := height - fromHeight; done%ReindexCheckpointInterval == 0 {
+			if err := writeCheckpoints(); err != nil {
+				return result, NewBlockChainError(ReindexChainError, err)
+			}
+			elapsed := time.Since(startTime)
+			speed := float64(done) / elapsed.Seconds()
+			eta := time.Duration(float64(finalHeight-height)/speed) * time.Second
+			Logger.log.Infof("%v | Reindex %+v at height %+v/%+v, %.1f blocks/s, ETA %v", chainName, indexes, height, finalHeight, speed, eta)
+		}
+	}
+	// blocks above the final view are indexed when they are stored, cross shard links and tx history only when they are finalized
+	if !result.Interrupted && len(pendingIndexes) > 0 {
+		for _, hash := range pendingBlockHashes {
+			if err := process(batch, hash, pendingIndexes, checkpoints); err != nil {
+				return result, NewBlockChainError(ReindexChainError, fmt.Errorf("Reindex block %+v above final height failed: %+v", hash.String(), err))
+			}
+		}
+	}
+	if err := writeCheckpoints(); err != nil {
+		return result, NewBlockChainError(ReindexChainError, err)
+	}
+	if result.Interrupted {
+		Logger.log.Infof("%v | Reindex %+v interrupted, checkpoint at height %+v", chainName, indexes, result.ToHeight)
+	} else {
+		Logger.log.Infof("%v | Reindex %+v finished at height %+v in %v", chainName, indexes, result.ToHeight, time.Since(startTime))
+	}
+	return result, nil
+}
+
+func reindexShardBlock(batch incdb.KeyValueWriter, block *ShardBlock, indexes []string) error {
+	blockHash := block.Header.Hash()
+	for _, index := range indexes {
+		switch index {
+		case ReindexTxHash:
+			for i, tx := range block.Body.Transactions {
+				if err := rawdbv2.StoreTransactionIndex(batch, *tx.Hash(), blockHash, i); err != nil {
+					return err
+				}
+			}
+		case ReindexTxByPublicKey:
+			for _, tx := range block.Body.Transactions {
+				for _, publicKey := range getTxReceiverPublicKeys(tx) {
+					if err := rawdbv2.StoreTxByPublicKey(batch, publicKey, *tx.Hash(), block.Header.ShardID); err != nil {
+						return err
+					}
+				}
+			}
+		case ReindexTokenTx:
+			for _, tx := range block.Body.Transactions {
+				if tokenID, ok := getTxTokenID(tx); ok {
+					if err := rawdbv2.StoreTokenTx(batch, tokenID, *tx.Hash()); err != nil {
+						return err
+					}
+				}
+			}
+		case ReindexTxHistory:
+			if err := storeTxHistory(batch, block); err != nil {
+				return err
+			}
+		case ReindexTxReceipt:
+			if err := storeTxReceiptResponses(batch, block); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getTxReceiverPublicKeys returns public keys of output coins of a transaction, which are the keys TxViewPoint
+// indexes when the block is stored (output coins of a valid transaction always have new commitments)
+func getTxReceiverPublicKeys(tx metadata.Transaction) [][]byte {
+	proofs := []*zkp.PaymentProof{}
+	switch tx.GetType() {
+	case common.TxNormalType, common.TxRewardType, common.TxReturnStakingType:
+		proofs = append(proofs, tx.(*transaction.Tx).Proof)
+	case common.TxCustomTokenPrivacyType:
+		tokenTx := tx.(*transaction.TxCustomTokenPrivacy)
+		proofs = append(proofs, tokenTx.Proof, tokenTx.TxPrivacyTokenData.TxNormal.Proof)
+	}
+	publicKeys := [][]byte{}
+	existed := make(map[string]bool)
+	for _, proof := range proofs {
+		if proof == nil {
+			continue
+		}
+		for _, outputCoin := range proof.GetOutputCoins() {
+			publicKey := outputCoin.CoinDetails.GetPublicKey().ToBytesS()
+			if !existed[string(publicKey)] {
+				existed[string(publicKey)] = true
+				publicKeys = append(publicKeys, publicKey)
+			}
+		}
+	}
+	return publicKeys
+}
+
+// getTxTokenID returns the token of a privacy token transaction, the transaction is recorded in token transactions of
+// transaction state when the block is stored
+func getTxTokenID(tx metadata.Transaction) (common.Hash, bool) {
+	if tx.GetType() != common.TxCustomTokenPrivacyType {
+		return common.Hash{}, false
+	}
+	return tx.(*transaction.TxCustomTokenPrivacy).TxPrivacyTokenData.PropertyID, true
+}
+
+// reindexCrossShardNextHeights stores cross shard next height links confirmed by a beacon block like processBeaconForConfirmmingCrossShard,
+// existing links are overwritten
+func reindexCrossShardNextHeights(batch incdb.KeyValueWriter, beaconBlock *BeaconBlock, lastCrossShardState map[byte]map[byte]uint64) error {
+	for fromShard, shardBlocks := range beaconBlock.Body.ShardState {
+		for _, shardBlock := range shardBlocks {
+			for _, toShard := range shardBlock.CrossShard {
+				if fromShard == toShard {
+					continue
+				}
+				if lastCrossShardState[fromShard] == nil {
+					lastCrossShardState[fromShard] = make(map[byte]uint64)
+				}
+				info := NextCrossShardInfo{
+					shardBlock.Height,
+					shardBlock.Hash.String(),
+					beaconBlock.GetHeight(),
+					beaconBlock.Hash().String(),
+				}
+				b, err := json.Marshal(info)
+				if err != nil {
+					return err
+				}
+				if err := rawdbv2.StoreCrossShardNextHeight(batch, fromShard, toShard, lastCrossShardState[fromShard][toShard], b); err != nil {
+					return err
+				}
+				lastCrossShardState[fromShard][toShard] = shardBlock.Height
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/transaction"
+)
+
+func TestReindexShardChain(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, beaconBlock := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+	txs := make(map[uint64]*transaction.Tx)
+	// block 5 finalize blocks 2 to 4 at once, blocks 5 and 6 are above the final view
+	timeSlots := []int64{10, 20, 30, 31, 40}
+	for i, timeSlot := range timeSlots {
+		height := uint64(i + 2)
+		txs[height] = newRollbackTestTx(t)
+		views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], timeSlot, []metadata.Transaction{txs[height]}))
+	}
+	if finalHeight := bc.ShardChain[0].GetFinalView().GetHeight(); finalHeight != 4 {
+		t.Fatalf("Expect block 4 is finalized, have final height %v", finalHeight)
+	}
+
+	staleTxHash := common.HashH([]byte("stale tx"))
+	stalePublicKey := getTxReceiverPublicKeys(newRollbackTestTx(t))[0]
+	corrupt := func(heights ...uint64) {
+		if err := rawdbv2.StoreTransactionIndex(db, staleTxHash, common.HashH([]byte("fork block")), 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := rawdbv2.StoreTxByPublicKey(db, stalePublicKey, staleTxHash, 0); err != nil {
+			t.Fatal(err)
+		}
+		for _, height := range heights {
+			if err := rawdbv2.DeleteTransactionIndex(db, *txs[height].Hash()); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	checkIndexes := func(repaired bool) {
+		t.Helper()
+		_, _, err := rawdbv2.GetTransactionByHash(db, staleTxHash)
+		if repaired != (err != nil) {
+			t.Errorf("Expect stale tx index is removed: %v, have error %v", repaired, err)
+		}
+		if txByShard, _ := rawdbv2.GetTxByPublicKey(db, stalePublicKey); repaired != (len(txByShard) == 0) {
+			t.Errorf("Expect stale tx by public key is removed: %v, have %+v", repaired, txByShard)
+		}
+		if !repaired {
+			return
+		}
+		for height, tx := range txs {
+			blockHash, index, err := rawdbv2.GetTransactionByHash(db, *tx.Hash())
+			if err != nil || blockHash != views[height-1].BestBlockHash || index != 0 {
+				t.Errorf("Expect tx of block %v is indexed in its block, have block %v index %v error %v", height, blockHash.String(), index, err)
+			}
+			txByShard, err := rawdbv2.GetTxByPublicKey(db, getTxReceiverPublicKeys(tx)[0])
+			if err != nil || len(txByShard[0]) != 1 || txByShard[0][0] != *tx.Hash() {
+				t.Errorf("Expect tx of block %v is indexed by receiver public key, have %+v error %v", height, txByShard, err)
+			}
+		}
+	}
+	indexes := []string{ReindexTxHash, ReindexTxByPublicKey}
+
+	// an index without checkpoint is cleared and rebuilt, including blocks above the final view
+	corrupt(3, 6)
+	result, err := bc.ReindexShardChain(0, indexes, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.FromHeight != 1 || result.ToHeight != 4 || result.Interrupted {
+		t.Fatalf("Expect reindex from height 1 to final height 4, have %+v", result)
+	}
+	checkIndexes(true)
+
+	// indexes resume from their checkpoints at final height, nothing is rebuilt
+	corrupt(2)
+	if result, err = bc.ReindexShardChain(0, indexes, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if result.ToHeight >= result.FromHeight {
+		t.Fatalf("Expect indexes are up to final height already, have %+v", result)
+	}
+	checkIndexes(false)
+
+	// restart is interrupted before the first block, the cleared indexes are rebuilt from beginning on next run
+	interrupt := make(chan struct{})
+	close(interrupt)
+	if result, err = bc.ReindexShardChain(0, indexes, true, interrupt); err != nil {
+		t.Fatal(err)
+	}
+	if !result.Interrupted || result.ToHeight != 0 {
+		t.Fatalf("Expect reindex is interrupted before the first block, have %+v", result)
+	}
+	if _, _, err := rawdbv2.GetTransactionByHash(db, *txs[4].Hash()); err == nil {
+		t.Fatal("Expect tx index is cleared when reindex restarts")
+	}
+	if result, err = bc.ReindexShardChain(0, indexes, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if result.FromHeight != 1 || result.ToHeight != 4 {
+		t.Fatalf("Expect reindex from height 1 to final height 4, have %+v", result)
+	}
+	checkIndexes(true)
+
+	if _, err := bc.ReindexShardChain(0, []string{ReindexCrossShard}, false, nil); err == nil {
+		t.Fatal("Expect beacon index is rejected for shard chain")
+	}
+}
+
+func TestReindexChainResume(t *testing.T) {
+	bc, _ := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	blockHash := func(height uint64) common.Hash {
+		return common.HashH(common.Uint64ToBytes(height))
+	}
+	getBlockHash := func(height uint64) (*common.Hash, error) {
+		hash := blockHash(height)
+		return &hash, nil
+	}
+	indexPrefix := func(index string) []byte {
+		return rawdbv2.GetTransactionHashIndexPrefix()
+	}
+	interrupt := make(chan struct{})
+	processed := []common.Hash{}
+	process := func(batch incdb.KeyValueWriter, hash common.Hash, indexes []string, checkpoints map[string]*reindexCheckpoint) error {
+		processed = append(processed, hash)
+		if hash == blockHash(3) {
+			close(interrupt)
+		}
+		return rawdbv2.StoreTransactionIndex(batch, hash, hash, 0)
+	}
+	pendingHash := common.HashH([]byte("pending block"))
+
+	// the walk stops at the next block once interrupted, the checkpoint is kept at the last reindexed block
+	result, err := reindexChain(db, 0, 6, []common.Hash{pendingHash}, []string{ReindexTxHash}, false, interrupt, getBlockHash, indexPrefix, process)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Interrupted || result.FromHeight != 1 || result.ToHeight != 3 || len(processed) != 3 {
+		t.Fatalf("Expect reindex is interrupted after height 3, have %+v and %v blocks processed", result, len(processed))
+	}
+
+	// resume does not clear the index and continues after the checkpoint, then indexes blocks above the final height
+	processed = []common.Hash{}
+	if result, err = reindexChain(db, 0, 6, []common.Hash{pendingHash}, []string{ReindexTxHash}, false, nil, getBlockHash, indexPrefix, process); err != nil {
+		t.Fatal(err)
+	}
+	if result.Interrupted || result.FromHeight != 4 || result.ToHeight != 6 {
+		t.Fatalf("Expect reindex resumes from height 4 to 6, have %+v", result)
+	}
+	expected := []common.Hash{blockHash(4), blockHash(5), blockHash(6), pendingHash}
+	if len(processed) != len(expected) {
+		t.Fatalf("Expect blocks 4 to 6 then the pending block are processed, have %v blocks", len(processed))
+	}
+	for i := range expected {
+		if processed[i] != expected[i] {
+			t.Fatalf("Expect block %v is processed at position %v, have %v", expected[i].String(), i, processed[i].String())
+		}
+	}
+	for height := uint64(1); height <= 6; height++ {
+		if _, _, err := rawdbv2.GetTransactionByHash(db, blockHash(height)); err != nil {
+			t.Fatalf("Expect index of height %v is kept, have %v", height, err)
+		}
+	}
+
+	// a checkpoint not on the finalized chain anymore restarts the index from beginning
+	getForkBlockHash := func(height uint64) (*common.Hash, error) {
+		hash := blockHash(height + 100)
+		return &hash, nil
+	}
+	processed = []common.Hash{}
+	if result, err = reindexChain(db, 0, 6, nil, []string{ReindexTxHash}, false, nil, getForkBlockHash, indexPrefix, process); err != nil {
+		t.Fatal(err)
+	}
+	if result.FromHeight != 1 || len(processed) != 6 {
+		t.Fatalf("Expect reindex restarts from height 1, have %+v", result)
+	}
+	if _, _, err := rawdbv2.GetTransactionByHash(db, blockHash(1)); err == nil {
+		t.Fatal("Expect index of the previous chain is cleared")
+	}
+}
+
+func TestReindexShardBlockTokenTx(t *testing.T) {
+	bc, _ := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	tokenID := common.HashH([]byte("token"))
+	tokenTx := &transaction.TxCustomTokenPrivacy{Tx: transaction.Tx{Version: 1, Type: common.TxCustomTokenPrivacyType}}
+	tokenTx.TxPrivacyTokenData.PropertyID = tokenID
+	block := NewShardBlock()
+	block.Body.Transactions = []metadata.Transaction{newRollbackTestTx(t), tokenTx}
+
+	batch := db.NewBatch()
+	if err := reindexShardBlock(batch, block, []string{ReindexTokenTx}); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	tokenTxs, err := rawdbv2.GetTokenTxs(db, tokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokenTxs) != 1 || tokenTxs[0] != *tokenTx.Hash() {
+		t.Fatalf("Expect only the token transaction is indexed by its token, have %+v", tokenTxs)
+	}
+}
@@ -1,7 +1,21 @@
 package blockchain
 
-func (blockchain *BlockChain) GetStakingAmountShard() uint64 {
-	return blockchain.config.ChainParams.StakingAmountShard
+import (
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// GetStakingAmountShard returns the staking amount at a beacon height of the beacon view of the block being
+// processed, a new tx (beacon height 0) is checked at the height of the view, the beacon best state is used without view
+func (blockchain *BlockChain) GetStakingAmountShard(beaconViewRetriever metadata.BeaconViewRetriever, beaconHeight uint64) uint64 {
+	beaconView, ok := beaconViewRetriever.(*BeaconBestState)
+	if !ok || beaconView == nil {
+		return blockchain.getCurrentGovernanceParam(StakingAmountShardParam)
+	}
+	if beaconHeight == 0 {
+		beaconHeight = beaconView.BeaconHeight
+	}
+	return blockchain.getGovernanceParam(beaconView, StakingAmountShardParam, beaconHeight)
 }
 
 func (blockchain *BlockChain) GetCentralizedWebsitePaymentAddress(beaconHeight uint64) string {
@@ -23,24 +37,11 @@ func (blockchain *BlockChain) GetCentralizedWebsitePaymentAddress(beaconHeight u
 	return ""
 }
 
-func (blockchain *BlockChain) GetBeaconHeightBreakPointBurnAddr() uint64 {
-	return blockchain.config.ChainParams.BeaconHeightBreakPointBurnAddr
-}
-
-func (blockchain *BlockChain) GetETHRemoveBridgeSigEpoch() uint64 {
-	return blockchain.config.ChainParams.ETHRemoveBridgeSigEpoch
-}
-
-func (blockchain *BlockChain) GetBCHeightBreakPointPortalV3() uint64 {
-	return blockchain.config.ChainParams.BCHeightBreakPointPortalV3
-}
-
 func (blockchain *BlockChain) GetBurningAddress(beaconHeight uint64) string {
-	breakPoint := blockchain.GetBeaconHeightBreakPointBurnAddr()
 	if beaconHeight == 0 {
 		beaconHeight = blockchain.BeaconChain.GetFinalViewHeight()
 	}
-	if beaconHeight <= breakPoint {
+	if !blockchain.IsFeatureActive(common.BurnAddressV2Feature, beaconHeight) {
 		return burningAddress
 	}
 
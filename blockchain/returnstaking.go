@@ -219,6 +219,11 @@ func (blockchain *BlockChain) getReturnStakingInfoFromBeaconInstructions(
 						continue
 					}
 
+					// unstaked validator, stake is returned at the end of its unbonding period
+					if _, has, err := statedb.GetValidatorUnbonding(beaconConsensusStateDB, outPublicKey); err != nil || has {
+						continue
+					}
+
 					if _, ok := res[stakerInfo.TxStakingID()]; ok {
 						err = errors.Errorf("Dupdate return staking using tx staking %v", stakerInfo.TxStakingID().String())
 						return nil, nil, err
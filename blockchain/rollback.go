@@ -0,0 +1,467 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/multiview"
+)
+
+// RollbackResult describes a chain rolled back to a finalized height
+type RollbackResult struct {
+	ChainID       int // -1 for beacon
+	FromHeight    uint64
+	ToHeight      uint64
+	BlockHash     common.Hash
+	RootsHash     interface{}
+	RemovedBlocks []common.Hash
+}
+
+// getViewsToFinalize returns view and the views of multiView it links to, down to the final view, by block hash.
+// It must be called before view is added to multiView, which then drops the views below the new final view
+func getViewsToFinalize(multiView *multiview.MultiView, view multiview.View) map[common.Hash]multiview.View {
+	views := map[common.Hash]multiview.View{*view.GetHash(): view}
+	for prevView := multiView.GetViewByHash(*view.GetPreviousHash()); prevView != nil; prevView = multiView.GetViewByHash(*prevView.GetPreviousHash()) {
+		views[*prevView.GetHash()] = prevView
+	}
+	return views
+}
+
+// storePreviousShardBestState keeps the best state of a newly finalized shard view so the chain can be rolled back to it,
+// the state finalized MaxRollbackBlocks blocks before is removed
+func storePreviousShardBestState(db incdb.KeyValueWriter, view *ShardBestState) error {
+	data, err := json.Marshal(view)
+	if err != nil {
+		return err
+	}
+	if err := rawdbv2.StorePreviousShardBestStateByHeight(db, view.ShardID, view.ShardHeight, data); err != nil {
+		return err
+	}
+	if view.ShardHeight > MaxRollbackBlocks {
+		return rawdbv2.DeletePreviousShardBestStateByHeight(db, view.ShardID, view.ShardHeight-MaxRollbackBlocks)
+	}
+	return nil
+}
+
+// storePreviousBeaconBestState keeps the best state of a newly finalized beacon view so the chain can be rolled back to it,
+// the state finalized MaxRollbackBlocks blocks before is removed
+func storePreviousBeaconBestState(db incdb.KeyValueWriter, view *BeaconBestState) error {
+	data, err := json.Marshal(view)
+	if err != nil {
+		return err
+	}
+	if err := rawdbv2.StorePreviousBeaconBestStateByHeight(db, view.BeaconHeight, data); err != nil {
+		return err
+	}
+	if view.BeaconHeight > MaxRollbackBlocks {
+		return rawdbv2.DeletePreviousBeaconBestStateByHeight(db, view.BeaconHeight-MaxRollbackBlocks)
+	}
+	return nil
+}
+
+// RollbackShardChain rolls shard chain back to a finalized height, the node must not be running
+//	- Best state is restored from the previous state record of the height, its roots must match the stored roots of the block
+//	and state tries of the roots must be available in database
+//	- Blocks above the height (finalized or not) are removed with their roots, finalized index, transaction indexes
+//	and validator stats
+//	- Other shard data (serial numbers, commitments, bridge tokens...) lives in state tries and is restored with the roots
+// All changes are written in one batch, then shard views are restored from database and verified again
+func (blockchain *BlockChain) RollbackShardChain(shardID byte, height uint64) (*RollbackResult, error) {
+	if int(shardID) >= blockchain.GetActiveShardNumber() {
+		return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("Shard %+v is not an active shard, there are %+v active shards", shardID, blockchain.GetActiveShardNumber()))
+	}
+	chain := blockchain.ShardChain[shardID]
+	chain.insertLock.Lock()
+	defer chain.insertLock.Unlock()
+	db := blockchain.GetShardChainDatabase(shardID)
+
+	finalHeight := chain.GetFinalView().GetHeight()
+	bestHeight := chain.GetBestView().GetHeight()
+	if height < 1 || height > finalHeight {
+		return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("Shard %+v can only roll back to finalized height in [1, %+v], got %+v", shardID, finalHeight, height))
+	}
+	data, err := rawdbv2.GetPreviousShardBestStateByHeight(db, shardID, height)
+	if err != nil {
+		return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("No previous state of shard %+v at height %+v, only last %+v finalized states are kept: %+v", shardID, height, MaxRollbackBlocks, err))
+	}
+	view := &ShardBestState{}
+	if err := json.Unmarshal(data, view); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	sRH, err := blockchain.verifyShardRollbackState(shardID, height, view)
+	if err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+
+	// blocks above height: finalized ones by index, then the ones of views not finalized yet
+	removedBlocks := []*ShardBlock{}
+	removed := make(map[common.Hash]bool)
+	for h := height + 1; h <= finalHeight; h++ {
+		hash, err := rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, h)
+		if err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		block, _, err := blockchain.GetShardBlockByHashWithShardID(*hash, shardID)
+		if err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		removedBlocks = append(removedBlocks, block)
+		removed[*hash] = true
+	}
+	for _, v := range chain.multiView.GetAllViewsWithBFS() {
+		if v.GetHeight() > height && !removed[*v.GetHash()] {
+			removedBlocks = append(removedBlocks, v.(*ShardBestState).BestBlock)
+			removed[*v.GetHash()] = true
+		}
+	}
+
+	batch := db.NewBatch()
+	removedHashes := []common.Hash{}
+	for _, block := range removedBlocks {
+		blockHash := *block.Hash()
+		for _, tx := range block.Body.Transactions {
+			// a transaction of a removed fork may also be in a kept block, its indexes are kept then
+			if txBlockHash, _, err := rawdbv2.GetTransactionByHash(db, *tx.Hash()); err != nil || txBlockHash != blockHash {
+				continue
+			}
+			if err := rawdbv2.DeleteTransactionIndex(batch, *tx.Hash()); err != nil {
+				return nil, NewBlockChainError(RollbackChainError, err)
+			}
+			for _, publicKey := range getTxReceiverPublicKeys(tx) {
+				if err := rawdbv2.DeleteTxByPublicKey(batch, publicKey, *tx.Hash(), shardID); err != nil {
+					return nil, NewBlockChainError(RollbackChainError, err)
+				}
+			}
+			if tokenID, ok := getTxTokenID(tx); ok {
+				if err := rawdbv2.DeleteTokenTx(batch, tokenID, *tx.Hash()); err != nil {
+					return nil, NewBlockChainError(RollbackChainError, err)
+				}
+			}
+		}
+		if err := deleteTxHistory(batch, block); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		if err := deleteTxReceiptResponses(batch, block); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		if block.GetHeight() <= finalHeight {
+			if err := rawdbv2.DeleteFinalizedShardBlockHashByIndex(batch, shardID, block.GetHeight()); err != nil {
+				return nil, NewBlockChainError(RollbackChainError, err)
+			}
+			if err := rawdbv2.DeletePreviousShardBestStateByHeight(batch, shardID, block.GetHeight()); err != nil {
+				return nil, NewBlockChainError(RollbackChainError, err)
+			}
+		}
+		if err := rawdbv2.DeleteShardRootsHash(batch, shardID, blockHash); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		if err := rawdbv2.DeleteShardBlock(batch, blockHash); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		removedHashes = append(removedHashes, blockHash)
+	}
+	if err := rawdbv2.DeleteValidatorBlockStatsOfBlocks(db, batch, int(shardID), removed); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := rawdbv2.StoreShardBestState(batch, shardID, []*ShardBestState{view}); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := batch.Write(); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	Logger.log.Infof("SHARD %+v | Roll back from height %+v to %+v, removed %+v blocks", shardID, bestHeight, height, len(removedHashes))
+
+	if err := blockchain.VerifyShardChainState(shardID); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := blockchain.RestoreShardViews(shardID); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	return &RollbackResult{
+		ChainID:       int(shardID),
+		FromHeight:    bestHeight,
+		ToHeight:      height,
+		BlockHash:     view.BestBlockHash,
+		RootsHash:     sRH,
+		RemovedBlocks: removedHashes,
+	}, nil
+}
+
+// verifyShardRollbackState checks a shard best state restored from database is the finalized state at height:
+// its block is the finalized block, its beacon block is finalized, its roots match stored roots of the block and
+// all its state tries are available
+func (blockchain *BlockChain) verifyShardRollbackState(shardID byte, height uint64, view *ShardBestState) (*ShardRootHash, error) {
+	db := blockchain.GetShardChainDatabase(shardID)
+	if view.ShardID != shardID || view.ShardHeight != height {
+		return nil, fmt.Errorf("State of shard %+v height %+v found, expected shard %+v height %+v", view.ShardID, view.ShardHeight, shardID, height)
+	}
+	hash, err := rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, height)
+	if err != nil {
+		return nil, err
+	}
+	if *hash != view.BestBlockHash {
+		return nil, fmt.Errorf("Shard %+v state block %+v is not finalized block %+v at height %+v", shardID, view.BestBlockHash.String(), hash.String(), height)
+	}
+	beaconHash, err := rawdbv2.GetFinalizedBeaconBlockHashByIndex(blockchain.GetBeaconChainDatabase(), view.BeaconHeight)
+	if err != nil {
+		return nil, err
+	}
+	if *beaconHash != view.BestBeaconHash {
+		return nil, fmt.Errorf("Shard %+v state beacon block %+v is not finalized beacon block %+v at height %+v", shardID, view.BestBeaconHash.String(), beaconHash.String(), view.BeaconHeight)
+	}
+	block, _, err := blockchain.GetShardBlockByHashWithShardID(view.BestBlockHash, shardID)
+	if err != nil {
+		return nil, err
+	}
+	view.BestBlock = block
+	sRH, err := GetShardRootsHashByBlockHash(db, shardID, view.BestBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if sRH.ConsensusStateDBRootHash != view.ConsensusStateDBRootHash ||
+		sRH.TransactionStateDBRootHash != view.TransactionStateDBRootHash ||
+		sRH.FeatureStateDBRootHash != view.FeatureStateDBRootHash ||
+		sRH.RewardStateDBRootHash != view.RewardStateDBRootHash ||
+		sRH.SlashStateDBRootHash != view.SlashStateDBRootHash {
+		return nil, fmt.Errorf("Shard %+v state roots at height %+v do not match stored roots of block %+v", shardID, height, view.BestBlockHash.String())
+	}
+	if err := view.InitStateRootHash(db, blockchain); err != nil {
+		return nil, fmt.Errorf("Shard %+v state tries at height %+v are not available: %+v", shardID, height, err)
+	}
+	return sRH, nil
+}
+
+// VerifyShardChainState verifies shard views stored in database, see verifyShardRollbackState
+func (blockchain *BlockChain) VerifyShardChainState(shardID byte) error {
+	b, err := rawdbv2.GetShardBestState(blockchain.GetShardChainDatabase(shardID), shardID)
+	if err != nil {
+		return err
+	}
+	views := []*ShardBestState{}
+	if err := json.Unmarshal(b, &views); err != nil {
+		return err
+	}
+	if len(views) == 0 {
+		return fmt.Errorf("No view of shard %+v in database", shardID)
+	}
+	_, err = blockchain.verifyShardRollbackState(shardID, views[0].ShardHeight, views[0])
+	return err
+}
+
+// RollbackBeaconChain rolls beacon chain back to a finalized height, the node must not be running
+//	- Best state is restored from the previous state record of the height, its roots must match the stored roots of the block
+//	and state tries of the roots must be available in database
+//	- Blocks above the height (finalized or not) are removed with their roots, finalized index and validator stats
+//	- Cross shard next heights confirmed by removed blocks are removed
+//	- Bridge tokens and other beacon data live in state tries and are restored with the roots
+// Shard chains must not be ahead of the height, roll them back first.
+// All changes are written in one batch, then beacon views are restored from database and verified again
+func (blockchain *BlockChain) RollbackBeaconChain(height uint64) (*RollbackResult, error) {
+	chain := blockchain.BeaconChain
+	chain.insertLock.Lock()
+	defer chain.insertLock.Unlock()
+	db := blockchain.GetBeaconChainDatabase()
+
+	finalHeight := chain.GetFinalView().GetHeight()
+	bestHeight := chain.GetBestView().GetHeight()
+	if height < 1 || height > finalHeight {
+		return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("Beacon can only roll back to finalized height in [1, %+v], got %+v", finalHeight, height))
+	}
+	for _, shardChain := range blockchain.ShardChain {
+		shardView := shardChain.GetBestView().(*ShardBestState)
+		if shardView.BeaconHeight > height {
+			return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("Shard %+v best state is at beacon height %+v, roll it back before rolling beacon back to %+v", shardView.ShardID, shardView.BeaconHeight, height))
+		}
+	}
+	data, err := rawdbv2.GetPreviousBeaconBestStateByHeight(db, height)
+	if err != nil {
+		return nil, NewBlockChainError(RollbackChainError, fmt.Errorf("No previous state of beacon at height %+v, only last %+v finalized states are kept: %+v", height, MaxRollbackBlocks, err))
+	}
+	view := &BeaconBestState{}
+	if err := json.Unmarshal(data, view); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	bRH, err := blockchain.verifyBeaconRollbackState(height, view)
+	if err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+
+	removedBlocks := []*BeaconBlock{}
+	removed := make(map[common.Hash]bool)
+	for h := height + 1; h <= finalHeight; h++ {
+		hash, err := rawdbv2.GetFinalizedBeaconBlockHashByIndex(db, h)
+		if err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		block, _, err := blockchain.GetBeaconBlockByHash(*hash)
+		if err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		removedBlocks = append(removedBlocks, block)
+		removed[*hash] = true
+	}
+	for _, v := range chain.multiView.GetAllViewsWithBFS() {
+		if v.GetHeight() > height && !removed[*v.GetHash()] {
+			block := v.(*BeaconBestState).BestBlock
+			removedBlocks = append(removedBlocks, &block)
+			removed[*v.GetHash()] = true
+		}
+	}
+
+	batch := db.NewBatch()
+	if err := rollbackCrossShardNextHeights(db, batch, height, view); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	removedHashes := []common.Hash{}
+	for _, block := range removedBlocks {
+		blockHash := *block.Hash()
+		if block.GetHeight() <= finalHeight {
+			if err := rawdbv2.DeleteFinalizedBeaconBlockHashByIndex(batch, block.GetHeight()); err != nil {
+				return nil, NewBlockChainError(RollbackChainError, err)
+			}
+			if err := rawdbv2.DeletePreviousBeaconBestStateByHeight(batch, block.GetHeight()); err != nil {
+				return nil, NewBlockChainError(RollbackChainError, err)
+			}
+		}
+		if err := deleteTxReceiptEvents(batch, block); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		if err := rawdbv2.DeleteBeaconRootsHash(batch, blockHash); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		if err := rawdbv2.DeleteBeaconBlockByHash(batch, blockHash); err != nil {
+			return nil, NewBlockChainError(RollbackChainError, err)
+		}
+		removedHashes = append(removedHashes, blockHash)
+	}
+	if err := rawdbv2.DeleteValidatorBlockStatsOfBlocks(db, batch, ValidatorStatsBeaconChainID, removed); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	b, err := json.Marshal([]*BeaconBestState{view})
+	if err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := rawdbv2.StoreBeaconViews(batch, b); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := batch.Write(); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	Logger.log.Infof("BEACON | Roll back from height %+v to %+v, removed %+v blocks", bestHeight, height, len(removedHashes))
+
+	if err := blockchain.VerifyBeaconChainState(); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	if err := blockchain.RestoreBeaconViews(); err != nil {
+		return nil, NewBlockChainError(RollbackChainError, err)
+	}
+	return &RollbackResult{
+		ChainID:       -1,
+		FromHeight:    bestHeight,
+		ToHeight:      height,
+		BlockHash:     view.BestBlockHash,
+		RootsHash:     bRH,
+		RemovedBlocks: removedHashes,
+	}, nil
+}
+
+// verifyBeaconRollbackState checks a beacon best state restored from database is the finalized state at height:
+// its block is the finalized block, its roots match stored roots of the block and all its state tries are available
+func (blockchain *BlockChain) verifyBeaconRollbackState(height uint64, view *BeaconBestState) (*BeaconRootHash, error) {
+	db := blockchain.GetBeaconChainDatabase()
+	if view.BeaconHeight != height {
+		return nil, fmt.Errorf("State of beacon height %+v found, expected height %+v", view.BeaconHeight, height)
+	}
+	hash, err := rawdbv2.GetFinalizedBeaconBlockHashByIndex(db, height)
+	if err != nil {
+		return nil, err
+	}
+	if *hash != view.BestBlockHash {
+		return nil, fmt.Errorf("Beacon state block %+v is not finalized block %+v at height %+v", view.BestBlockHash.String(), hash.String(), height)
+	}
+	block, _, err := blockchain.GetBeaconBlockByHash(view.BestBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	view.BestBlock = *block
+	bRH, err := GetBeaconRootsHashByBlockHash(db, view.BestBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if bRH.ConsensusStateDBRootHash != view.ConsensusStateDBRootHash ||
+		bRH.FeatureStateDBRootHash != view.FeatureStateDBRootHash ||
+		bRH.RewardStateDBRootHash != view.RewardStateDBRootHash ||
+		bRH.SlashStateDBRootHash != view.SlashStateDBRootHash {
+		return nil, fmt.Errorf("Beacon state roots at height %+v do not match stored roots of block %+v", height, view.BestBlockHash.String())
+	}
+	if err := view.InitStateRootHash(blockchain); err != nil {
+		return nil, fmt.Errorf("Beacon state tries at height %+v are not available: %+v", height, err)
+	}
+	return bRH, nil
+}
+
+// VerifyBeaconChainState verifies beacon views stored in database, see verifyBeaconRollbackState
+func (blockchain *BlockChain) VerifyBeaconChainState() error {
+	b, err := rawdbv2.GetBeaconViews(blockchain.GetBeaconChainDatabase())
+	if err != nil {
+		return err
+	}
+	views := []*BeaconBestState{}
+	if err := json.Unmarshal(b, &views); err != nil {
+		return err
+	}
+	if len(views) == 0 {
+		return fmt.Errorf("No beacon view in database")
+	}
+	_, err = blockchain.verifyBeaconRollbackState(views[0].BeaconHeight, views[0])
+	return err
+}
+
+// rollbackCrossShardNextHeights removes cross shard next heights confirmed by beacon blocks above height,
+// walking from the last cross shard heights of the beacon state at height
+func rollbackCrossShardNextHeights(db incdb.Database, batch incdb.KeyValueWriter, height uint64, view *BeaconBestState) error {
+	for fromShard := 0; fromShard < view.ActiveShards; fromShard++ {
+		for toShard := 0; toShard < view.ActiveShards; toShard++ {
+			if fromShard == toShard {
+				continue
+			}
+			curHeight := view.LastCrossShardState[byte(fromShard)][byte(toShard)]
+			for {
+				b, err := rawdbv2.GetCrossShardNextHeight(db, byte(fromShard), byte(toShard), curHeight)
+				if err != nil || len(b) == 0 {
+					break
+				}
+				info := NextCrossShardInfo{}
+				if err := json.Unmarshal(b, &info); err != nil {
+					return err
+				}
+				if info.ConfirmBeaconHeight > height {
+					if err := rawdbv2.DeleteCrossShardNextHeight(batch, byte(fromShard), byte(toShard), curHeight); err != nil {
+						return err
+					}
+				}
+				if info.NextCrossShardHeight <= curHeight {
+					break
+				}
+				curHeight = info.NextCrossShardHeight
+			}
+		}
+	}
+
+	// cross shard confirmation of syncker restarts from the state at height
+	lastConfirm := struct {
+		BeaconHeight        uint64
+		LastCrossShardState map[byte]map[byte]uint64
+	}{}
+	if err := json.Unmarshal(rawdbv2.GetLastBeaconStateConfirmCrossShard(db), &lastConfirm); err == nil && lastConfirm.BeaconHeight > height+1 {
+		lastConfirm.BeaconHeight = height + 1
+		lastConfirm.LastCrossShardState = view.LastCrossShardState
+		if err := rawdbv2.StoreLastBeaconStateConfirmCrossShard(batch, lastConfirm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
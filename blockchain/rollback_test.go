@@ -0,0 +1,247 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	_ "github.com/incognitochain/incognito-chain/incdb/lvdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/privacy"
+	zkp "github.com/incognitochain/incognito-chain/privacy/zeroknowledge"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/transaction"
+)
+
+//newRollbackTestChain create a chain with beacon at height 1 and an empty shard 0, databases are removed by cleanup
+func newRollbackTestChain(t *testing.T) (*BlockChain, *BeaconBlock) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test_rollback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	dbs := make(map[int]incdb.Database)
+	for _, chainID := range []int{common.BeaconChainDataBaseID, 0} {
+		db, err := incdb.Open("leveldb", filepath.Join(dir, strconv.Itoa(chainID)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		dbs[chainID] = db
+	}
+	bc := &BlockChain{config: Config{DataBase: dbs, ChainParams: &ChainTestParam, PubSubManager: pubsub.NewPubSubManager()}}
+	bc.BeaconChain = NewBeaconChain(multiview.NewMultiView(), nil, bc, common.BeaconChainKey)
+	bc.ShardChain = []*ShardChain{NewShardChain(0, multiview.NewMultiView(), nil, bc, common.GetShardChainKey(0))}
+
+	beaconBlock := NewBeaconBlock()
+	beaconBlock.Header.Version = 1
+	beaconBlock.Header.Height = 1
+	beaconView := NewBeaconBestState()
+	beaconView.ActiveShards = 1
+	beaconView.BeaconHeight = 1
+	beaconView.BestBlock = *beaconBlock
+	beaconView.BestBlockHash = *beaconBlock.Hash()
+	beaconView.ConsensusStateDBRootHash = common.EmptyRoot
+	beaconView.FeatureStateDBRootHash = common.EmptyRoot
+	beaconView.RewardStateDBRootHash = common.EmptyRoot
+	beaconView.SlashStateDBRootHash = common.EmptyRoot
+	if err := beaconView.InitStateRootHash(bc); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB := bc.GetBeaconChainDatabase()
+	if err := rawdbv2.StoreBeaconBlockByHash(beaconDB, *beaconBlock.Hash(), beaconBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := rawdbv2.StoreFinalizedBeaconBlockHashByIndex(beaconDB, 1, *beaconBlock.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	bRH := BeaconRootHash{
+		ConsensusStateDBRootHash: common.EmptyRoot,
+		FeatureStateDBRootHash:   common.EmptyRoot,
+		RewardStateDBRootHash:    common.EmptyRoot,
+		SlashStateDBRootHash:     common.EmptyRoot,
+	}
+	if err := rawdbv2.StoreBeaconRootsHash(beaconDB, *beaconBlock.Hash(), bRH); err != nil {
+		t.Fatal(err)
+	}
+	bc.BeaconChain.multiView.AddView(beaconView)
+	return bc, beaconBlock
+}
+
+//newRollbackTestTx create a transaction paying one output coin to a random public key
+func newRollbackTestTx(t *testing.T) *transaction.Tx {
+	outputCoin := new(privacy.OutputCoin).Init()
+	outputCoin.CoinDetails.SetPublicKey(privacy.RandomPoint())
+	outputCoin.CoinDetails.SetSNDerivator(privacy.RandomScalar())
+	outputCoin.CoinDetails.SetRandomness(privacy.RandomScalar())
+	outputCoin.CoinDetails.SetValue(10)
+	if err := outputCoin.CoinDetails.CommitAll(); err != nil {
+		t.Fatal(err)
+	}
+	proof := new(zkp.PaymentProof)
+	proof.Init()
+	proof.SetOutputCoins([]*privacy.OutputCoin{outputCoin})
+	return &transaction.Tx{Version: 1, Type: common.TxNormalType, Proof: proof}
+}
+
+//newRollbackTestView create the view of a shard block proposed at timeSlot on top of prevView,
+//a block finalize its previous block if they are proposed in sequential timeslots
+func newRollbackTestView(t *testing.T, bc *BlockChain, beaconBlock *BeaconBlock, prevView *ShardBestState, timeSlot int64, txs []metadata.Transaction) *ShardBestState {
+	block := NewShardBlock()
+	block.Header.Version = 2
+	block.Header.ProposeTime = timeSlot * int64(common.TIMESLOT)
+	block.Header.ShardID = 0
+	block.Header.Epoch = 1
+	block.Header.BeaconHeight = 1
+	block.Header.BeaconHash = *beaconBlock.Hash()
+	block.Header.Round = 1
+	block.Header.TotalTxsFee = make(map[common.Hash]uint64)
+	block.Body.Instructions = [][]string{}
+	block.Body.CrossTransactions = make(map[byte][]CrossTransaction)
+	block.Body.Transactions = []metadata.Transaction{}
+	if len(txs) > 0 {
+		block.Header.TxRoot = common.HashH([]byte("txs"))
+		block.Body.Transactions = txs
+	}
+	view := NewShardBestState()
+	if prevView == nil {
+		block.Header.Height = 1
+		view.ShardID = 0
+		view.BeaconHeight = 1
+		view.BestBeaconHash = *beaconBlock.Hash()
+		view.Epoch = 1
+		view.ConsensusStateDBRootHash = common.EmptyRoot
+		view.TransactionStateDBRootHash = common.EmptyRoot
+		view.FeatureStateDBRootHash = common.EmptyRoot
+		view.RewardStateDBRootHash = common.EmptyRoot
+		view.SlashStateDBRootHash = common.EmptyRoot
+		view.StakingTx = NewMapStringString()
+		if err := view.InitStateRootHash(bc.GetShardChainDatabase(0), bc); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		block.Header.Height = prevView.ShardHeight + 1
+		block.Header.PreviousBlockHash = prevView.BestBlockHash
+		block.Header.CommitteeRoot = common.HashH([]byte("committee"))
+		block.ValidationData = "{}"
+		// state of previous view may not be flushed yet (batch insertion), it is copied as block processing does
+		if err := view.cloneShardBestStateFrom(prevView); err != nil {
+			t.Fatal(err)
+		}
+	}
+	block.Header.Timestamp = int64(block.Header.Height)
+	view.ShardHeight = block.Header.Height
+	view.BestBlock = block
+	view.BestBlockHash = *block.Hash()
+	return view
+}
+
+//insertRollbackTestBlock store a shard block on top of prevView, see newRollbackTestView
+func insertRollbackTestBlock(t *testing.T, bc *BlockChain, beaconBlock *BeaconBlock, prevView *ShardBestState, timeSlot int64, txs []metadata.Transaction) *ShardBestState {
+	view := newRollbackTestView(t, bc, beaconBlock, prevView, timeSlot, txs)
+	block := view.BestBlock
+	if err := bc.processStoreShardBlock(view, block, newCommitteeChange(), []*BeaconBlock{beaconBlock}, nil); err != nil {
+		t.Fatal(err)
+	}
+	stats := &rawdbv2.ValidatorBlockStats{Epoch: 1, BlockChainID: 0, BlockHeight: block.Header.Height, BlockHash: *block.Hash(), BlocksProposed: 1}
+	if err := rawdbv2.StoreValidatorBlockStats(bc.GetShardChainDatabase(0), "validator", stats); err != nil {
+		t.Fatal(err)
+	}
+	return view
+}
+
+func TestRollbackShardChain(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, beaconBlock := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+	txs := make(map[uint64]*transaction.Tx)
+	// block 5 finalize blocks 2 to 4 at once
+	timeSlots := []int64{10, 20, 30, 31, 40}
+	for i, timeSlot := range timeSlots {
+		height := uint64(i + 2)
+		txs[height] = newRollbackTestTx(t)
+		views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], timeSlot, []metadata.Transaction{txs[height]}))
+	}
+	if finalHeight := bc.ShardChain[0].GetFinalView().GetHeight(); finalHeight != 4 {
+		t.Fatalf("Expect block 4 is finalized, have final height %v", finalHeight)
+	}
+	for height := uint64(2); height <= 4; height++ {
+		data, err := rawdbv2.GetPreviousShardBestStateByHeight(db, 0, height)
+		if err != nil {
+			t.Fatalf("Expect state of finalized height %v is stored, have %v", height, err)
+		}
+		view := &ShardBestState{}
+		if err := json.Unmarshal(data, view); err != nil {
+			t.Fatal(err)
+		}
+		if view.BestBlockHash != views[height-1].BestBlockHash {
+			t.Fatalf("Expect state of block %v is stored at its height, have state of block %v", height, view.ShardHeight)
+		}
+	}
+	for height := uint64(5); height <= 6; height++ {
+		if _, err := rawdbv2.GetPreviousShardBestStateByHeight(db, 0, height); err == nil {
+			t.Fatalf("Expect state of height %v is not stored before it is finalized", height)
+		}
+	}
+
+	if _, err := bc.RollbackShardChain(1, 3); err == nil {
+		t.Fatal("Expect rollback of inactive shard is rejected")
+	}
+	result, err := bc.RollbackShardChain(0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.FromHeight != 6 || result.ToHeight != 3 || len(result.RemovedBlocks) != 3 || result.BlockHash != views[2].BestBlockHash {
+		t.Fatalf("Expect blocks 4 to 6 are removed, have %+v", result)
+	}
+	if bestView := bc.ShardChain[0].GetBestView(); bestView.GetHeight() != 3 || *bestView.GetHash() != views[2].BestBlockHash {
+		t.Fatalf("Expect best view is restored at height 3, have height %v", bestView.GetHeight())
+	}
+
+	validatorStats, err := rawdbv2.GetValidatorBlockStats(db, "validator", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validatorStats) != 3 {
+		t.Fatalf("Expect validator stats of blocks 1 to 3 are kept, have %v records", len(validatorStats))
+	}
+	for height, tx := range txs {
+		publicKey := tx.Proof.GetOutputCoins()[0].CoinDetails.GetPublicKey().ToBytesS()
+		txByPublicKey, err := rawdbv2.GetTxByPublicKey(db, publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, txIndexErr := rawdbv2.GetTransactionByHash(db, *tx.Hash())
+		_, blockErr := rawdbv2.GetShardBlockByHash(db, views[height-1].BestBlockHash)
+		if height <= 3 && (len(txByPublicKey[0]) != 1 || txIndexErr != nil || blockErr != nil) {
+			t.Errorf("Expect block %v and indexes of its tx are kept", height)
+		}
+		if height > 3 && (len(txByPublicKey[0]) != 0 || txIndexErr == nil || blockErr == nil) {
+			t.Errorf("Expect block %v and indexes of its tx are removed", height)
+		}
+	}
+	for height := uint64(4); height <= 4; height++ {
+		if _, err := rawdbv2.GetFinalizedShardBlockHashByIndex(db, 0, height); err == nil {
+			t.Errorf("Expect finalized index of height %v is removed", height)
+		}
+		if _, err := rawdbv2.GetPreviousShardBestStateByHeight(db, 0, height); err == nil {
+			t.Errorf("Expect state of height %v is removed", height)
+		}
+	}
+
+	// chain continues from the restored view
+	views = append(views[:3], insertRollbackTestBlock(t, bc, beaconBlock, views[2], 50, nil))
+	if bestHeight := bc.ShardChain[0].GetBestView().GetHeight(); bestHeight != 4 {
+		t.Fatalf("Expect block is inserted after rollback, have best height %v", bestHeight)
+	}
+}
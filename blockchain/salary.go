@@ -14,9 +14,11 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (blockchain *BlockChain) getRewardAmount(blkHeight uint64) uint64 {
-	blockBeaconInterval := blockchain.config.ChainParams.MinBeaconBlockInterval.Seconds()
-	blockInYear := getNoBlkPerYear(uint64(blockBeaconInterval))
+// getRewardAmount returns the basic reward of a block, blocks per year are counted with the beacon block interval
+// activated by governance in beaconView at beaconHeight
+func (blockchain *BlockChain) getRewardAmount(beaconView *BeaconBestState, beaconHeight uint64, blkHeight uint64) uint64 {
+	blockBeaconInterval := blockchain.getGovernanceParam(beaconView, MinBeaconBlockIntervalParam, beaconHeight)
+	blockInYear := getNoBlkPerYear(blockBeaconInterval)
 	n := (blkHeight - 1) / blockInYear
 	reward := uint64(blockchain.config.ChainParams.BasicReward)
 	for ; n > 0; n-- {
@@ -26,7 +28,7 @@ func (blockchain *BlockChain) getRewardAmount(blkHeight uint64) uint64 {
 	return reward
 }
 
-func (blockchain *BlockChain) addShardRewardRequestToBeacon(beaconBlock *BeaconBlock, rewardStateDB *statedb.StateDB) error {
+func (blockchain *BlockChain) addShardRewardRequestToBeacon(beaconView *BeaconBestState, beaconBlock *BeaconBlock, rewardStateDB *statedb.StateDB) error {
 	for _, inst := range beaconBlock.Body.Instructions {
 		if len(inst) <= 2 {
 			continue
@@ -45,12 +47,12 @@ func (blockchain *BlockChain) addShardRewardRequestToBeacon(beaconBlock *BeaconB
 				return err
 			}
 			if val, ok := acceptedBlkRewardInfo.TxsFee[common.PRVCoinID]; ok {
-				acceptedBlkRewardInfo.TxsFee[common.PRVCoinID] = val + blockchain.getRewardAmount(acceptedBlkRewardInfo.ShardBlockHeight)
+				acceptedBlkRewardInfo.TxsFee[common.PRVCoinID] = val + blockchain.getRewardAmount(beaconView, beaconBlock.Header.Height, acceptedBlkRewardInfo.ShardBlockHeight)
 			} else {
 				if acceptedBlkRewardInfo.TxsFee == nil {
 					acceptedBlkRewardInfo.TxsFee = map[common.Hash]uint64{}
 				}
-				acceptedBlkRewardInfo.TxsFee[common.PRVCoinID] = blockchain.getRewardAmount(acceptedBlkRewardInfo.ShardBlockHeight)
+				acceptedBlkRewardInfo.TxsFee[common.PRVCoinID] = blockchain.getRewardAmount(beaconView, beaconBlock.Header.Height, acceptedBlkRewardInfo.ShardBlockHeight)
 			}
 			for key, value := range acceptedBlkRewardInfo.TxsFee {
 				if value != 0 {
@@ -68,6 +70,8 @@ func (blockchain *BlockChain) addShardRewardRequestToBeacon(beaconBlock *BeaconB
 
 func (blockchain *BlockChain) processSalaryInstructions(rewardStateDB *statedb.StateDB, beaconBlocks []*BeaconBlock, shardID byte) error {
 	cInfos := make(map[int][]*statedb.StakerInfo)
+	cPools := make(map[int][]*stakingPoolReward)
+	stakingAmountShard := uint64(0)
 	isInit := false
 	epoch := uint64(0)
 	for _, beaconBlock := range beaconBlocks {
@@ -142,8 +146,13 @@ func (blockchain *BlockChain) processSalaryInstructions(rewardStateDB *statedb.S
 						return NewBlockChainError(ProcessSalaryInstructionsError, err)
 					}
 					cInfos = statedb.GetAllCommitteeStakeInfo(beaconConsensusStateDB, blockchain.GetShardIDs())
+					cPools, err = getCommitteeStakingPools(beaconConsensusStateDB, blockchain.GetShardIDs(), epoch)
+					if err != nil {
+						return NewBlockChainError(ProcessSalaryInstructionsError, err)
+					}
+					stakingAmountShard = blockchain.getGovernanceParamByStateDB(beaconConsensusStateDB, StakingAmountShardParam, height)
 				}
-				err = blockchain.addShardCommitteeRewardV2(rewardStateDB, shardID, shardRewardInfo, cInfos[int(shardToProcess)])
+				err = blockchain.addShardCommitteeRewardV2(rewardStateDB, shardID, shardRewardInfo, cInfos[int(shardToProcess)], cPools[int(shardToProcess)], stakingAmountShard)
 				if err != nil {
 					return err
 				}
@@ -160,11 +169,37 @@ func (blockchain *BlockChain) addShardCommitteeRewardV2(
 	shardID byte,
 	rewardInfoShardToProcess *metadata.ShardBlockRewardInfo,
 	cStakeInfos []*statedb.StakerInfo,
+	cPools []*stakingPoolReward,
+	stakingAmountShard uint64,
 ) (
 	err error,
 ) {
 	committeeSize := len(cStakeInfos)
-	for _, candidate := range cStakeInfos {
+	for i, candidate := range cStakeInfos {
+		if i < len(cPools) && cPools[i] != nil {
+			// reward of a committee member with delegations is split with its delegators, by the amount of its
+			// staking tx or the staking amount at the epoch if it staked before staking amounts were recorded
+			stakingAmount := candidate.StakingAmount()
+			if stakingAmount == 0 {
+				stakingAmount = stakingAmountShard
+			}
+			for key, value := range rewardInfoShardToProcess.ShardReward {
+				rewards := cPools[i].splitReward(value/uint64(committeeSize), stakingAmount)
+				for pk, reward := range rewards {
+					receiverPk := []byte(pk)
+					if common.GetShardIDFromLastByte(receiverPk[common.PublicKeySize-1]) != shardID {
+						continue
+					}
+					tempPK := base58.Base58Check{}.Encode(receiverPk, common.Base58Version)
+					Logger.log.Criticalf("Add Committee Reward ShardCommitteeReward of Staking Pool, Public Key %+v, reward %+v, token %+v", tempPK, reward, key)
+					err = statedb.AddCommitteeReward(rewardStateDB, tempPK, reward, key)
+					if err != nil {
+						return NewBlockChainError(ProcessSalaryInstructionsError, err)
+					}
+				}
+			}
+			continue
+		}
 		if common.GetShardIDFromLastByte(candidate.RewardReceiver().Pk[common.PublicKeySize-1]) == shardID {
 			for key, value := range rewardInfoShardToProcess.ShardReward {
 				tempPK := base58.Base58Check{}.Encode(candidate.RewardReceiver().Pk, common.Base58Version)
@@ -332,7 +332,7 @@ func TestBlockChain_getRewardAmount(t *testing.T) {
 			blockchain := &BlockChain{
 				config: tt.fields.config,
 			}
-			if got := blockchain.getRewardAmount(tt.args.blkHeight); got != tt.want {
+			if got := blockchain.getRewardAmount(nil, 0, tt.args.blkHeight); got != tt.want {
 				t.Errorf("getRewardAmount() = %v, want %v", got, tt.want)
 			}
 		})
@@ -630,7 +630,7 @@ func TestBlockChain_addShardRewardRequestToBeacon(t *testing.T) {
 			blockchain := &BlockChain{
 				config: tt.fields.config,
 			}
-			if err := blockchain.addShardRewardRequestToBeacon(tt.args.beaconBlock, sDB); (err != nil) != tt.wantErr {
+			if err := blockchain.addShardRewardRequestToBeacon(nil, tt.args.beaconBlock, sDB); (err != nil) != tt.wantErr {
 				t.Errorf("addShardRewardRequestToBeacon() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			rootHash, _ := sDB.Commit(true)
@@ -649,10 +649,10 @@ func TestBlockChain_addShardRewardRequestToBeacon(t *testing.T) {
 
 func TestBlockChain_buildInstRewardForBeacons(t *testing.T) {
 	type fields struct {
-		BestState *BestState
+		beaconBestState *BeaconBestState
 	}
 	fields1 := fields{
-		BestState: &BestState{Beacon: &BeaconBestState{BeaconCommittee: committeesKeys}},
+		beaconBestState: &BeaconBestState{BeaconCommittee: committeesKeys},
 	}
 	totalReward1 := make(map[common.Hash]uint64)
 	totalReward1_1 := make(map[common.Hash]uint64)
@@ -685,10 +685,7 @@ func TestBlockChain_buildInstRewardForBeacons(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			blockchain := &BlockChain{
-				BestState: tt.fields.BestState,
-			}
-			got, err := blockchain.buildInstRewardForBeacons(tt.args.epoch, tt.args.totalReward)
+			got, err := tt.fields.beaconBestState.buildInstRewardForBeacons(tt.args.epoch, tt.args.totalReward)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildInstRewardForBeacons() error = %v, wantErr %v", err, tt.wantErr)
 				return
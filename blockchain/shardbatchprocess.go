@@ -0,0 +1,212 @@
+package blockchain
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/pubsub"
+)
+
+// InsertShardBatchBlock inserts a range of finalized shard blocks, it is used to catch up when the node is far behind
+//	- Signatures of all blocks are verified concurrently with the committee of the view the range links to
+//	- Blocks are finalized, they are applied without pre processing verification of transactions,
+//	post processing verification of the new views still runs
+//	- State tries are committed to memory block by block and flushed to disk once at the end of the batch,
+//	so only states of the views still kept in multiview are persisted (states of all views in archive mode).
+//	Roots and rollback states are only recorded for the persisted views: a non archive node cannot query state
+//	nor roll back to the heights of the batch below its final view
+//	- Blocks, their indexes and the backup of shard views are written to disk in one batch after the state tries,
+//	so a node stopped in the middle restarts from the view before the batch
+// The range stops after a block swapping shard committee, or before the first block with invalid signature.
+// Return number of blocks of shardBlocks which are inserted (or already in chain)
+func (blockchain *BlockChain) InsertShardBatchBlock(shardBlocks []*ShardBlock) (int, error) {
+	if len(shardBlocks) == 0 {
+		return 0, nil
+	}
+	shardID := shardBlocks[0].Header.ShardID
+	chain := blockchain.ShardChain[int(shardID)]
+	chain.insertLock.Lock()
+	defer chain.insertLock.Unlock()
+
+	//skip blocks already in chain
+	existed := 0
+	for existed < len(shardBlocks) && chain.CheckExistedBlk(shardBlocks[existed]) {
+		existed++
+	}
+	blocks := getShardBatchRange(shardBlocks[existed:])
+	if len(blocks) == 0 {
+		return existed, nil
+	}
+
+	preView := chain.GetViewByHash(blocks[0].Header.PreviousBlockHash)
+	if preView == nil {
+		return existed, NewBlockChainError(InsertShardBatchBlockError, fmt.Errorf("ShardBlock %v link to wrong view (%s)", blocks[0].Header.Height, blocks[0].Header.PreviousBlockHash.String()))
+	}
+	curView := preView.(*ShardBestState)
+	if blocks[0].Header.Height != curView.ShardHeight+1 {
+		return existed, NewBlockChainError(InsertShardBatchBlockError, fmt.Errorf("Not expected height, current view height %+v, incomming block height %+v", curView.ShardHeight, blocks[0].Header.Height))
+	}
+
+	validBlocks, err := verifyShardBatchSignatures(chain, curView.ShardCommittee, blocks)
+	if validBlocks == 0 {
+		return existed, err
+	}
+	blocks = blocks[:validBlocks]
+
+	Logger.log.Infof("SHARD %+v | InsertShardBatchBlock from %+v to %+v", shardID, blocks[0].Header.Height, blocks[len(blocks)-1].Header.Height)
+	batch := blockchain.GetShardChainDatabase(shardID).NewBatch()
+	views := []*ShardBestState{}
+	batchBeaconBlocks := []*BeaconBlock{}
+	var processErr error
+	for _, shardBlock := range blocks {
+		newView, beaconBlocks, err := blockchain.processShardBatchBlock(batch, curView, shardBlock)
+		if err != nil {
+			processErr = err
+			break
+		}
+		views = append(views, newView)
+		batchBeaconBlocks = append(batchBeaconBlocks, beaconBlocks...)
+		curView = newView
+	}
+
+	if len(views) > 0 {
+		// inserted views must always be flushed, even when the batch stops in the middle
+		if err := blockchain.flushShardBatch(batch, shardID, views, batchBeaconBlocks); err != nil {
+			return existed, NewBlockChainError(InsertShardBatchBlockError, err)
+		}
+		Logger.log.Infof("SHARD %+v | Finish InsertShardBatchBlock from %+v to %+v 🔗", shardID, views[0].ShardHeight, views[len(views)-1].ShardHeight)
+	}
+	return existed + len(views), processErr
+}
+
+// getShardBatchRange returns the leading blocks linking to each other,
+// ending at the first block swapping shard committee as next blocks are signed by the new committee
+func getShardBatchRange(shardBlocks []*ShardBlock) []*ShardBlock {
+	for i, shardBlock := range shardBlocks {
+		if i > 0 {
+			prevBlock := shardBlocks[i-1]
+			if shardBlock.Header.PreviousBlockHash != *prevBlock.Hash() || shardBlock.Header.Height != prevBlock.Header.Height+1 {
+				return shardBlocks[:i]
+			}
+		}
+		for _, inst := range shardBlock.Body.Instructions {
+			if len(inst) > 0 && inst[0] == SwapAction {
+				return shardBlocks[:i+1]
+			}
+		}
+	}
+	return shardBlocks
+}
+
+// verifyShardBatchSignatures verifies producer and committee signatures of blocks concurrently
+// Return number of leading blocks having valid signatures and the error of the first invalid block
+func verifyShardBatchSignatures(chain *ShardChain, committee []incognitokey.CommitteePublicKey, shardBlocks []*ShardBlock) (int, error) {
+	errs := make([]error, len(shardBlocks))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = chain.ValidateBlockSignatures(shardBlocks[i], committee)
+			}
+		}()
+	}
+	for i := range shardBlocks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			Logger.log.Errorf("SHARD %+v | Block %+v with hash %+v has invalid signature: %+v", shardBlocks[i].Header.ShardID, shardBlocks[i].Header.Height, shardBlocks[i].Hash().String(), err)
+			return i, NewBlockChainError(InsertShardBatchBlockError, err)
+		}
+	}
+	return len(shardBlocks), nil
+}
+
+// processShardBatchBlock applies one block of a batch on top of curView, state tries of the new view are not flushed
+// and its data is only written to batch
+func (blockchain *BlockChain) processShardBatchBlock(batch incdb.Batch, curView *ShardBestState, shardBlock *ShardBlock) (*ShardBestState, []*BeaconBlock, error) {
+	shardID := shardBlock.Header.ShardID
+	committeeChange := newCommitteeChange()
+	beaconBlocks, err := FetchBeaconBlockFromHeight(blockchain, curView.BeaconHeight+1, shardBlock.Header.BeaconHeight)
+	if err != nil {
+		return nil, nil, NewBlockChainError(FetchBeaconBlocksError, err)
+	}
+	newView, err := curView.updateShardBestState(blockchain, shardBlock, beaconBlocks, committeeChange)
+	if err != nil {
+		return nil, nil, err
+	}
+	newView.updateNumOfBlocksByProducers(shardBlock)
+	if err := blockchain.verifyPostProcessingShardBlock(newView, shardBlock, shardID); err != nil {
+		return nil, nil, err
+	}
+	if err := blockchain.processSalaryInstructions(newView.rewardStateDB, beaconBlocks, shardID); err != nil {
+		return nil, nil, err
+	}
+	if err := blockchain.processStoreShardBlock(newView, shardBlock, committeeChange, beaconBlocks, batch); err != nil {
+		return nil, nil, err
+	}
+	if err := blockchain.storeShardValidatorStats(batch, curView, shardBlock); err != nil {
+		Logger.log.Errorf("SHARD %+v | Failed to store validator stats of block %+v with error: %+v", shardID, shardBlock.Header.Height, err)
+	}
+	blockchain.removeOldDataAfterProcessingShardBlock(shardBlock, shardID)
+	go blockchain.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.NewShardblockTopic, shardBlock))
+	return newView, beaconBlocks, nil
+}
+
+// flushShardBatch flushes state tries of the views still kept in multiview to disk and drops the others from memory,
+// archive node flushes state tries of all views. Roots of the flushed views are recorded, with their rollback state
+// if they are finalized. Then blocks of the batch are written with the backup of shard views
+func (blockchain *BlockChain) flushShardBatch(batch incdb.Batch, shardID byte, views []*ShardBestState, beaconBlocks []*BeaconBlock) error {
+	chain := blockchain.ShardChain[int(shardID)]
+	finalHeight := chain.GetFinalView().GetHeight()
+	dropped := []*ShardBestState{}
+	for _, view := range views {
+		if !blockchain.config.ArchiveMode && chain.GetViewByHash(*view.GetHash()) == nil {
+			dropped = append(dropped, view)
+			continue
+		}
+		if err := view.flushStateDB(); err != nil {
+			return err
+		}
+		sRH := ShardRootHash{
+			ConsensusStateDBRootHash:   view.ConsensusStateDBRootHash,
+			FeatureStateDBRootHash:     view.FeatureStateDBRootHash,
+			RewardStateDBRootHash:      view.RewardStateDBRootHash,
+			SlashStateDBRootHash:       view.SlashStateDBRootHash,
+			TransactionStateDBRootHash: view.TransactionStateDBRootHash,
+		}
+		if err := rawdbv2.StoreShardRootsHash(batch, shardID, *view.GetHash(), sRH); err != nil {
+			return NewBlockChainError(StoreShardBlockError, err)
+		}
+		if view.ShardHeight <= finalHeight {
+			if err := storePreviousShardBestState(batch, view); err != nil {
+				return NewBlockChainError(StoreShardBlockError, err)
+			}
+		}
+	}
+	for _, view := range dropped {
+		view.dropStateDB()
+	}
+
+	if err := blockchain.BackupShardViews(batch, shardID); err != nil {
+		return NewBlockChainError(BackUpShardStateError, err)
+	}
+	if err := batch.Write(); err != nil {
+		return NewBlockChainError(StoreShardBlockError, err)
+	}
+
+	tip := views[len(views)-1]
+	blockchain.backupShardDatabase(tip, beaconBlocks)
+	go blockchain.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.ShardBeststateTopic, tip))
+	return nil
+}
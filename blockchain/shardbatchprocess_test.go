@@ -0,0 +1,103 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+func TestGetShardBatchRange(t *testing.T) {
+	blocks := []*ShardBlock{}
+	prevHash := common.Hash{}
+	for height := uint64(2); height <= 5; height++ {
+		block := NewShardBlock()
+		block.Header.Height = height
+		block.Header.PreviousBlockHash = prevHash
+		if height == 4 {
+			block.Body.Instructions = [][]string{{SwapAction}}
+		}
+		blocks = append(blocks, block)
+		prevHash = *block.Hash()
+	}
+	if got := getShardBatchRange(blocks); len(got) != 3 {
+		t.Fatalf("Expect range stops after the block swapping committee, have %v blocks", len(got))
+	}
+	blocks[1].Header.PreviousBlockHash = common.Hash{}
+	if got := getShardBatchRange(blocks); len(got) != 1 {
+		t.Fatalf("Expect range stops before the block not linking to the previous one, have %v blocks", len(got))
+	}
+}
+
+func TestRollbackIntoShardBatch(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+
+	for _, archiveMode := range []bool{false, true} {
+		bc, beaconBlock := newRollbackTestChain(t)
+		bc.config.ArchiveMode = archiveMode
+		db := bc.GetShardChainDatabase(0)
+		views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+
+		// blocks 2 to 6 are proposed in sequential timeslots, each one finalizes the previous one
+		batch := db.NewBatch()
+		batchViews := []*ShardBestState{}
+		for i := 0; i < 5; i++ {
+			view := newRollbackTestView(t, bc, beaconBlock, views[len(views)-1], int64(10+i), []metadata.Transaction{newRollbackTestTx(t)})
+			if err := bc.processStoreShardBlock(view, view.BestBlock, newCommitteeChange(), []*BeaconBlock{beaconBlock}, batch); err != nil {
+				t.Fatal(err)
+			}
+			views = append(views, view)
+			batchViews = append(batchViews, view)
+		}
+		if err := bc.flushShardBatch(batch, 0, batchViews, []*BeaconBlock{beaconBlock}); err != nil {
+			t.Fatal(err)
+		}
+		if finalHeight := bc.ShardChain[0].GetFinalView().GetHeight(); finalHeight != 5 {
+			t.Fatalf("Expect block 5 is finalized, have final height %v", finalHeight)
+		}
+
+		// roots and rollback state are recorded only for the views whose state tries are flushed
+		for _, view := range views[1:] {
+			_, rootsErr := rawdbv2.GetShardRootsHash(db, 0, view.BestBlockHash)
+			_, stateErr := rawdbv2.GetPreviousShardBestStateByHeight(db, 0, view.ShardHeight)
+			kept := archiveMode || view.ShardHeight >= 5
+			if kept != (rootsErr == nil) {
+				t.Errorf("Expect roots of height %v are recorded: %v (archive mode %v), have %v", view.ShardHeight, kept, archiveMode, rootsErr)
+			}
+			finalized := view.ShardHeight <= 5
+			if (kept && finalized) != (stateErr == nil) {
+				t.Errorf("Expect rollback state of height %v is recorded: %v (archive mode %v), have %v", view.ShardHeight, kept && finalized, archiveMode, stateErr)
+			}
+		}
+
+		if !archiveMode {
+			if _, err := bc.RollbackShardChain(0, 3); err == nil {
+				t.Fatal("Expect non archive node cannot roll back below the final view of a batch")
+			}
+			result, err := bc.RollbackShardChain(0, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.FromHeight != 6 || result.BlockHash != views[4].BestBlockHash {
+				t.Fatalf("Expect chain is rolled back to the final view of the batch, have %+v", result)
+			}
+			continue
+		}
+		result, err := bc.RollbackShardChain(0, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.FromHeight != 6 || result.ToHeight != 3 || result.BlockHash != views[2].BestBlockHash {
+			t.Fatalf("Expect chain is rolled back into the batch at height 3, have %+v", result)
+		}
+		if bestView := bc.ShardChain[0].GetBestView(); bestView.GetHeight() != 3 || *bestView.GetHash() != views[2].BestBlockHash {
+			t.Fatalf("Expect best view is restored at height 3, have height %v", bestView.GetHeight())
+		}
+		if _, err := bc.GetShardTransactionStateDBByHeight(0, 3); err != nil {
+			t.Fatalf("Expect state of height 3 is readable after rollback, have %v", err)
+		}
+	}
+}
@@ -70,6 +70,8 @@ type ShardBestState struct {
 	RewardStateDBRootHash      common.Hash
 	slashStateDB               *statedb.StateDB
 	SlashStateDBRootHash       common.Hash
+	// state tries are committed to memory only (batch insertion), roots and rollback state of the view are not recorded yet
+	pendingFlush bool
 }
 
 func (shardBestState *ShardBestState) GetCopiedConsensusStateDB() *statedb.StateDB {
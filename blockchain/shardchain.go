@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/incognitochain/incognito-chain/incdb"
 	"sync"
 	"time"
@@ -56,8 +57,21 @@ func (s *ShardChain) GetEpoch() uint64 {
 	return s.GetBestState().Epoch
 }
 
-func (s *ShardChain) InsertBatchBlock([]common.BlockInterface) (int, error) {
-	panic("implement me")
+// InsertBatchBlock inserts finalized blocks in batch, see BlockChain.InsertShardBatchBlock
+func (s *ShardChain) InsertBatchBlock(blocks []common.BlockInterface) (int, error) {
+	shardBlocks := make([]*ShardBlock, 0, len(blocks))
+	for _, blk := range blocks {
+		shardBlock, ok := blk.(*ShardBlock)
+		if !ok {
+			return 0, NewBlockChainError(InsertShardBatchBlockError, fmt.Errorf("expect shard block but get %T", blk))
+		}
+		shardBlocks = append(shardBlocks, shardBlock)
+	}
+	successBlk, err := s.Blockchain.InsertShardBatchBlock(shardBlocks)
+	if err != nil {
+		Logger.log.Error(err)
+	}
+	return successBlk, err
 }
 
 func (s *ShardChain) GetCrossShardState() map[byte]uint64 {
@@ -275,6 +289,18 @@ func (chain *ShardChain) ValidatePreSignBlock(block common.BlockInterface) error
 	return err
 }
 
+// PreValidateBlock verify shard block without the view of its previous block, consensus run it while the previous block is being inserted
+func (chain *ShardChain) PreValidateBlock(block common.BlockInterface) error {
+	shardBlock := block.(*ShardBlock)
+	if int(shardBlock.Header.ShardID) != chain.shardID {
+		return NewBlockChainError(WrongShardIDError, fmt.Errorf("Expect receive shardBlock from Shard ID %+v but get %+v", chain.shardID, shardBlock.Header.ShardID))
+	}
+	if err := chain.Blockchain.config.ConsensusEngine.ValidateProducerSig(shardBlock, common.BlsConsensus); err != nil {
+		return err
+	}
+	return chain.Blockchain.verifyShardBlockRoots(shardBlock)
+}
+
 func (chain *ShardChain) GetAllView() []multiview.View {
 	return chain.multiView.GetAllViewsWithBFS()
 }
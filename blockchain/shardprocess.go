@@ -189,11 +189,14 @@ func (blockchain *BlockChain) InsertShardBlock(shardBlock *ShardBlock, shouldVal
 	}
 	Logger.log.Infof("SHARD %+v | Store New Shard Block And Update Data, block height %+v with hash %+v \n", shardID, blockHeight, blockHash)
 	//========Store new  Shard block and new shard bestState
-	err = blockchain.processStoreShardBlock(newBestState, shardBlock, committeeChange, beaconBlocks)
+	err = blockchain.processStoreShardBlock(newBestState, shardBlock, committeeChange, beaconBlocks, nil)
 	if err != nil {
 
 		return err
 	}
+	if err := blockchain.storeShardValidatorStats(blockchain.GetShardChainDatabase(shardID), curView, shardBlock); err != nil {
+		Logger.log.Errorf("SHARD %+v | Failed to store validator stats of block %+v with error: %+v", shardID, blockHeight, err)
+	}
 	blockchain.removeOldDataAfterProcessingShardBlock(shardBlock, shardID)
 	go blockchain.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.NewShardblockTopic, shardBlock))
 	go blockchain.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.ShardBeststateTopic, newBestState))
@@ -287,25 +290,8 @@ func (blockchain *BlockChain) verifyPreProcessingShardBlock(curView *ShardBestSt
 		return NewBlockChainError(WrongTimeslotError, fmt.Errorf("Propose timeslot must be greater than last propose timeslot (but get %v <= %v) ", common.CalculateTimeSlot(shardBlock.Header.ProposeTime), common.CalculateTimeSlot(curView.BestBlock.GetProposeTime())))
 	}
 
-	// Verify transaction root
-	txMerkleTree := Merkle{}.BuildMerkleTreeStore(shardBlock.Body.Transactions)
-	txRoot := &common.Hash{}
-	if len(txMerkleTree) > 0 {
-		txRoot = txMerkleTree[len(txMerkleTree)-1]
-	}
-	if !bytes.Equal(shardBlock.Header.TxRoot.GetBytes(), txRoot.GetBytes()) && (blockchain.config.ChainParams.Net != Testnet || (shardBlock.Header.Height != 487260 && shardBlock.Header.Height != 487261 && shardBlock.Header.Height != 494144)) {
-		return NewBlockChainError(TransactionRootHashError, fmt.Errorf("Expect transaction root hash %+v but get %+v", shardBlock.Header.TxRoot, txRoot))
-	}
-
-	// Verify ShardTx Root
-	_, shardTxMerkleData := CreateShardTxRoot(shardBlock.Body.Transactions)
-	shardTxRoot := shardTxMerkleData[len(shardTxMerkleData)-1]
-	if !bytes.Equal(shardBlock.Header.ShardTxRoot.GetBytes(), shardTxRoot.GetBytes()) {
-		return NewBlockChainError(ShardTransactionRootHashError, fmt.Errorf("Expect shard transaction root hash %+v but get %+v", shardBlock.Header.ShardTxRoot, shardTxRoot))
-	}
-	// Verify crossTransaction coin
-	if !VerifyMerkleCrossTransaction(shardBlock.Body.CrossTransactions, shardBlock.Header.CrossTransactionRoot) {
-		return NewBlockChainError(CrossShardTransactionRootHashError, fmt.Errorf("Expect cross shard transaction root hash %+v", shardBlock.Header.CrossTransactionRoot))
+	if err := blockchain.verifyShardBlockRoots(shardBlock); err != nil {
+		return err
 	}
 	// Verify Action
 	txInstructions, err := CreateShardInstructionsFromTransactionAndInstruction(shardBlock.Body.Transactions, blockchain, shardID, shardBlock.Header.Height)
@@ -443,6 +429,31 @@ func (blockchain *BlockChain) verifyPreProcessingShardBlock(curView *ShardBestSt
 	return err
 }
 
+// verifyShardBlockRoots verify the merkle roots of block body, which do not depend on any view
+func (blockchain *BlockChain) verifyShardBlockRoots(shardBlock *ShardBlock) error {
+	// Verify transaction root
+	txMerkleTree := Merkle{}.BuildMerkleTreeStore(shardBlock.Body.Transactions)
+	txRoot := &common.Hash{}
+	if len(txMerkleTree) > 0 {
+		txRoot = txMerkleTree[len(txMerkleTree)-1]
+	}
+	if !bytes.Equal(shardBlock.Header.TxRoot.GetBytes(), txRoot.GetBytes()) && (blockchain.config.ChainParams.Net != Testnet || (shardBlock.Header.Height != 487260 && shardBlock.Header.Height != 487261 && shardBlock.Header.Height != 494144)) {
+		return NewBlockChainError(TransactionRootHashError, fmt.Errorf("Expect transaction root hash %+v but get %+v", shardBlock.Header.TxRoot, txRoot))
+	}
+
+	// Verify ShardTx Root
+	_, shardTxMerkleData := CreateShardTxRoot(shardBlock.Body.Transactions)
+	shardTxRoot := shardTxMerkleData[len(shardTxMerkleData)-1]
+	if !bytes.Equal(shardBlock.Header.ShardTxRoot.GetBytes(), shardTxRoot.GetBytes()) {
+		return NewBlockChainError(ShardTransactionRootHashError, fmt.Errorf("Expect shard transaction root hash %+v but get %+v", shardBlock.Header.ShardTxRoot, shardTxRoot))
+	}
+	// Verify crossTransaction coin
+	if !VerifyMerkleCrossTransaction(shardBlock.Body.CrossTransactions, shardBlock.Header.CrossTransactionRoot) {
+		return NewBlockChainError(CrossShardTransactionRootHashError, fmt.Errorf("Expect cross shard transaction root hash %+v", shardBlock.Header.CrossTransactionRoot))
+	}
+	return nil
+}
+
 // VerifyPreProcessingShardBlockForSigning verify shard block before a validator signs new shard block
 //	- Verify Transactions In New Block
 //	- Generate Instruction (from beacon), create instruction root and compare instruction root with instruction root in header
@@ -990,6 +1001,12 @@ func (blockchain *BlockChain) verifyTransactionFromNewBlock(shardID byte, txs []
 		}
 	}
 	_, err := blockchain.config.TempTxPool.MaybeAcceptBatchTransactionForBlockProducing(shardID, listTxs, beaconHeight, curView)
+	if batchErr, ok := err.(*transaction.BatchTxError); ok {
+		// batch verification already pinpoints the invalid transaction, no need to verify one by one
+		tx := listTxs[batchErr.TxIndex]
+		Logger.log.Errorf("Batching verify transactions from new block, tx at index %d error: %+v", batchErr.TxIndex, batchErr.Err)
+		return NewBlockChainError(TransactionFromNewBlockError, fmt.Errorf("Transaction %+v, index %+v get %+v ", *tx.Hash(), batchErr.TxIndex, batchErr.Err))
+	}
 	if err != nil {
 		Logger.log.Errorf("Batching verify transactions from new block err: %+v\n Trying verify one by one", err)
 		for index, tx := range listTxs {
@@ -1016,9 +1033,16 @@ func (blockchain *BlockChain) verifyTransactionFromNewBlock(shardID byte, txs []
 //	- Store incoming cross shard block
 //	- Store Burning Confirmation
 //	- Update Mempool fee estimator
-func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestState, shardBlock *ShardBlock, committeeChange *committeeChange, beaconBlocks []*BeaconBlock) error {
+// If batch is set (batch insertion), state tries are only committed to memory and block data is only written to batch,
+// shard views are not backed up, caller must flush them when the batch is done
+func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestState, shardBlock *ShardBlock, committeeChange *committeeChange, beaconBlocks []*BeaconBlock, batch incdb.Batch) error {
 
 	shardID := shardBlock.Header.ShardID
+	deferFlush := batch != nil
+	var db incdb.KeyValueWriter = blockchain.GetShardChainDatabase(shardID)
+	if deferFlush {
+		db = batch
+	}
 	blockHeight := shardBlock.Header.Height
 	blockHash := shardBlock.Header.Hash()
 
@@ -1031,9 +1055,14 @@ func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestSta
 	}
 
 	for index, tx := range shardBlock.Body.Transactions {
-		if err := rawdbv2.StoreTransactionIndex(blockchain.GetShardChainDatabase(shardID), *tx.Hash(), shardBlock.Header.Hash(), index); err != nil {
+		if err := rawdbv2.StoreTransactionIndex(db, *tx.Hash(), shardBlock.Header.Hash(), index); err != nil {
 			return NewBlockChainError(FetchAndStoreTransactionError, err)
 		}
+		if tokenID, ok := getTxTokenID(tx); ok {
+			if err := rawdbv2.StoreTokenTx(db, tokenID, *tx.Hash()); err != nil {
+				return NewBlockChainError(FetchAndStoreTransactionError, err)
+			}
+		}
 		// Process Transaction Metadata
 		metaType := tx.GetMetadataType()
 		if metaType == metadata.WithDrawRewardResponseMeta {
@@ -1043,8 +1072,19 @@ func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestSta
 				return NewBlockChainError(RemoveCommitteeRewardError, err)
 			}
 		}
+		// auto withdrawal pays the reward without fee, the fee is removed with it
+		if metaType == metadata.AutoWithdrawRewardResponseMeta {
+			_, publicKey, amountRes, coinID := tx.GetTransferData()
+			err := statedb.RemoveCommitteeReward(newShardState.rewardStateDB, publicKey, amountRes+tx.GetMetadata().(*metadata.AutoWithdrawRewardResponse).Fee, *coinID)
+			if err != nil {
+				return NewBlockChainError(RemoveCommitteeRewardError, err)
+			}
+		}
 		Logger.log.Debug("Transaction in block with hash", blockHash, "and index", index)
 	}
+	if err := storeTxReceiptResponses(db, shardBlock); err != nil {
+		return NewBlockChainError(FetchAndStoreTransactionError, err)
+	}
 	// Store Incomming Cross Shard
 	if err := blockchain.CreateAndSaveCrossTransactionViewPointFromBlock(shardBlock, newShardState.transactionStateDB); err != nil {
 		return NewBlockChainError(FetchAndStoreCrossTransactionError, err)
@@ -1114,82 +1154,28 @@ func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestSta
 		return NewBlockChainError(StoreShardBlockError, err)
 	}
 
-	// consensus root hash
-	consensusRootHash, err := newShardState.consensusStateDB.Commit(true) // Store data to memory
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	err = newShardState.consensusStateDB.Database().TrieDB().Commit(consensusRootHash, false) // Save data to disk database
+	sRH, err := newShardState.commitStateDB(!deferFlush)
 	if err != nil {
 		return NewBlockChainError(StoreShardBlockError, err)
 	}
 
-	newShardState.ConsensusStateDBRootHash = consensusRootHash
-	// transaction root hash
-	transactionRootHash, err := newShardState.transactionStateDB.Commit(true)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	err = newShardState.transactionStateDB.Database().TrieDB().Commit(transactionRootHash, false)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	newShardState.TransactionStateDBRootHash = transactionRootHash
-	// feature root hash
-	featureRootHash, err := newShardState.featureStateDB.Commit(true)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	err = newShardState.featureStateDB.Database().TrieDB().Commit(featureRootHash, false)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	newShardState.FeatureStateDBRootHash = featureRootHash
-	// reward root hash
-	rewardRootHash, err := newShardState.rewardStateDB.Commit(true)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	err = newShardState.rewardStateDB.Database().TrieDB().Commit(rewardRootHash, false)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	newShardState.RewardStateDBRootHash = rewardRootHash
-	// slash root hash
-	slashRootHash, err := newShardState.slashStateDB.Commit(true)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	err = newShardState.slashStateDB.Database().TrieDB().Commit(slashRootHash, false)
-	if err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
-	}
-	newShardState.consensusStateDB.ClearObjects()
-	newShardState.transactionStateDB.ClearObjects()
-	newShardState.featureStateDB.ClearObjects()
-	newShardState.rewardStateDB.ClearObjects()
-	newShardState.slashStateDB.ClearObjects()
-
-	batchData := blockchain.GetShardChainDatabase(shardID).NewBatch()
-	sRH := ShardRootHash{
-		ConsensusStateDBRootHash:   consensusRootHash,
-		FeatureStateDBRootHash:     featureRootHash,
-		RewardStateDBRootHash:      rewardRootHash,
-		SlashStateDBRootHash:       slashRootHash,
-		TransactionStateDBRootHash: transactionRootHash,
-	}
-
-	if err := rawdbv2.StoreShardRootsHash(batchData, shardID, blockHash, sRH); err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
+	batchData := batch
+	if !deferFlush {
+		batchData = blockchain.GetShardChainDatabase(shardID).NewBatch()
+		if err := rawdbv2.StoreShardRootsHash(batchData, shardID, blockHash, sRH); err != nil {
+			return NewBlockChainError(StoreShardBlockError, err)
+		}
 	}
 
 	//statedb===========================END
 	if err := rawdbv2.StoreShardBlock(batchData, blockHash, shardBlock); err != nil {
 		return NewBlockChainError(StoreShardBlockError, err)
 	}
-	finalView := blockchain.ShardChain[shardID].multiView.GetFinalView()
-	blockchain.ShardChain[shardBlock.Header.ShardID].multiView.AddView(newShardState)
-	newFinalView := blockchain.ShardChain[shardID].multiView.GetFinalView()
+	multiView := blockchain.ShardChain[shardID].multiView
+	finalView := multiView.GetFinalView()
+	views := getViewsToFinalize(multiView, newShardState)
+	multiView.AddView(newShardState)
+	newFinalView := multiView.GetFinalView()
 
 	storeBlock := newFinalView.GetBlock()
 
@@ -1198,32 +1184,116 @@ func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestSta
 		if err != nil {
 			return NewBlockChainError(StoreBeaconBlockError, err)
 		}
+		// tx history is indexed from finalized blocks only, transactions of forks are never listed
+		if err := storeTxHistory(batchData, storeBlock.(*ShardBlock)); err != nil {
+			return NewBlockChainError(FetchAndStoreTransactionError, err)
+		}
+		// state of a view inserted in batch is recorded when its tries are flushed, see flushShardBatch
+		if view, ok := views[*storeBlock.Hash()]; ok && !view.(*ShardBestState).pendingFlush {
+			if err := storePreviousShardBestState(batchData, view.(*ShardBestState)); err != nil {
+				return NewBlockChainError(StoreShardBlockError, err)
+			}
+		}
 		if storeBlock.GetHeight() == 1 {
 			break
 		}
 		prevHash := storeBlock.GetPrevHash()
-		newFinalView = blockchain.ShardChain[shardID].multiView.GetViewByHash(prevHash)
-		if newFinalView == nil {
+		if view, ok := views[prevHash]; ok {
+			storeBlock = view.GetBlock()
+		} else {
 			storeBlock, _, err = blockchain.GetShardBlockByHashWithShardID(prevHash, shardID)
 			if err != nil {
 				panic("Database is corrupt")
 			}
-		} else {
-			storeBlock = newFinalView.GetBlock()
 		}
 	}
 
-	err = blockchain.BackupShardViews(batchData, shardBlock.Header.ShardID)
-	if err != nil {
-		panic("Backup shard view error")
+	if !deferFlush {
+		err = blockchain.BackupShardViews(batchData, shardBlock.Header.ShardID)
+		if err != nil {
+			panic("Backup shard view error")
+		}
+		if err := batchData.Write(); err != nil {
+			return NewBlockChainError(StoreShardBlockError, err)
+		}
+		blockchain.backupShardDatabase(newShardState, beaconBlocks)
 	}
 
-	if err := batchData.Write(); err != nil {
-		return NewBlockChainError(StoreShardBlockError, err)
+	Logger.log.Infof("SHARD %+v | 🔎 %d transactions in block height %+v \n", shardBlock.Header.ShardID, len(shardBlock.Body.Transactions), blockHeight)
+	return nil
+}
+
+// commitStateDB commits all state tries of the view and clears their cached objects
+// if flush is not set, tries are only committed to the in-memory trie database, see flushStateDB
+func (shardBestState *ShardBestState) commitStateDB(flush bool) (ShardRootHash, error) {
+	shardBestState.pendingFlush = !flush
+	stateDBs, rootHashes := shardBestState.stateDBsWithRootHash()
+	for i, stateDB := range stateDBs {
+		rootHash, err := stateDB.Commit(true) // Store data to memory
+		if err != nil {
+			return ShardRootHash{}, err
+		}
+		if flush {
+			err = stateDB.Database().TrieDB().Commit(rootHash, false) // Save data to disk database
+			if err != nil {
+				return ShardRootHash{}, err
+			}
+		}
+		*rootHashes[i] = rootHash
+	}
+	for _, stateDB := range stateDBs {
+		stateDB.ClearObjects()
+	}
+	return ShardRootHash{
+		ConsensusStateDBRootHash:   shardBestState.ConsensusStateDBRootHash,
+		FeatureStateDBRootHash:     shardBestState.FeatureStateDBRootHash,
+		RewardStateDBRootHash:      shardBestState.RewardStateDBRootHash,
+		SlashStateDBRootHash:       shardBestState.SlashStateDBRootHash,
+		TransactionStateDBRootHash: shardBestState.TransactionStateDBRootHash,
+	}, nil
+}
+
+// flushStateDB saves state tries of a view committed with commitStateDB(false) to disk database
+func (shardBestState *ShardBestState) flushStateDB() error {
+	stateDBs, rootHashes := shardBestState.stateDBsWithRootHash()
+	for i, stateDB := range stateDBs {
+		if err := stateDB.Database().TrieDB().Commit(*rootHashes[i], false); err != nil {
+			return err
+		}
+	}
+	shardBestState.pendingFlush = false
+	return nil
+}
+
+// dropStateDB removes state tries of a view committed with commitStateDB(false) from memory,
+// trie nodes already flushed to disk by another view are kept
+func (shardBestState *ShardBestState) dropStateDB() {
+	stateDBs, rootHashes := shardBestState.stateDBsWithRootHash()
+	for i, stateDB := range stateDBs {
+		stateDB.Database().TrieDB().Dereference(*rootHashes[i])
 	}
+}
 
+func (shardBestState *ShardBestState) stateDBsWithRootHash() ([]*statedb.StateDB, []*common.Hash) {
+	return []*statedb.StateDB{
+			shardBestState.consensusStateDB,
+			shardBestState.transactionStateDB,
+			shardBestState.featureStateDB,
+			shardBestState.rewardStateDB,
+			shardBestState.slashStateDB,
+		}, []*common.Hash{
+			&shardBestState.ConsensusStateDBRootHash,
+			&shardBestState.TransactionStateDBRootHash,
+			&shardBestState.FeatureStateDBRootHash,
+			&shardBestState.RewardStateDBRootHash,
+			&shardBestState.SlashStateDBRootHash,
+		}
+}
+
+// backupShardDatabase backs up shard database when one of the beacon blocks is the last block of an epoch
+func (blockchain *BlockChain) backupShardDatabase(newShardState *ShardBestState, beaconBlocks []*BeaconBlock) {
 	if !blockchain.config.ChainParams.IsBackup {
-		return nil
+		return
 	}
 
 	backupPoint := false
@@ -1239,9 +1309,6 @@ func (blockchain *BlockChain) processStoreShardBlock(newShardState *ShardBestSta
 			blockchain.GetShardChainDatabase(newShardState.ShardID).RemoveBackup(fmt.Sprintf("../../backup/shard%d/%d", newShardState.ShardID, newShardState.Epoch))
 		}
 	}
-
-	Logger.log.Infof("SHARD %+v | 🔎 %d transactions in block height %+v \n", shardBlock.Header.ShardID, len(shardBlock.Body.Transactions), blockHeight)
-	return nil
 }
 
 // removeOldDataAfterProcessingShardBlock remove outdate data from pool and beststate
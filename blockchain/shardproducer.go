@@ -308,6 +308,12 @@ func (blockGenerator *BlockGenerator) buildResponseTxsFromBeaconInstructions(cur
 	responsedHashTxs := []common.Hash{} // capture hash of responsed tx
 	errorInstructions := [][]string{}   // capture error instruction -> which instruction can not create tx
 	beaconView := blockGenerator.chain.BeaconChain.GetFinalView().(*BeaconBestState)
+	builders := blockGenerator.newResponseBuilders(&ResponseBuilderEnv{
+		ShardView:          curView,
+		BeaconView:         beaconView,
+		ProducerPrivateKey: producerPrivateKey,
+		ShardID:            shardID,
+	})
 	//TODO: Please check this logic again, why PDE, Bridge build from old beacon block but get info from beacon final view
 	for _, beaconBlock := range beaconBlocks {
 		for _, l := range beaconBlock.Body.Instructions {
@@ -321,105 +327,16 @@ func (blockGenerator *BlockGenerator) buildResponseTxsFromBeaconInstructions(cur
 			if err != nil {
 				return nil, nil, err
 			}
-			var newTx metadata.Transaction
-			switch metaType {
-			case metadata.IssuingETHRequestMeta:
-				if len(l) >= 4 && l[2] == "accepted" {
-					newTx, err = blockGenerator.buildETHIssuanceTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.IssuingRequestMeta:
-				if len(l) >= 4 && l[2] == "accepted" {
-					newTx, err = blockGenerator.buildIssuanceTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.PDETradeRequestMeta:
-				if len(l) >= 4 {
-					newTx, err = blockGenerator.buildPDETradeIssuanceTx(l[2], l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.PDECrossPoolTradeRequestMeta:
-				if len(l) >= 4 {
-					newTx, err = blockGenerator.buildPDECrossPoolTradeIssuanceTx(l[2], l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.PDEWithdrawalRequestMeta:
-				if len(l) >= 4 && l[2] == common.PDEWithdrawalAcceptedChainStatus {
-					newTx, err = blockGenerator.buildPDEWithdrawalTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.PDEFeeWithdrawalRequestMeta:
-				if len(l) >= 4 && l[2] == common.PDEFeeWithdrawalAcceptedChainStatus {
-					newTx, err = blockGenerator.buildPDEFeeWithdrawalTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-				}
-			case metadata.PDEContributionMeta, metadata.PDEPRVRequiredContributionRequestMeta:
-				if len(l) >= 4 {
-					if l[2] == common.PDEContributionRefundChainStatus {
-						newTx, err = blockGenerator.buildPDERefundContributionTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-					} else if l[2] == common.PDEContributionMatchedNReturnedChainStatus {
-						newTx, err = blockGenerator.buildPDEMatchedNReturnedContributionTx(l[3], producerPrivateKey, shardID, curView, beaconView)
-					}
-				}
-			// portal
-			case metadata.PortalRequestPortingMeta, metadata.PortalRequestPortingMetaV3:
-				if len(l) >= 4 && l[2] == common.PortalPortingRequestRejectedChainStatus {
-					newTx, err = curView.buildPortalRefundPortingFeeTx(l[3], producerPrivateKey, shardID)
-				}
-			case metadata.PortalCustodianDepositMeta:
-				if len(l) >= 4 && l[2] == common.PortalCustodianDepositRefundChainStatus {
-					newTx, err = curView.buildPortalRefundCustodianDepositTx(l[3], producerPrivateKey, shardID)
-				}
-			case metadata.PortalUserRequestPTokenMeta:
-				if len(l) >= 4 && l[2] == common.PortalReqPTokensAcceptedChainStatus {
-					newTx, err = curView.buildPortalAcceptedRequestPTokensTx(blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
-				}
-				//custodian withdraw
-			case metadata.PortalCustodianWithdrawRequestMeta:
-				if len(l) >= 4 && l[2] == common.PortalCustodianWithdrawRequestAcceptedChainStatus {
-					newTx, err = curView.buildPortalCustodianWithdrawRequest(l[3], producerPrivateKey, shardID)
-				}
-			case metadata.PortalRedeemRequestMeta, metadata.PortalRedeemRequestMetaV3:
-				if len(l) >= 4 && (l[2] == common.PortalRedeemRequestRejectedChainStatus || l[2] == common.PortalRedeemReqCancelledByLiquidationChainStatus) {
-					newTx, err = curView.buildPortalRejectedRedeemRequestTx(blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
-				}
-				//liquidation: redeem ptoken
-			case metadata.PortalRedeemFromLiquidationPoolMeta:
-				if len(l) >= 4 {
-					if l[2] == common.PortalRedeemFromLiquidationPoolSuccessChainStatus {
-						newTx, err = curView.buildPortalRedeemLiquidateExchangeRatesRequestTx(l[3], producerPrivateKey, shardID)
-					} else if l[2] == common.PortalRedeemFromLiquidationPoolRejectedChainStatus {
-						newTx, err = curView.buildPortalRefundRedeemLiquidateExchangeRatesTx(blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
-					}
-				}
-			case metadata.PortalLiquidateCustodianMeta, metadata.PortalLiquidateCustodianMetaV3:
-				if len(l) >= 4 && l[2] == common.PortalLiquidateCustodianSuccessChainStatus {
-					newTx, err = curView.buildPortalLiquidateCustodianResponseTx(l[3], producerPrivateKey, shardID)
-				}
-			case metadata.PortalRequestWithdrawRewardMeta:
-				if len(l) >= 4 && l[2] == common.PortalReqWithdrawRewardAcceptedChainStatus {
-					newTx, err = curView.buildPortalAcceptedWithdrawRewardTx(blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
-				}
-				//liquidation: custodian deposit
-			case metadata.PortalCustodianTopupMeta:
-				if len(l) >= 4 && l[2] == common.PortalCustodianTopupRejectedChainStatus {
-					newTx, err = curView.buildPortalLiquidationCustodianDepositReject(l[3], producerPrivateKey, shardID)
-				}
-			case metadata.PortalCustodianTopupMetaV2:
-				if len(l) >= 4 && l[2] == common.PortalCustodianTopupRejectedChainStatus {
-					newTx, err = curView.buildPortalLiquidationCustodianDepositRejectV2(l[3], producerPrivateKey, shardID)
-				}
-			//
-			case metadata.PortalTopUpWaitingPortingRequestMeta:
-				if len(l) >= 4 && l[2] == common.PortalTopUpWaitingPortingRejectedChainStatus {
-					newTx, err = curView.buildPortalRejectedTopUpWaitingPortingTx(l[3], producerPrivateKey, shardID)
-				}
-			//redeem from liquidation pool
-			case metadata.PortalRedeemFromLiquidationPoolMetaV3:
-				if len(l) >= 4 {
-					if l[2] == common.PortalRedeemFromLiquidationPoolSuccessChainStatus {
-						newTx, err = curView.buildPortalRedeemLiquidateExchangeRatesRequestTxV3(l[3], producerPrivateKey, shardID)
-					} else if l[2] == common.PortalRedeemFromLiquidationPoolRejectedChainStatus {
-						newTx, err = curView.buildPortalRefundRedeemLiquidateExchangeRatesTxV3(blockGenerator.chain.GetBeaconBestState(), l[3], producerPrivateKey, shardID)
-					}
-				}
-			default:
+			// instructions are answered by the feature of their metadata type
+			feature, ok := metadata.GetMetadataFeature(metaType)
+			if !ok {
+				continue
+			}
+			builder, ok := builders[feature]
+			if !ok {
 				continue
 			}
+			newTx, err := builder.BuildResponseTx(metaType, l)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -586,7 +503,7 @@ func (blockchain *BlockChain) generateInstruction(view *ShardBestState, shardID
 		// Generate instruction storing merkle root of validators pubkey and send to beacon
 		bridgeID := byte(common.BridgeShardID)
 		epoch := beaconHeight / blockchain.config.ChainParams.Epoch
-		if shardID == bridgeID && committeeChanged(swapInstruction) && epoch < blockchain.config.ChainParams.ETHRemoveBridgeSigEpoch { // Disable SwapConfirm inst after this epoch
+		if shardID == bridgeID && committeeChanged(swapInstruction) && !blockchain.IsFeatureActiveAtEpoch(common.ETHRemoveBridgeSigFeature, epoch) { // Disable SwapConfirm inst after this epoch
 			blockHeight := view.ShardHeight + 1
 			bridgeSwapConfirmInst, err = buildBridgeSwapConfirmInstruction(shardCommittee, blockHeight)
 			if err != nil {
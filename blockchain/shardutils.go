@@ -248,6 +248,20 @@ func reqTableFromReqTxs(
 	return txRequestTable
 }
 
+// autoWithdrawnTableFromTxs returns the requesters (as in reqTableFromReqTxs) of which the reward is paid by auto withdrawal
+func autoWithdrawnTableFromTxs(
+	transactions []metadata.Transaction,
+) map[string]bool {
+	autoWithdrawnTable := map[string]bool{}
+	for _, tx := range transactions {
+		if tx.GetMetadataType() == metadata.AutoWithdrawRewardResponseMeta {
+			_, receiver, _, coinID := tx.GetTransferData()
+			autoWithdrawnTable[getRequesterFromPKnCoinID(receiver, *coinID)] = true
+		}
+	}
+	return autoWithdrawnTable
+}
+
 func filterReqTxs(
 	transactions []metadata.Transaction,
 	txRequestTable map[string]metadata.Transaction,
@@ -0,0 +1,178 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes/bridgesig"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+type signedHeaderInfo struct {
+	hash        common.Hash
+	height      uint64
+	proposeTime int64
+	proposer    string
+	shardID     int
+}
+
+func decodeSignedHeader(chainID int, signed metadata.SignedBlockHeader) (*signedHeaderInfo, error) {
+	if chainID == -1 { // beacon
+		header := BeaconHeader{}
+		if err := json.Unmarshal(signed.Header, &header); err != nil {
+			return nil, err
+		}
+		return &signedHeaderInfo{
+			hash:        header.Hash(),
+			height:      header.Height,
+			proposeTime: header.ProposeTime,
+			proposer:    header.Proposer,
+			shardID:     -1,
+		}, nil
+	}
+	header := ShardHeader{}
+	if err := json.Unmarshal(signed.Header, &header); err != nil {
+		return nil, err
+	}
+	return &signedHeaderInfo{
+		hash:        header.Hash(),
+		height:      header.Height,
+		proposeTime: header.ProposeTime,
+		proposer:    header.Proposer,
+		shardID:     int(header.ShardID),
+	}, nil
+}
+
+func verifyBridgeSig(data common.Hash, signature []byte, bridgePk []byte) error {
+	ok, err := bridgesig.Verify(bridgePk, data.GetBytes(), signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// verifyEquivocationEvidence checks that the offender signed two different headers
+// of the same chain at the same height and timeslot
+func verifyEquivocationEvidence(evidence metadata.EquivocationEvidence) error {
+	if err := evidence.ValidateSanity(); err != nil {
+		return err
+	}
+	offender := incognitokey.CommitteePublicKey{}
+	if err := offender.FromBase58(evidence.Validator); err != nil {
+		return err
+	}
+	bridgePk := offender.MiningPubKey[common.BridgeConsensus]
+	headers := []*signedHeaderInfo{}
+	for _, signed := range []metadata.SignedBlockHeader{evidence.First, evidence.Second} {
+		header, err := decodeSignedHeader(evidence.ChainID, signed)
+		if err != nil {
+			return err
+		}
+		if header.shardID != evidence.ChainID {
+			return fmt.Errorf("header of chain %+v does not belong to chain %+v", header.shardID, evidence.ChainID)
+		}
+		switch evidence.Type {
+		case metadata.EquivocationPropose:
+			if header.proposer != evidence.Validator {
+				return fmt.Errorf("header %+v is not proposed by %+v", header.hash.String(), evidence.Validator)
+			}
+			if err := verifyBridgeSig(header.hash, signed.Signature, bridgePk); err != nil {
+				return err
+			}
+		case metadata.EquivocationVote:
+			data := []byte{}
+			data = append(data, header.hash.String()...)
+			data = append(data, signed.BLS...)
+			data = append(data, signed.BRI...)
+			if err := verifyBridgeSig(common.HashH(data), signed.Signature, bridgePk); err != nil {
+				return err
+			}
+		}
+		headers = append(headers, header)
+	}
+	if headers[0].hash.IsEqual(&headers[1].hash) {
+		return errors.New("evidence must contain two different blocks")
+	}
+	if headers[0].height != headers[1].height {
+		return fmt.Errorf("blocks height are different %+v %+v", headers[0].height, headers[1].height)
+	}
+	if common.CalculateTimeSlot(headers[0].proposeTime) != common.CalculateTimeSlot(headers[1].proposeTime) {
+		return errors.New("blocks are proposed in different timeslots")
+	}
+	return nil
+}
+
+// isStakeConfiscated return true if the staking tx of committee public key is already invalidated
+func isStakeConfiscated(consensusStateDB *statedb.StateDB, committeePublicKey string) bool {
+	stakerInfo, has, err := statedb.GetStakerInfo(consensusStateDB, committeePublicKey)
+	if err != nil || !has {
+		return false
+	}
+	return !stakerInfo.AutoStaking() && stakerInfo.TxStakingID() == common.HashH([]byte{0})
+}
+
+// buildInstructionsForSlashEquivocationReq verify the evidence carried by request and return
+// ["slashequivocation", "committeePublicKey", "txReqID"]
+func (blockchain *BlockChain) buildInstructionsForSlashEquivocationReq(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	slashedPublicKeys map[string]bool,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var slashAction metadata.SlashEquivocationRequestAction
+	err = json.Unmarshal(contentBytes, &slashAction)
+	if err != nil {
+		return [][]string{}, err
+	}
+	evidence := slashAction.Meta.Evidence
+	if slashedPublicKeys[evidence.Validator] {
+		Logger.log.Warnf("WARNING: %+v is already slashed in the current block", evidence.Validator)
+		return [][]string{}, nil
+	}
+	if common.IndexOfStr(evidence.Validator, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+		// stake of an unstaked validator is still slashable during its unbonding period
+		if _, has, err := statedb.GetValidatorUnbonding(beaconBestState.consensusStateDB, evidence.Validator); err != nil || !has {
+			Logger.log.Warnf("WARNING: %+v not found in any committee list", evidence.Validator)
+			return [][]string{}, nil
+		}
+	}
+	if isStakeConfiscated(beaconBestState.consensusStateDB, evidence.Validator) {
+		Logger.log.Warnf("WARNING: stake of %+v is already confiscated", evidence.Validator)
+		return [][]string{}, nil
+	}
+	if err := verifyEquivocationEvidence(evidence); err != nil {
+		Logger.log.Warnf("WARNING: invalid equivocation evidence of tx %+v: %+v", slashAction.TxReqID.String(), err)
+		return [][]string{}, nil
+	}
+	slashedPublicKeys[evidence.Validator] = true
+	inst := []string{SlashEquivocationAction, evidence.Validator, slashAction.TxReqID.String()}
+	return [][]string{inst}, nil
+}
+
+// processSlashEquivocationInstruction turn off auto staking and invalidate the staking tx of offender,
+// so that its stake is not returned when it is swapped out
+func (beaconBestState *BeaconBestState) processSlashEquivocationInstruction(instruction []string, committeeChange *committeeChange) {
+	if len(instruction) != 3 {
+		return
+	}
+	committeePublicKey := instruction[1]
+	if _, ok := beaconBestState.AutoStaking.Get(committeePublicKey); !ok {
+		return
+	}
+	beaconBestState.AutoStaking.Set(committeePublicKey, false)
+	beaconBestState.StakingTx[committeePublicKey] = common.HashH([]byte{0})
+	if common.IndexOfStr(committeePublicKey, committeeChange.stopAutoStaking) == -1 {
+		committeeChange.stopAutoStaking = append(committeeChange.stopAutoStaking, committeePublicKey)
+	}
+}
@@ -0,0 +1,217 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes/bridgesig"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+type testEquivocationSigner struct {
+	publicKey string
+	bridgeSK  []byte
+}
+
+func newTestEquivocationSigner(t *testing.T, seed string) *testEquivocationSigner {
+	seedBytes := common.HashB([]byte(seed))
+	committeePublicKey, err := incognitokey.NewCommitteeKeyFromSeed(seedBytes, seedBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := committeePublicKey.ToBase58()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridgeSK, _ := bridgesig.KeyGen(seedBytes)
+	return &testEquivocationSigner{
+		publicKey: publicKey,
+		bridgeSK:  bridgesig.SKBytes(&bridgeSK),
+	}
+}
+
+func (signer *testEquivocationSigner) signPropose(t *testing.T, header ShardHeader) metadata.SignedBlockHeader {
+	hash := header.Hash()
+	sig, err := bridgesig.Sign(signer.bridgeSK, hash.GetBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.SignedBlockHeader{Header: mustMarshal(t, header), Signature: sig}
+}
+
+func (signer *testEquivocationSigner) signVote(t *testing.T, header ShardHeader) metadata.SignedBlockHeader {
+	hash := header.Hash()
+	bls := common.HashB([]byte("bls" + hash.String()))
+	data := []byte{}
+	data = append(data, hash.String()...)
+	data = append(data, bls...)
+	sig, err := bridgesig.Sign(signer.bridgeSK, common.HashB(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.SignedBlockHeader{Header: mustMarshal(t, header), BLS: bls, Signature: sig}
+}
+
+func mustMarshal(t *testing.T, header ShardHeader) []byte {
+	data, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestVerifyEquivocationEvidence(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+
+	offender := newTestEquivocationSigner(t, "offender")
+	other := newTestEquivocationSigner(t, "other")
+	newHeader := func(height uint64, proposeTime int64, txRoot string) ShardHeader {
+		return ShardHeader{
+			ShardID:     1,
+			Height:      height,
+			ProposeTime: proposeTime,
+			Proposer:    offender.publicKey,
+			TxRoot:      common.HashH([]byte(txRoot)),
+		}
+	}
+	first := newHeader(10, 1000, "first")
+	second := newHeader(10, 1005, "second")
+
+	tests := []struct {
+		name     string
+		evidence metadata.EquivocationEvidence
+		wantErr  bool
+	}{
+		{
+			name: "two proposed blocks at the same height and timeslot",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    offender.signPropose(t, second),
+			},
+			wantErr: false,
+		},
+		{
+			name: "two votes at the same height and timeslot",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationVote,
+				Validator: offender.publicKey,
+				First:     offender.signVote(t, first),
+				Second:    offender.signVote(t, second),
+			},
+			wantErr: false,
+		},
+		{
+			name: "same block signed twice",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    offender.signPropose(t, first),
+			},
+			wantErr: true,
+		},
+		{
+			name: "blocks at different heights",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    offender.signPropose(t, newHeader(11, 1005, "second")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "blocks in different timeslots",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    offender.signPropose(t, newHeader(10, 1010, "second")),
+			},
+			wantErr: true,
+		},
+		{
+			name: "block signed by another validator",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    other.signPropose(t, second),
+			},
+			wantErr: true,
+		},
+		{
+			name: "vote signed by another validator",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   1,
+				Type:      metadata.EquivocationVote,
+				Validator: offender.publicKey,
+				First:     offender.signVote(t, first),
+				Second:    other.signVote(t, second),
+			},
+			wantErr: true,
+		},
+		{
+			name: "headers of another chain",
+			evidence: metadata.EquivocationEvidence{
+				ChainID:   2,
+				Type:      metadata.EquivocationPropose,
+				Validator: offender.publicKey,
+				First:     offender.signPropose(t, first),
+				Second:    offender.signPropose(t, second),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyEquivocationEvidence(tt.evidence)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyEquivocationEvidence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBeaconBestState_processSlashEquivocationInstruction(t *testing.T) {
+	offender := newTestEquivocationSigner(t, "offender").publicKey
+	stakingTx := common.HashH([]byte("staking tx"))
+	beaconBestState := &BeaconBestState{
+		AutoStaking: NewMapStringBool(),
+		StakingTx:   map[string]common.Hash{offender: stakingTx},
+	}
+	beaconBestState.AutoStaking.Set(offender, true)
+	committeeChange := newCommitteeChange()
+
+	// malformed and unknown validators are ignored
+	beaconBestState.processSlashEquivocationInstruction([]string{SlashEquivocationAction, offender}, committeeChange)
+	beaconBestState.processSlashEquivocationInstruction([]string{SlashEquivocationAction, "unknown", "tx"}, committeeChange)
+	if autoStaking, _ := beaconBestState.AutoStaking.Get(offender); !autoStaking || len(committeeChange.stopAutoStaking) != 0 {
+		t.Fatal("Expect no change of auto staking for malformed or unknown instructions")
+	}
+
+	inst := []string{SlashEquivocationAction, offender, common.HashH([]byte("slash tx")).String()}
+	beaconBestState.processSlashEquivocationInstruction(inst, committeeChange)
+	beaconBestState.processSlashEquivocationInstruction(inst, committeeChange)
+	if autoStaking, _ := beaconBestState.AutoStaking.Get(offender); autoStaking {
+		t.Error("Expect auto staking of offender is turned off")
+	}
+	if beaconBestState.StakingTx[offender] != common.HashH([]byte{0}) {
+		t.Errorf("Expect staking tx of offender is invalidated, have %v", beaconBestState.StakingTx[offender].String())
+	}
+	if len(committeeChange.stopAutoStaking) != 1 || committeeChange.stopAutoStaking[0] != offender {
+		t.Errorf("Expect offender is stopped auto staking once, have %v", committeeChange.stopAutoStaking)
+	}
+}
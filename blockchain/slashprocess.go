@@ -129,6 +129,10 @@ func (blockchain *BlockChain) processForSlashing(slashStateDB *statedb.StateDB,
 		if len(inst) == 0 {
 			continue
 		}
+		if inst[0] == SlashEquivocationAction && len(inst) == 3 {
+			producersBlackList[inst[1]] = EquivocationPunishedEpoches
+			continue
+		}
 		if inst[0] != SwapAction {
 			continue
 		}
@@ -0,0 +1,253 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// Staking pools let PRV holders delegate to a shard validator and get a share of its rewards:
+//	- the funder of the staking tx of a validator registers its pool with a commission (basis points), registering
+//	again changes the commission StakingPoolCommissionChangeDelay epochs later so delegators can leave the pool before
+//	- delegators burn PRV to the pool, the amount is stored in the beacon consensus state, a rejected delegation
+//	is refunded by the shard of the delegator
+//	- rewards of a validator with delegations are split pro rata between its own stake and the delegated amounts,
+//	the operator of the pool gets the commission on the delegators part
+// Pools are kept in beacon consensus state so shards read them at the same root as the committee of the epoch
+
+func buildStakingPoolInst(metaType int, shardID byte, status string, content interface{}) ([][]string, error) {
+	contentBytes, err := json.Marshal(content)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return [][]string{{
+		strconv.Itoa(metaType),
+		strconv.Itoa(int(shardID)),
+		status,
+		string(contentBytes),
+	}}, nil
+}
+
+// buildInstructionsForStakingPoolRegisterReq accept the request if the validator is still staking,
+// the reward receiver of the validator becomes the operator of the pool
+func (blockchain *BlockChain) buildInstructionsForStakingPoolRegisterReq(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	shardID byte,
+	metaType int,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.StakingPoolRegisterAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	content := metadata.StakingPoolRegisterContent{
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		Commission:         action.Meta.Commission,
+		TxReqID:            action.TxReqID,
+		ShardID:            shardID,
+	}
+	if common.IndexOfStr(action.Meta.CommitteePublicKey, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+		Logger.log.Warnf("WARNING: staking pool register %+v, %+v not found in any committee list", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildStakingPoolInst(metaType, shardID, common.StakingPoolRequestRejectedChainStatus, content)
+	}
+	stakerInfo, has, err := statedb.GetStakerInfo(beaconBestState.consensusStateDB, action.Meta.CommitteePublicKey)
+	if err != nil || !has {
+		Logger.log.Warnf("WARNING: staking pool register %+v, staker info of %+v not found, error %+v", action.TxReqID.String(), action.Meta.CommitteePublicKey, err)
+		return buildStakingPoolInst(metaType, shardID, common.StakingPoolRequestRejectedChainStatus, content)
+	}
+	keyWallet := wallet.KeyWallet{}
+	keyWallet.KeySet.PaymentAddress = stakerInfo.RewardReceiver()
+	content.OperatorAddress = keyWallet.Base58CheckSerialize(wallet.PaymentAddressType)
+	return buildStakingPoolInst(metaType, shardID, common.StakingPoolRequestAcceptedChainStatus, content)
+}
+
+// buildInstructionsForStakingPoolDelegateReq accept the request if the pool exists. Shards only accept delegate txs
+// to existing pools but the pool may not be found by beacon (e.g. a request built on a fork), the rejected
+// instruction goes to the shard of the delegator which refunds the burnt amount
+func (blockchain *BlockChain) buildInstructionsForStakingPoolDelegateReq(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	shardID byte,
+	metaType int,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.StakingPoolDelegateAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	content := metadata.StakingPoolDelegateContent{
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		DelegatorAddress:   action.Meta.DelegatorPaymentAddress,
+		Amount:             action.Amount,
+		TxReqID:            action.TxReqID,
+		ShardID:            shardID,
+	}
+	_, has, err := statedb.GetStakingPool(beaconBestState.consensusStateDB, action.Meta.CommitteePublicKey)
+	if err != nil || !has {
+		Logger.log.Warnf("WARNING: staking pool delegate %+v, pool of %+v not found, error %+v", action.TxReqID.String(), action.Meta.CommitteePublicKey, err)
+		if delegator, err := wallet.Base58CheckDeserialize(action.Meta.DelegatorPaymentAddress); err == nil {
+			delegatorPk := delegator.KeySet.PaymentAddress.Pk
+			content.ShardID = common.GetShardIDFromLastByte(delegatorPk[len(delegatorPk)-1])
+		}
+		return buildStakingPoolInst(metaType, content.ShardID, common.StakingPoolRequestRejectedChainStatus, content)
+	}
+	return buildStakingPoolInst(metaType, shardID, common.StakingPoolRequestAcceptedChainStatus, content)
+}
+
+// processStakingPoolInstructions store accepted staking pool register and delegate instructions of beacon block
+func (blockchain *BlockChain) processStakingPoolInstructions(consensusStateDB *statedb.StateDB, beaconBlock *BeaconBlock) error {
+	for _, inst := range beaconBlock.Body.Instructions {
+		if len(inst) != 4 || inst[2] != common.StakingPoolRequestAcceptedChainStatus {
+			continue
+		}
+		switch inst[0] {
+		case strconv.Itoa(metadata.StakingPoolRegisterMeta):
+			var content metadata.StakingPoolRegisterContent
+			if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+				return err
+			}
+			operator, err := wallet.Base58CheckDeserialize(content.OperatorAddress)
+			if err != nil {
+				return err
+			}
+			epoch := beaconBlock.Header.Epoch
+			if err := statedb.StoreStakingPool(consensusStateDB, content.CommitteePublicKey, operator.KeySet.PaymentAddress, content.Commission, epoch, epoch+metadata.StakingPoolCommissionChangeDelay); err != nil {
+				return err
+			}
+		case strconv.Itoa(metadata.StakingPoolDelegateMeta):
+			var content metadata.StakingPoolDelegateContent
+			if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+				return err
+			}
+			delegator, err := wallet.Base58CheckDeserialize(content.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+			if err := statedb.AddDelegation(consensusStateDB, content.CommitteePublicKey, delegator.KeySet.PaymentAddress, content.Amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// buildStakingPoolDelegateRefundTx refund the burnt amount of a rejected delegate request to the delegator
+func (blockGenerator *BlockGenerator) buildStakingPoolDelegateRefundTx(
+	contentStr string,
+	producerPrivateKey *privacy.PrivateKey,
+	shardID byte,
+	shardView *ShardBestState,
+) (metadata.Transaction, error) {
+	var content metadata.StakingPoolDelegateContent
+	err := json.Unmarshal([]byte(contentStr), &content)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while unmarshaling staking pool delegate content: %+v", err)
+		return nil, nil
+	}
+	if content.ShardID != shardID {
+		return nil, nil
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(content.DelegatorAddress)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while deserializing delegator payment address: %+v", err)
+		return nil, nil
+	}
+	receiverAddr := keyWallet.KeySet.PaymentAddress
+	meta := metadata.NewStakingPoolDelegateRefund(content.TxReqID, metadata.StakingPoolDelegateRefundMeta)
+	resTx := new(transaction.Tx)
+	err = resTx.InitTxSalary(
+		content.Amount,
+		&receiverAddr,
+		producerPrivateKey,
+		shardView.GetCopiedTransactionStateDB(),
+		meta,
+	)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while initializing staking pool delegate refund tx: %+v", err)
+		return nil, nil
+	}
+	return resTx, nil
+}
+
+// stakingPoolReward is the pool of a committee member used to split its reward of an epoch, nil if it has no delegation
+type stakingPoolReward struct {
+	operator       privacy.PaymentAddress
+	commission     uint64
+	totalDelegated uint64
+	delegations    []*statedb.DelegationState
+}
+
+// getCommitteeStakingPools return the pools of shard committees with their commission of epoch,
+// in the same order as statedb.GetAllCommitteeStakeInfo
+func getCommitteeStakingPools(beaconConsensusStateDB *statedb.StateDB, shardIDs []int, epoch uint64) (map[int][]*stakingPoolReward, error) {
+	res := make(map[int][]*stakingPoolReward)
+	committees := statedb.GetAllCommitteeState(beaconConsensusStateDB, shardIDs)
+	for shardID, committee := range committees {
+		pools := make([]*stakingPoolReward, len(committee))
+		for i, c := range committee {
+			committeePublicKey := c.CommitteePublicKey()
+			committeePublicKeyStr, err := committeePublicKey.ToBase58()
+			if err != nil {
+				return nil, err
+			}
+			pool, has, err := statedb.GetStakingPool(beaconConsensusStateDB, committeePublicKeyStr)
+			if err != nil {
+				return nil, err
+			}
+			if !has || pool.TotalDelegated() == 0 {
+				continue
+			}
+			delegations, err := statedb.GetDelegations(beaconConsensusStateDB, committeePublicKeyStr)
+			if err != nil {
+				return nil, err
+			}
+			pools[i] = &stakingPoolReward{
+				operator:       pool.Operator(),
+				commission:     pool.CommissionAt(epoch),
+				totalDelegated: pool.TotalDelegated(),
+				delegations:    delegations,
+			}
+		}
+		res[shardID] = pools
+	}
+	return res, nil
+}
+
+// splitReward split the reward of a committee member, the stake of the member and the delegated amounts
+// share the reward without commission pro rata, the operator of the pool gets the rest
+func (pool *stakingPoolReward) splitReward(reward uint64, stakingAmount uint64) map[string]uint64 {
+	res := make(map[string]uint64)
+	commission := new(big.Int).Div(new(big.Int).Mul(new(big.Int).SetUint64(reward), new(big.Int).SetUint64(pool.commission)), big.NewInt(metadata.StakingPoolCommissionBase))
+	poolReward := new(big.Int).Sub(new(big.Int).SetUint64(reward), commission)
+	totalStake := new(big.Int).Add(new(big.Int).SetUint64(stakingAmount), new(big.Int).SetUint64(pool.totalDelegated))
+	delegatorsReward := uint64(0)
+	for _, delegation := range pool.delegations {
+		delegatorReward := new(big.Int).Mul(poolReward, new(big.Int).SetUint64(delegation.Amount()))
+		delegatorReward.Div(delegatorReward, totalStake)
+		if delegatorReward.Uint64() == 0 {
+			continue
+		}
+		delegator := delegation.Delegator()
+		res[string(delegator.Pk)] += delegatorReward.Uint64()
+		delegatorsReward += delegatorReward.Uint64()
+	}
+	res[string(pool.operator.Pk)] += reward - delegatorsReward
+	return res
+}
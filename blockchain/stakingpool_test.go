@@ -0,0 +1,354 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+func newTestPaymentAddress(seed string) privacy.PaymentAddress {
+	return privacy.GeneratePaymentAddress(privacy.GeneratePrivateKey(common.HashB([]byte(seed))))
+}
+
+func newTestStakingPoolStateDB(t *testing.T) *statedb.StateDB {
+	stateDB, err := statedb.NewWithPrefixTrie(common.EmptyRoot, wrarperDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stateDB
+}
+
+func TestStakingPoolSplitReward(t *testing.T) {
+	operator := newTestPaymentAddress("operator")
+	alice := newTestPaymentAddress("alice")
+	bob := newTestPaymentAddress("bob")
+	newPool := func(commission uint64, amounts map[string]uint64) *stakingPoolReward {
+		pool := &stakingPoolReward{operator: operator, commission: commission}
+		for name, amount := range amounts {
+			pool.delegations = append(pool.delegations, statedb.NewDelegationStateWithValue("", newTestPaymentAddress(name), amount))
+			pool.totalDelegated += amount
+		}
+		return pool
+	}
+
+	tests := []struct {
+		name          string
+		pool          *stakingPoolReward
+		reward        uint64
+		stakingAmount uint64
+		want          map[string]uint64
+	}{
+		{
+			name:          "no commission, even split",
+			pool:          newPool(0, map[string]uint64{"alice": 100}),
+			reward:        1000,
+			stakingAmount: 100,
+			want:          map[string]uint64{string(operator.Pk): 500, string(alice.Pk): 500},
+		},
+		{
+			name:          "commission on the pool reward",
+			pool:          newPool(1000, map[string]uint64{"alice": 100}),
+			reward:        1000,
+			stakingAmount: 100,
+			// commission 100, delegator gets half of 900
+			want: map[string]uint64{string(operator.Pk): 550, string(alice.Pk): 450},
+		},
+		{
+			name:          "delegator rewards are rounded down, the operator gets the remainder",
+			pool:          newPool(0, map[string]uint64{"alice": 1, "bob": 1}),
+			reward:        10,
+			stakingAmount: 1,
+			// each delegator gets 10/3 = 3
+			want: map[string]uint64{string(operator.Pk): 4, string(alice.Pk): 3, string(bob.Pk): 3},
+		},
+		{
+			name:          "delegator with a zero reward is skipped",
+			pool:          newPool(0, map[string]uint64{"alice": 1}),
+			reward:        10,
+			stakingAmount: 100,
+			want:          map[string]uint64{string(operator.Pk): 10},
+		},
+		{
+			name:          "full commission",
+			pool:          newPool(metadata.StakingPoolCommissionBase, map[string]uint64{"alice": 100}),
+			reward:        1000,
+			stakingAmount: 100,
+			want:          map[string]uint64{string(operator.Pk): 1000},
+		},
+		{
+			name:          "large amounts do not overflow",
+			pool:          newPool(500, map[string]uint64{"alice": 1 << 62}),
+			reward:        1 << 62,
+			stakingAmount: 1 << 62,
+			// commission 2^62/20, delegator gets half of the rest
+			want: map[string]uint64{string(operator.Pk): (1 << 62) - ((1<<62)-(1<<62)/20)/2, string(alice.Pk): ((1 << 62) - (1<<62)/20) / 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.pool.splitReward(tt.reward, tt.stakingAmount)
+			if len(got) != len(tt.want) {
+				t.Fatalf("splitReward() = %v, want %v", got, tt.want)
+			}
+			total := uint64(0)
+			for receiver, amount := range tt.want {
+				if got[receiver] != amount {
+					t.Errorf("splitReward() = %v, want %v", got, tt.want)
+				}
+			}
+			for _, amount := range got {
+				total += amount
+			}
+			if total != tt.reward {
+				t.Errorf("splitReward() pays %v, want %v", total, tt.reward)
+			}
+		})
+	}
+}
+
+func TestStakingPoolDelegation(t *testing.T) {
+	stateDB := newTestStakingPoolStateDB(t)
+	committeePublicKey := newTestEquivocationSigner(t, "validator").publicKey
+	operator := newTestPaymentAddress("operator")
+	alice := newTestPaymentAddress("alice")
+	bob := newTestPaymentAddress("bob")
+
+	if err := statedb.AddDelegation(stateDB, committeePublicKey, alice, 100); err == nil {
+		t.Fatal("AddDelegation() to a missing pool should fail")
+	}
+	if err := statedb.StoreStakingPool(stateDB, committeePublicKey, operator, 500, 1, 1+metadata.StakingPoolCommissionChangeDelay); err != nil {
+		t.Fatal(err)
+	}
+	checkTotal := func(want uint64) {
+		t.Helper()
+		pool, has, err := statedb.GetStakingPool(stateDB, committeePublicKey)
+		if err != nil || !has {
+			t.Fatalf("GetStakingPool() has %v, error %v", has, err)
+		}
+		if pool.TotalDelegated() != want {
+			t.Errorf("TotalDelegated() = %v, want %v", pool.TotalDelegated(), want)
+		}
+	}
+	checkDelegation := func(delegator privacy.PaymentAddress, want uint64) {
+		t.Helper()
+		delegation, has, err := statedb.GetDelegation(stateDB, committeePublicKey, delegator.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == 0 {
+			if has {
+				t.Errorf("delegation of %v should be deleted", delegator.String())
+			}
+			return
+		}
+		if !has || delegation.Amount() != want {
+			t.Errorf("delegation has %v, amount %v, want %v", has, delegation, want)
+		}
+	}
+
+	for _, delegation := range []struct {
+		delegator privacy.PaymentAddress
+		amount    uint64
+	}{{alice, 100}, {bob, 50}, {alice, 20}} {
+		if err := statedb.AddDelegation(stateDB, committeePublicKey, delegation.delegator, delegation.amount); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkTotal(170)
+	checkDelegation(alice, 120)
+	checkDelegation(bob, 50)
+
+	if err := statedb.RemoveDelegation(stateDB, committeePublicKey, bob, 51); err == nil {
+		t.Error("RemoveDelegation() more than delegated should fail")
+	}
+	if err := statedb.RemoveDelegation(stateDB, committeePublicKey, alice, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := statedb.RemoveDelegation(stateDB, committeePublicKey, bob, 50); err != nil {
+		t.Fatal(err)
+	}
+	checkTotal(100)
+	checkDelegation(alice, 100)
+	checkDelegation(bob, 0)
+
+	// delegations of a pool are listed from the committed trie
+	if _, err := stateDB.Commit(true); err != nil {
+		t.Fatal(err)
+	}
+	delegations, err := statedb.GetDelegations(stateDB, committeePublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(delegations) != 1 {
+		t.Errorf("GetDelegations() returns %v delegations, want 1", len(delegations))
+	}
+}
+
+func TestStakingPoolCommissionChange(t *testing.T) {
+	stateDB := newTestStakingPoolStateDB(t)
+	committeePublicKey := newTestEquivocationSigner(t, "validator").publicKey
+	operator := newTestPaymentAddress("operator")
+	alice := newTestPaymentAddress("alice")
+
+	if err := statedb.StoreStakingPool(stateDB, committeePublicKey, operator, 500, 1, 1+metadata.StakingPoolCommissionChangeDelay); err != nil {
+		t.Fatal(err)
+	}
+	if err := statedb.AddDelegation(stateDB, committeePublicKey, alice, 100); err != nil {
+		t.Fatal(err)
+	}
+	// raise the commission at epoch 5, it applies from epoch 7
+	changeEpoch := uint64(5 + metadata.StakingPoolCommissionChangeDelay)
+	if err := statedb.StoreStakingPool(stateDB, committeePublicKey, operator, 9000, 5, changeEpoch); err != nil {
+		t.Fatal(err)
+	}
+	if err := statedb.AddDelegation(stateDB, committeePublicKey, alice, 10); err != nil {
+		t.Fatal(err)
+	}
+	pool, _, err := statedb.GetStakingPool(stateDB, committeePublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for epoch, want := range map[uint64]uint64{1: 500, 5: 500, changeEpoch - 1: 500, changeEpoch: 9000, changeEpoch + 1: 9000} {
+		if got := pool.CommissionAt(epoch); got != want {
+			t.Errorf("CommissionAt(%v) = %v, want %v", epoch, got, want)
+		}
+	}
+	if pool.TotalDelegated() != 110 {
+		t.Errorf("TotalDelegated() = %v, want 110", pool.TotalDelegated())
+	}
+
+	// registering again after the change keeps the applied commission until the next change
+	if err := statedb.StoreStakingPool(stateDB, committeePublicKey, operator, 100, changeEpoch+1, changeEpoch+1+metadata.StakingPoolCommissionChangeDelay); err != nil {
+		t.Fatal(err)
+	}
+	pool, _, err = statedb.GetStakingPool(stateDB, committeePublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := pool.CommissionAt(changeEpoch + 1); got != 9000 {
+		t.Errorf("CommissionAt(%v) = %v, want 9000", changeEpoch+1, got)
+	}
+	if got := pool.CommissionAt(changeEpoch + 1 + metadata.StakingPoolCommissionChangeDelay); got != 100 {
+		t.Errorf("CommissionAt(%v) = %v, want 100", changeEpoch+1+metadata.StakingPoolCommissionChangeDelay, got)
+	}
+}
+
+func TestStakingPoolRejectedDelegationRefund(t *testing.T) {
+	stateDB := newTestStakingPoolStateDB(t)
+	committeePublicKey := newTestEquivocationSigner(t, "validator").publicKey
+	delegator := newTestPaymentAddress("delegator")
+	delegatorWallet := wallet.KeyWallet{}
+	delegatorWallet.KeySet.PaymentAddress = delegator
+	delegatorShardID := common.GetShardIDFromLastByte(delegator.Pk[len(delegator.Pk)-1])
+	requestShardID := delegatorShardID + 1
+
+	action := metadata.StakingPoolDelegateAction{
+		Meta: metadata.StakingPoolDelegate{
+			MetadataBase:            *metadata.NewMetadataBase(metadata.StakingPoolDelegateMeta),
+			CommitteePublicKey:      committeePublicKey,
+			DelegatorPaymentAddress: delegatorWallet.Base58CheckSerialize(wallet.PaymentAddressType),
+		},
+		TxReqID: common.HashH([]byte("delegate")),
+		ShardID: requestShardID,
+		Amount:  100,
+	}
+	actionBytes, err := json.Marshal(action)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the pool of the validator does not exist
+	insts, err := (&BlockChain{}).buildInstructionsForStakingPoolDelegateReq(&BeaconBestState{consensusStateDB: stateDB}, base64.StdEncoding.EncodeToString(actionBytes), requestShardID, metadata.StakingPoolDelegateMeta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 1 || insts[0][2] != common.StakingPoolRequestRejectedChainStatus {
+		t.Fatalf("buildInstructionsForStakingPoolDelegateReq() = %v, want a rejected instruction", insts)
+	}
+	var content metadata.StakingPoolDelegateContent
+	if err := json.Unmarshal([]byte(insts[0][3]), &content); err != nil {
+		t.Fatal(err)
+	}
+	if content.ShardID != delegatorShardID {
+		t.Fatalf("rejected instruction goes to shard %v, want the shard of the delegator %v", content.ShardID, delegatorShardID)
+	}
+
+	producerPrivateKey := privacy.GeneratePrivateKey(common.HashB([]byte("producer")))
+	shardView := &ShardBestState{transactionStateDB: stateDB}
+	if tx, _ := (&BlockGenerator{}).buildStakingPoolDelegateRefundTx(insts[0][3], &producerPrivateKey, requestShardID, shardView); tx != nil {
+		t.Error("buildStakingPoolDelegateRefundTx() on another shard should not refund")
+	}
+	tx, err := (&BlockGenerator{}).buildStakingPoolDelegateRefundTx(insts[0][3], &producerPrivateKey, delegatorShardID, shardView)
+	if err != nil || tx == nil {
+		t.Fatalf("buildStakingPoolDelegateRefundTx() = %v, %v", tx, err)
+	}
+	refund, ok := tx.GetMetadata().(*metadata.StakingPoolDelegateRefund)
+	if !ok {
+		t.Fatalf("refund tx metadata is %T", tx.GetMetadata())
+	}
+	ok, err = refund.VerifyMinerCreatedTxBeforeGettingInBlock(nil, nil, insts, []int{0}, delegatorShardID, tx, nil, nil, nil, nil)
+	if !ok || err != nil {
+		t.Errorf("VerifyMinerCreatedTxBeforeGettingInBlock() = %v, %v", ok, err)
+	}
+	ok, _ = refund.VerifyMinerCreatedTxBeforeGettingInBlock(nil, nil, insts, []int{1}, delegatorShardID, tx, nil, nil, nil, nil)
+	if ok {
+		t.Error("VerifyMinerCreatedTxBeforeGettingInBlock() should reject a refund of a used instruction")
+	}
+}
+
+// newTestShardPaymentAddress returns a payment address of a seed in a shard
+func newTestShardPaymentAddress(seed string, shardID byte) privacy.PaymentAddress {
+	for i := 0; ; i++ {
+		address := newTestPaymentAddress(seed + strconv.Itoa(i))
+		if common.GetShardIDFromLastByte(address.Pk[common.PublicKeySize-1]) == shardID {
+			return address
+		}
+	}
+}
+
+func TestStakingPoolRewardSplitByStakedAmount(t *testing.T) {
+	// the chain params staking amount changed by governance is not the stake of the members
+	bc := &BlockChain{config: Config{ChainParams: &Params{StakingAmountShard: 1000}}}
+	rewardStateDB := newTestStakingPoolStateDB(t)
+	newStaker := func(stakingAmount uint64) *statedb.StakerInfo {
+		stakerInfo := statedb.NewStakerInfoWithValue(newTestShardPaymentAddress("receiver", 0), true, common.Hash{})
+		stakerInfo.SetStakingAmount(stakingAmount)
+		return stakerInfo
+	}
+	operators := []privacy.PaymentAddress{newTestShardPaymentAddress("operator 1", 0), newTestShardPaymentAddress("operator 2", 0)}
+	delegators := []privacy.PaymentAddress{newTestShardPaymentAddress("delegator 1", 0), newTestShardPaymentAddress("delegator 2", 0)}
+	pools := []*stakingPoolReward{}
+	for i := range operators {
+		pools = append(pools, &stakingPoolReward{
+			operator:       operators[i],
+			totalDelegated: 1000,
+			delegations:    []*statedb.DelegationState{statedb.NewDelegationStateWithValue("", delegators[i], 1000)},
+		})
+	}
+	// the first member recorded a stake of 3000, the second one staked before stakes were recorded
+	// and counts with the staking amount of the epoch 3000
+	stakers := []*statedb.StakerInfo{newStaker(3000), newStaker(0)}
+	rewardInfo := &metadata.ShardBlockRewardInfo{ShardReward: map[common.Hash]uint64{common.PRVCoinID: 2000}, Epoch: 1}
+	if err := bc.addShardCommitteeRewardV2(rewardStateDB, 0, rewardInfo, stakers, pools, 3000); err != nil {
+		t.Fatal(err)
+	}
+	getReward := func(address privacy.PaymentAddress) uint64 {
+		reward, err := statedb.GetCommitteeReward(rewardStateDB, base58.Base58Check{}.Encode(address.Pk, common.Base58Version), common.PRVCoinID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reward
+	}
+	for i := range operators {
+		if operatorReward, delegatorReward := getReward(operators[i]), getReward(delegators[i]); operatorReward != 750 || delegatorReward != 250 {
+			t.Errorf("Expect reward of member %v is split 750 and 250 by a stake of 3000, have %v and %v", i, operatorReward, delegatorReward)
+		}
+	}
+}
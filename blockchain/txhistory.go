@@ -0,0 +1,306 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/transaction"
+)
+
+// Tx history index keeps transactions of a public key in the database of the shard of the transactions
+//	- in: the public key receives output coins, change coins back to a known sender are not counted
+//	- out: the public key spends input coins, sender is only known when a proof does not hide it (no privacy),
+//	so transactions sent with privacy are not indexed as out, the note scanner records them for keys registered with
+//	private key and they are merged by a TxHistorySource
+// Entries are stored by processStoreShardBlock when a block is finalized, so transactions of forks are never indexed.
+// Finalized blocks stored before the index existed are indexed by MigrateTxHistory, the others when they are finalized
+
+type txHistoryEntry struct {
+	publicKey []byte
+	record    *rawdbv2.TxHistoryRecord
+}
+
+// proofOfToken is a proof transferring a token in a transaction
+type proofOfToken struct {
+	tokenID common.Hash
+	tx      *transaction.Tx
+}
+
+func getTxProofsOfToken(tx metadata.Transaction) []proofOfToken {
+	switch tx.GetType() {
+	case common.TxNormalType, common.TxRewardType, common.TxReturnStakingType:
+		if normalTx, ok := tx.(*transaction.Tx); ok {
+			return []proofOfToken{{common.PRVCoinID, normalTx}}
+		}
+	case common.TxCustomTokenPrivacyType:
+		if tokenTx, ok := tx.(*transaction.TxCustomTokenPrivacy); ok {
+			return []proofOfToken{{common.PRVCoinID, &tokenTx.Tx}, {tokenTx.TxPrivacyTokenData.PropertyID, &tokenTx.TxPrivacyTokenData.TxNormal}}
+		}
+	}
+	return nil
+}
+
+// getTxHistoryEntries returns in and out entries of public keys of a transaction at an index of a block
+func getTxHistoryEntries(tx metadata.Transaction, height uint64, txIndex int) []txHistoryEntry {
+	entries := []txHistoryEntry{}
+	entryIndex := make(map[string]int)
+	addToken := func(publicKey []byte, direction byte, tokenID common.Hash) {
+		id := string(append([]byte{direction}, publicKey...))
+		i, ok := entryIndex[id]
+		if !ok {
+			i = len(entries)
+			entryIndex[id] = i
+			entries = append(entries, txHistoryEntry{
+				publicKey: publicKey,
+				record: &rawdbv2.TxHistoryRecord{
+					Height:       height,
+					TxIndex:      uint32(txIndex),
+					Direction:    direction,
+					TxHash:       *tx.Hash(),
+					MetadataType: tx.GetMetadataType(),
+				},
+			})
+		}
+		record := entries[i].record
+		for _, existed := range record.TokenIDs {
+			if existed == tokenID {
+				return
+			}
+		}
+		record.TokenIDs = append(record.TokenIDs, tokenID)
+	}
+	for _, proof := range getTxProofsOfToken(tx) {
+		if proof.tx.Proof == nil {
+			continue
+		}
+		sender := proof.tx.GetSender()
+		if sender != nil {
+			addToken(sender, rawdbv2.TxHistoryOut, proof.tokenID)
+		}
+		for _, outputCoin := range proof.tx.Proof.GetOutputCoins() {
+			if outputCoin == nil || outputCoin.CoinDetails == nil || outputCoin.CoinDetails.GetPublicKey() == nil {
+				continue
+			}
+			publicKey := outputCoin.CoinDetails.GetPublicKey().ToBytesS()
+			if sender != nil && bytes.Equal(publicKey, sender) {
+				continue
+			}
+			addToken(publicKey, rawdbv2.TxHistoryIn, proof.tokenID)
+		}
+	}
+	return entries
+}
+
+// GetTxOutputShardIDs returns shards of public keys of output coins of a transaction in order, except the shard of the
+// transaction, output coins of those shards are sent to them in cross shard blocks
+func GetTxOutputShardIDs(tx metadata.Transaction, txShardID byte) []byte {
+	existed := make(map[byte]bool)
+	for _, proof := range getTxProofsOfToken(tx) {
+		if proof.tx.Proof == nil {
+			continue
+		}
+		for _, outputCoin := range proof.tx.Proof.GetOutputCoins() {
+			if outputCoin == nil || outputCoin.CoinDetails == nil || outputCoin.CoinDetails.GetPublicKey() == nil {
+				continue
+			}
+			publicKey := outputCoin.CoinDetails.GetPublicKey().ToBytesS()
+			if shardID := common.GetShardIDFromLastByte(publicKey[len(publicKey)-1]); shardID != txShardID {
+				existed[shardID] = true
+			}
+		}
+	}
+	shardIDs := []byte{}
+	for shardID := 0; shardID < common.MaxShardNumber; shardID++ {
+		if existed[byte(shardID)] {
+			shardIDs = append(shardIDs, byte(shardID))
+		}
+	}
+	return shardIDs
+}
+
+func storeTxHistory(db incdb.KeyValueWriter, shardBlock *ShardBlock) error {
+	for index, tx := range shardBlock.Body.Transactions {
+		for _, entry := range getTxHistoryEntries(tx, shardBlock.Header.Height, index) {
+			if err := rawdbv2.StoreTxHistory(db, entry.publicKey, entry.record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func deleteTxHistory(db incdb.KeyValueWriter, shardBlock *ShardBlock) error {
+	for index, tx := range shardBlock.Body.Transactions {
+		for _, entry := range getTxHistoryEntries(tx, shardBlock.Header.Height, index) {
+			if err := rawdbv2.DeleteTxHistory(db, entry.publicKey, entry.record.Height, entry.record.TxIndex, entry.record.Direction); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// TxHistoryItem is a tx history record of a public key in a shard
+type TxHistoryItem struct {
+	ShardID byte
+	*rawdbv2.TxHistoryRecord
+}
+
+// TxHistorySource returns records of a public key of a shard which are not in the tx history index, like out records
+// of transactions with privacy found by the note scanner. Records are paged as rawdbv2.GetTxHistory does
+type TxHistorySource func(
+	cursor []byte,
+	fromHeight uint64,
+	toHeight uint64,
+	limit int,
+	filter func(record *rawdbv2.TxHistoryRecord) bool,
+) ([]*rawdbv2.TxHistoryRecord, []byte, error)
+
+// GetTxHistory returns at most limit tx history records of a public key in shards, shard by shard in shardIDs order
+// and from the newest block of each shard. Heights filter blocks of the shard of transactions.
+// Records of sources (by shard, may be nil) are merged with the index, the index is kept for a transaction in both.
+// The returned cursor gets the next records with the same params, it is empty when there is no more record
+func (blockchain *BlockChain) GetTxHistory(
+	publicKey []byte,
+	shardIDs []byte,
+	cursor string,
+	fromHeight uint64,
+	toHeight uint64,
+	limit int,
+	filter func(record *rawdbv2.TxHistoryRecord) bool,
+	sources map[byte]TxHistorySource,
+) ([]*TxHistoryItem, string, error) {
+	var shardCursor []byte
+	startShard := 0
+	if cursor != "" {
+		data, err := hex.DecodeString(cursor)
+		if err != nil || len(data) != 1+rawdbv2.TxHistoryCursorSize {
+			return nil, "", NewBlockChainError(GetTxHistoryError, fmt.Errorf("Cursor %+v is invalid", cursor))
+		}
+		startShard = bytes.IndexByte(shardIDs, data[0])
+		if startShard < 0 {
+			return nil, "", NewBlockChainError(GetTxHistoryError, fmt.Errorf("Cursor %+v is not of shards %+v", cursor, shardIDs))
+		}
+		shardCursor = data[1:]
+	}
+	items := []*TxHistoryItem{}
+	for i := startShard; i < len(shardIDs); i++ {
+		shardID := shardIDs[i]
+		if int(shardID) >= len(blockchain.ShardChain) {
+			return nil, "", NewBlockChainError(GetTxHistoryError, fmt.Errorf("Shard %+v not found", shardID))
+		}
+		records, nextCursor, err := rawdbv2.GetTxHistory(blockchain.GetShardChainDatabase(shardID), publicKey, shardCursor, fromHeight, toHeight, limit-len(items), filter)
+		if err != nil {
+			return nil, "", NewBlockChainError(GetTxHistoryError, err)
+		}
+		if source, ok := sources[shardID]; ok {
+			sourceRecords, sourceNextCursor, err := source(shardCursor, fromHeight, toHeight, limit-len(items), filter)
+			if err != nil {
+				return nil, "", NewBlockChainError(GetTxHistoryError, err)
+			}
+			records, nextCursor = mergeTxHistory(records, nextCursor, sourceRecords, sourceNextCursor, limit-len(items))
+		}
+		for _, record := range records {
+			items = append(items, &TxHistoryItem{ShardID: shardID, TxHistoryRecord: record})
+		}
+		if nextCursor != nil {
+			return items, hex.EncodeToString(append([]byte{shardID}, nextCursor...)), nil
+		}
+		shardCursor = nil
+		if len(items) == limit {
+			// the next page starts from the next shard, records of this shard are all returned
+			if i+1 < len(shardIDs) {
+				return items, hex.EncodeToString(append([]byte{shardIDs[i+1]}, make([]byte, rawdbv2.TxHistoryCursorSize)...)), nil
+			}
+			break
+		}
+	}
+	return items, "", nil
+}
+
+// mergeTxHistory merges two pages of records from the same cursor in index order, a record of others at the position
+// of a record of records is dropped. The merged page has at most limit records, its cursor is nil if both pages are the last
+func mergeTxHistory(records []*rawdbv2.TxHistoryRecord, nextCursor []byte, others []*rawdbv2.TxHistoryRecord, othersNextCursor []byte, limit int) ([]*rawdbv2.TxHistoryRecord, []byte) {
+	getCursor := func(record *rawdbv2.TxHistoryRecord) []byte {
+		return rawdbv2.GetTxHistoryCursor(record.Height, record.TxIndex, record.Direction)
+	}
+	merged := []*rawdbv2.TxHistoryRecord{}
+	i, j := 0, 0
+	for i < len(records) || j < len(others) {
+		if j == len(others) {
+			merged = append(merged, records[i])
+			i++
+			continue
+		}
+		if i == len(records) {
+			merged = append(merged, others[j])
+			j++
+			continue
+		}
+		switch bytes.Compare(getCursor(records[i]), getCursor(others[j])) {
+		case 0:
+			merged = append(merged, records[i])
+			i++
+			j++
+		case -1:
+			merged = append(merged, records[i])
+			i++
+		default:
+			merged = append(merged, others[j])
+			j++
+		}
+	}
+	more := nextCursor != nil || othersNextCursor != nil
+	if len(merged) > limit {
+		merged = merged[:limit]
+		more = true
+	}
+	if !more || len(merged) == 0 {
+		return merged, nil
+	}
+	return merged, getCursor(merged[len(merged)-1])
+}
+
+// MigrateTxHistory builds tx history of finalized shard blocks stored before the index existed, the node keeps running
+// and indexes new blocks itself. Progress is kept by reindex checkpoints, so an interrupted migration resumes on next start
+func (blockchain *BlockChain) MigrateTxHistory(interrupt <-chan struct{}) {
+	for shardID := 0; shardID < len(blockchain.ShardChain); shardID++ {
+		if err := blockchain.migrateShardTxHistory(byte(shardID), interrupt); err != nil {
+			Logger.log.Errorf("SHARD %+v | Migrate tx history failed, error %+v", shardID, err)
+		}
+		select {
+		case <-interrupt:
+			return
+		default:
+		}
+	}
+}
+
+func (blockchain *BlockChain) migrateShardTxHistory(shardID byte, interrupt <-chan struct{}) error {
+	db := blockchain.GetShardChainDatabase(shardID)
+	if migrated, err := rawdbv2.HasTxHistoryMigrated(db, shardID); err != nil || migrated {
+		return err
+	}
+	getBlockHash := func(height uint64) (*common.Hash, error) {
+		return rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, height)
+	}
+	process := func(batch incdb.KeyValueWriter, blockHash common.Hash, indexes []string, checkpoints map[string]*reindexCheckpoint) error {
+		block, _, err := blockchain.GetShardBlockByHashWithShardID(blockHash, shardID)
+		if err != nil {
+			return err
+		}
+		return storeTxHistory(batch, block)
+	}
+	// blocks after the final height now are indexed when they are finalized, even if they are stored already
+	finalHeight := blockchain.ShardChain[shardID].GetFinalView().GetHeight()
+	result, err := reindexChain(db, int(shardID), finalHeight, nil, []string{ReindexTxHistory}, false, interrupt, getBlockHash, nil, process)
+	if err != nil || result.Interrupted {
+		return err
+	}
+	return rawdbv2.StoreTxHistoryMigrated(db, shardID)
+}
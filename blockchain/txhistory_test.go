@@ -0,0 +1,204 @@
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/transaction"
+)
+
+// hasTxHistory checks whether the receiver of a test tx has an in record of it
+func hasTxHistory(t *testing.T, db incdb.Database, tx *transaction.Tx) bool {
+	t.Helper()
+	records, _, err := rawdbv2.GetTxHistory(db, getTxReceiverPublicKeys(tx)[0], nil, 0, 0, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(records) == 1 && records[0].TxHash == *tx.Hash() && records[0].Direction == rawdbv2.TxHistoryIn
+}
+
+func TestTxHistoryIndexedWhenFinalized(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, beaconBlock := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+	txs := make(map[uint64]*transaction.Tx)
+	for i, timeSlot := range []int64{10, 20, 30} {
+		height := uint64(i + 2)
+		txs[height] = newRollbackTestTx(t)
+		views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], timeSlot, []metadata.Transaction{txs[height]}))
+	}
+	// a fork of block 3 is never finalized
+	forkTx := newRollbackTestTx(t)
+	insertRollbackTestBlock(t, bc, beaconBlock, views[1], 21, []metadata.Transaction{forkTx})
+	// block 5 finalizes blocks 2 to 4 at once
+	txs[5] = newRollbackTestTx(t)
+	views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], 31, []metadata.Transaction{txs[5]}))
+	if finalHeight := bc.ShardChain[0].GetFinalView().GetHeight(); finalHeight != 4 {
+		t.Fatalf("Expect block 4 is finalized, have final height %v", finalHeight)
+	}
+
+	for height := uint64(2); height <= 5; height++ {
+		if indexed := hasTxHistory(t, db, txs[height]); indexed != (height <= 4) {
+			t.Errorf("Expect tx of block %v is indexed: %v, have %v", height, height <= 4, indexed)
+		}
+	}
+	if hasTxHistory(t, db, forkTx) {
+		t.Error("Expect tx of the fork is not indexed")
+	}
+
+	// block 6 finalizes block 5
+	insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], 32, nil)
+	if !hasTxHistory(t, db, txs[5]) {
+		t.Error("Expect tx of block 5 is indexed once finalized")
+	}
+	if hasTxHistory(t, db, forkTx) {
+		t.Error("Expect tx of the fork is not indexed")
+	}
+}
+
+func TestMigrateTxHistory(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, beaconBlock := newRollbackTestChain(t)
+	db := bc.GetShardChainDatabase(0)
+	views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+	txs := make(map[uint64]*transaction.Tx)
+	for i, timeSlot := range []int64{10, 20, 30, 31} {
+		height := uint64(i + 2)
+		txs[height] = newRollbackTestTx(t)
+		views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], timeSlot, []metadata.Transaction{txs[height]}))
+	}
+	// blocks were stored before the index existed
+	if err := rawdbv2.DeleteIndex(db, rawdbv2.GetTxHistoryIndexPrefix()); err != nil {
+		t.Fatal(err)
+	}
+
+	// finalized blocks are migrated, block 5 above the final view is indexed when it is finalized
+	bc.MigrateTxHistory(nil)
+	if migrated, err := rawdbv2.HasTxHistoryMigrated(db, 0); err != nil || !migrated {
+		t.Fatalf("Expect shard is marked migrated, have %v error %v", migrated, err)
+	}
+	for height := uint64(2); height <= 5; height++ {
+		if indexed := hasTxHistory(t, db, txs[height]); indexed != (height <= 4) {
+			t.Errorf("Expect tx of block %v is migrated: %v, have %v", height, height <= 4, indexed)
+		}
+	}
+	insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], 32, nil)
+	if !hasTxHistory(t, db, txs[5]) {
+		t.Error("Expect tx of block 5 stored before migration is indexed once finalized")
+	}
+}
+
+func TestGetTxHistory(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test_txhistory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbs := make(map[int]incdb.Database)
+	for _, name := range []int{0, 1, 2} {
+		db, err := incdb.Open("leveldb", filepath.Join(dir, string(rune('0'+name))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		dbs[name] = db
+	}
+	// dbs[2] is the database of a source, like the note scanner
+	sourceDB := dbs[2]
+	delete(dbs, 2)
+	bc := &BlockChain{config: Config{DataBase: dbs}}
+	bc.ShardChain = []*ShardChain{
+		NewShardChain(0, multiview.NewMultiView(), nil, bc, common.GetShardChainKey(0)),
+		NewShardChain(1, multiview.NewMultiView(), nil, bc, common.GetShardChainKey(1)),
+	}
+
+	publicKey := []byte("public key")
+	newRecord := func(height uint64, direction byte) *rawdbv2.TxHistoryRecord {
+		return &rawdbv2.TxHistoryRecord{Height: height, Direction: direction, TxHash: common.HashH(common.Uint64ToBytes(height)), TokenIDs: []common.Hash{common.PRVCoinID}}
+	}
+	store := func(db incdb.Database, records ...*rawdbv2.TxHistoryRecord) {
+		for _, record := range records {
+			if err := rawdbv2.StoreTxHistory(db, publicKey, record); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	store(dbs[0], newRecord(5, rawdbv2.TxHistoryIn), newRecord(5, rawdbv2.TxHistoryOut), newRecord(3, rawdbv2.TxHistoryIn))
+	store(dbs[1], newRecord(7, rawdbv2.TxHistoryIn))
+	// the source knows the out record of height 5 and a tx with privacy at height 4
+	store(sourceDB, newRecord(5, rawdbv2.TxHistoryOut), newRecord(4, rawdbv2.TxHistoryOut))
+	sources := map[byte]TxHistorySource{
+		0: func(cursor []byte, fromHeight uint64, toHeight uint64, limit int, filter func(record *rawdbv2.TxHistoryRecord) bool) ([]*rawdbv2.TxHistoryRecord, []byte, error) {
+			return rawdbv2.GetTxHistory(sourceDB, publicKey, cursor, fromHeight, toHeight, limit, filter)
+		},
+	}
+	type position struct {
+		shardID   byte
+		height    uint64
+		direction byte
+	}
+	getPages := func(fromHeight uint64, limit int, sources map[byte]TxHistorySource) [][]position {
+		pages := [][]position{}
+		cursor := ""
+		for {
+			items, nextCursor, err := bc.GetTxHistory(publicKey, []byte{0, 1}, cursor, fromHeight, 0, limit, nil, sources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			page := []position{}
+			for _, item := range items {
+				page = append(page, position{item.ShardID, item.Height, item.Direction})
+			}
+			pages = append(pages, page)
+			if nextCursor == "" || len(pages) > 10 {
+				return pages
+			}
+			cursor = nextCursor
+		}
+	}
+	in, out := rawdbv2.TxHistoryIn, rawdbv2.TxHistoryOut
+	for _, tc := range []struct {
+		name       string
+		fromHeight uint64
+		sources    map[byte]TxHistorySource
+		expected   [][]position
+	}{
+		{"index only", 0, nil, [][]position{{{0, 5, in}, {0, 5, out}}, {{0, 3, in}, {1, 7, in}}}},
+		{"from height", 4, nil, [][]position{{{0, 5, in}, {0, 5, out}}, {{1, 7, in}}}},
+		{"merged with source", 0, sources, [][]position{{{0, 5, in}, {0, 5, out}}, {{0, 4, out}, {0, 3, in}}, {{1, 7, in}}}},
+	} {
+		pages := getPages(tc.fromHeight, 2, tc.sources)
+		if len(pages) != len(tc.expected) {
+			t.Errorf("%v: expect pages %+v, have %+v", tc.name, tc.expected, pages)
+			continue
+		}
+		for i := range pages {
+			if len(pages[i]) != len(tc.expected[i]) {
+				t.Errorf("%v: expect page %v is %+v, have %+v", tc.name, i, tc.expected[i], pages[i])
+				continue
+			}
+			for j := range pages[i] {
+				if pages[i][j] != tc.expected[i][j] {
+					t.Errorf("%v: expect page %v is %+v, have %+v", tc.name, i, tc.expected[i], pages[i])
+					break
+				}
+			}
+		}
+	}
+
+	if _, _, err := bc.GetTxHistory(publicKey, []byte{0, 1}, "invalid", 0, 0, 2, nil, nil); err == nil {
+		t.Error("Expect invalid cursor is rejected")
+	}
+}
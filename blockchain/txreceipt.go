@@ -0,0 +1,420 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/multiview"
+)
+
+// Receipts of metadata transactions are built from
+//	- events: each beacon instruction about a request tx gives an event with its status and amounts, stored by the beacon
+//	- responses: each response tx of a request tx is linked to it, stored by the shard of the response tx
+// Events and responses of blocks not on the canonical chain (forks) are skipped when a receipt is read
+
+const (
+	TxReceiptPendingStatus   = "pending"   // request is in a block, it is not processed yet
+	TxReceiptRespondedStatus = "responded" // request is processed by shard only, it got response txs
+	TxReceiptConfirmedStatus = "confirmed" // burning is confirmed, a proof can be got to unlock tokens
+)
+
+// TxReceipt is the result of a metadata transaction, Status is the status of the last event
+type TxReceipt struct {
+	TxHash           common.Hash
+	MetadataType     int
+	ShardID          byte
+	BlockHash        common.Hash
+	BlockHeight      uint64
+	Status           string
+	Amounts          []rawdbv2.TxReceiptAmount
+	RefundedAmounts  []rawdbv2.TxReceiptAmount
+	ResponseTxHashes []common.Hash
+	Events           []*rawdbv2.TxReceiptEvent
+	Responses        []*rawdbv2.TxReceiptResponse
+	Finalized        bool // blocks of the request, its events and its responses are all finalized
+}
+
+// receiptContent has the fields of instruction contents used by receipts, json field names are matched case-insensitively
+// so contents of different metadata types are decoded by it (results, and request actions of refunded requests)
+type receiptContent struct {
+	TxReqID       *common.Hash
+	RequestedTxID *common.Hash
+	ReqTxID       *common.Hash
+	Meta          *receiptContent
+
+	TokenIDStr                string
+	TokenID                   string
+	TokenIDToBuyStr           string
+	TokenIDToSellStr          string
+	PTokenId                  string
+	IncTokenID                *common.Hash
+	Amount                    uint64
+	ReceiveAmount             uint64
+	SellAmount                uint64
+	TradingFee                uint64
+	ContributedAmount         uint64
+	ActualContributedAmount   uint64
+	ReturnedContributedAmount uint64
+	IssuingAmount             uint64
+	RegisterAmount            uint64
+	PortingAmount             uint64
+	RedeemAmount              uint64
+}
+
+func (content *receiptContent) requestTxHash() *common.Hash {
+	for _, hash := range []*common.Hash{content.TxReqID, content.RequestedTxID, content.ReqTxID} {
+		if hash != nil && !hash.IsEqual(&common.Hash{}) {
+			return hash
+		}
+	}
+	return nil
+}
+
+// decodeReceiptContent decodes a json content, a base64 json content or a content of the request tx hash only
+func decodeReceiptContent(contentStr string) *receiptContent {
+	content := &receiptContent{}
+	if err := json.Unmarshal([]byte(contentStr), content); err == nil {
+		return content
+	}
+	if contentBytes, err := base64.StdEncoding.DecodeString(contentStr); err == nil {
+		if err := json.Unmarshal(contentBytes, content); err == nil {
+			return content
+		}
+	}
+	if hash, err := (common.Hash{}).NewHashFromStr(contentStr); err == nil {
+		content.TxReqID = hash
+		return content
+	}
+	return nil
+}
+
+func addReceiptAmount(amounts []rawdbv2.TxReceiptAmount, tokenID string, amount uint64) []rawdbv2.TxReceiptAmount {
+	if amount == 0 || tokenID == "" {
+		return amounts
+	}
+	return append(amounts, rawdbv2.TxReceiptAmount{TokenID: tokenID, Amount: amount})
+}
+
+// GetInstructionReceiptEvent returns the request tx hash and the receipt event of a beacon instruction, nil if the
+// instruction is not about a request tx. Instructions are [metaType, shardID, status, content] except burning confirm ones
+func GetInstructionReceiptEvent(inst []string) (*common.Hash, *rawdbv2.TxReceiptEvent) {
+	if len(inst) < 2 {
+		return nil, nil
+	}
+	metaType, err := strconv.Atoi(inst[0])
+	if err != nil {
+		return nil, nil
+	}
+	event := &rawdbv2.TxReceiptEvent{MetadataType: metaType}
+	switch metaType {
+	case metadata.BurningConfirmMeta, metadata.BurningConfirmMetaV2, metadata.BurningConfirmForDepositToSCMeta, metadata.BurningConfirmForDepositToSCMetaV2:
+		if len(inst) < 6 {
+			return nil, nil
+		}
+		reqTxHash, err := (common.Hash{}).NewHashFromStr(inst[5])
+		if err != nil {
+			return nil, nil
+		}
+		event.Status = TxReceiptConfirmedStatus
+		return reqTxHash, event
+	}
+	if len(inst) < 4 {
+		return nil, nil
+	}
+	content := decodeReceiptContent(inst[3])
+	if content == nil || content.requestTxHash() == nil {
+		return nil, nil
+	}
+	event.Status = inst[2]
+	switch metaType {
+	case metadata.PDETradeRequestMeta, metadata.PDECrossPoolTradeRequestMeta:
+		if content.Meta != nil {
+			// refunded request action
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.Meta.TokenIDToSellStr, content.Meta.SellAmount+content.Meta.TradingFee)
+		} else if content.ReceiveAmount > 0 {
+			event.Amounts = addReceiptAmount(event.Amounts, content.TokenIDToBuyStr, content.ReceiveAmount)
+		} else {
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.TokenIDStr, content.Amount)
+		}
+	case metadata.PDEContributionMeta, metadata.PDEPRVRequiredContributionRequestMeta:
+		switch {
+		case content.Meta != nil:
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.Meta.TokenIDStr, content.Meta.ContributedAmount)
+		case event.Status == common.PDEContributionRefundChainStatus:
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.TokenIDStr, content.ContributedAmount)
+		case event.Status == common.PDEContributionMatchedNReturnedChainStatus:
+			event.Amounts = addReceiptAmount(event.Amounts, content.TokenIDStr, content.ActualContributedAmount)
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.TokenIDStr, content.ReturnedContributedAmount)
+		default:
+			event.Amounts = addReceiptAmount(event.Amounts, content.TokenIDStr, content.ContributedAmount)
+		}
+	case metadata.IssuingRequestMeta, metadata.IssuingETHRequestMeta:
+		if content.IncTokenID != nil {
+			event.Amounts = addReceiptAmount(event.Amounts, content.IncTokenID.String(), content.IssuingAmount)
+		}
+	case metadata.PortalRequestPortingMeta, metadata.PortalRequestPortingMetaV3:
+		if event.Status == common.PortalPortingRequestAcceptedChainStatus {
+			event.Amounts = addReceiptAmount(event.Amounts, content.PTokenId, content.RegisterAmount)
+		}
+	case metadata.StakingPoolDelegateMeta:
+		// the delegation of a rejected request is refunded
+		if event.Status == common.StakingPoolRequestRejectedChainStatus {
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, common.PRVIDStr, content.Amount)
+		} else {
+			event.Amounts = addReceiptAmount(event.Amounts, common.PRVIDStr, content.Amount)
+		}
+	case metadata.UnstakeRequestMeta:
+		if event.Status == common.UnstakeReleasedChainStatus {
+			event.Amounts = addReceiptAmount(event.Amounts, common.PRVIDStr, content.Amount)
+		}
+	case metadata.PortalUserRequestPTokenMeta:
+		if event.Status == common.PortalReqPTokensAcceptedChainStatus {
+			event.Amounts = addReceiptAmount(event.Amounts, content.TokenID, content.PortingAmount)
+		}
+	case metadata.PortalRedeemRequestMeta, metadata.PortalRedeemRequestMetaV3:
+		if event.Status == common.PortalRedeemRequestRejectedChainStatus || event.Status == common.PortalRedeemReqCancelledByLiquidationChainStatus {
+			event.RefundedAmounts = addReceiptAmount(event.RefundedAmounts, content.TokenID, content.RedeemAmount)
+		} else {
+			event.Amounts = addReceiptAmount(event.Amounts, content.TokenID, content.RedeemAmount)
+		}
+	}
+	return content.requestTxHash(), event
+}
+
+// getResponseRequestTxHash returns the request tx hash of a response metadata, nil if the metadata is not a response
+func getResponseRequestTxHash(meta metadata.Metadata) *common.Hash {
+	switch m := meta.(type) {
+	case *metadata.IssuingResponse:
+		return &m.RequestedTxID
+	case *metadata.IssuingETHResponse:
+		return &m.RequestedTxID
+	case *metadata.PDEContributionResponse:
+		return &m.RequestedTxID
+	case *metadata.PDETradeResponse:
+		return &m.RequestedTxID
+	case *metadata.PDECrossPoolTradeResponse:
+		return &m.RequestedTxID
+	case *metadata.PDEWithdrawalResponse:
+		return &m.RequestedTxID
+	case *metadata.PDEFeeWithdrawalResponse:
+		return &m.RequestedTxID
+	case *metadata.PortalCustodianDepositResponse:
+		return &m.ReqTxID
+	case *metadata.PortalLiquidationCustodianDepositResponse:
+		return &m.ReqTxID
+	case *metadata.PortalLiquidationCustodianDepositResponseV2:
+		return &m.ReqTxID
+	case *metadata.PortalCustodianWithdrawResponse:
+		return &m.ReqTxID
+	case *metadata.PortalFeeRefundResponse:
+		return &m.ReqTxID
+	case *metadata.PortalRedeemLiquidateExchangeRatesResponse:
+		return &m.ReqTxID
+	case *metadata.PortalRedeemFromLiquidationPoolResponseV3:
+		return &m.ReqTxID
+	case *metadata.PortalRedeemRequestResponse:
+		return &m.ReqTxID
+	case *metadata.PortalRequestPTokensResponse:
+		return &m.ReqTxID
+	case *metadata.PortalWithdrawRewardResponse:
+		return &m.TxReqID
+	case *metadata.WithDrawRewardResponse:
+		return m.TxRequest
+	case *metadata.UnstakeResponse:
+		return &m.RequestedTxID
+	case *metadata.StakingPoolDelegateRefund:
+		return &m.RequestedTxID
+	case *metadata.ReturnStakingMetadata:
+		hash, err := (common.Hash{}).NewHashFromStr(m.TxID)
+		if err != nil {
+			return nil
+		}
+		return hash
+	}
+	return nil
+}
+
+// getTxReceiptResponses returns response txs of a shard block by their request tx hashes
+func getTxReceiptResponses(shardBlock *ShardBlock) map[common.Hash][]*rawdbv2.TxReceiptResponse {
+	responses := make(map[common.Hash][]*rawdbv2.TxReceiptResponse)
+	for _, tx := range shardBlock.Body.Transactions {
+		if tx.GetMetadata() == nil {
+			continue
+		}
+		reqTxHash := getResponseRequestTxHash(tx.GetMetadata())
+		if reqTxHash == nil {
+			continue
+		}
+		response := &rawdbv2.TxReceiptResponse{
+			ResponseTxHash: *tx.Hash(),
+			MetadataType:   tx.GetMetadataType(),
+			ShardID:        shardBlock.Header.ShardID,
+			BlockHash:      *shardBlock.Hash(),
+			BlockHeight:    shardBlock.Header.Height,
+		}
+		for _, proof := range getTxProofsOfToken(tx) {
+			response.Amounts = addReceiptAmount(response.Amounts, proof.tokenID.String(), proof.tx.CalculateTxValue())
+		}
+		responses[*reqTxHash] = append(responses[*reqTxHash], response)
+	}
+	return responses
+}
+
+func storeTxReceiptResponses(db incdb.KeyValueWriter, shardBlock *ShardBlock) error {
+	for reqTxHash, responses := range getTxReceiptResponses(shardBlock) {
+		for _, response := range responses {
+			if err := rawdbv2.StoreTxReceiptResponse(db, reqTxHash, response); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func deleteTxReceiptResponses(db incdb.KeyValueWriter, shardBlock *ShardBlock) error {
+	for reqTxHash, responses := range getTxReceiptResponses(shardBlock) {
+		for _, response := range responses {
+			if err := rawdbv2.DeleteTxReceiptResponse(db, reqTxHash, response.ResponseTxHash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func storeTxReceiptEvents(db incdb.KeyValueWriter, beaconBlock *BeaconBlock) error {
+	for index, inst := range beaconBlock.Body.Instructions {
+		reqTxHash, event := GetInstructionReceiptEvent(inst)
+		if event == nil {
+			continue
+		}
+		event.BeaconHeight = beaconBlock.Header.Height
+		event.InstIndex = uint32(index)
+		event.BeaconBlockHash = *beaconBlock.Hash()
+		if err := rawdbv2.StoreTxReceiptEvent(db, *reqTxHash, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteTxReceiptEvents(db incdb.KeyValueWriter, beaconBlock *BeaconBlock) error {
+	for index, inst := range beaconBlock.Body.Instructions {
+		reqTxHash, event := GetInstructionReceiptEvent(inst)
+		if event == nil {
+			continue
+		}
+		if err := rawdbv2.DeleteTxReceiptEvent(db, *reqTxHash, beaconBlock.Header.Height, uint32(index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type receiptChain interface {
+	GetBestView() multiview.View
+	GetFinalView() multiview.View
+	GetViewByHash(hash common.Hash) multiview.View
+}
+
+// isCanonicalBlock returns whether a block is finalized or on the chain of the best view, and whether it is finalized
+func isCanonicalBlock(chain receiptChain, height uint64, hash common.Hash, getFinalizedHash func(height uint64) (*common.Hash, error)) (bool, bool) {
+	if height <= chain.GetFinalView().GetHeight() {
+		finalizedHash, err := getFinalizedHash(height)
+		return err == nil && finalizedHash.IsEqual(&hash), true
+	}
+	for view := chain.GetBestView(); view != nil && view.GetHeight() >= height; view = chain.GetViewByHash(*view.GetPreviousHash()) {
+		if view.GetHeight() == height {
+			return view.GetHash().IsEqual(&hash), false
+		}
+	}
+	return false, false
+}
+
+func (blockchain *BlockChain) isCanonicalShardBlock(shardID byte, height uint64, hash common.Hash) (bool, bool) {
+	return isCanonicalBlock(blockchain.ShardChain[shardID], height, hash, func(height uint64) (*common.Hash, error) {
+		return rawdbv2.GetFinalizedShardBlockHashByIndex(blockchain.GetShardChainDatabase(shardID), shardID, height)
+	})
+}
+
+func (blockchain *BlockChain) isCanonicalBeaconBlock(height uint64, hash common.Hash) (bool, bool) {
+	return isCanonicalBlock(blockchain.BeaconChain, height, hash, func(height uint64) (*common.Hash, error) {
+		return rawdbv2.GetFinalizedBeaconBlockHashByIndex(blockchain.GetBeaconChainDatabase(), height)
+	})
+}
+
+// GetTxReceipt returns the receipt of a metadata transaction in a block of the canonical chain
+func (blockchain *BlockChain) GetTxReceipt(txHash common.Hash) (*TxReceipt, error) {
+	shardID, blockHash, height, _, tx, err := blockchain.GetTransactionByHash(txHash)
+	if err != nil {
+		return nil, NewBlockChainError(GetTxReceiptError, err)
+	}
+	if tx.GetMetadata() == nil {
+		return nil, NewBlockChainError(GetTxReceiptError, fmt.Errorf("Transaction %+v has no metadata", txHash))
+	}
+	canonical, finalized := blockchain.isCanonicalShardBlock(shardID, height, blockHash)
+	if !canonical {
+		return nil, NewBlockChainError(GetTxReceiptError, fmt.Errorf("Block %+v of transaction %+v is not on canonical chain", blockHash, txHash))
+	}
+	receipt := &TxReceipt{
+		TxHash:           txHash,
+		MetadataType:     tx.GetMetadataType(),
+		ShardID:          shardID,
+		BlockHash:        blockHash,
+		BlockHeight:      height,
+		Amounts:          []rawdbv2.TxReceiptAmount{},
+		RefundedAmounts:  []rawdbv2.TxReceiptAmount{},
+		ResponseTxHashes: []common.Hash{},
+		Events:           []*rawdbv2.TxReceiptEvent{},
+		Responses:        []*rawdbv2.TxReceiptResponse{},
+		Finalized:        finalized,
+	}
+
+	events, err := rawdbv2.GetTxReceiptEvents(blockchain.GetBeaconChainDatabase(), txHash)
+	if err != nil {
+		return nil, NewBlockChainError(GetTxReceiptError, err)
+	}
+	for _, event := range events {
+		canonical, finalized := blockchain.isCanonicalBeaconBlock(event.BeaconHeight, event.BeaconBlockHash)
+		if !canonical {
+			continue
+		}
+		receipt.Finalized = receipt.Finalized && finalized
+		receipt.Events = append(receipt.Events, event)
+		receipt.Status = event.Status
+		if len(event.Amounts) > 0 {
+			receipt.Amounts = event.Amounts
+		}
+		receipt.RefundedAmounts = append(receipt.RefundedAmounts, event.RefundedAmounts...)
+	}
+
+	// a response tx can be in any shard, it is minted to the shard of its receiver
+	for _, responseShardID := range blockchain.GetShardIDs() {
+		responses, err := rawdbv2.GetTxReceiptResponses(blockchain.GetShardChainDatabase(byte(responseShardID)), txHash)
+		if err != nil {
+			return nil, NewBlockChainError(GetTxReceiptError, err)
+		}
+		for _, response := range responses {
+			canonical, finalized := blockchain.isCanonicalShardBlock(response.ShardID, response.BlockHeight, response.BlockHash)
+			if !canonical {
+				continue
+			}
+			receipt.Finalized = receipt.Finalized && finalized
+			receipt.Responses = append(receipt.Responses, response)
+			receipt.ResponseTxHashes = append(receipt.ResponseTxHashes, response.ResponseTxHash)
+		}
+	}
+	if len(receipt.Events) == 0 {
+		receipt.Status = TxReceiptPendingStatus
+		if len(receipt.Responses) > 0 {
+			receipt.Status = TxReceiptRespondedStatus
+		}
+	}
+	return receipt, nil
+}
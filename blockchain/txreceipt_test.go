@@ -0,0 +1,276 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+func TestGetInstructionReceiptEvent(t *testing.T) {
+	reqTxHash := common.HashH([]byte("request tx"))
+	tokenID := common.HashH([]byte("token"))
+	buyTokenID := common.HashH([]byte("token to buy"))
+	toJSON := func(content interface{}) string {
+		contentBytes, err := json.Marshal(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(contentBytes)
+	}
+	toBase64 := func(content interface{}) string {
+		return base64.StdEncoding.EncodeToString([]byte(toJSON(content)))
+	}
+	inst := func(metaType int, status string, content string) []string {
+		return []string{strconv.Itoa(metaType), "0", status, content}
+	}
+	amounts := func(tokenID string, amount uint64) []rawdbv2.TxReceiptAmount {
+		return []rawdbv2.TxReceiptAmount{{TokenID: tokenID, Amount: amount}}
+	}
+	tradeRequest := metadata.PDETradeRequestAction{
+		Meta:    metadata.PDETradeRequest{TokenIDToBuyStr: buyTokenID.String(), TokenIDToSellStr: tokenID.String(), SellAmount: 100, TradingFee: 2},
+		TxReqID: reqTxHash,
+	}
+	contributionRequest := metadata.PDEContributionAction{
+		Meta:    metadata.PDEContribution{TokenIDStr: tokenID.String(), ContributedAmount: 100},
+		TxReqID: reqTxHash,
+	}
+	redeem := metadata.PortalRedeemRequestContent{TokenID: tokenID.String(), RedeemAmount: 100, TxReqID: reqTxHash}
+	delegate := metadata.StakingPoolDelegateContent{Amount: 100, TxReqID: reqTxHash}
+	unstake := metadata.UnstakeRequestContent{Amount: 100, TxReqID: reqTxHash}
+
+	for _, tc := range []struct {
+		name            string
+		inst            []string
+		status          string
+		amounts         []rawdbv2.TxReceiptAmount
+		refundedAmounts []rawdbv2.TxReceiptAmount
+	}{
+		{
+			name:   "burning confirm",
+			inst:   []string{strconv.Itoa(metadata.BurningConfirmMetaV2), "0", "token", "address", "amount", reqTxHash.String(), "incToken", "height"},
+			status: TxReceiptConfirmedStatus,
+		},
+		{
+			name:    "pde trade accepted",
+			inst:    inst(metadata.PDETradeRequestMeta, common.PDETradeAcceptedChainStatus, toJSON(metadata.PDETradeAcceptedContent{TokenIDToBuyStr: buyTokenID.String(), ReceiveAmount: 90, RequestedTxID: reqTxHash})),
+			status:  common.PDETradeAcceptedChainStatus,
+			amounts: amounts(buyTokenID.String(), 90),
+		},
+		{
+			name:            "pde trade refunded with request action",
+			inst:            inst(metadata.PDETradeRequestMeta, common.PDETradeRefundChainStatus, toBase64(tradeRequest)),
+			status:          common.PDETradeRefundChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 102),
+		},
+		{
+			name:    "pde cross pool trade accepted",
+			inst:    inst(metadata.PDECrossPoolTradeRequestMeta, common.PDETradeAcceptedChainStatus, toJSON(metadata.PDECrossPoolTradeAcceptedContent{TokenIDToBuyStr: buyTokenID.String(), ReceiveAmount: 90, RequestedTxID: reqTxHash})),
+			status:  common.PDETradeAcceptedChainStatus,
+			amounts: amounts(buyTokenID.String(), 90),
+		},
+		{
+			name:            "pde cross pool trade refunded",
+			inst:            inst(metadata.PDECrossPoolTradeRequestMeta, common.PDECrossPoolTradeFeeRefundChainStatus, toJSON(metadata.PDERefundCrossPoolTrade{TokenIDStr: tokenID.String(), Amount: 100, TxReqID: reqTxHash})),
+			status:          common.PDECrossPoolTradeFeeRefundChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:    "pde contribution waiting",
+			inst:    inst(metadata.PDEContributionMeta, common.PDEContributionWaitingChainStatus, toJSON(metadata.PDEWaitingContribution{TokenIDStr: tokenID.String(), ContributedAmount: 100, TxReqID: reqTxHash})),
+			status:  common.PDEContributionWaitingChainStatus,
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:            "pde contribution refunded",
+			inst:            inst(metadata.PDEPRVRequiredContributionRequestMeta, common.PDEContributionRefundChainStatus, toJSON(metadata.PDERefundContribution{TokenIDStr: tokenID.String(), ContributedAmount: 100, TxReqID: reqTxHash})),
+			status:          common.PDEContributionRefundChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:            "pde contribution refunded with request action",
+			inst:            inst(metadata.PDEContributionMeta, common.PDEContributionRefundChainStatus, toBase64(contributionRequest)),
+			status:          common.PDEContributionRefundChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:            "pde contribution matched and returned",
+			inst:            inst(metadata.PDEPRVRequiredContributionRequestMeta, common.PDEContributionMatchedNReturnedChainStatus, toJSON(metadata.PDEMatchedNReturnedContribution{TokenIDStr: tokenID.String(), ActualContributedAmount: 80, ReturnedContributedAmount: 20, TxReqID: reqTxHash})),
+			status:          common.PDEContributionMatchedNReturnedChainStatus,
+			amounts:         amounts(tokenID.String(), 80),
+			refundedAmounts: amounts(tokenID.String(), 20),
+		},
+		{
+			name:    "issuing accepted",
+			inst:    inst(metadata.IssuingRequestMeta, "accepted", toBase64(metadata.IssuingAcceptedInst{DepositedAmount: 100, IncTokenID: tokenID, TxReqID: reqTxHash})),
+			status:  "accepted",
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:    "issuing eth accepted",
+			inst:    inst(metadata.IssuingETHRequestMeta, "accepted", toBase64(metadata.IssuingETHAcceptedInst{IssuingAmount: 100, IncTokenID: tokenID, TxReqID: reqTxHash})),
+			status:  "accepted",
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:    "portal porting accepted",
+			inst:    inst(metadata.PortalRequestPortingMetaV3, common.PortalPortingRequestAcceptedChainStatus, toJSON(metadata.PortalPortingRequestContent{PTokenId: tokenID.String(), RegisterAmount: 100, TxReqID: reqTxHash})),
+			status:  common.PortalPortingRequestAcceptedChainStatus,
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:   "portal porting rejected",
+			inst:   inst(metadata.PortalRequestPortingMeta, common.PortalPortingRequestRejectedChainStatus, toJSON(metadata.PortalPortingRequestContent{PTokenId: tokenID.String(), RegisterAmount: 100, TxReqID: reqTxHash})),
+			status: common.PortalPortingRequestRejectedChainStatus,
+		},
+		{
+			name:    "portal request ptokens accepted",
+			inst:    inst(metadata.PortalUserRequestPTokenMeta, common.PortalReqPTokensAcceptedChainStatus, toJSON(metadata.PortalRequestPTokensContent{TokenID: tokenID.String(), PortingAmount: 100, TxReqID: reqTxHash})),
+			status:  common.PortalReqPTokensAcceptedChainStatus,
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:   "portal request ptokens rejected",
+			inst:   inst(metadata.PortalUserRequestPTokenMeta, common.PortalReqPTokensRejectedChainStatus, toJSON(metadata.PortalRequestPTokensContent{TokenID: tokenID.String(), PortingAmount: 100, TxReqID: reqTxHash})),
+			status: common.PortalReqPTokensRejectedChainStatus,
+		},
+		{
+			name:    "portal redeem accepted",
+			inst:    inst(metadata.PortalRedeemRequestMetaV3, common.PortalRedeemRequestAcceptedChainStatus, toJSON(redeem)),
+			status:  common.PortalRedeemRequestAcceptedChainStatus,
+			amounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:            "portal redeem rejected",
+			inst:            inst(metadata.PortalRedeemRequestMeta, common.PortalRedeemRequestRejectedChainStatus, toJSON(redeem)),
+			status:          common.PortalRedeemRequestRejectedChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:            "portal redeem cancelled by liquidation",
+			inst:            inst(metadata.PortalRedeemRequestMetaV3, common.PortalRedeemReqCancelledByLiquidationChainStatus, toJSON(redeem)),
+			status:          common.PortalRedeemReqCancelledByLiquidationChainStatus,
+			refundedAmounts: amounts(tokenID.String(), 100),
+		},
+		{
+			name:    "staking pool delegate accepted",
+			inst:    inst(metadata.StakingPoolDelegateMeta, common.StakingPoolRequestAcceptedChainStatus, toJSON(delegate)),
+			status:  common.StakingPoolRequestAcceptedChainStatus,
+			amounts: amounts(common.PRVIDStr, 100),
+		},
+		{
+			name:            "staking pool delegate rejected",
+			inst:            inst(metadata.StakingPoolDelegateMeta, common.StakingPoolRequestRejectedChainStatus, toJSON(delegate)),
+			status:          common.StakingPoolRequestRejectedChainStatus,
+			refundedAmounts: amounts(common.PRVIDStr, 100),
+		},
+		{
+			name:   "unstake accepted",
+			inst:   inst(metadata.UnstakeRequestMeta, common.UnstakeRequestAcceptedChainStatus, toJSON(unstake)),
+			status: common.UnstakeRequestAcceptedChainStatus,
+		},
+		{
+			name:    "unstake released",
+			inst:    inst(metadata.UnstakeRequestMeta, common.UnstakeReleasedChainStatus, toJSON(unstake)),
+			status:  common.UnstakeReleasedChainStatus,
+			amounts: amounts(common.PRVIDStr, 100),
+		},
+		{
+			name:   "content of request tx hash only",
+			inst:   inst(metadata.WithDrawRewardRequestMeta, "accepted", reqTxHash.String()),
+			status: "accepted",
+		},
+		{
+			name:   "unknown metadata type with request tx hash",
+			inst:   inst(10000, "accepted", toJSON(map[string]interface{}{"TxReqID": reqTxHash})),
+			status: "accepted",
+		},
+	} {
+		hash, event := GetInstructionReceiptEvent(tc.inst)
+		if event == nil || hash == nil {
+			t.Errorf("%v: expect event of the request tx, have none", tc.name)
+			continue
+		}
+		if *hash != reqTxHash {
+			t.Errorf("%v: expect request tx hash %v, have %v", tc.name, reqTxHash.String(), hash.String())
+		}
+		metaType, _ := strconv.Atoi(tc.inst[0])
+		if event.MetadataType != metaType || event.Status != tc.status {
+			t.Errorf("%v: expect metadata type %v status %v, have %v %v", tc.name, metaType, tc.status, event.MetadataType, event.Status)
+		}
+		if len(event.Amounts) != len(tc.amounts) || (len(tc.amounts) > 0 && !reflect.DeepEqual(event.Amounts, tc.amounts)) {
+			t.Errorf("%v: expect amounts %+v, have %+v", tc.name, tc.amounts, event.Amounts)
+		}
+		if len(event.RefundedAmounts) != len(tc.refundedAmounts) || (len(tc.refundedAmounts) > 0 && !reflect.DeepEqual(event.RefundedAmounts, tc.refundedAmounts)) {
+			t.Errorf("%v: expect refunded amounts %+v, have %+v", tc.name, tc.refundedAmounts, event.RefundedAmounts)
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		inst []string
+	}{
+		{"empty", []string{}},
+		{"not a metadata instruction", []string{SwapAction, "", "", "beacon", "", "0"}},
+		{"burning confirm too short", []string{strconv.Itoa(metadata.BurningConfirmMeta), "0", "token", "address", "amount"}},
+		{"burning confirm with invalid request tx hash", []string{strconv.Itoa(metadata.BurningConfirmMeta), "0", "token", "address", "amount", "invalid", "incToken", "height"}},
+		{"no content", []string{strconv.Itoa(metadata.PDETradeRequestMeta), "0", common.PDETradeAcceptedChainStatus}},
+		{"malformed content", inst(metadata.PDETradeRequestMeta, common.PDETradeAcceptedChainStatus, "{malformed")},
+		{"malformed base64 content", inst(metadata.IssuingRequestMeta, "accepted", base64.StdEncoding.EncodeToString([]byte("{malformed")))},
+		{"content without request tx hash", inst(metadata.PDETradeRequestMeta, common.PDETradeAcceptedChainStatus, toJSON(metadata.PDETradeAcceptedContent{ReceiveAmount: 90}))},
+		{"content with json array", inst(metadata.UnstakeRequestMeta, common.UnstakeReleasedChainStatus, "[1, 2]")},
+	} {
+		if hash, event := GetInstructionReceiptEvent(tc.inst); hash != nil || event != nil {
+			t.Errorf("%v: expect no event, have %+v of %v", tc.name, event, hash)
+		}
+	}
+}
+
+func TestIsCanonicalShardBlock(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, beaconBlock := newRollbackTestChain(t)
+	views := []*ShardBestState{insertRollbackTestBlock(t, bc, beaconBlock, nil, 1, nil)}
+	for _, timeSlot := range []int64{10, 20, 30} {
+		views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], timeSlot, nil))
+	}
+	// a fork of block 3, and a fork of block 5 on top of it which is never the best view
+	fork := insertRollbackTestBlock(t, bc, beaconBlock, views[1], 21, nil)
+	forkTip := insertRollbackTestBlock(t, bc, beaconBlock, fork, 22, nil)
+	// block 5 finalizes blocks 2 to 4, block 6 is the best view
+	views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], 31, nil))
+	views = append(views, insertRollbackTestBlock(t, bc, beaconBlock, views[len(views)-1], 40, nil))
+	if finalHeight := bc.ShardChain[0].GetFinalView().GetHeight(); finalHeight != 4 {
+		t.Fatalf("Expect block 4 is finalized, have final height %v", finalHeight)
+	}
+	if bestHash := *bc.ShardChain[0].GetBestView().GetHash(); bestHash != views[5].BestBlockHash {
+		t.Fatalf("Expect block 6 is the best view, have %v", bestHash.String())
+	}
+
+	for _, tc := range []struct {
+		name      string
+		height    uint64
+		hash      common.Hash
+		canonical bool
+		finalized bool
+	}{
+		{"finalized block", 3, views[2].BestBlockHash, true, true},
+		{"fork below the final view", 3, fork.BestBlockHash, false, true},
+		{"block on the best chain", 5, views[4].BestBlockHash, true, false},
+		{"best view", 6, views[5].BestBlockHash, true, false},
+		{"fork above the final view", 4, forkTip.BestBlockHash, false, true},
+		{"unknown block above the final view", 5, common.HashH([]byte("unknown")), false, false},
+		{"block above the best view", 7, views[5].BestBlockHash, false, false},
+	} {
+		canonical, finalized := bc.isCanonicalShardBlock(0, tc.height, tc.hash)
+		if canonical != tc.canonical || finalized != tc.finalized {
+			t.Errorf("%v: expect canonical %v finalized %v, have %v %v", tc.name, tc.canonical, tc.finalized, canonical, finalized)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// Unstaked stakes are locked in beacon consensus state for UnbondingPeriod (a governable param) epochs before being returned:
+//	- an unstaked validator stops auto staking, its stake is not returned when it is swapped out,
+//	the unbonding period starts at the swap out instead
+//	- an unstaked delegation leaves the staking pool right away (it gets no more reward), the unbonding period starts at once
+//	- an equivocation of an unstaked validator found during its unbonding period confiscates its stake
+//	- once the period ends, beacon releases the stake and the shard of the receiver returns it with an UnstakeResponse tx
+
+func buildUnstakeInst(shardID byte, status string, content metadata.UnstakeRequestContent) ([][]string, error) {
+	contentBytes, err := json.Marshal(content)
+	if err != nil {
+		return [][]string{}, err
+	}
+	return [][]string{{
+		strconv.Itoa(metadata.UnstakeRequestMeta),
+		strconv.Itoa(int(shardID)),
+		status,
+		string(contentBytes),
+	}}, nil
+}
+
+// buildInstructionsForUnstakeReq accept the request if the validator is still staking and not unstaked yet,
+// or if the delegation left after unstake requests of the same block is enough
+func (blockchain *BlockChain) buildInstructionsForUnstakeReq(
+	beaconBestState *BeaconBestState,
+	contentStr string,
+	shardID byte,
+	unstakedAmounts map[string]uint64,
+) ([][]string, error) {
+	contentBytes, err := base64.StdEncoding.DecodeString(contentStr)
+	if err != nil {
+		return [][]string{}, err
+	}
+	var action metadata.UnstakeRequestAction
+	err = json.Unmarshal(contentBytes, &action)
+	if err != nil {
+		return [][]string{}, err
+	}
+	content := metadata.UnstakeRequestContent{
+		CommitteePublicKey: action.Meta.CommitteePublicKey,
+		PaymentAddress:     action.Meta.PaymentAddress,
+		Amount:             action.Meta.Amount,
+		IsDelegation:       action.Meta.IsDelegation(),
+		TxReqID:            action.TxReqID,
+		ShardID:            shardID,
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(action.Meta.PaymentAddress)
+	if err != nil {
+		Logger.log.Warnf("WARNING: unstake request %+v, invalid payment address %+v", action.TxReqID.String(), action.Meta.PaymentAddress)
+		return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+	}
+	if content.IsDelegation {
+		unstakedKey := action.Meta.CommitteePublicKey + string(keyWallet.KeySet.PaymentAddress.Pk)
+		delegation, has, err := statedb.GetDelegation(beaconBestState.consensusStateDB, action.Meta.CommitteePublicKey, keyWallet.KeySet.PaymentAddress.Pk)
+		if err != nil || !has || delegation.Amount() < unstakedAmounts[unstakedKey]+action.Meta.Amount {
+			Logger.log.Warnf("WARNING: unstake request %+v, delegation of %+v to %+v is not enough, error %+v", action.TxReqID.String(), action.Meta.PaymentAddress, action.Meta.CommitteePublicKey, err)
+			return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+		}
+		unstakedAmounts[unstakedKey] += action.Meta.Amount
+		content.ReleaseEpoch = beaconBestState.Epoch + blockchain.getGovernanceParam(beaconBestState, UnbondingPeriodParam, beaconBestState.BeaconHeight+1)
+		return buildUnstakeInst(shardID, common.UnstakeRequestAcceptedChainStatus, content)
+	}
+	if _, ok := unstakedAmounts[action.Meta.CommitteePublicKey]; ok {
+		Logger.log.Warnf("WARNING: unstake request %+v, %+v is already unstaked in the current block", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+	}
+	if common.IndexOfStr(action.Meta.CommitteePublicKey, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+		Logger.log.Warnf("WARNING: unstake request %+v, %+v not found in any committee list", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+	}
+	if _, has, err := statedb.GetValidatorUnbonding(beaconBestState.consensusStateDB, action.Meta.CommitteePublicKey); err != nil || has {
+		Logger.log.Warnf("WARNING: unstake request %+v, %+v is already unstaked, error %+v", action.TxReqID.String(), action.Meta.CommitteePublicKey, err)
+		return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+	}
+	if isStakeConfiscated(beaconBestState.consensusStateDB, action.Meta.CommitteePublicKey) {
+		Logger.log.Warnf("WARNING: unstake request %+v, stake of %+v is already confiscated", action.TxReqID.String(), action.Meta.CommitteePublicKey)
+		return buildUnstakeInst(shardID, common.UnstakeRequestRejectedChainStatus, content)
+	}
+	// the stake returned is the amount of the staking tx (checked by the shard), the staking amount param may have changed since
+	unstakedAmounts[action.Meta.CommitteePublicKey] = action.Meta.StakingAmount
+	content.Amount = action.Meta.StakingAmount
+	return buildUnstakeInst(shardID, common.UnstakeRequestAcceptedChainStatus, content)
+}
+
+// buildUnbondingReleaseInstructions release unbonding stakes of which the unbonding period ended
+func (blockchain *BlockChain) buildUnbondingReleaseInstructions(beaconBestState *BeaconBestState) [][]string {
+	instructions := [][]string{}
+	unbondings, err := statedb.GetAllUnbondings(beaconBestState.consensusStateDB)
+	if err != nil {
+		Logger.log.Error(err)
+		return instructions
+	}
+	for _, unbonding := range unbondings {
+		if unbonding.ReleaseEpoch() == 0 || unbonding.ReleaseEpoch() > beaconBestState.Epoch {
+			continue
+		}
+		receiver := unbonding.Receiver()
+		shardID := common.GetShardIDFromLastByte(receiver.Pk[len(receiver.Pk)-1])
+		keyWallet := wallet.KeyWallet{}
+		keyWallet.KeySet.PaymentAddress = receiver
+		inst, err := buildUnstakeInst(shardID, common.UnstakeReleasedChainStatus, metadata.UnstakeRequestContent{
+			CommitteePublicKey: unbonding.CommitteePublicKey(),
+			PaymentAddress:     keyWallet.Base58CheckSerialize(wallet.PaymentAddressType),
+			Amount:             unbonding.Amount(),
+			IsDelegation:       unbonding.IsDelegation(),
+			ReleaseEpoch:       unbonding.ReleaseEpoch(),
+			TxReqID:            unbonding.TxReqID(),
+			ShardID:            shardID,
+		})
+		if err != nil {
+			Logger.log.Error(err)
+			continue
+		}
+		instructions = append(instructions, inst...)
+	}
+	return instructions
+}
+
+// processUnstakeInstruction turn off auto staking of an unstaked validator
+func (beaconBestState *BeaconBestState) processUnstakeInstruction(instruction []string, committeeChange *committeeChange) {
+	if len(instruction) != 4 || instruction[2] != common.UnstakeRequestAcceptedChainStatus {
+		return
+	}
+	var content metadata.UnstakeRequestContent
+	if err := json.Unmarshal([]byte(instruction[3]), &content); err != nil || content.IsDelegation {
+		return
+	}
+	if _, ok := beaconBestState.AutoStaking.Get(content.CommitteePublicKey); !ok {
+		return
+	}
+	beaconBestState.AutoStaking.Set(content.CommitteePublicKey, false)
+	if common.IndexOfStr(content.CommitteePublicKey, committeeChange.stopAutoStaking) == -1 {
+		committeeChange.stopAutoStaking = append(committeeChange.stopAutoStaking, content.CommitteePublicKey)
+	}
+}
+
+// processUnstakeInstructions store unbonding stakes of beacon block:
+//	- accepted unstake requests add unbonding stakes, unstaked delegations leave their pool
+//	- unbonding period of unstaked validators swapped out (and not staking again) starts
+//	- stakes of slashed validators and released stakes are removed
+func (blockchain *BlockChain) processUnstakeInstructions(beaconBestState *BeaconBestState, beaconBlock *BeaconBlock) error {
+	consensusStateDB := beaconBestState.consensusStateDB
+	for _, inst := range beaconBlock.Body.Instructions {
+		switch {
+		case inst[0] == strconv.Itoa(metadata.UnstakeRequestMeta) && len(inst) == 4:
+			var content metadata.UnstakeRequestContent
+			if err := json.Unmarshal([]byte(inst[3]), &content); err != nil {
+				return err
+			}
+			switch inst[2] {
+			case common.UnstakeRequestAcceptedChainStatus:
+				keyWallet, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+				if err != nil {
+					return err
+				}
+				if content.IsDelegation {
+					if err := statedb.RemoveDelegation(consensusStateDB, content.CommitteePublicKey, keyWallet.KeySet.PaymentAddress, content.Amount); err != nil {
+						return err
+					}
+				}
+				releaseEpoch := content.ReleaseEpoch
+				if !content.IsDelegation && common.IndexOfStr(content.CommitteePublicKey, beaconBestState.getAllCommitteeValidatorCandidateFlattenList()) == -1 {
+					// validator is swapped out by the same block
+					releaseEpoch = beaconBestState.Epoch + blockchain.getGovernanceParam(beaconBestState, UnbondingPeriodParam, beaconBestState.BeaconHeight)
+				}
+				unbonding := statedb.NewUnbondingStateWithValue(content.TxReqID, content.CommitteePublicKey, keyWallet.KeySet.PaymentAddress, content.Amount, content.IsDelegation, releaseEpoch)
+				if err := statedb.StoreUnbonding(consensusStateDB, unbonding); err != nil {
+					return err
+				}
+			case common.UnstakeReleasedChainStatus:
+				statedb.DeleteUnbonding(consensusStateDB, content.TxReqID)
+			}
+		case inst[0] == SwapAction && len(inst) > 2:
+			allCommitteeValidatorCandidate := beaconBestState.getAllCommitteeValidatorCandidateFlattenList()
+			for _, outPublicKey := range strings.Split(inst[2], ",") {
+				if len(outPublicKey) == 0 || common.IndexOfStr(outPublicKey, allCommitteeValidatorCandidate) > -1 {
+					continue
+				}
+				unbonding, has, err := statedb.GetValidatorUnbonding(consensusStateDB, outPublicKey)
+				if err != nil {
+					return err
+				}
+				if !has || unbonding.ReleaseEpoch() != 0 {
+					continue
+				}
+				unbonding.SetReleaseEpoch(beaconBestState.Epoch + blockchain.getGovernanceParam(beaconBestState, UnbondingPeriodParam, beaconBestState.BeaconHeight))
+				if err := statedb.StoreUnbonding(consensusStateDB, unbonding); err != nil {
+					return err
+				}
+			}
+		case inst[0] == SlashEquivocationAction && len(inst) == 3:
+			unbonding, has, err := statedb.GetValidatorUnbonding(consensusStateDB, inst[1])
+			if err != nil {
+				return err
+			}
+			if has {
+				statedb.DeleteUnbonding(consensusStateDB, unbonding.TxReqID())
+			}
+		}
+	}
+	return nil
+}
+
+func (blockGenerator *BlockGenerator) buildUnstakeResponseTx(
+	contentStr string,
+	producerPrivateKey *privacy.PrivateKey,
+	shardID byte,
+	shardView *ShardBestState,
+) (metadata.Transaction, error) {
+	var content metadata.UnstakeRequestContent
+	err := json.Unmarshal([]byte(contentStr), &content)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while unmarshaling unstake content: %+v", err)
+		return nil, nil
+	}
+	if content.ShardID != shardID {
+		return nil, nil
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while deserializing unstake payment address: %+v", err)
+		return nil, nil
+	}
+	receiverAddr := keyWallet.KeySet.PaymentAddress
+	meta := metadata.NewUnstakeResponse(content.TxReqID, metadata.UnstakeResponseMeta)
+	resTx := new(transaction.Tx)
+	err = resTx.InitTxSalary(
+		content.Amount,
+		&receiverAddr,
+		producerPrivateKey,
+		shardView.GetCopiedTransactionStateDB(),
+		meta,
+	)
+	if err != nil {
+		Logger.log.Errorf("ERROR: an error occured while initializing unstake response tx: %+v", err)
+		return nil, nil
+	}
+	return resTx, nil
+}
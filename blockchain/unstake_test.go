@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+func TestUnstakeValidatorAfterStakingAmountChange(t *testing.T) {
+	stakedAmount := uint64(1750)
+	stateDB := newTestStakingPoolStateDB(t)
+	validator := newTestEquivocationSigner(t, "validator").publicKey
+	validatorKeys, err := incognitokey.CommitteeBase58KeyListToStruct([]string{validator})
+	if err != nil {
+		t.Fatal(err)
+	}
+	funderWallet := wallet.KeyWallet{}
+	funderWallet.KeySet.PaymentAddress = newTestPaymentAddress("funder")
+
+	// the validator staked stakedAmount, governance doubled the staking amount at height 5
+	bc := &BlockChain{config: Config{ChainParams: &Params{StakingAmountShard: stakedAmount}}}
+	err = statedb.AddGovernanceParamActivation(stateDB, StakingAmountShardParam, statedb.GovernanceParamActivation{Height: 5, Value: 2 * stakedAmount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconBestState := &BeaconBestState{
+		BeaconHeight:     10,
+		ShardCommittee:   map[byte][]incognitokey.CommitteePublicKey{0: validatorKeys},
+		consensusStateDB: stateDB,
+	}
+	if got := bc.getGovernanceParam(beaconBestState, StakingAmountShardParam, 11); got != 2*stakedAmount {
+		t.Fatalf("staking amount param = %v, want %v", got, 2*stakedAmount)
+	}
+
+	action := metadata.UnstakeRequestAction{
+		Meta: metadata.UnstakeRequest{
+			MetadataBase:       *metadata.NewMetadataBase(metadata.UnstakeRequestMeta),
+			CommitteePublicKey: validator,
+			PaymentAddress:     funderWallet.Base58CheckSerialize(wallet.PaymentAddressType),
+			StakingAmount:      stakedAmount,
+		},
+		TxReqID: common.HashH([]byte("unstake")),
+	}
+	actionBytes, err := json.Marshal(action)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unstakedAmounts := map[string]uint64{}
+	insts, err := bc.buildInstructionsForUnstakeReq(beaconBestState, base64.StdEncoding.EncodeToString(actionBytes), 0, unstakedAmounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 1 || insts[0][2] != common.UnstakeRequestAcceptedChainStatus {
+		t.Fatalf("buildInstructionsForUnstakeReq() = %v, want an accepted instruction", insts)
+	}
+	var content metadata.UnstakeRequestContent
+	if err := json.Unmarshal([]byte(insts[0][3]), &content); err != nil {
+		t.Fatal(err)
+	}
+	if content.Amount != stakedAmount {
+		t.Errorf("unstaked amount = %v, want the staked amount %v", content.Amount, stakedAmount)
+	}
+	if unstakedAmounts[validator] != stakedAmount {
+		t.Errorf("unstakedAmounts = %v, want %v", unstakedAmounts[validator], stakedAmount)
+	}
+}
@@ -0,0 +1,369 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// Validator stats are recorded per block into the database of the chain of the block:
+//	- a block counts one proposed block for its proposer, and one missed slot for the proposer of each time slot
+//	skipped since the previous block
+//	- a block counts one expected vote for each member of the committee of the previous view, and one cast vote
+//	for each validator of the block
+//	- a beacon block also records the rewards of shard and beacon committees, and the slash and blacklist events
+// Records of blocks out of the canonical chain are filtered out when they are read, they are not rebuilt by reindex
+
+const (
+	ValidatorStatsBeaconChainID = -1
+	ValidatorSlashEvent         = "slash"
+	ValidatorBlacklistEvent     = "blacklist"
+)
+
+// ValidatorEpochStats is the stats of a validator in a committee during an epoch
+type ValidatorEpochStats struct {
+	Epoch          uint64
+	ChainID        int
+	BlocksProposed uint64
+	SlotsMissed    uint64
+	VotesCast      uint64
+	VotesExpected  uint64
+	Rewards        []rawdbv2.TxReceiptAmount
+	Events         []rawdbv2.ValidatorEvent
+}
+
+type validatorStatsTable map[string]*rawdbv2.ValidatorBlockStats
+
+func (table validatorStatsTable) get(committeePublicKey string, chainID int) *rawdbv2.ValidatorBlockStats {
+	stats, ok := table[committeePublicKey]
+	if !ok {
+		stats = &rawdbv2.ValidatorBlockStats{
+			ChainID: chainID,
+			Rewards: []rawdbv2.TxReceiptAmount{},
+			Events:  []rawdbv2.ValidatorEvent{},
+		}
+		table[committeePublicKey] = stats
+	}
+	return stats
+}
+
+func (table validatorStatsTable) store(db incdb.KeyValueWriter, epoch uint64, blockChainID int, height uint64, hash common.Hash) error {
+	for committeePublicKey, stats := range table {
+		stats.Epoch = epoch
+		stats.BlockChainID = blockChainID
+		stats.BlockHeight = height
+		stats.BlockHash = hash
+		if err := rawdbv2.StoreValidatorBlockStats(db, committeePublicKey, stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addValidatorRewards merge reward amounts by token
+func addValidatorRewards(rewards []rawdbv2.TxReceiptAmount, tokenID string, amount uint64) []rawdbv2.TxReceiptAmount {
+	if amount == 0 {
+		return rewards
+	}
+	for i := range rewards {
+		if rewards[i].TokenID == tokenID {
+			rewards[i].Amount += amount
+			return rewards
+		}
+	}
+	return append(rewards, rawdbv2.TxReceiptAmount{TokenID: tokenID, Amount: amount})
+}
+
+// addCommitteeBlockStats count proposal, missed slots and votes of a block in the committee of the previous view
+func addCommitteeBlockStats(
+	table validatorStatsTable,
+	chainID int,
+	committee []incognitokey.CommitteePublicKey,
+	minCommitteeSize int,
+	proposer string,
+	prevProposeTime int64,
+	proposeTime int64,
+	validationData string,
+) error {
+	committeeStr, err := incognitokey.CommitteeKeyListToString(committee)
+	if err != nil {
+		return err
+	}
+	if proposer != "" {
+		table.get(proposer, chainID).BlocksProposed++
+	}
+	slotCommitteeSize := minCommitteeSize
+	if slotCommitteeSize > len(committeeStr) {
+		slotCommitteeSize = len(committeeStr)
+	}
+	prevTimeSlot := common.CalculateTimeSlot(prevProposeTime)
+	timeSlot := common.CalculateTimeSlot(proposeTime)
+	if slotCommitteeSize > 0 && prevProposeTime > 0 && proposeTime > 0 && timeSlot > prevTimeSlot+1 {
+		missed := timeSlot - prevTimeSlot - 1
+		size := int64(slotCommitteeSize)
+		first := int64(GetProposerByTimeSlot(prevTimeSlot+1, slotCommitteeSize))
+		for i := int64(0); i < size; i++ {
+			count := missed / size
+			if (i-first+size)%size < missed%size {
+				count++
+			}
+			if count > 0 {
+				table.get(committeeStr[i], chainID).SlotsMissed += uint64(count)
+			}
+		}
+	}
+	if validationData == "" {
+		return nil
+	}
+	var votes struct {
+		ValidatiorsIdx []int
+	}
+	if err := json.Unmarshal([]byte(validationData), &votes); err != nil {
+		return err
+	}
+	for _, key := range committeeStr {
+		table.get(key, chainID).VotesExpected++
+	}
+	for _, idx := range votes.ValidatiorsIdx {
+		if idx >= 0 && idx < len(committeeStr) {
+			table.get(committeeStr[idx], chainID).VotesCast++
+		}
+	}
+	return nil
+}
+
+// storeShardValidatorStats record the stats of the committee of prevView in a new shard block to db
+func (blockchain *BlockChain) storeShardValidatorStats(db incdb.KeyValueWriter, prevView *ShardBestState, shardBlock *ShardBlock) error {
+	if shardBlock.Header.Height <= 1 {
+		return nil
+	}
+	shardID := shardBlock.Header.ShardID
+	proposer := shardBlock.Header.Proposer
+	if proposer == "" {
+		proposer = shardBlock.Header.Producer
+	}
+	table := validatorStatsTable{}
+	err := addCommitteeBlockStats(
+		table,
+		int(shardID),
+		prevView.ShardCommittee,
+		prevView.MinShardCommitteeSize,
+		proposer,
+		prevView.BestBlock.Header.ProposeTime,
+		shardBlock.Header.ProposeTime,
+		shardBlock.ValidationData,
+	)
+	if err != nil {
+		return err
+	}
+	return table.store(db, shardBlock.Header.Epoch, int(shardID), shardBlock.Header.Height, shardBlock.Header.Hash())
+}
+
+// storeBeaconValidatorStats record the stats of the beacon committee of prevView in a new beacon block to db,
+// with the rewards and punishments of all committees found in its instructions
+func (blockchain *BlockChain) storeBeaconValidatorStats(db incdb.KeyValueWriter, prevView *BeaconBestState, beaconBlock *BeaconBlock) error {
+	if beaconBlock.Header.Height <= 1 {
+		return nil
+	}
+	height := beaconBlock.Header.Height
+	hash := beaconBlock.Header.Hash()
+	proposer := beaconBlock.Header.Proposer
+	if proposer == "" {
+		proposer = beaconBlock.Header.Producer
+	}
+	table := validatorStatsTable{}
+	err := addCommitteeBlockStats(
+		table,
+		ValidatorStatsBeaconChainID,
+		prevView.BeaconCommittee,
+		prevView.MinBeaconCommitteeSize,
+		proposer,
+		prevView.BestBlock.Header.ProposeTime,
+		beaconBlock.Header.ProposeTime,
+		beaconBlock.ValidationData,
+	)
+	if err != nil {
+		return err
+	}
+	// rewards are paid at the first block of an epoch for the previous epoch, to the committees of the previous view
+	tables := map[uint64]validatorStatsTable{beaconBlock.Header.Epoch: table}
+	getRewardTable := func(epoch uint64) validatorStatsTable {
+		if _, ok := tables[epoch]; !ok {
+			tables[epoch] = validatorStatsTable{}
+		}
+		return tables[epoch]
+	}
+	for _, inst := range beaconBlock.Body.Instructions {
+		if len(inst) == 0 {
+			continue
+		}
+		switch {
+		case inst[0] == strconv.Itoa(metadata.ShardBlockRewardRequestMeta) && len(inst) == 4:
+			shardID, err := strconv.Atoi(inst[1])
+			if err != nil {
+				return err
+			}
+			info, err := metadata.NewShardBlockRewardInfoFromString(inst[3])
+			if err != nil {
+				return err
+			}
+			committee, err := incognitokey.CommitteeKeyListToString(prevView.ShardCommittee[byte(shardID)])
+			if err != nil {
+				return err
+			}
+			if len(committee) == 0 {
+				continue
+			}
+			rewardTable := getRewardTable(info.Epoch)
+			for _, key := range committee {
+				stats := rewardTable.get(key, shardID)
+				for tokenID, amount := range info.ShardReward {
+					stats.Rewards = addValidatorRewards(stats.Rewards, tokenID.String(), amount/uint64(len(committee)))
+				}
+			}
+		case inst[0] == strconv.Itoa(metadata.BeaconRewardRequestMeta) && len(inst) == 4:
+			info, err := metadata.NewBeaconBlockRewardInfoFromStr(inst[3])
+			if err != nil {
+				return err
+			}
+			for _, member := range prevView.BeaconCommittee {
+				if (base58.Base58Check{}).Encode(member.GetNormalKey(), common.ZeroByte) != info.PayToPublicKey {
+					continue
+				}
+				key, err := member.ToBase58()
+				if err != nil {
+					return err
+				}
+				stats := getRewardTable(prevView.Epoch).get(key, ValidatorStatsBeaconChainID)
+				for tokenID, amount := range info.BeaconReward {
+					stats.Rewards = addValidatorRewards(stats.Rewards, tokenID.String(), amount)
+				}
+			}
+		case inst[0] == SlashEquivocationAction && len(inst) == 3:
+			stats := table.get(inst[1], getCommitteeChainID(prevView, inst[1]))
+			stats.Events = append(stats.Events, rawdbv2.ValidatorEvent{
+				Type:           ValidatorSlashEvent,
+				PunishedEpochs: EquivocationPunishedEpoches,
+				TxReqID:        inst[2],
+			})
+		case inst[0] == SwapAction:
+			chainID := ValidatorStatsBeaconChainID
+			badProducersWithPunishment := ""
+			if len(inst) == 6 && inst[3] == "shard" {
+				shardID, err := strconv.Atoi(inst[4])
+				if err != nil {
+					return err
+				}
+				chainID = shardID
+				badProducersWithPunishment = inst[5]
+			}
+			if len(inst) == 5 && inst[3] == "beacon" {
+				badProducersWithPunishment = inst[4]
+			}
+			if badProducersWithPunishment == "" {
+				continue
+			}
+			punishments := map[string]uint8{}
+			if err := json.Unmarshal([]byte(badProducersWithPunishment), &punishments); err != nil {
+				return err
+			}
+			for producer, punishedEpochs := range punishments {
+				stats := table.get(producer, chainID)
+				stats.Events = append(stats.Events, rawdbv2.ValidatorEvent{
+					Type:           ValidatorBlacklistEvent,
+					PunishedEpochs: punishedEpochs,
+				})
+			}
+		}
+	}
+	for epoch, epochTable := range tables {
+		if err := epochTable.store(db, epoch, ValidatorStatsBeaconChainID, height, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getCommitteeChainID returns the chain of which committee has a validator, beacon chain if it is in no shard committee
+func getCommitteeChainID(view *BeaconBestState, committeePublicKey string) int {
+	for shardID, committee := range view.ShardCommittee {
+		committeeStr, err := incognitokey.CommitteeKeyListToString(committee)
+		if err == nil && common.IndexOfStr(committeePublicKey, committeeStr) != -1 {
+			return int(shardID)
+		}
+	}
+	return ValidatorStatsBeaconChainID
+}
+
+// GetValidatorStats returns the stats of a validator by epoch and committee from fromEpoch to toEpoch,
+// only blocks of the canonical chains are counted
+func (blockchain *BlockChain) GetValidatorStats(committeePublicKey string, fromEpoch uint64, toEpoch uint64) ([]*ValidatorEpochStats, error) {
+	if fromEpoch > toEpoch {
+		return nil, NewBlockChainError(GetValidatorStatsError, fmt.Errorf("From epoch %+v is greater than to epoch %+v", fromEpoch, toEpoch))
+	}
+	records, err := rawdbv2.GetValidatorBlockStats(blockchain.GetBeaconChainDatabase(), committeePublicKey, fromEpoch, toEpoch)
+	if err != nil {
+		return nil, NewBlockChainError(GetValidatorStatsError, err)
+	}
+	for shardID := 0; shardID < blockchain.GetActiveShardNumber(); shardID++ {
+		shardRecords, err := rawdbv2.GetValidatorBlockStats(blockchain.GetShardChainDatabase(byte(shardID)), committeePublicKey, fromEpoch, toEpoch)
+		if err != nil {
+			return nil, NewBlockChainError(GetValidatorStatsError, err)
+		}
+		records = append(records, shardRecords...)
+	}
+	type statsKey struct {
+		epoch   uint64
+		chainID int
+	}
+	statsByKey := map[statsKey]*ValidatorEpochStats{}
+	for _, record := range records {
+		canonical := false
+		if record.BlockChainID == ValidatorStatsBeaconChainID {
+			canonical, _ = blockchain.isCanonicalBeaconBlock(record.BlockHeight, record.BlockHash)
+		} else {
+			canonical, _ = blockchain.isCanonicalShardBlock(byte(record.BlockChainID), record.BlockHeight, record.BlockHash)
+		}
+		if !canonical {
+			continue
+		}
+		key := statsKey{epoch: record.Epoch, chainID: record.ChainID}
+		stats, ok := statsByKey[key]
+		if !ok {
+			stats = &ValidatorEpochStats{
+				Epoch:   record.Epoch,
+				ChainID: record.ChainID,
+				Rewards: []rawdbv2.TxReceiptAmount{},
+				Events:  []rawdbv2.ValidatorEvent{},
+			}
+			statsByKey[key] = stats
+		}
+		stats.BlocksProposed += record.BlocksProposed
+		stats.SlotsMissed += record.SlotsMissed
+		stats.VotesCast += record.VotesCast
+		stats.VotesExpected += record.VotesExpected
+		for _, reward := range record.Rewards {
+			stats.Rewards = addValidatorRewards(stats.Rewards, reward.TokenID, reward.Amount)
+		}
+		stats.Events = append(stats.Events, record.Events...)
+	}
+	res := []*ValidatorEpochStats{}
+	for _, stats := range statsByKey {
+		res = append(res, stats)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Epoch != res[j].Epoch {
+			return res[i].Epoch < res[j].Epoch
+		}
+		return res[i].ChainID < res[j].ChainID
+	})
+	return res, nil
+}
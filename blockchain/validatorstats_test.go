@@ -0,0 +1,188 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+// newTestValidatorCommittee returns committee keys of seeds, by their index in committee
+func newTestValidatorCommittee(t *testing.T, seeds ...string) ([]incognitokey.CommitteePublicKey, []string) {
+	keys := []string{}
+	for _, seed := range seeds {
+		keys = append(keys, newTestEquivocationSigner(t, seed).publicKey)
+	}
+	committee, err := incognitokey.CommitteeBase58KeyListToStruct(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return committee, keys
+}
+
+// getTestValidatorStats returns the stats of a validator by epoch, summed over blocks
+func getTestValidatorStats(t *testing.T, db incdb.Database, committeePublicKey string) map[uint64]*rawdbv2.ValidatorBlockStats {
+	t.Helper()
+	records, err := rawdbv2.GetValidatorBlockStats(db, committeePublicKey, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statsByEpoch := map[uint64]*rawdbv2.ValidatorBlockStats{}
+	for _, record := range records {
+		stats, ok := statsByEpoch[record.Epoch]
+		if !ok {
+			stats = &rawdbv2.ValidatorBlockStats{Epoch: record.Epoch, ChainID: record.ChainID}
+			statsByEpoch[record.Epoch] = stats
+		}
+		stats.BlocksProposed += record.BlocksProposed
+		stats.SlotsMissed += record.SlotsMissed
+		stats.VotesCast += record.VotesCast
+		stats.VotesExpected += record.VotesExpected
+		stats.Rewards = append(stats.Rewards, record.Rewards...)
+	}
+	return statsByEpoch
+}
+
+func TestAddCommitteeBlockStats(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	// the fifth validator is out of the proposers rotation of min committee size 4
+	committee, keys := newTestValidatorCommittee(t, "alice", "bob", "carol", "dave", "eve")
+	type counts struct {
+		proposed, missed, cast, expected uint64
+	}
+	for _, tc := range []struct {
+		name           string
+		prevTimeSlot   int64
+		timeSlot       int64
+		validationData string
+		expected       []counts
+	}{
+		{
+			name:           "proposer of the next slot",
+			prevTimeSlot:   10,
+			timeSlot:       11,
+			validationData: `{"ValidatiorsIdx":[0,1,3]}`,
+			expected:       []counts{{0, 0, 1, 1}, {0, 0, 1, 1}, {0, 0, 0, 1}, {1, 0, 1, 1}, {0, 0, 0, 1}},
+		},
+		{
+			name:         "skipped proposer",
+			prevTimeSlot: 10,
+			timeSlot:     12,
+			// slot 11 of dave is skipped, alice proposes in slot 12
+			expected: []counts{{1, 0, 0, 0}, {}, {}, {0, 1, 0, 0}, {}},
+		},
+		{
+			name:         "view change over more than a rotation",
+			prevTimeSlot: 10,
+			timeSlot:     17,
+			// slots 11 to 16 of dave, alice, bob, carol, dave and alice are skipped, bob proposes in slot 17
+			expected: []counts{{0, 2, 0, 0}, {1, 1, 0, 0}, {0, 1, 0, 0}, {0, 2, 0, 0}, {}},
+		},
+		{
+			name:         "no previous propose time",
+			prevTimeSlot: 0,
+			timeSlot:     17,
+			expected:     []counts{{}, {1, 0, 0, 0}, {}, {}, {}},
+		},
+	} {
+		table := validatorStatsTable{}
+		proposer := keys[GetProposerByTimeSlot(tc.timeSlot, 4)]
+		if err := addCommitteeBlockStats(table, 0, committee, 4, proposer, tc.prevTimeSlot*10, tc.timeSlot*10, tc.validationData); err != nil {
+			t.Fatalf("%v: %v", tc.name, err)
+		}
+		for i, key := range keys {
+			have := counts{}
+			if stats, ok := table[key]; ok {
+				have = counts{stats.BlocksProposed, stats.SlotsMissed, stats.VotesCast, stats.VotesExpected}
+			}
+			if have != tc.expected[i] {
+				t.Errorf("%v: expect validator %v has %+v, have %+v", tc.name, i, tc.expected[i], have)
+			}
+		}
+	}
+
+	if err := addCommitteeBlockStats(validatorStatsTable{}, 0, committee, 4, keys[0], 100, 110, "{malformed"); err == nil {
+		t.Error("Expect malformed validation data is rejected")
+	}
+}
+
+func TestStoreValidatorStatsAtEpochBoundary(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	bc, _ := newRollbackTestChain(t)
+	oldCommittee, oldKeys := newTestValidatorCommittee(t, "alice", "bob")
+	_, newKeys := newTestValidatorCommittee(t, "carol", "dave")
+
+	// the first shard block of epoch 2 is proposed and signed by the committee of the last view of epoch 1,
+	// the slot skipped before it is counted for the old committee in epoch 2
+	prevShardBlock := NewShardBlock()
+	prevShardBlock.Header.Epoch = 1
+	prevShardBlock.Header.ProposeTime = 100
+	prevShardView := &ShardBestState{ShardCommittee: oldCommittee, MinShardCommitteeSize: 2, BestBlock: prevShardBlock}
+	shardBlock := NewShardBlock()
+	shardBlock.Header.Height = 10
+	shardBlock.Header.Epoch = 2
+	shardBlock.Header.ProposeTime = 120
+	shardBlock.Header.Proposer = oldKeys[0]
+	shardBlock.ValidationData = `{"ValidatiorsIdx":[0,1]}`
+	shardDB := bc.GetShardChainDatabase(0)
+	if err := bc.storeShardValidatorStats(shardDB, prevShardView, shardBlock); err != nil {
+		t.Fatal(err)
+	}
+	if stats := getTestValidatorStats(t, shardDB, oldKeys[1]); len(stats) != 1 || stats[2] == nil || stats[2].SlotsMissed != 1 || stats[2].VotesCast != 1 {
+		t.Errorf("Expect skipped slot and vote of the old committee are counted in epoch 2, have %+v", stats)
+	}
+	if stats := getTestValidatorStats(t, shardDB, oldKeys[0]); stats[2] == nil || stats[2].BlocksProposed != 1 {
+		t.Errorf("Expect proposal of the old committee is counted in epoch 2, have %+v", stats)
+	}
+	for _, key := range newKeys {
+		if stats := getTestValidatorStats(t, shardDB, key); len(stats) != 0 {
+			t.Errorf("Expect new committee has no stats before it takes over, have %+v", stats)
+		}
+	}
+
+	// the first beacon block of epoch 2 pays the shard rewards of epoch 1 to the committee of the previous view
+	beaconCommittee, beaconKeys := newTestValidatorCommittee(t, "beacon 1", "beacon 2")
+	prevBeaconView := NewBeaconBestState()
+	prevBeaconView.Epoch = 1
+	prevBeaconView.BeaconCommittee = beaconCommittee
+	prevBeaconView.MinBeaconCommitteeSize = 2
+	prevBeaconView.ShardCommittee = map[byte][]incognitokey.CommitteePublicKey{0: oldCommittee}
+	prevBeaconView.BestBlock.Header.ProposeTime = 100
+	rewardInsts, err := metadata.BuildInstForShardReward(map[common.Hash]uint64{common.PRVCoinID: 100}, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconBlock := NewBeaconBlock()
+	beaconBlock.Header.Height = 20
+	beaconBlock.Header.Epoch = 2
+	beaconBlock.Header.ProposeTime = 120
+	beaconBlock.Header.Proposer = beaconKeys[0]
+	beaconBlock.Body.Instructions = rewardInsts
+	beaconDB := bc.GetBeaconChainDatabase()
+	batch := beaconDB.NewBatch()
+	if err := bc.storeBeaconValidatorStats(batch, prevBeaconView, beaconBlock); err != nil {
+		t.Fatal(err)
+	}
+	if stats := getTestValidatorStats(t, beaconDB, beaconKeys[1]); len(stats) != 0 {
+		t.Fatalf("Expect stats are written with the batch of the block only, have %+v", stats)
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if stats := getTestValidatorStats(t, beaconDB, beaconKeys[1]); len(stats) != 1 || stats[2] == nil || stats[2].SlotsMissed != 1 {
+		t.Errorf("Expect skipped slot of the beacon committee is counted in epoch 2, have %+v", stats)
+	}
+	for _, key := range oldKeys {
+		stats := getTestValidatorStats(t, beaconDB, key)
+		if len(stats) != 1 || stats[1] == nil || len(stats[1].Rewards) != 1 || stats[1].Rewards[0].Amount != 50 {
+			t.Errorf("Expect shard reward of epoch 1 is split in the old committee, have %+v", stats)
+		}
+	}
+}
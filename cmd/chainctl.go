@@ -1,17 +1,20 @@
 package main
 
 import (
+	"fmt"
 	"github.com/incognitochain/incognito-chain/consensus"
 	"github.com/incognitochain/incognito-chain/dataaccessobject"
 	"github.com/incognitochain/incognito-chain/peerv2"
 	"github.com/incognitochain/incognito-chain/trie"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/incognitochain/incognito-chain/blockchain"
 	"github.com/incognitochain/incognito-chain/common"
@@ -27,7 +30,8 @@ func makeBlockChain(databaseDir string, testNet bool) (*blockchain.BlockChain, e
 	mempool.Logger.Init(common.NewBackend(nil).Logger("ChainCMD", true))
 	dataaccessobject.Logger.Init(common.NewBackend(nil).Logger("ChainCMD", true))
 	trie.Logger.Init(common.NewBackend(nil).Logger("ChainCMD", true))
-	db, err := incdb.Open("leveldb", filepath.Join(databaseDir))
+	// the node keeps one database per chain under databaseDir (see incognito.go), blockchain and mempool take them by chain ID
+	db, err := incdb.OpenMultipleDB("leveldb", filepath.Join(databaseDir))
 	if err != nil {
 		return nil, err
 	}
@@ -270,3 +274,90 @@ func restoreBeaconChain(bc *blockchain.BlockChain, filename string) error {
 	log.Println("Restore Beacon Chain Successfully")
 	return nil
 }
+
+// rollbackChain rolls shard chains then beacon chain back to finalized heights, shards go first
+// as beacon chain can not be rolled back below beacon height of a shard best state.
+// Results are written to a report file in outDatadir
+func rollbackChain(bc *blockchain.BlockChain, beaconHeight uint64, shardHeights map[byte]uint64, outDatadir string) error {
+	for shardID := range shardHeights {
+		if int(shardID) >= bc.GetActiveShardNumber() {
+			return fmt.Errorf("Shard %+v is not an active shard, there are %+v active shards", shardID, bc.GetActiveShardNumber())
+		}
+	}
+	results := []*blockchain.RollbackResult{}
+	writeReport := func() error {
+		if outDatadir == "" {
+			outDatadir = "./"
+		}
+		file := filepath.Join(outDatadir, "rollback-"+strconv.FormatInt(time.Now().Unix(), 10)+".json")
+		report, err := parseToJsonString(results)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, report, 0644); err != nil {
+			return err
+		}
+		log.Printf("Rollback report, file %+v", file)
+		return nil
+	}
+	for shardID, height := range shardHeights {
+		result, err := bc.RollbackShardChain(shardID, height)
+		if err != nil {
+			writeReport()
+			return err
+		}
+		log.Printf("Rollback Shard %+v Chain from height %+v to %+v, block %+v", shardID, result.FromHeight, result.ToHeight, result.BlockHash.String())
+		results = append(results, result)
+	}
+	if beaconHeight > 0 {
+		result, err := bc.RollbackBeaconChain(beaconHeight)
+		if err != nil {
+			writeReport()
+			return err
+		}
+		log.Printf("Rollback Beacon Chain from height %+v to %+v, block %+v", result.FromHeight, result.ToHeight, result.BlockHash.String())
+		results = append(results, result)
+	}
+	return writeReport()
+}
+
+// reindexChain rebuilds secondary indexes of shard chains then beacon chain from stored blocks,
+// Ctrl-C stops at the next block and progress is kept in checkpoints to resume later
+func reindexChain(bc *blockchain.BlockChain, beaconIndexes []string, shardIDs []byte, shardIndexes []string, restart bool) error {
+	interrupt := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+	defer close(interrupt)
+	go func() {
+		if _, ok := <-interrupt; ok {
+			log.Println("Interrupted during reindex, stopping at next block")
+		}
+		close(stop)
+	}()
+	if len(shardIndexes) > 0 {
+		for _, shardID := range shardIDs {
+			result, err := bc.ReindexShardChain(shardID, shardIndexes, restart, stop)
+			if err != nil {
+				return err
+			}
+			if result.Interrupted {
+				log.Printf("Reindex Shard %+v %+v interrupted at height %+v, run again to resume", shardID, shardIndexes, result.ToHeight)
+				return nil
+			}
+			log.Printf("Reindex Shard %+v %+v from height %+v to %+v successfully", shardID, shardIndexes, result.FromHeight, result.ToHeight)
+		}
+	}
+	if len(beaconIndexes) > 0 {
+		result, err := bc.ReindexBeaconChain(beaconIndexes, restart, stop)
+		if err != nil {
+			return err
+		}
+		if result.Interrupted {
+			log.Printf("Reindex Beacon %+v interrupted at height %+v, run again to resume", beaconIndexes, result.ToHeight)
+			return nil
+		}
+		log.Printf("Reindex Beacon %+v from height %+v to %+v successfully", beaconIndexes, result.FromHeight, result.ToHeight)
+	}
+	return nil
+}
@@ -39,6 +39,12 @@ type params struct {
 	ChainDataDir string `long:"chaindatadir" description:"Directory of Stored Blockchain Database"`
 	OutDataDir   string `long:"outdatadir" description:"Directory of Export Blockchain Data"`
 	FileName     string `long:"filename" description:"Filename of Backup Blockchin Data"`
+	// rollback
+	BeaconHeight uint64 `long:"beaconheight" description:"Finalized beacon height to roll back to"`
+	ShardHeights string `long:"shardheights" description:"Finalized shard heights to roll back to, in format shardID:height separated by ','"`
+	// reindex
+	Indexes        string `long:"indexes" description:"Indexes to rebuild separated by ',' (txhash, txpubkey, tokentx, txhistory, txreceipt, crossshard) or all"`
+	ReindexRestart bool   `long:"reindexrestart" description:"Rebuild indexes from the first block instead of the last checkpoint"`
 	// wallet
 	WalletName        string `long:"wallet" description:"Wallet Database Name file, default is 'wallet'"`
 	WalletPassphrase  string `long:"walletpassphrase" description:"Wallet passphrase"`
@@ -8,6 +8,8 @@ const (
 	getPrivacyTokenID      = "getprivacytokenid"
 	backupChain            = "backupchain"
 	restoreChain           = "restorechain"
+	rollbackChainCmd       = "rollbackchain"
+	reindexChainCmd        = "reindexchain"
 )
 
 var CmdList = []string{
@@ -18,4 +20,6 @@ var CmdList = []string{
 	getPrivacyTokenID,
 	backupChain,
 	restoreChain,
+	rollbackChainCmd,
+	reindexChainCmd,
 }
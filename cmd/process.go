@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/incognitochain/incognito-chain/privacy"
 	"log"
 	"strconv"
@@ -20,6 +21,35 @@ func parseToJsonString(data interface{}) ([]byte, error) {
 	return result, nil
 }
 
+// parseShardIDs parses "all" or shard IDs separated by ','
+func parseShardIDs(param string, testNet bool) ([]byte, error) {
+	shardIDs := []byte{}
+	if param == "" {
+		return shardIDs, nil
+	}
+	if param == "all" {
+		numberOfShards := blockchain.ChainMainParam.ActiveShards
+		if testNet {
+			numberOfShards = blockchain.ChainTestParam.ActiveShards
+		}
+		for i := 0; i < numberOfShards; i++ {
+			shardIDs = append(shardIDs, byte(i))
+		}
+		return shardIDs, nil
+	}
+	for _, value := range strings.Split(param, ",") {
+		shardID, err := strconv.Atoi(value)
+		if err != nil || shardID < 0 || shardID > 255 {
+			return nil, errors.New("ShardID Params MUST contain number only in range 0-255")
+		}
+		if common.IndexOfByte(byte(shardID), shardIDs) > -1 {
+			continue
+		}
+		shardIDs = append(shardIDs, byte(shardID))
+	}
+	return shardIDs, nil
+}
+
 func processCmd() {
 	switch cfg.Command {
 	case getPrivacyTokenID:
@@ -213,5 +243,80 @@ func processCmd() {
 				}
 			}
 		}
+	case rollbackChainCmd:
+		{
+			if cfg.BeaconHeight == 0 && cfg.ShardHeights == "" {
+				log.Println("No Expected Params")
+				return
+			}
+			shardHeights := make(map[byte]uint64)
+			if cfg.ShardHeights != "" {
+				for _, value := range strings.Split(cfg.ShardHeights, ",") {
+					strs := strings.Split(value, ":")
+					if len(strs) != 2 {
+						log.Println("ShardHeights Params MUST be in format shardID:height")
+						return
+					}
+					shardID, err := strconv.Atoi(strs[0])
+					if err != nil || shardID < 0 || shardID > 255 {
+						log.Println("ShardID Params MUST contain number only in range 0-255")
+						return
+					}
+					height, err := strconv.ParseUint(strs[1], 10, 64)
+					if err != nil {
+						log.Println("Height Params MUST contain number only")
+						return
+					}
+					shardHeights[byte(shardID)] = height
+				}
+			}
+			bc, err := makeBlockChain(cfg.ChainDataDir, cfg.TestNet)
+			if err != nil {
+				log.Println("Error create blockchain variable ", err)
+				return
+			}
+			err = rollbackChain(bc, cfg.BeaconHeight, shardHeights, cfg.OutDataDir)
+			if err != nil {
+				log.Printf("Rollback failed, err %+v", err)
+			}
+		}
+	case reindexChainCmd:
+		{
+			if cfg.Indexes == "" || (cfg.Beacon == false && cfg.ShardIDs == "") {
+				log.Println("No Expected Params")
+				return
+			}
+			shardIndexes, beaconIndexes := blockchain.ShardReindexList, blockchain.BeaconReindexList
+			if cfg.Indexes != "all" {
+				shardIndexes, beaconIndexes = []string{}, []string{}
+				for _, index := range strings.Split(cfg.Indexes, ",") {
+					if common.IndexOfStr(index, blockchain.ShardReindexList) > -1 {
+						shardIndexes = append(shardIndexes, index)
+					} else if common.IndexOfStr(index, blockchain.BeaconReindexList) > -1 {
+						beaconIndexes = append(beaconIndexes, index)
+					} else {
+						log.Printf("Index %+v is not supported, supported indexes %+v %+v", index, blockchain.ShardReindexList, blockchain.BeaconReindexList)
+						return
+					}
+				}
+			}
+			shardIDs, err := parseShardIDs(cfg.ShardIDs, cfg.TestNet)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			bc, err := makeBlockChain(cfg.ChainDataDir, cfg.TestNet)
+			if err != nil {
+				log.Println("Error create blockchain variable ", err)
+				return
+			}
+			if !cfg.Beacon {
+				beaconIndexes = []string{}
+			}
+			err = reindexChain(bc, beaconIndexes, shardIDs, shardIndexes, cfg.ReindexRestart)
+			if err != nil {
+				log.Printf("Reindex failed, err %+v", err)
+			}
+		}
 	}
 }
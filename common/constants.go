@@ -230,6 +230,45 @@ const (
 	PDECrossPoolTradeAcceptedChainStatus           = "xPoolTradeAccepted"
 )
 
+// Staking amount status for chain
+const (
+	StakingAmountStakedChainStatus = "staked"
+)
+
+// Staking pool status for chain
+const (
+	StakingPoolRequestAcceptedChainStatus = "accepted"
+	StakingPoolRequestRejectedChainStatus = "rejected"
+)
+
+// Unstake status for chain
+const (
+	UnstakeRequestAcceptedChainStatus = "accepted"
+	UnstakeRequestRejectedChainStatus = "rejected"
+	UnstakeReleasedChainStatus        = "released"
+)
+
+// Auto withdraw reward status for chain
+const (
+	AutoWithdrawRewardPolicyAcceptedChainStatus = "accepted"
+	AutoWithdrawRewardDueChainStatus            = "due"
+)
+
+// Governance status for chain
+const (
+	GovernanceRequestAcceptedChainStatus   = "accepted"
+	GovernanceRequestRejectedChainStatus   = "rejected"
+	GovernanceProposalApprovedChainStatus  = "approved"
+	GovernanceProposalDeclinedChainStatus  = "declined"
+	GovernanceProposalActivatedChainStatus = "activated"
+)
+
+// Governance proposal status, a proposal is voted until the end of the voting period
+// then its status is the status of the chain (approved, declined, activated)
+const (
+	GovernanceProposalVotingStatus = "voting"
+)
+
 // Portal status for chain
 const (
 	PortalCustodianDepositAcceptedChainStatus = "accepted"
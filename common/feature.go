@@ -0,0 +1,13 @@
+package common
+
+// Feature is the name of a protocol change switched on from an activation beacon height,
+// activation heights of each network are in the chain params
+type Feature string
+
+const (
+	ConsensusV2Feature        Feature = "ConsensusV2"        // bls bft v2 consensus
+	BurnAddressV2Feature      Feature = "BurnAddressV2"      // new burning address
+	ETHRemoveBridgeSigFeature Feature = "ETHRemoveBridgeSig" // burning requests v2 without bridge swap confirm signatures
+	NewZKPFeature             Feature = "NewZKP"             // new zero knowledge proofs with fixed randomness for shard id commitments
+	PortalV3Feature           Feature = "PortalV3"           // portal v3 requests
+)
@@ -21,10 +21,9 @@ func (hashObj Hash) MarshalText() ([]byte, error) {
 	return []byte(hashObj.String()), nil
 }
 
-// UnmarshalText reverts bytes array to hashObj
-func (hashObj Hash) UnmarshalText(text []byte) error {
-	copy(hashObj[:], text)
-	return nil
+// UnmarshalText decodes the string of MarshalText to hashObj, it is used for hash keys of json maps
+func (hashObj *Hash) UnmarshalText(text []byte) error {
+	return hashObj.Decode(hashObj, string(text))
 }
 
 // UnmarshalJSON unmarshal json data to hashObj
@@ -38,6 +38,8 @@ const (
 	DefaultRPCLimitRequestPerDay       = 0 // 0: unlimited
 	DefaultRPCLimitErrorRequestPerHour = 0 // 0: unlimited
 	DefaultMaxRPCWsClients             = 200
+	DefaultRPCBatchWorkers             = 8
+	DefaultRPCMaxBatchSize             = 1000
 	DefaultMetricUrl                   = ""
 	SampleConfigFilename               = "sample-config.conf"
 	DefaultDisableRpcTLS               = true
@@ -101,14 +103,18 @@ type config struct {
 	RPCPass                     string   `short:"P" long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	RPCLimitUser                string   `long:"rpclimituser" description:"Username for limited RPC connections"`
 	RPCLimitPass                string   `long:"rpclimitpass" default-mask:"-" description:"Password for limited RPC connections"`
+	RPCACLFile                  string   `long:"rpcaclfile" description:"File of RPC API keys with their allowed and denied methods, it is reloaded when modified"`
 	RPCListeners                []string `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 9334, testnet: 9334)"`
 	RPCWSListeners              []string `long:"rpcwslisten" description:"Add an interface/port to listen for RPC Websocket connections (default port: 19334, testnet: 19334)"`
+	RPCGrpcListeners            []string `long:"rpcgrpclisten" description:"Add an interface/port to listen for RPC gRPC connections, gRPC is disabled if it is not set"`
 	RPCCert                     string   `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                      string   `long:"rpckey" description:"File containing the certificate key"`
 	RPCLimitRequestPerDay       int      `long:"rpclimitrequestperday" description:"Max request per day by remote address"`
 	RPCLimitRequestErrorPerHour int      `long:"rpclimitrequesterrorperhour" description:"Max request error per hour by remote address"`
 	RPCMaxClients               int      `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWSClients             int      `long:"rpcmaxwsclients" description:"Max number of RPC clients for standard connections"`
+	RPCBatchWorkers             int      `long:"rpcbatchworkers" description:"Number of requests of JSON-RPC batches processed concurrently, by all clients"`
+	RPCMaxBatchSize             int      `long:"rpcmaxbatchsize" description:"Max number of requests of a JSON-RPC batch, 0 is unlimited"`
 	RPCQuirks                   bool     `long:"rpcquirks" description:"Mirror some JSON-RPC quirks of coin Core -- NOTE: Discouraged unless interoperability issues need to be worked around"`
 	DisableRPC                  bool     `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass or rpclimituser/rpclimitpass is specified"`
 	DisableTLS                  bool     `long:"notls" description:"Disable TLS for the RPC server -- NOTE: This is only allowed if the RPC server is bound to localhost"`
@@ -132,10 +138,16 @@ type config struct {
 	WalletShardID    int    `long:"walletshardid" description:"ShardID which wallet use to create account"`
 
 	FastStartup bool `long:"faststartup" description:"Load existed shard/chain dependencies instead of rebuild from block data"`
+	Archive     bool `long:"archive" description:"Archive node, keep state of blocks synced in batch to answer read RPCs at any height (blocks inserted one by one always keep their state)"`
 
-	TxPoolTTL   uint   `long:"txpoolttl" description:"Set Time To Live (TTL) Value for transaction that enter pool"`
-	TxPoolMaxTx uint64 `long:"txpoolmaxtx" description:"Set Maximum number of transaction in pool"`
-	LimitFee    uint64 `long:"limitfee" description:"Limited fee for tx(per Kb data), default is 0.00 PRV"`
+	// For note scanner
+	NoteScannerPassphrase string `long:"notescannerpassphrase" description:"Passphrase to encrypt keys registered to note scanner, note scanner is enabled only when it is set"`
+	NoteScannerMaxKeys    int    `long:"notescannermaxkeys" description:"Maximum number of keys registered to note scanner, default is 1000"`
+
+	TxPoolTTL          uint   `long:"txpoolttl" description:"Set Time To Live (TTL) Value for transaction that enter pool"`
+	TxPoolMaxTx        uint64 `long:"txpoolmaxtx" description:"Set Maximum number of transaction in pool"`
+	LimitFee           uint64 `long:"limitfee" description:"Limited fee for tx(per Kb data), default is 0.00 PRV"`
+	BatchVerifyWorkers int    `long:"batchverifyworkers" description:"Number of goroutines verifying transaction proofs of a block in parallel, default is number of CPUs"`
 
 	LoadMempool       bool   `long:"loadmempool" description:"Load transactions from Mempool database"`
 	PersistMempool    bool   `long:"persistmempool" description:"Persistence transaction in memepool database"`
@@ -151,7 +163,8 @@ type config struct {
 	Accelerator       bool   `long:"accelerator" description:"Relay Node Configuration For Consensus"`
 
 	// Highway
-	Libp2pPrivateKey string `long:"libp2pprivatekey" description:"Private key used to create node's PeerID, empty to generate random key each run"`
+	Libp2pPrivateKey string   `long:"libp2pprivatekey" description:"Private key used to create node's PeerID, empty to generate random key each run"`
+	DirectPeers      []string `long:"directpeer" description:"Libp2p address (/ip4/<ip>/tcp/<port>/p2p/<peerID>) of a node to sync blocks from directly when highway is unavailable"`
 
 	//backup
 	PreloadAddress string `long:"preloadaddress" description:"Endpoint of fullnode to download backup database"`
@@ -317,6 +330,8 @@ func loadConfig() (*config, []string, error) {
 		MaxPeersBeacon:              DefaultMaxPeersBeacon,
 		RPCMaxClients:               DefaultMaxRPCClients,
 		RPCMaxWSClients:             DefaultMaxRPCWsClients,
+		RPCBatchWorkers:             DefaultRPCBatchWorkers,
+		RPCMaxBatchSize:             DefaultRPCMaxBatchSize,
 		RPCLimitRequestPerDay:       DefaultRPCLimitRequestPerDay,
 		RPCLimitRequestErrorPerHour: DefaultRPCLimitErrorRequestPerHour,
 		DataDir:                     defaultDataDir,
@@ -523,10 +538,10 @@ func loadConfig() (*config, []string, error) {
 			return nil, nil, err
 		}
 
-		// The RPC server is disabled if no username or password is provided.
+		// The RPC server is disabled if no username or password or API key file is provided.
 		if (cfg.RPCUser == "" || cfg.RPCPass == "") &&
-			(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") {
-			Logger.log.Info("The RPC server is disabled if no username or password is provided.")
+			(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") && cfg.RPCACLFile == "" {
+			Logger.log.Info("The RPC server is disabled if no username or password or API key file is provided.")
 			cfg.DisableRPC = true
 		}
 	}
@@ -638,6 +653,24 @@ func loadConfig() (*config, []string, error) {
 				return nil, nil, err
 			}
 		}
+		for _, addr := range cfg.RPCGrpcListeners {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				str := "%s: gRPC listen interface '%s' is " +
+					"invalid: %v"
+				err := fmt.Errorf(str, funcName, addr, err)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, nil, err
+			}
+			if _, ok := allowedTLSListeners[host]; !ok {
+				str := "%s: the --notls option may not be used when binding gRPC to non localhost addresses: %s"
+				err := fmt.Errorf(str, funcName, addr)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, nil, err
+			}
+		}
 	}
 
 	if cfg.DiscoverPeers {
@@ -183,7 +183,7 @@ func (engine *Engine) updateVersion(chainID int) {
 		chainEpoch = engine.config.Blockchain.ShardChain[chainID].GetEpoch()
 	}
 
-	if chainEpoch >= engine.config.Blockchain.GetConfig().ChainParams.ConsensusV2Epoch {
+	if engine.config.Blockchain.IsFeatureActiveAtEpoch(common.ConsensusV2Feature, chainEpoch) {
 		engine.version = 2
 	}
 }
@@ -32,15 +32,35 @@ type BLSBFT_V2 struct {
 	destroyCh    chan struct{}
 	Logger       common.Logger
 
+	timeSlot         uint64 // timeslot duration in seconds
+	isSerial         bool   // validate and commit block in the actor loop, without pipelining
 	currentTime      int64
 	currentTimeSlot  int64
 	proposeHistory   *lru.Cache
 	ProposeMessageCh chan BFTPropose
 	VoteMessageCh    chan BFTVote
+	NewViewMessageCh chan BFTNewView
+	validateResultCh chan validateResult
+	commitResultCh   chan commitResult
+	nextProposalCh   chan nextProposal
 
 	receiveBlockByHeight map[uint64][]*ProposeBlockInfo   //blockHeight -> blockInfo
 	receiveBlockByHash   map[string]*ProposeBlockInfo     //blockHash -> blockInfo
 	voteHistory          map[uint64]common.BlockInterface // bestview height (previsous height )-> block
+	committingBlocks     map[string]chan struct{}         //blockHash -> closed when insert finish
+
+	proposeRecords map[equivocationKey]*signedMessageRecord // proposer, height, timeslot -> signed block
+	voteRecords    map[equivocationKey]*signedMessageRecord // validator, height, timeslot -> signed vote
+	equivocators   map[equivocationKey]struct{}
+	evidencePool   *lru.Cache // evidence hash -> metadata.EquivocationEvidence
+
+	roundStartTime    time.Time
+	failedRounds      int                                   // number of consecutive failed rounds
+	skipTimeSlot      int64                                 // highest timeslot skipped by 2/3+ new views
+	nextProposal      *nextProposal                         // block of the round after the failing one
+	receiveNewView    map[newViewKey]map[string]*BFTNewView // (height, timeslot) -> validator -> new view
+	sentNewView       map[newViewKey]struct{}
+	viewChangeMetrics *viewChangeMetrics
 }
 
 func (e BLSBFT_V2) GetChainKey() string {
@@ -60,12 +80,30 @@ func (e BLSBFT_V2) IsStarted() bool {
 }
 
 type ProposeBlockInfo struct {
-	receiveTime time.Time
-	block       common.BlockInterface
-	votes       map[string]*BFTVote //pk->BFTVote
-	isValid     bool
-	hasNewVote  bool
-	sendVote    bool
+	receiveTime  time.Time
+	block        common.BlockInterface
+	votes        map[string]*BFTVote //pk->BFTVote
+	isValid      bool
+	isValidating bool
+	hasNewVote   bool
+	sendVote     bool
+
+	commitFailures int
+}
+
+//SetSerial make the engine validate and commit blocks in the actor loop, without pipelining.
+//It is used by the simulation to compare both modes
+func (e *BLSBFT_V2) SetSerial(isSerial bool) {
+	e.isSerial = isSerial
+}
+
+//calculateTimeSlot return the timeslot of a unix time
+func (e *BLSBFT_V2) calculateTimeSlot(t int64) int64 {
+	return t / int64(e.timeSlot)
+}
+
+func (e *BLSBFT_V2) getTimeSlotDuration() time.Duration {
+	return time.Duration(e.timeSlot) * time.Second
 }
 
 func (e *BLSBFT_V2) GetConsensusName() string {
@@ -126,11 +164,12 @@ func (e *BLSBFT_V2) run() error {
 						hasNewVote:  false,
 						receiveTime: time.Now(),
 					}
-					e.Logger.Info(e.ChainKey, "Receive block ", block.Hash().String(), "height", block.GetHeight(), ",block timeslot ", common.CalculateTimeSlot(block.GetProposeTime()))
+					e.Logger.Info(e.ChainKey, "Receive block ", block.Hash().String(), "height", block.GetHeight(), ",block timeslot ", e.calculateTimeSlot(block.GetProposeTime()))
 					e.receiveBlockByHeight[block.GetHeight()] = append(e.receiveBlockByHeight[block.GetHeight()], e.receiveBlockByHash[blkHash])
 				} else {
 					e.receiveBlockByHash[blkHash].block = block
 				}
+				e.collectProposeEvidence(block)
 
 				if block.GetHeight() <= e.Chain.GetBestView().GetHeight() {
 					e.Logger.Infof("%v Receive block create from old view - height %v. Rejected! Expect: %v", e.ChainKey, block.GetHeight(), e.Chain.GetBestView().GetHeight())
@@ -172,6 +211,14 @@ func (e *BLSBFT_V2) run() error {
 						e.Logger.Infof("%v Receive vote (%d) for block from unknown validator", e.ChainKey, len(e.receiveBlockByHash[voteMsg.BlockHash].votes), voteMsg.BlockHash, voteMsg.Validator)
 					}
 				}
+			case newViewMsg := <-e.NewViewMessageCh:
+				e.processNewView(&newViewMsg)
+			case result := <-e.validateResultCh:
+				e.processValidateResult(result)
+			case result := <-e.commitResultCh:
+				e.processCommitResult(result)
+			case result := <-e.nextProposalCh:
+				e.processNextProposal(result)
 			case <-cleanMemTicker:
 				for h, _ := range e.receiveBlockByHeight {
 					if h <= e.Chain.GetFinalView().GetHeight() {
@@ -188,19 +235,26 @@ func (e *BLSBFT_V2) run() error {
 						delete(e.receiveBlockByHash, h)
 					}
 				}
+				e.cleanEquivocationRecords(e.Chain.GetFinalView().GetHeight())
+				e.cleanNewViews(e.Chain.GetFinalView().GetHeight())
 			case <-ticker:
 				if !e.Chain.IsReady() {
 					continue
 				}
 				e.currentTime = time.Now().Unix()
+				currentTimeSlot := e.getRoundTimeSlot(e.currentTime)
 
 				newTimeSlot := false
-				if e.currentTimeSlot != common.CalculateTimeSlot(e.currentTime) {
+				if e.currentTimeSlot != currentTimeSlot {
 					newTimeSlot = true
 				}
 
-				e.currentTimeSlot = common.CalculateTimeSlot(e.currentTime)
+				prevTimeSlot := e.currentTimeSlot
+				e.currentTimeSlot = currentTimeSlot
 				bestView := e.Chain.GetBestView()
+				if newTimeSlot {
+					e.onNewRound(prevTimeSlot, bestView)
+				}
 
 				/*
 					Check for whether we should propose block
@@ -213,9 +267,9 @@ func (e *BLSBFT_V2) run() error {
 					userPk := userKey.GetPublicKey().GetMiningKeyBase58(common.BlsConsensus)
 					if proposerPk.GetMiningKeyBase58(common.BlsConsensus) == userPk {
 						shouldListen = false
-						if common.CalculateTimeSlot(bestView.GetBlock().GetProposeTime()) != e.currentTimeSlot { // current timeslot is not add to view, and this user is proposer of this timeslot
+						if e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()) != e.currentTimeSlot { // current timeslot is not add to view, and this user is proposer of this timeslot
 							//using block hash as key of best view -> check if this best view we propose or not
-							if _, ok := e.proposeHistory.Get(fmt.Sprintf("%s%d", e.currentTimeSlot)); !ok {
+							if _, ok := e.proposeHistory.Get(fmt.Sprintf("%d", e.currentTimeSlot)); !ok {
 								shouldPropose = true
 								userProposeKey = userKey
 							}
@@ -223,21 +277,35 @@ func (e *BLSBFT_V2) run() error {
 					}
 				}
 
+				if shouldPropose && e.isCommittingAtHeight(bestView.GetHeight()+1) { //wait for the block at this height to be inserted, then propose on top of it
+					shouldPropose = false
+				}
+
+				if shouldPropose && e.isTimeSlotSkipped(e.currentTimeSlot) { //committee moved past this proposer
+					shouldPropose = false
+				}
+
 				if newTimeSlot { //for logging
 					e.Logger.Infof("%v", e.ChainKey)
 					e.Logger.Infof("%v ======================================================", e.ChainKey)
 					e.Logger.Infof("%v", e.ChainKey)
 					if shouldListen {
-						e.Logger.Infof("%v TS: %v, LISTEN BLOCK %v, Round %v", e.ChainKey, common.CalculateTimeSlot(e.currentTime), bestView.GetHeight()+1, e.currentTimeSlot-common.CalculateTimeSlot(bestView.GetBlock().GetProposeTime()))
+						e.Logger.Infof("%v TS: %v, LISTEN BLOCK %v, Round %v", e.ChainKey, e.calculateTimeSlot(e.currentTime), bestView.GetHeight()+1, e.currentTimeSlot-e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()))
 					}
 					if shouldPropose {
-						e.Logger.Infof("%v TS: %v, PROPOSE BLOCK %v, Round %v", e.ChainKey, common.CalculateTimeSlot(e.currentTime), bestView.GetHeight()+1, e.currentTimeSlot-common.CalculateTimeSlot(bestView.GetBlock().GetProposeTime()))
+						e.Logger.Infof("%v TS: %v, PROPOSE BLOCK %v, Round %v", e.ChainKey, e.calculateTimeSlot(e.currentTime), bestView.GetHeight()+1, e.currentTimeSlot-e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()))
 					}
 
 				}
 
+				if shouldListen {
+					e.checkRoundTimeout(bestView)
+					e.checkViewChange(bestView)
+				}
+				e.prepareNextProposal(bestView)
+
 				if shouldPropose {
-					e.proposeHistory.Add(fmt.Sprintf("%s%d", e.currentTimeSlot), 1)
+					e.proposeHistory.Add(fmt.Sprintf("%d", e.currentTimeSlot), 1)
 					//Proposer Rule: check propose block connected to bestview(longest chain rule 1) and re-propose valid block with smallest timestamp (including already propose in the past) (rule 2)
 					sort.Slice(e.receiveBlockByHeight[bestView.GetHeight()+1], func(i, j int) bool {
 						return e.receiveBlockByHeight[bestView.GetHeight()+1][i].block.GetProduceTime() < e.receiveBlockByHeight[bestView.GetHeight()+1][j].block.GetProduceTime()
@@ -258,7 +326,7 @@ func (e *BLSBFT_V2) run() error {
 						e.Logger.Critical(err)
 
 					} else {
-						e.Logger.Infof("%v proposer block %v round %v time slot %v blockTimeSlot %v with hash %v", e.ChainKey, createdBlk.GetHeight(), e.currentTimeSlot-common.CalculateTimeSlot(bestView.GetBlock().GetProposeTime()), e.currentTimeSlot, common.CalculateTimeSlot(createdBlk.GetProduceTime()), createdBlk.Hash().String())
+						e.Logger.Infof("%v proposer block %v round %v time slot %v blockTimeSlot %v with hash %v", e.ChainKey, createdBlk.GetHeight(), e.currentTimeSlot-e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()), e.currentTimeSlot, e.calculateTimeSlot(createdBlk.GetProduceTime()), createdBlk.Hash().String())
 					}
 				}
 
@@ -273,7 +341,7 @@ func (e *BLSBFT_V2) run() error {
 					}
 					// e.Logger.Infof("[Monitor] bestview height %v, finalview height %v, block height %v %v", bestViewHeight, e.Chain.GetFinalView().GetHeight(), proposeBlockInfo.block.GetHeight(), proposeBlockInfo.block.GetProduceTime())
 					// check if propose block in current time
-					if e.currentTimeSlot == common.CalculateTimeSlot(proposeBlockInfo.block.GetProposeTime()) {
+					if e.currentTimeSlot == e.calculateTimeSlot(proposeBlockInfo.block.GetProposeTime()) && !e.isTimeSlotSkipped(e.currentTimeSlot) {
 						validProposeBlock = append(validProposeBlock, proposeBlockInfo)
 					}
 
@@ -287,17 +355,19 @@ func (e *BLSBFT_V2) run() error {
 				})
 
 				for _, v := range validProposeBlock {
-					if v.sendVote {
+					if v.sendVote || v.isValidating {
 						continue
 					}
 
-					blkCreateTimeSlot := common.CalculateTimeSlot(v.block.GetProduceTime())
-					bestViewHeight := bestView.GetHeight()
+					blkCreateTimeSlot := e.calculateTimeSlot(v.block.GetProduceTime())
 
-					if lastVotedBlk, ok := e.voteHistory[bestViewHeight+1]; ok {
-						if blkCreateTimeSlot < common.CalculateTimeSlot(lastVotedBlk.GetProduceTime()) { //blkCreateTimeSlot is smaller than voted block => vote for this blk
+					if lastVotedBlk, ok := e.voteHistory[v.block.GetHeight()]; ok {
+						if e.calculateTimeSlot(v.block.GetProposeTime()) == e.calculateTimeSlot(lastVotedBlk.GetProposeTime()) { //already vote in this timeslot => never sign two blocks in same timeslot
+							continue
+						}
+						if blkCreateTimeSlot < e.calculateTimeSlot(lastVotedBlk.GetProduceTime()) { //blkCreateTimeSlot is smaller than voted block => vote for this blk
 							e.validateAndVote(v)
-						} else if blkCreateTimeSlot == common.CalculateTimeSlot(lastVotedBlk.GetProduceTime()) && common.CalculateTimeSlot(v.block.GetProposeTime()) > common.CalculateTimeSlot(lastVotedBlk.GetProposeTime()) { //blk is old block (same round), but new proposer(larger timeslot) => vote again
+						} else if blkCreateTimeSlot == e.calculateTimeSlot(lastVotedBlk.GetProduceTime()) && e.calculateTimeSlot(v.block.GetProposeTime()) > e.calculateTimeSlot(lastVotedBlk.GetProposeTime()) { //blk is old block (same round), but new proposer(larger timeslot) => vote again
 							e.validateAndVote(v)
 						} //blkCreateTimeSlot is larger or equal than voted block => do nothing
 					} else { //there is no vote for this height yet
@@ -309,6 +379,9 @@ func (e *BLSBFT_V2) run() error {
 					Check for 2/3 vote to commit
 				*/
 				for k, v := range e.receiveBlockByHash {
+					if v.hasNewVote {
+						e.collectVoteEvidence(v)
+					}
 					e.processIfBlockGetEnoughVote(k, v)
 				}
 
@@ -319,6 +392,13 @@ func (e *BLSBFT_V2) run() error {
 }
 
 func NewInstance(chain ChainInterface, chainKey string, chainID int, node NodeInterface, logger common.Logger) *BLSBFT_V2 {
+	newInstance := newInstance(chain, chainKey, chainID, node, logger)
+	newInstance.run()
+	return newInstance
+}
+
+//newInstance init the state of consensus without running the actor loop
+func newInstance(chain ChainInterface, chainKey string, chainID int, node NodeInterface, logger common.Logger) *BLSBFT_V2 {
 	var err error
 	var newInstance = new(BLSBFT_V2)
 	newInstance.Chain = chain
@@ -326,17 +406,32 @@ func NewInstance(chain ChainInterface, chainKey string, chainID int, node NodeIn
 	newInstance.ChainID = chainID
 	newInstance.Node = node
 	newInstance.Logger = logger
+	newInstance.timeSlot = common.TIMESLOT
 	newInstance.destroyCh = make(chan struct{})
 	newInstance.ProposeMessageCh = make(chan BFTPropose)
 	newInstance.VoteMessageCh = make(chan BFTVote)
+	newInstance.NewViewMessageCh = make(chan BFTNewView)
 	newInstance.receiveBlockByHash = make(map[string]*ProposeBlockInfo)
 	newInstance.receiveBlockByHeight = make(map[uint64][]*ProposeBlockInfo)
 	newInstance.voteHistory = make(map[uint64]common.BlockInterface)
+	newInstance.committingBlocks = make(map[string]chan struct{})
+	newInstance.validateResultCh = make(chan validateResult, 100)
+	newInstance.commitResultCh = make(chan commitResult, 100)
+	newInstance.nextProposalCh = make(chan nextProposal, 10)
 	newInstance.proposeHistory, err = lru.New(1000)
 	if err != nil {
 		panic(err) //must not error
 	}
-	newInstance.run()
+	newInstance.proposeRecords = make(map[equivocationKey]*signedMessageRecord)
+	newInstance.voteRecords = make(map[equivocationKey]*signedMessageRecord)
+	newInstance.equivocators = make(map[equivocationKey]struct{})
+	newInstance.evidencePool, err = lru.New(maxEquivocationEvidences)
+	if err != nil {
+		panic(err) //must not error
+	}
+	newInstance.receiveNewView = make(map[newViewKey]map[string]*BFTNewView)
+	newInstance.sentNewView = make(map[newViewKey]struct{})
+	newInstance.viewChangeMetrics = newViewChangeMetrics(chainKey)
 	return newInstance
 }
 
@@ -355,6 +450,16 @@ func (e *BLSBFT_V2) processIfBlockGetEnoughVote(blockHash string, v *ProposeBloc
 		return
 	}
 
+	//being inserted
+	if e.isCommitting(blockHash) {
+		return
+	}
+
+	//dropped after failed commits
+	if v.commitFailures >= maxCommitRetries {
+		return
+	}
+
 	//no block
 	if v.block == nil {
 		return
@@ -394,7 +499,6 @@ func (e *BLSBFT_V2) processIfBlockGetEnoughVote(blockHash string, v *ProposeBloc
 			if err != nil {
 				e.Logger.Error(dsaKey)
 				e.Logger.Error(err)
-				panic(1)
 				v.votes[id].IsValid = -1
 				errVote++
 			} else {
@@ -445,28 +549,24 @@ func (e *BLSBFT_V2) processIfBlockGetEnoughVote(blockHash string, v *ProposeBloc
 			return
 		}
 
-		go e.Chain.InsertAndBroadcastBlock(v.block)
+		e.commitAsync(blockHash, v)
 	}
 }
 
 func (e *BLSBFT_V2) validateAndVote(v *ProposeBlockInfo) error {
-	//not connected
 	e.Logger.Info(e.ChainKey, "validateAndVote")
+	return e.validateAsync(v)
+}
+
+//vote send vote for a validated block
+func (e *BLSBFT_V2) vote(v *ProposeBlockInfo) error {
+	//not connected
 	view := e.Chain.GetViewByHash(v.block.GetPrevHash())
 	if view == nil {
 		e.Logger.Error(e.ChainKey, "view is null")
 		return errors.New("View not connect")
 	}
 
-	//TODO: using context to validate block
-	_, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
-
-	if err := e.Chain.ValidatePreSignBlock(v.block); err != nil {
-		e.Logger.Error(err)
-		return err
-	}
-
 	//if valid then vote
 	committeeBLSString, _ := incognitokey.ExtractPublickeysFromCommitteeKeyList(view.GetCommittee(), common.BlsConsensus)
 	for _, userKey := range e.UserKeySet {
@@ -538,16 +638,18 @@ func (e *BLSBFT_V2) proposeBlock(userMiningKey signatureschemes2.MiningKey, prop
 	time1 := time.Now()
 	b58Str, _ := proposerPk.ToBase58()
 	var err error
-	if block == nil {
+	if prepared := e.takeNextProposal(e.Chain.GetBestView()); block == nil && prepared != nil {
+		block = prepared
+	} else if block == nil {
 		ctx := context.Background()
-		ctx, cancel := context.WithTimeout(ctx, (time.Duration(common.TIMESLOT)*time.Second)/2)
+		ctx, cancel := context.WithTimeout(ctx, e.getTimeSlotDuration()/2)
 		defer cancel()
 		//block, _ = e.Chain.CreateNewBlock(ctx, e.currentTimeSlot, e.UserKeySet.GetPublicKeyBase58())
 		//e.Logger.Info("debug CreateNewBlock")
-		block, err = e.Chain.CreateNewBlock(2, b58Str, 1, e.currentTime)
+		block, err = e.Chain.CreateNewBlock(2, b58Str, 1, e.getProposeTime())
 	} else {
 		//e.Logger.Info("debug CreateNewBlockFromOldBlock")
-		block, err = e.Chain.CreateNewBlockFromOldBlock(block, b58Str, e.currentTime)
+		block, err = e.Chain.CreateNewBlockFromOldBlock(block, b58Str, e.getProposeTime())
 		//b58Str, _ := proposerPk.ToBase58()
 		//block = e.voteHistory[e.Chain.GetBestViewHeight()+1]
 	}
@@ -596,6 +698,14 @@ func (e *BLSBFT_V2) ProcessBFTMsg(msgBFT *wire.MessageBFT) {
 			return
 		}
 		e.VoteMessageCh <- msgVote
+	case MSG_NEWVIEW:
+		var msgNewView BFTNewView
+		err := json.Unmarshal(msgBFT.Content, &msgNewView)
+		if err != nil {
+			e.Logger.Error(err)
+			return
+		}
+		e.NewViewMessageCh <- msgNewView
 	default:
 		e.Logger.Critical("Unknown BFT message type")
 		return
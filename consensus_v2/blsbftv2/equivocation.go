@@ -0,0 +1,184 @@
+package blsbftv2
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+const maxEquivocationEvidences = 100
+
+//equivocationKey identify a slot in which one validator must sign at most one message of a kind
+type equivocationKey struct {
+	validator string
+	height    uint64
+	timeSlot  int64
+}
+
+type signedMessageRecord struct {
+	blockHash string
+	signed    metadata.SignedBlockHeader
+}
+
+func extractBlockHeader(block common.BlockInterface) (json.RawMessage, error) {
+	blockData, err := json.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	temp := struct {
+		Header json.RawMessage
+	}{}
+	if err := json.Unmarshal(blockData, &temp); err != nil {
+		return nil, err
+	}
+	if len(temp.Header) == 0 {
+		return nil, errors.New("block has no header")
+	}
+	return temp.Header, nil
+}
+
+//collectProposeEvidence record the producer signature of a received block,
+//and create evidence if the same proposer signed another block at the same height and timeslot
+func (e *BLSBFT_V2) collectProposeEvidence(block common.BlockInterface) {
+	if err := ValidateProducerSig(block); err != nil {
+		return
+	}
+	valData, err := DecodeValidationData(block.GetValidationField())
+	if err != nil {
+		return
+	}
+	header, err := extractBlockHeader(block)
+	if err != nil {
+		e.Logger.Error(err)
+		return
+	}
+	key := equivocationKey{
+		validator: block.GetProposer(),
+		height:    block.GetHeight(),
+		timeSlot:  e.calculateTimeSlot(block.GetProposeTime()),
+	}
+	record := &signedMessageRecord{
+		blockHash: block.Hash().String(),
+		signed: metadata.SignedBlockHeader{
+			Header:    header,
+			Signature: valData.ProducerBLSSig,
+		},
+	}
+	e.checkAndRecord(e.proposeRecords, key, record, metadata.EquivocationPropose, block.GetProposer())
+}
+
+//collectVoteEvidence record the valid votes of a received block,
+//and create evidence if a validator voted for another block at the same height and timeslot
+func (e *BLSBFT_V2) collectVoteEvidence(v *ProposeBlockInfo) {
+	if v.block == nil || len(v.votes) == 0 {
+		return
+	}
+	view := e.Chain.GetViewByHash(v.block.GetPrevHash())
+	if view == nil {
+		return
+	}
+	var header json.RawMessage
+	for _, vote := range v.votes {
+		key := equivocationKey{
+			validator: vote.Validator,
+			height:    v.block.GetHeight(),
+			timeSlot:  e.calculateTimeSlot(v.block.GetProposeTime()),
+		}
+		if record, ok := e.voteRecords[key]; ok && record.blockHash == vote.BlockHash {
+			continue
+		}
+		if _, ok := e.equivocators[key]; ok {
+			continue
+		}
+		_, committeePk := GetValidatorIndex(view, vote.Validator)
+		if committeePk == nil {
+			continue
+		}
+		if err := vote.validateVoteOwner(committeePk.MiningPubKey[common.BridgeConsensus]); err != nil {
+			continue
+		}
+		if header == nil {
+			var err error
+			header, err = extractBlockHeader(v.block)
+			if err != nil {
+				e.Logger.Error(err)
+				return
+			}
+		}
+		committeePkStr, err := committeePk.ToBase58()
+		if err != nil {
+			continue
+		}
+		record := &signedMessageRecord{
+			blockHash: vote.BlockHash,
+			signed: metadata.SignedBlockHeader{
+				Header:    header,
+				BLS:       vote.BLS,
+				BRI:       vote.BRI,
+				Signature: vote.Confirmation,
+			},
+		}
+		e.checkAndRecord(e.voteRecords, key, record, metadata.EquivocationVote, committeePkStr)
+	}
+}
+
+func (e *BLSBFT_V2) checkAndRecord(records map[equivocationKey]*signedMessageRecord, key equivocationKey, record *signedMessageRecord, equivocationType string, offender string) {
+	oldRecord, ok := records[key]
+	if !ok {
+		records[key] = record
+		return
+	}
+	if oldRecord.blockHash == record.blockHash {
+		return
+	}
+	if _, ok := e.equivocators[key]; ok {
+		return
+	}
+	e.equivocators[key] = struct{}{}
+	evidence := metadata.EquivocationEvidence{
+		ChainID:   e.ChainID,
+		Type:      equivocationType,
+		Validator: offender,
+		First:     oldRecord.signed,
+		Second:    record.signed,
+	}
+	e.evidencePool.Add(evidence.Hash().String(), evidence)
+	e.Logger.Warnf("%v Detect %v equivocation of %v at height %v timeslot %v: %v and %v", e.ChainKey, equivocationType, offender, key.height, key.timeSlot, oldRecord.blockHash, record.blockHash)
+}
+
+//cleanEquivocationRecords remove records of finalized heights
+func (e *BLSBFT_V2) cleanEquivocationRecords(finalHeight uint64) {
+	for k := range e.proposeRecords {
+		if k.height <= finalHeight {
+			delete(e.proposeRecords, k)
+		}
+	}
+	for k := range e.voteRecords {
+		if k.height <= finalHeight {
+			delete(e.voteRecords, k)
+		}
+	}
+	for k := range e.equivocators {
+		if k.height <= finalHeight {
+			delete(e.equivocators, k)
+		}
+	}
+}
+
+//GetEquivocationEvidences return all collected evidences which are not yet submitted
+func (e *BLSBFT_V2) GetEquivocationEvidences() []metadata.EquivocationEvidence {
+	res := []metadata.EquivocationEvidence{}
+	for _, k := range e.evidencePool.Keys() {
+		if evidence, ok := e.evidencePool.Peek(k); ok {
+			res = append(res, evidence.(metadata.EquivocationEvidence))
+		}
+	}
+	return res
+}
+
+//RemoveEquivocationEvidence remove evidence from pool, after it is submitted to the chain
+func (e *BLSBFT_V2) RemoveEquivocationEvidence(evidenceHash string) {
+	e.evidencePool.Remove(evidenceHash)
+}
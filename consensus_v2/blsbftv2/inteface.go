@@ -51,3 +51,9 @@ type ChainInterface interface {
 
 	GetViewByHash(hash common.Hash) multiview.View
 }
+
+//BlockPreValidator is implemented by chains which can check the parts of block that do not depend on the view of previous block,
+//so that the check run while the previous block is still being inserted
+type BlockPreValidator interface {
+	PreValidateBlock(block common.BlockInterface) error
+}
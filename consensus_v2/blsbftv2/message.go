@@ -11,6 +11,7 @@ const (
 	MSG_PROPOSE    = "propose"
 	MSG_VOTE       = "vote"
 	MSG_REQUESTBLK = "getblk"
+	MSG_NEWVIEW    = "newview"
 )
 
 type BFTPropose struct {
@@ -31,6 +32,15 @@ type BFTVote struct {
 	TimeSlot      uint64
 }
 
+//BFTNewView is sent by a validator which timeout waiting for the proposer of TimeSlot
+type BFTNewView struct {
+	PrevBlockHash string
+	Height        uint64
+	TimeSlot      int64
+	Validator     string
+	Confirmation  []byte
+}
+
 type BFTRequestBlock struct {
 	BlockHash string
 	PeerID    string
@@ -65,6 +75,20 @@ func MakeBFTVoteMsg(vote *BFTVote, chainKey string, ts int64, height uint64) (wi
 	return msg, nil
 }
 
+func MakeBFTNewViewMsg(newView *BFTNewView, chainKey string, ts int64, height uint64) (wire.Message, error) {
+	newViewCtnBytes, err := json.Marshal(newView)
+	if err != nil {
+		return nil, NewConsensusError(UnExpectedError, err)
+	}
+	msg, _ := wire.MakeEmptyMessage(wire.CmdBFT)
+	msg.(*wire.MessageBFT).ChainKey = chainKey
+	msg.(*wire.MessageBFT).Content = newViewCtnBytes
+	msg.(*wire.MessageBFT).Type = MSG_NEWVIEW
+	msg.(*wire.MessageBFT).TimeSlot = ts
+	msg.(*wire.MessageBFT).Timestamp = time.Now().UnixNano() / int64(time.Millisecond)
+	return msg, nil
+}
+
 func MakeBFTRequestBlk(request BFTRequestBlock, peerID string, chainKey string) (wire.Message, error) {
 	requestCtnBytes, err := json.Marshal(request)
 	if err != nil {
@@ -0,0 +1,194 @@
+package blsbftv2
+
+import (
+	"errors"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+const (
+	maxCommitRetries = 3
+)
+
+type validateResult struct {
+	blockHash string
+	err       error
+}
+
+type commitResult struct {
+	blockHash string
+	height    uint64
+	err       error
+}
+
+//getPipelineTimeout bound how long a validation wait for the commit of its previous block
+func (e *BLSBFT_V2) getPipelineTimeout() time.Duration {
+	return e.getTimeSlotDuration()
+}
+
+//isCommitting return true if the block with this hash got enough vote and is being inserted to chain
+func (e *BLSBFT_V2) isCommitting(blockHash string) bool {
+	_, ok := e.committingBlocks[blockHash]
+	return ok
+}
+
+//isCommittingAtHeight return true if a block at this height is being inserted to chain
+func (e *BLSBFT_V2) isCommittingAtHeight(height uint64) bool {
+	for _, v := range e.receiveBlockByHeight[height] {
+		if v.block != nil && e.isCommitting(v.block.Hash().String()) {
+			return true
+		}
+	}
+	return false
+}
+
+//validateAsync validate block in background, so that the actor loop is not blocked.
+//The checks which do not need the view of previous block run right away, in parallel with the commit of previous block,
+//the checks against previous view start as soon as the commit finish.
+func (e *BLSBFT_V2) validateAsync(v *ProposeBlockInfo) error {
+	if v.isValidating {
+		return nil
+	}
+	var commitDone chan struct{}
+	if view := e.Chain.GetViewByHash(v.block.GetPrevHash()); view == nil {
+		ch, ok := e.committingBlocks[v.block.GetPrevHash().String()]
+		if !ok {
+			e.Logger.Error(e.ChainKey, "view is null")
+			return errors.New("View not connect")
+		}
+		commitDone = ch
+	}
+	v.isValidating = true
+	block := v.block
+	if e.isSerial {
+		e.processValidateResult(e.validateBlock(block, commitDone))
+		return nil
+	}
+	go func() {
+		result := e.validateBlock(block, commitDone)
+		select {
+		case e.validateResultCh <- result:
+		case <-e.destroyCh:
+		}
+	}()
+	return nil
+}
+
+//validateBlock run the checks of block, commitDone is closed when previous block is inserted
+func (e *BLSBFT_V2) validateBlock(block common.BlockInterface, commitDone chan struct{}) validateResult {
+	result := validateResult{blockHash: block.Hash().String()}
+	if preValidator, ok := e.Chain.(BlockPreValidator); ok {
+		if result.err = preValidator.PreValidateBlock(block); result.err != nil {
+			return result
+		}
+	}
+	if commitDone != nil {
+		select {
+		case <-commitDone:
+		case <-time.After(e.getPipelineTimeout()):
+			result.err = errors.New("timeout waiting for previous block commit")
+			return result
+		}
+	}
+	if e.Chain.GetViewByHash(block.GetPrevHash()) == nil {
+		result.err = errors.New("previous block is not committed")
+		return result
+	}
+	result.err = e.Chain.ValidatePreSignBlock(block)
+	return result
+}
+
+//processValidateResult vote for block if it is valid and we did not vote for another block in the same timeslot
+func (e *BLSBFT_V2) processValidateResult(result validateResult) {
+	v, ok := e.receiveBlockByHash[result.blockHash]
+	if !ok || v.block == nil {
+		return
+	}
+	v.isValidating = false
+	if result.err != nil {
+		e.Logger.Error(result.err)
+		return
+	}
+	if e.isTimeSlotSkipped(e.calculateTimeSlot(v.block.GetProposeTime())) {
+		return
+	}
+	if lastVotedBlk, ok := e.voteHistory[v.block.GetHeight()]; ok && lastVotedBlk.Hash().String() != result.blockHash {
+		if e.calculateTimeSlot(v.block.GetProposeTime()) == e.calculateTimeSlot(lastVotedBlk.GetProposeTime()) {
+			return
+		}
+	}
+	if err := e.vote(v); err != nil {
+		e.Logger.Error(err)
+	}
+}
+
+//commitAsync insert block to chain in background, blocks of next height can be validated as soon as it finish
+func (e *BLSBFT_V2) commitAsync(blockHash string, v *ProposeBlockInfo) {
+	commitDone := make(chan struct{})
+	e.committingBlocks[blockHash] = commitDone
+	block := v.block
+	if e.isSerial {
+		err := e.Chain.InsertAndBroadcastBlock(block)
+		close(commitDone)
+		e.processCommitResult(commitResult{blockHash: blockHash, height: block.GetHeight(), err: err})
+		return
+	}
+	go func() {
+		err := e.Chain.InsertAndBroadcastBlock(block)
+		close(commitDone)
+		select {
+		case e.commitResultCh <- commitResult{blockHash: blockHash, height: block.GetHeight(), err: err}:
+		case <-e.destroyCh:
+		}
+	}()
+}
+
+//processCommitResult rollback consensus state of block if commit failed, so that it can be committed again.
+//The block is dropped after maxCommitRetries failed commits.
+func (e *BLSBFT_V2) processCommitResult(result commitResult) {
+	delete(e.committingBlocks, result.blockHash)
+	if result.err == nil {
+		e.Logger.Infof("%v Finish commit block %v, height: %v", e.ChainKey, result.blockHash, result.height)
+		return
+	}
+	v, ok := e.receiveBlockByHash[result.blockHash]
+	if !ok {
+		return
+	}
+	v.commitFailures++
+	if v.commitFailures >= maxCommitRetries {
+		e.Logger.Errorf("%v Commit block %v at height %v failed: %v, drop block after %v retries", e.ChainKey, result.blockHash, result.height, result.err, v.commitFailures)
+		e.dropPipelinedView(result.blockHash, result.height, true)
+		return
+	}
+	e.Logger.Errorf("%v Commit block %v at height %v failed: %v, rollback", e.ChainKey, result.blockHash, result.height, result.err)
+	for _, vote := range v.votes {
+		vote.IsValid = 0
+	}
+	v.hasNewVote = true
+	e.dropPipelinedView(result.blockHash, result.height, false)
+}
+
+//dropPipelinedView discard the work done on top of a block whose commit failed: the blocks of next height built on it
+//are not valid anymore and must be validated again once it is committed, the next proposal built on it is dropped.
+//When the block itself is dropped, the blocks built on it are dropped too
+func (e *BLSBFT_V2) dropPipelinedView(blockHash string, height uint64, dropChildren bool) {
+	kept := []*ProposeBlockInfo{}
+	for _, v := range e.receiveBlockByHeight[height+1] {
+		if v.block == nil || v.block.GetPrevHash().String() != blockHash {
+			kept = append(kept, v)
+			continue
+		}
+		v.isValid = false
+		if dropChildren {
+			delete(e.receiveBlockByHash, v.block.Hash().String())
+			continue
+		}
+		kept = append(kept, v)
+	}
+	e.receiveBlockByHeight[height+1] = kept
+	if e.nextProposal != nil && e.nextProposal.prevHash.String() == blockHash {
+		e.nextProposal = nil
+	}
+}
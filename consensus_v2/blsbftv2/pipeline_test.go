@@ -0,0 +1,185 @@
+package blsbftv2
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	signatureschemes2 "github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+)
+
+//newTestProposeBlock create a block of the proposer of the timeslot, signed by the proposer
+func newTestProposeBlock(t *testing.T, keys []signatureschemes2.MiningKey, committee []incognitokey.CommitteePublicKey, height uint64, timeSlot int64, timeSlotDuration uint64, prev common.Hash) *blockchain.ShardBlock {
+	id := blockchain.GetProposerByTimeSlot(timeSlot, len(committee))
+	proposer, _ := committee[id].ToBase58()
+	block := newTestBlock(height, timeSlot*int64(timeSlotDuration), proposer, prev)
+	var validationData ValidationData
+	validationData.ProducerBLSSig, _ = keys[id].BriSignData(block.Hash().GetBytes())
+	validationDataString, _ := EncodeValidationData(validationData)
+	if err := block.AddValidationField(validationDataString); err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+//runTestValidator let a validator vote and commit a chain of blocks, and return the voted and inserted blocks
+func runTestValidator(t *testing.T, isSerial bool) ([]string, []string, *testChain) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	chain.validateDelay = 20 * time.Millisecond
+	chain.insertDelay = 100 * time.Millisecond
+	node := newTestNode()
+	e := newTestEngine(chain, node, keys[0])
+	e.isSerial = isSerial
+
+	votes := []string{}
+	prev := *chain.GetBestView().GetHash()
+	for i := 0; i < 4; i++ {
+		timeSlot := int64(101 + i)
+		block := newTestProposeBlock(t, keys, committee, uint64(2+i), timeSlot, e.timeSlot, prev)
+		blockHash := block.Hash().String()
+		prev = *block.Hash()
+
+		e.currentTimeSlot = timeSlot
+		v := &ProposeBlockInfo{block: block, votes: make(map[string]*BFTVote), receiveTime: time.Now()}
+		e.receiveBlockByHash[blockHash] = v
+		e.receiveBlockByHeight[block.GetHeight()] = append(e.receiveBlockByHeight[block.GetHeight()], v)
+		if err := e.validateAndVote(v); err != nil {
+			t.Fatal(err)
+		}
+		if !isSerial {
+			select {
+			case result := <-e.validateResultCh:
+				e.processValidateResult(result)
+			case <-time.After(time.Second):
+				t.Fatal("Expect block is validated")
+			}
+		}
+		msg := node.waitMessage(t, MSG_VOTE)
+		vote := BFTVote{}
+		if err := json.Unmarshal(msg.Content, &vote); err != nil {
+			t.Fatal(err)
+		}
+		votes = append(votes, vote.BlockHash)
+
+		for _, key := range keys[1:] {
+			vote, err := CreateVote(&key, block, committee)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v.votes[vote.Validator] = vote
+		}
+		v.hasNewVote = true
+		e.processIfBlockGetEnoughVote(blockHash, v)
+	}
+	for len(e.committingBlocks) > 0 {
+		select {
+		case result := <-e.commitResultCh:
+			e.processCommitResult(result)
+		case <-time.After(time.Second):
+			t.Fatal("Expect block is committed")
+		}
+	}
+	return votes, chain.inserted, chain
+}
+
+func TestPipelineSameAsSerial(t *testing.T) {
+	serialVotes, serialInserted, serialChain := runTestValidator(t, true)
+	pipelineVotes, pipelineInserted, pipelineChain := runTestValidator(t, false)
+
+	if len(serialInserted) != 4 {
+		t.Fatalf("Expect 4 blocks are inserted, have %v", len(serialInserted))
+	}
+	if !reflect.DeepEqual(serialVotes, pipelineVotes) {
+		t.Errorf("Expect pipeline vote the same blocks as serial, have %v and %v", pipelineVotes, serialVotes)
+	}
+	if !reflect.DeepEqual(serialInserted, pipelineInserted) {
+		t.Errorf("Expect pipeline insert the same blocks as serial, have %v and %v", pipelineInserted, serialInserted)
+	}
+	if *serialChain.GetBestView().GetHash() != *pipelineChain.GetBestView().GetHash() {
+		t.Error("Expect pipeline and serial have the same best view")
+	}
+	if serialChain.overlapped != 0 {
+		t.Errorf("Expect serial validate block after its previous block is inserted, have %v overlaps", serialChain.overlapped)
+	}
+	if pipelineChain.overlapped != 3 {
+		t.Errorf("Expect every block after the first one is validated before its previous block is inserted, have %v overlaps", pipelineChain.overlapped)
+	}
+}
+
+func TestCommitRetriesAreBounded(t *testing.T) {
+	for _, isSerial := range []bool{true, false} {
+		keys, committee := newTestCommittee(t, 4)
+		chain := newTestChain(committee, 1000)
+		chain.insertErr = errors.New("insert failed")
+		e := newTestEngine(chain, newTestNode(), keys[0])
+		e.isSerial = isSerial
+
+		block := newTestProposeBlock(t, keys, committee, 2, 101, e.timeSlot, *chain.GetBestView().GetHash())
+		blockHash := block.Hash().String()
+		v := &ProposeBlockInfo{block: block, votes: make(map[string]*BFTVote), hasNewVote: true}
+		e.receiveBlockByHash[blockHash] = v
+		for _, key := range keys[1:] {
+			vote, err := CreateVote(&key, block, committee)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v.votes[vote.Validator] = vote
+		}
+		for i := 0; i < 2*maxCommitRetries; i++ {
+			e.processIfBlockGetEnoughVote(blockHash, v)
+			if !isSerial && e.isCommitting(blockHash) {
+				e.processCommitResult(<-e.commitResultCh)
+			}
+		}
+		if chain.insertCount != maxCommitRetries {
+			t.Errorf("Expect block is committed %v times then dropped, have %v commits (serial %v)", maxCommitRetries, chain.insertCount, isSerial)
+		}
+		if v.hasNewVote {
+			t.Errorf("Expect dropped block is not retried (serial %v)", isSerial)
+		}
+	}
+}
+
+func TestRollbackDropPipelinedView(t *testing.T) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	e := newTestEngine(chain, newTestNode(), keys[3]) // proposer of timeslot 103
+
+	block := newTestProposeBlock(t, keys, committee, 2, 101, e.timeSlot, *chain.GetBestView().GetHash())
+	blockHash := block.Hash().String()
+	e.receiveBlockByHash[blockHash] = &ProposeBlockInfo{block: block, votes: make(map[string]*BFTVote)}
+	child := newTestProposeBlock(t, keys, committee, 3, 102, e.timeSlot, *block.Hash())
+	other := newTestProposeBlock(t, keys, committee, 3, 102, e.timeSlot, common.Hash{})
+	for _, b := range []*blockchain.ShardBlock{child, other} {
+		v := &ProposeBlockInfo{block: b, votes: make(map[string]*BFTVote), isValid: true}
+		e.receiveBlockByHash[b.Hash().String()] = v
+		e.receiveBlockByHeight[3] = append(e.receiveBlockByHeight[3], v)
+	}
+	e.nextProposal = &nextProposal{timeSlot: 103, prevHash: *block.Hash()}
+
+	e.processCommitResult(commitResult{blockHash: blockHash, height: 2, err: errors.New("insert failed")})
+	if e.receiveBlockByHash[child.Hash().String()].isValid {
+		t.Fatal("Expect block built on a failed commit must be validated again")
+	}
+	if !e.receiveBlockByHash[other.Hash().String()].isValid {
+		t.Fatal("Expect block built on another view is kept")
+	}
+	if e.nextProposal != nil {
+		t.Fatal("Expect next proposal built on a failed commit is dropped")
+	}
+
+	e.receiveBlockByHash[blockHash].commitFailures = maxCommitRetries
+	e.processCommitResult(commitResult{blockHash: blockHash, height: 2, err: errors.New("insert failed")})
+	if _, ok := e.receiveBlockByHash[child.Hash().String()]; ok || len(e.receiveBlockByHeight[3]) != 1 {
+		t.Fatal("Expect blocks built on a dropped block are dropped")
+	}
+	if _, ok := e.receiveBlockByHash[other.Hash().String()]; !ok {
+		t.Fatal("Expect block built on another view is kept")
+	}
+}
@@ -0,0 +1,321 @@
+package blsbftv2
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+	signatureschemes2 "github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metrics"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/wire"
+)
+
+const (
+	maxFailedRounds = 6 // round timeout stop growing after this number of consecutive failed rounds
+)
+
+type newViewKey struct {
+	height   uint64
+	timeSlot int64
+}
+
+//nextProposal is a block created for the next round while the current one is failing.
+//It is built off the actor loop, block is nil until the creation finishes
+type nextProposal struct {
+	timeSlot int64
+	prevHash common.Hash
+	block    common.BlockInterface
+	err      error
+}
+
+type viewChangeMetrics struct {
+	roundTimeout metrics.Gauge
+	failedRounds metrics.Gauge
+	roundSucceed metrics.Counter
+	roundFailed  metrics.Counter
+	newViewSent  metrics.Counter
+	viewChange   metrics.Counter
+}
+
+func newViewChangeMetrics(chainKey string) *viewChangeMetrics {
+	return &viewChangeMetrics{
+		roundTimeout: metrics.GetOrRegisterGauge(fmt.Sprintf("consensus/%v/roundtimeout", chainKey), nil),
+		failedRounds: metrics.GetOrRegisterGauge(fmt.Sprintf("consensus/%v/failedrounds", chainKey), nil),
+		roundSucceed: metrics.GetOrRegisterCounter(fmt.Sprintf("consensus/%v/round/succeed", chainKey), nil),
+		roundFailed:  metrics.GetOrRegisterCounter(fmt.Sprintf("consensus/%v/round/failed", chainKey), nil),
+		newViewSent:  metrics.GetOrRegisterCounter(fmt.Sprintf("consensus/%v/newview/sent", chainKey), nil),
+		viewChange:   metrics.GetOrRegisterCounter(fmt.Sprintf("consensus/%v/viewchange", chainKey), nil),
+	}
+}
+
+//getRoundTimeout return how long a validator wait for the proposer before sending new view.
+//It doubles after each consecutive failed round, halves after each succeed round and never exceed a timeslot
+func (e *BLSBFT_V2) getRoundTimeout() time.Duration {
+	maxTimeout := e.getTimeSlotDuration()
+	timeout := (maxTimeout / 4) << uint(e.failedRounds)
+	if timeout > maxTimeout {
+		return maxTimeout
+	}
+	return timeout
+}
+
+//isTimeSlotSkipped return true if 2/3+ committee gave up on the proposer of this timeslot,
+//blocks proposed in a skipped timeslot are not proposed nor voted anymore
+func (e *BLSBFT_V2) isTimeSlotSkipped(timeSlot int64) bool {
+	return timeSlot <= e.skipTimeSlot
+}
+
+//getRoundTimeSlot return the timeslot of the current round. It is the wall clock timeslot,
+//unless the committee already skipped it: the round then moves to the next timeslot right away,
+//so the next proposer does not wait for the end of the skipped one
+func (e *BLSBFT_V2) getRoundTimeSlot(t int64) int64 {
+	timeSlot := e.calculateTimeSlot(t)
+	if timeSlot <= e.skipTimeSlot {
+		return e.skipTimeSlot + 1
+	}
+	return timeSlot
+}
+
+//getProposeTime return the propose time of a block of the current round, never before the round timeslot starts
+func (e *BLSBFT_V2) getProposeTime() int64 {
+	if roundStart := e.currentTimeSlot * int64(e.timeSlot); roundStart > e.currentTime {
+		return roundStart
+	}
+	return e.currentTime
+}
+
+//onNewRound update round statistic when moving from prevTimeSlot to a new timeslot
+func (e *BLSBFT_V2) onNewRound(prevTimeSlot int64, bestView multiview.View) {
+	e.roundStartTime = time.Now()
+	if prevTimeSlot == 0 {
+		return
+	}
+	if e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()) == prevTimeSlot {
+		if e.failedRounds > 0 {
+			e.failedRounds--
+		}
+		e.viewChangeMetrics.roundSucceed.Inc(1)
+	} else {
+		if e.failedRounds < maxFailedRounds {
+			e.failedRounds++
+		}
+		e.viewChangeMetrics.roundFailed.Inc(1)
+	}
+	e.viewChangeMetrics.failedRounds.Update(int64(e.failedRounds))
+	e.viewChangeMetrics.roundTimeout.Update(int64(e.getRoundTimeout() / time.Millisecond))
+}
+
+func (e *BLSBFT_V2) hasProposeBlockInTimeSlot(key newViewKey) bool {
+	for _, v := range e.receiveBlockByHeight[key.height] {
+		if v.block != nil && e.calculateTimeSlot(v.block.GetProposeTime()) == key.timeSlot {
+			return true
+		}
+	}
+	return false
+}
+
+//checkRoundTimeout send new view if proposer of current timeslot is not responsive after round timeout
+func (e *BLSBFT_V2) checkRoundTimeout(bestView multiview.View) {
+	key := newViewKey{
+		height:   bestView.GetHeight() + 1,
+		timeSlot: e.currentTimeSlot,
+	}
+	if _, ok := e.sentNewView[key]; ok {
+		return
+	}
+	if time.Since(e.roundStartTime) < e.getRoundTimeout() {
+		return
+	}
+	if e.calculateTimeSlot(bestView.GetBlock().GetProposeTime()) >= key.timeSlot || e.isCommittingAtHeight(key.height) {
+		return
+	}
+	if e.hasProposeBlockInTimeSlot(key) {
+		return
+	}
+	if lastVotedBlk, ok := e.voteHistory[key.height]; ok && e.calculateTimeSlot(lastVotedBlk.GetProposeTime()) == key.timeSlot {
+		return
+	}
+	e.sentNewView[key] = struct{}{}
+	committeeBLSString, _ := incognitokey.ExtractPublickeysFromCommitteeKeyList(bestView.GetCommittee(), common.BlsConsensus)
+	for _, userKey := range e.UserKeySet {
+		pubKey := userKey.GetPublicKey()
+		if common.IndexOfStr(pubKey.GetMiningKeyBase58(e.GetConsensusName()), committeeBLSString) == -1 {
+			continue
+		}
+		newView, err := CreateNewView(&userKey, bestView.GetHash().String(), key.height, key.timeSlot)
+		if err != nil {
+			e.Logger.Error(err)
+			continue
+		}
+		msg, err := MakeBFTNewViewMsg(newView, e.ChainKey, e.currentTimeSlot, key.height)
+		if err != nil {
+			e.Logger.Error(err)
+			continue
+		}
+		e.Logger.Infof("%v Round timeout (%v) at height %v timeslot %v, sending new view", e.ChainKey, e.getRoundTimeout(), key.height, key.timeSlot)
+		e.viewChangeMetrics.newViewSent.Inc(1)
+		go e.ProcessBFTMsg(msg.(*wire.MessageBFT))
+		go e.Node.PushMessageToChain(msg, e.Chain)
+	}
+}
+
+//processNewView store a valid new view message, and skip its timeslot once 2/3+ committee agree
+func (e *BLSBFT_V2) processNewView(newView *BFTNewView) {
+	bestView := e.Chain.GetBestView()
+	if newView.Height != bestView.GetHeight()+1 || newView.PrevBlockHash != bestView.GetHash().String() {
+		return
+	}
+	_, committeePk := GetValidatorIndex(bestView, newView.Validator)
+	if committeePk == nil {
+		e.Logger.Error("Receive new view from nonCommittee member")
+		return
+	}
+	if err := newView.validateNewViewOwner(committeePk.MiningPubKey[common.BridgeConsensus]); err != nil {
+		e.Logger.Error(err)
+		return
+	}
+	key := newViewKey{
+		height:   newView.Height,
+		timeSlot: newView.TimeSlot,
+	}
+	if _, ok := e.receiveNewView[key]; !ok {
+		e.receiveNewView[key] = make(map[string]*BFTNewView)
+	}
+	e.receiveNewView[key][newView.Validator] = newView
+	e.checkViewChange(bestView)
+}
+
+//checkViewChange skip the current round once 2/3+ committee sent new view for it, which moves the round
+//and the proposer to the next timeslot. Only the current round can be skipped, new views of a later round are kept until it starts
+func (e *BLSBFT_V2) checkViewChange(bestView multiview.View) {
+	key := newViewKey{
+		height:   bestView.GetHeight() + 1,
+		timeSlot: e.currentTimeSlot,
+	}
+	if e.isTimeSlotSkipped(key.timeSlot) {
+		return
+	}
+	if len(e.receiveNewView[key]) > 2*len(bestView.GetCommittee())/3 {
+		e.Logger.Infof("%v View change at height %v, skip timeslot %v with %v new views", e.ChainKey, key.height, key.timeSlot, len(e.receiveNewView[key]))
+		e.skipTimeSlot = key.timeSlot
+		e.viewChangeMetrics.viewChange.Inc(1)
+	}
+}
+
+//prepareNextProposal start creating the block of the next round once this node gave up on the current proposer,
+//so that it is ready when the view change completes. Its propose time is the start of the next timeslot.
+//The block is created in another goroutine, the result is fed back to the actor loop by processNextProposal
+func (e *BLSBFT_V2) prepareNextProposal(bestView multiview.View) {
+	key := newViewKey{
+		height:   bestView.GetHeight() + 1,
+		timeSlot: e.currentTimeSlot,
+	}
+	if _, ok := e.sentNewView[key]; !ok && !e.isTimeSlotSkipped(key.timeSlot) {
+		return
+	}
+	nextTimeSlot := key.timeSlot + 1
+	if e.nextProposal != nil && e.nextProposal.timeSlot == nextTimeSlot && e.nextProposal.prevHash == *bestView.GetHash() {
+		return
+	}
+	proposerPk, _ := bestView.GetProposerByTimeSlot(nextTimeSlot, 2)
+	if !e.hasUserKey(proposerPk) {
+		return
+	}
+	b58Str, _ := proposerPk.ToBase58()
+	e.nextProposal = &nextProposal{
+		timeSlot: nextTimeSlot,
+		prevHash: *bestView.GetHash(),
+	}
+	go func(result nextProposal) {
+		result.block, result.err = e.Chain.CreateNewBlock(2, b58Str, 1, nextTimeSlot*int64(e.timeSlot))
+		select {
+		case e.nextProposalCh <- result:
+		case <-e.destroyCh:
+		}
+	}(*e.nextProposal)
+}
+
+//processNextProposal keep the created block if it is still the one being prepared
+func (e *BLSBFT_V2) processNextProposal(result nextProposal) {
+	prepared := e.nextProposal
+	if prepared == nil || prepared.timeSlot != result.timeSlot || prepared.prevHash != result.prevHash {
+		return
+	}
+	if result.err != nil {
+		e.Logger.Error(result.err)
+		return
+	}
+	if result.block == nil || result.block.GetPrevHash() != result.prevHash {
+		return
+	}
+	e.Logger.Infof("%v Prepare block %v for timeslot %v", e.ChainKey, result.block.GetHeight(), result.timeSlot)
+	prepared.block = result.block
+}
+
+//takeNextProposal return the prepared block if it is for the current round and on top of best view
+func (e *BLSBFT_V2) takeNextProposal(bestView multiview.View) common.BlockInterface {
+	prepared := e.nextProposal
+	if prepared == nil || prepared.block == nil || prepared.timeSlot > e.currentTimeSlot {
+		return nil
+	}
+	e.nextProposal = nil
+	if prepared.timeSlot != e.currentTimeSlot || prepared.block.GetPrevHash() != *bestView.GetHash() {
+		return nil
+	}
+	return prepared.block
+}
+
+func (e *BLSBFT_V2) hasUserKey(committeePk incognitokey.CommitteePublicKey) bool {
+	for _, userKey := range e.UserKeySet {
+		if userKey.GetPublicKey().GetMiningKeyBase58(common.BlsConsensus) == committeePk.GetMiningKeyBase58(common.BlsConsensus) {
+			return true
+		}
+	}
+	return false
+}
+
+//cleanNewViews remove new views of finalized heights
+func (e *BLSBFT_V2) cleanNewViews(finalHeight uint64) {
+	for k := range e.receiveNewView {
+		if k.height <= finalHeight {
+			delete(e.receiveNewView, k)
+		}
+	}
+	for k := range e.sentNewView {
+		if k.height <= finalHeight {
+			delete(e.sentNewView, k)
+		}
+	}
+}
+
+func CreateNewView(userKey *signatureschemes2.MiningKey, prevBlockHash string, height uint64, timeSlot int64) (*BFTNewView, error) {
+	newView := &BFTNewView{
+		PrevBlockHash: prevBlockHash,
+		Height:        height,
+		TimeSlot:      timeSlot,
+		Validator:     userKey.GetPublicKey().GetMiningKeyBase58(common.BlsConsensus),
+	}
+	var err error
+	newView.Confirmation, err = userKey.BriSignData(common.HashB(newView.signedData()))
+	if err != nil {
+		return nil, NewConsensusError(UnExpectedError, err)
+	}
+	return newView, nil
+}
+
+func (s *BFTNewView) signedData() []byte {
+	data := []byte{}
+	data = append(data, s.PrevBlockHash...)
+	data = append(data, strconv.FormatUint(s.Height, 10)...)
+	data = append(data, strconv.FormatInt(s.TimeSlot, 10)...)
+	data = append(data, s.Validator...)
+	return data
+}
+
+func (s *BFTNewView) validateNewViewOwner(ownerPk []byte) error {
+	dataHash := common.HashH(s.signedData())
+	return validateSingleBriSig(&dataHash, s.Confirmation, ownerPk)
+}
@@ -0,0 +1,493 @@
+package blsbftv2
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	signatureschemes2 "github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/wire"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+type testView struct {
+	block     common.BlockInterface
+	committee []incognitokey.CommitteePublicKey
+}
+
+func (s *testView) GetHash() *common.Hash {
+	return s.block.Hash()
+}
+
+func (s *testView) GetPreviousHash() *common.Hash {
+	hash := s.block.GetPrevHash()
+	return &hash
+}
+
+func (s *testView) GetHeight() uint64 {
+	return s.block.GetHeight()
+}
+
+func (s *testView) GetCommittee() []incognitokey.CommitteePublicKey {
+	return s.committee
+}
+
+func (s *testView) GetProposerByTimeSlot(ts int64, version int) (incognitokey.CommitteePublicKey, int) {
+	id := blockchain.GetProposerByTimeSlot(ts, len(s.committee))
+	return s.committee[id], id
+}
+
+func (s *testView) GetBlock() common.BlockInterface {
+	return s.block
+}
+
+//testChain keep views in memory, validation and insertion can be delayed or failed
+type testChain struct {
+	lock          sync.Mutex
+	views         map[common.Hash]*testView
+	bestView      *testView
+	finalView     *testView
+	validateDelay time.Duration
+	insertDelay   time.Duration
+	insertErr     error
+	inserted      []string
+	insertCount   int
+	// number of blocks pre-validated before their previous block is inserted
+	overlapped int
+}
+
+func newTestBlock(height uint64, proposeTime int64, proposer string, prev common.Hash) *blockchain.ShardBlock {
+	return &blockchain.ShardBlock{
+		Header: blockchain.ShardHeader{
+			Version:           2,
+			Height:            height,
+			Round:             1,
+			Epoch:             1,
+			Timestamp:         proposeTime,
+			PreviousBlockHash: prev,
+			Producer:          proposer,
+			ProposeTime:       proposeTime,
+			Proposer:          proposer,
+		},
+	}
+}
+
+func newTestChain(committee []incognitokey.CommitteePublicKey, genesisTime int64) *testChain {
+	genesis := &testView{block: newTestBlock(1, genesisTime, "", common.Hash{}), committee: committee}
+	return &testChain{
+		views:     map[common.Hash]*testView{*genesis.GetHash(): genesis},
+		bestView:  genesis,
+		finalView: genesis,
+	}
+}
+
+func (c *testChain) GetFinalView() multiview.View {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.finalView
+}
+
+func (c *testChain) GetBestView() multiview.View {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.bestView
+}
+
+func (c *testChain) GetChainName() string {
+	return "shard0"
+}
+
+func (c *testChain) IsReady() bool {
+	return true
+}
+
+func (c *testChain) UnmarshalBlock(blockString []byte) (common.BlockInterface, error) {
+	block := &blockchain.ShardBlock{}
+	if err := json.Unmarshal(blockString, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (c *testChain) CreateNewBlock(version int, proposer string, round int, startTime int64) (common.BlockInterface, error) {
+	bestView := c.GetBestView()
+	return newTestBlock(bestView.GetHeight()+1, startTime, proposer, *bestView.GetHash()), nil
+}
+
+func (c *testChain) CreateNewBlockFromOldBlock(oldBlock common.BlockInterface, proposer string, startTime int64) (common.BlockInterface, error) {
+	block := *oldBlock.(*blockchain.ShardBlock)
+	block.Header.Proposer = proposer
+	block.Header.ProposeTime = startTime
+	return &block, nil
+}
+
+func (c *testChain) InsertAndBroadcastBlock(block common.BlockInterface) error {
+	c.lock.Lock()
+	c.insertCount++
+	c.lock.Unlock()
+	time.Sleep(c.insertDelay)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.insertErr != nil {
+		return c.insertErr
+	}
+	prevView, ok := c.views[block.GetPrevHash()]
+	if !ok {
+		return errors.New("previous view not found")
+	}
+	view := &testView{block: block, committee: prevView.committee}
+	c.views[*block.Hash()] = view
+	if view.GetHeight() > c.bestView.GetHeight() {
+		c.bestView = view
+	}
+	c.inserted = append(c.inserted, block.Hash().String())
+	return nil
+}
+
+func (c *testChain) PreValidateBlock(block common.BlockInterface) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.views[block.GetPrevHash()]; !ok {
+		c.overlapped++
+	}
+	return nil
+}
+
+func (c *testChain) ValidatePreSignBlock(block common.BlockInterface) error {
+	time.Sleep(c.validateDelay)
+	return nil
+}
+
+func (c *testChain) GetShardID() int {
+	return 0
+}
+
+func (c *testChain) GetViewByHash(hash common.Hash) multiview.View {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if view, ok := c.views[hash]; ok {
+		return view
+	}
+	return nil
+}
+
+//testNode record the messages pushed by consensus
+type testNode struct {
+	msgCh chan *wire.MessageBFT
+}
+
+func newTestNode() *testNode {
+	return &testNode{msgCh: make(chan *wire.MessageBFT, 100)}
+}
+
+func (n *testNode) PushMessageToChain(msg wire.Message, chain common.ChainInterface) error {
+	n.msgCh <- msg.(*wire.MessageBFT)
+	return nil
+}
+
+func (n *testNode) RequestMissingViewViaStream(peerID string, hashes [][]byte, fromCID int, chainName string) error {
+	return nil
+}
+
+func (n *testNode) GetSelfPeerID() peer.ID {
+	return peer.ID("test")
+}
+
+func (n *testNode) waitMessage(t *testing.T, msgType string) *wire.MessageBFT {
+	select {
+	case msg := <-n.msgCh:
+		if msg.Type != msgType {
+			t.Fatalf("Expect %v message, have %v", msgType, msg.Type)
+		}
+		return msg
+	case <-time.After(time.Second):
+		t.Fatalf("Expect %v message is pushed", msgType)
+	}
+	return nil
+}
+
+func newTestCommittee(t *testing.T, size int) ([]signatureschemes2.MiningKey, []incognitokey.CommitteePublicKey) {
+	keys := []signatureschemes2.MiningKey{}
+	committee := []incognitokey.CommitteePublicKey{}
+	for i := 0; i < size; i++ {
+		seed := base58.Base58Check{}.Encode(common.HashB([]byte{byte(i)}), common.ZeroByte)
+		key, err := newMiningKey(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, *key)
+		committee = append(committee, *key.GetPublicKey())
+	}
+	return keys, committee
+}
+
+func newTestEngine(chain ChainInterface, node NodeInterface, userKeys ...signatureschemes2.MiningKey) *BLSBFT_V2 {
+	logger := common.NewBackend(ioutil.Discard).Logger("Consensus", true)
+	e := newInstance(chain, "shard0", 0, node, logger)
+	e.UserKeySet = userKeys
+	e.timeSlot = 10
+	e.ProposeMessageCh = make(chan BFTPropose, 100)
+	e.VoteMessageCh = make(chan BFTVote, 100)
+	e.NewViewMessageCh = make(chan BFTNewView, 100)
+	return e
+}
+
+func TestGetRoundTimeout(t *testing.T) {
+	e := newTestEngine(nil, nil)
+	e.timeSlot = 8
+	tests := []struct {
+		failedRounds int
+		want         time.Duration
+	}{
+		{failedRounds: 0, want: 2 * time.Second},
+		{failedRounds: 1, want: 4 * time.Second},
+		{failedRounds: 2, want: 8 * time.Second},
+		{failedRounds: maxFailedRounds, want: 8 * time.Second},
+	}
+	for _, tt := range tests {
+		e.failedRounds = tt.failedRounds
+		if got := e.getRoundTimeout(); got != tt.want {
+			t.Errorf("getRoundTimeout() with %v failed rounds = %v, want %v", tt.failedRounds, got, tt.want)
+		}
+	}
+}
+
+func TestOnNewRound(t *testing.T) {
+	_, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	e := newTestEngine(chain, newTestNode())
+	bestView := chain.GetBestView()
+
+	e.onNewRound(0, bestView)
+	if e.failedRounds != 0 {
+		t.Fatalf("Expect first round is not counted, have %v failed rounds", e.failedRounds)
+	}
+	for i := 1; i <= maxFailedRounds+1; i++ {
+		e.onNewRound(101, bestView) // best block is proposed at timeslot 100
+	}
+	if e.failedRounds != maxFailedRounds {
+		t.Fatalf("Expect failed rounds stop at %v, have %v", maxFailedRounds, e.failedRounds)
+	}
+	e.onNewRound(100, bestView)
+	if e.failedRounds != maxFailedRounds-1 {
+		t.Fatalf("Expect a succeed round decrease failed rounds to %v, have %v", maxFailedRounds-1, e.failedRounds)
+	}
+}
+
+func TestProcessNewView(t *testing.T) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	e := newTestEngine(chain, newTestNode())
+	e.currentTimeSlot = 101
+	bestView := chain.GetBestView()
+	newView := func(key signatureschemes2.MiningKey, prevHash string, timeSlot int64) *BFTNewView {
+		newView, err := CreateNewView(&key, prevHash, bestView.GetHeight()+1, timeSlot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return newView
+	}
+	prevHash := bestView.GetHash().String()
+
+	e.processNewView(newView(keys[0], prevHash, 101))
+	e.processNewView(newView(keys[1], prevHash, 101))
+	forged := newView(keys[3], prevHash, 101)
+	forged.Validator = keys[2].GetPublicKey().GetMiningKeyBase58(common.BlsConsensus)
+	e.processNewView(forged)
+	e.processNewView(newView(keys[2], common.Hash{}.String(), 101))
+	if e.isTimeSlotSkipped(101) {
+		t.Fatal("Expect timeslot is not skipped without 2/3+ valid new views")
+	}
+	e.processNewView(newView(keys[2], prevHash, 101))
+	if !e.isTimeSlotSkipped(101) || e.isTimeSlotSkipped(102) {
+		t.Fatalf("Expect only timeslot 101 is skipped, have skipped timeslot %v", e.skipTimeSlot)
+	}
+
+	// new views of a timeslot which is not started yet are kept until it starts
+	for _, key := range keys[:3] {
+		e.processNewView(newView(key, prevHash, 102))
+	}
+	if e.isTimeSlotSkipped(102) {
+		t.Fatal("Expect future timeslot is not skipped")
+	}
+	e.currentTimeSlot = 102
+	e.checkViewChange(bestView)
+	if !e.isTimeSlotSkipped(102) {
+		t.Fatal("Expect timeslot 102 is skipped once it starts")
+	}
+}
+
+func TestCheckRoundTimeout(t *testing.T) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	node := newTestNode()
+	e := newTestEngine(chain, node, keys[1])
+	e.currentTimeSlot = 101
+	bestView := chain.GetBestView()
+
+	e.roundStartTime = time.Now()
+	e.checkRoundTimeout(bestView)
+	if len(e.sentNewView) != 0 {
+		t.Fatal("Expect no new view before round timeout")
+	}
+
+	e.roundStartTime = time.Now().Add(-e.getRoundTimeout())
+	e.checkRoundTimeout(bestView)
+	msg := node.waitMessage(t, MSG_NEWVIEW)
+	newView := BFTNewView{}
+	if err := json.Unmarshal(msg.Content, &newView); err != nil {
+		t.Fatal(err)
+	}
+	if newView.Height != 2 || newView.TimeSlot != 101 || newView.PrevBlockHash != bestView.GetHash().String() {
+		t.Fatalf("Expect new view of height 2 timeslot 101, have %+v", newView)
+	}
+	if err := newView.validateNewViewOwner(committee[1].MiningPubKey[common.BridgeConsensus]); err != nil {
+		t.Fatal(err)
+	}
+
+	e.checkRoundTimeout(bestView)
+	select {
+	case msg := <-node.msgCh:
+		t.Fatalf("Expect new view is sent once per timeslot, have %v", msg.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRoundMovesPastSkippedTimeSlot(t *testing.T) {
+	_, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	e := newTestEngine(chain, newTestNode())
+	bestView := chain.GetBestView()
+
+	if got := e.getRoundTimeSlot(1012); got != 101 {
+		t.Fatalf("Expect round follows wall clock timeslot 101, have %v", got)
+	}
+	e.skipTimeSlot = 101
+	if got := e.getRoundTimeSlot(1012); got != 102 {
+		t.Fatalf("Expect round moves to timeslot 102 once 101 is skipped, have %v", got)
+	}
+	if _, id := bestView.GetProposerByTimeSlot(e.getRoundTimeSlot(1012), 2); id != 2 {
+		t.Fatalf("Expect proposer of timeslot 102 proposes right away, have proposer %v", id)
+	}
+	if got := e.getRoundTimeSlot(1025); got != 102 {
+		t.Fatalf("Expect round is not skipped again when its wall clock timeslot starts, have %v", got)
+	}
+
+	e.currentTime = 1012
+	e.currentTimeSlot = 102
+	if got := e.getProposeTime(); got != 1020 || e.calculateTimeSlot(got) != 102 {
+		t.Fatalf("Expect block of an early round is proposed at the start of its timeslot, have %v", got)
+	}
+	e.currentTime = 1025
+	if got := e.getProposeTime(); got != 1025 {
+		t.Fatalf("Expect block is proposed at current time once the round timeslot started, have %v", got)
+	}
+}
+
+func waitNextProposal(t *testing.T, e *BLSBFT_V2) {
+	select {
+	case result := <-e.nextProposalCh:
+		e.processNextProposal(result)
+	case <-time.After(time.Second):
+		t.Fatal("Expect next proposal is created")
+	}
+}
+
+func TestNextProposal(t *testing.T) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	e := newTestEngine(chain, newTestNode(), keys[2]) // proposer of timeslot 102
+	e.currentTimeSlot = 101
+	bestView := chain.GetBestView()
+
+	e.prepareNextProposal(bestView)
+	if e.nextProposal != nil {
+		t.Fatal("Expect no block is prepared while current round is not failing")
+	}
+
+	e.sentNewView[newViewKey{height: 2, timeSlot: 101}] = struct{}{}
+	e.prepareNextProposal(bestView)
+	if e.nextProposal == nil || e.nextProposal.block != nil {
+		t.Fatal("Expect block of next round is being created off the actor loop")
+	}
+	if e.takeNextProposal(bestView) != nil {
+		t.Fatal("Expect no block is proposed before it is created")
+	}
+	waitNextProposal(t, e)
+	if e.nextProposal == nil || e.nextProposal.block == nil {
+		t.Fatal("Expect block of next round is prepared")
+	}
+	block := e.nextProposal.block
+	if block.GetProposeTime() != 102*10 || e.calculateTimeSlot(block.GetProposeTime()) != 102 {
+		t.Fatalf("Expect block is proposed at the start of timeslot 102, have propose time %v", block.GetProposeTime())
+	}
+	e.prepareNextProposal(bestView)
+	select {
+	case <-e.nextProposalCh:
+		t.Fatal("Expect block is created once per round")
+	case <-time.After(100 * time.Millisecond):
+	}
+	if e.takeNextProposal(bestView) != nil || e.nextProposal == nil {
+		t.Fatal("Expect prepared block is kept until its round")
+	}
+	e.currentTimeSlot = 102
+	if got := e.takeNextProposal(bestView); got == nil || got.Hash().String() != block.Hash().String() {
+		t.Fatal("Expect prepared block is proposed in its round")
+	}
+	if e.takeNextProposal(bestView) != nil {
+		t.Fatal("Expect prepared block is proposed once")
+	}
+
+	// a result of a stale preparation is dropped
+	e.currentTimeSlot = 101
+	e.skipTimeSlot = 101
+	e.prepareNextProposal(bestView)
+	e.nextProposal = nil
+	waitNextProposal(t, e)
+	if e.nextProposal != nil {
+		t.Fatal("Expect stale prepared block is dropped")
+	}
+
+	// not the next proposer
+	other := newTestEngine(chain, newTestNode(), keys[0])
+	other.currentTimeSlot = 101
+	other.skipTimeSlot = 101
+	other.prepareNextProposal(bestView)
+	if other.nextProposal != nil {
+		t.Fatal("Expect only the proposer of next round prepares a block")
+	}
+}
+
+func TestSkippedTimeSlotIsNotVoted(t *testing.T) {
+	keys, committee := newTestCommittee(t, 4)
+	chain := newTestChain(committee, 1000)
+	node := newTestNode()
+	e := newTestEngine(chain, node, keys[0])
+	e.currentTimeSlot = 101
+	bestView := chain.GetBestView()
+
+	proposer := committee[1].GetMiningKeyBase58(common.BlsConsensus)
+	block := newTestBlock(2, 1010, proposer, *bestView.GetHash())
+	e.receiveBlockByHash[block.Hash().String()] = &ProposeBlockInfo{block: block, votes: make(map[string]*BFTVote)}
+
+	e.skipTimeSlot = 101
+	e.processValidateResult(validateResult{blockHash: block.Hash().String()})
+	if _, ok := e.voteHistory[2]; ok {
+		t.Fatal("Expect no vote for a block of skipped timeslot")
+	}
+
+	e.skipTimeSlot = 100
+	e.processValidateResult(validateResult{blockHash: block.Hash().String()})
+	if _, ok := e.voteHistory[2]; !ok {
+		t.Fatal("Expect vote for a valid block")
+	}
+	node.waitMessage(t, MSG_VOTE)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/incognitochain/incognito-chain/consensus_v2/blsbft"
 	blsbft2 "github.com/incognitochain/incognito-chain/consensus_v2/blsbftv2"
 	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/wire"
 )
 
@@ -204,7 +205,7 @@ func (engine *Engine) updateVersion(chainID int) {
 		chainEpoch = engine.config.Blockchain.ShardChain[chainID].GetEpoch()
 	}
 
-	if chainEpoch >= engine.config.Blockchain.GetConfig().ChainParams.ConsensusV2Epoch {
+	if engine.config.Blockchain.IsFeatureActiveAtEpoch(common.ConsensusV2Feature, chainEpoch) {
 		engine.version[chainID] = 2
 	} else {
 		engine.version[chainID] = 1
@@ -276,3 +277,23 @@ func (engine *Engine) IsCommitteeInShard(shardID byte) bool {
 	}
 	return false
 }
+
+//GetEquivocationEvidences return equivocation evidences collected by all running BLSBFT_V2 processes
+func (engine *Engine) GetEquivocationEvidences() []metadata.EquivocationEvidence {
+	result := []metadata.EquivocationEvidence{}
+	for _, process := range engine.BFTProcess {
+		if bftv2, ok := process.(*blsbft2.BLSBFT_V2); ok {
+			result = append(result, bftv2.GetEquivocationEvidences()...)
+		}
+	}
+	return result
+}
+
+//RemoveEquivocationEvidence remove a submitted evidence from all BLSBFT_V2 processes
+func (engine *Engine) RemoveEquivocationEvidence(evidenceHash string) {
+	for _, process := range engine.BFTProcess {
+		if bftv2, ok := process.(*blsbft2.BLSBFT_V2); ok {
+			bftv2.RemoveEquivocationEvidence(evidenceHash)
+		}
+	}
+}
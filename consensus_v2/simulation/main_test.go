@@ -474,7 +474,8 @@ func RunSimulation(testScn *testScenerio, t *testing.T) {
 	var timeslot uint64
 	for i := 1; i <= testScn.TimeSlots; i++ {
 		timeslot = setTimeSlot(i)
-		slotProducerIdx := GetIndexOfBytes(nodeList[0].chain.GetBestView().GetProposerByTimeSlot(int64(timeslot), 2).MiningPubKey["bls"], committeePkBytes)
+		slotProducer, _ := nodeList[0].chain.GetBestView().GetProposerByTimeSlot(int64(timeslot), 2)
+		slotProducerIdx := GetIndexOfBytes(slotProducer.MiningPubKey["bls"], committeePkBytes)
 		if scenerio, ok := testScn.TimeSlotScenerios[i]; ok {
 			pComm := make([]int, len(testScn.Committee))
 			for a := range pComm {
@@ -523,7 +524,8 @@ func RunSimulation(testScn *testScenerio, t *testing.T) {
 		curTimeSlot := (curTimeSlotTime - startTimeSlot) + 1
 		if lastTimeSlot != curTimeSlot {
 			time.AfterFunc(time.Millisecond*1500, func() {
-				slotProducerIdx := GetIndexOfBytes(nodeList[0].chain.GetBestView().GetProposerByTimeSlot(int64(curTimeSlotTime), 2).MiningPubKey["bls"], committeePkBytes)
+				slotProducer, _ := nodeList[0].chain.GetBestView().GetProposerByTimeSlot(int64(curTimeSlotTime), 2)
+				slotProducerIdx := GetIndexOfBytes(slotProducer.MiningPubKey["bls"], committeePkBytes)
 				fmt.Println("==========================")
 				fmt.Printf("Timeslot is: %v\n", curTimeSlot)
 				fmt.Printf("Proposer is: %d\n", slotProducerIdx)
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/consensus_v2"
+	"github.com/incognitochain/incognito-chain/consensus_v2/blsbftv2"
+	"github.com/incognitochain/incognito-chain/consensus_v2/signatureschemes"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/wire"
+	libp2p "github.com/libp2p/go-libp2p-peer"
+)
+
+var benchCommittee = []string{
+	"112t8rnXB47RhSdyVRU41TEf78nxbtWGtmjutwSp9YqsNaCpFxQGXcnwcXTtBkCGDk1KLBRBeWMvb2aXG5SeDUJRHtFV8jTB3weHEkbMJ1AL",
+	"112t8rnXVdfBqBMigSs5fm9NSS8rgsVVURUxArpv6DxYmPZujKqomqUa2H9wh1zkkmDGtDn2woK4NuRDYnYRtVkUhK34TMfbUF4MShSkrCw5",
+	"112t8rnXi8eKJ5RYJjyQYcFMThfbXHgaL6pq5AF5bWsDXwfsw8pqQUreDv6qgWyiABoDdphvqE7NFr9K92aomX7Gi5Nm1e4tEoV3qRLVdfSR",
+	"112t8rnY42xRqJghQX3zvhgEa2ZJBwSzJ46SXyVQEam1yNpN4bfAqJwh1SsobjHAz8wwRvwnqJBfxrbwUuTxqgEbuEE8yMu6F14QmwtwyM43",
+}
+
+// slowChain simulate the cost of block validation and block insertion (statedb commit).
+// Like the real chains, part of the validation does not need the view of previous block
+type slowChain struct {
+	*Chain
+	lock             sync.Mutex
+	preValidateDelay time.Duration
+	validateDelay    time.Duration
+	insertDelay      time.Duration
+}
+
+func (c *slowChain) InsertAndBroadcastBlock(block common.BlockInterface) error {
+	time.Sleep(c.insertDelay)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.Chain.InsertAndBroadcastBlock(block)
+}
+
+func (c *slowChain) PreValidateBlock(block common.BlockInterface) error {
+	time.Sleep(c.preValidateDelay)
+	return nil
+}
+
+func (c *slowChain) ValidatePreSignBlock(block common.BlockInterface) error {
+	time.Sleep(c.validateDelay)
+	return nil
+}
+
+type benchNode struct {
+	id     string
+	engine *blsbftv2.BLSBFT_V2
+	chain  *slowChain
+	peers  []*benchNode
+}
+
+func (n *benchNode) PushMessageToChain(msg wire.Message, chain common.ChainInterface) error {
+	for _, p := range n.peers {
+		if p.id == n.id {
+			continue
+		}
+		go p.engine.ProcessBFTMsg(msg.(*wire.MessageBFT))
+	}
+	return nil
+}
+
+func (n *benchNode) RequestMissingViewViaStream(peerID string, hashes [][]byte, fromCID int, chainName string) error {
+	return nil
+}
+
+func (n *benchNode) GetSelfPeerID() libp2p.ID {
+	return libp2p.ID(n.id)
+}
+
+// runPipelineNetwork run a committee for a number of timeslots and return the number of committed blocks
+func runPipelineNetwork(b *testing.B, timeSlots int, isSerial bool, preValidateDelay, validateDelay, insertDelay time.Duration) uint64 {
+	committee := []incognitokey.CommitteePublicKey{}
+	for _, k := range benchCommittee {
+		p, _ := consensus_v2.LoadUserKeyFromIncPrivateKey(k)
+		m, err := consensus_v2.GetMiningKeyFromPrivateSeed(p)
+		if err != nil {
+			b.Fatal(err)
+		}
+		committee = append(committee, *m.GetPublicKey())
+	}
+	logger := common.NewBackend(ioutil.Discard).Logger("Consensus", true)
+	nodes := []*benchNode{}
+	for i, k := range benchCommittee {
+		p, _ := consensus_v2.LoadUserKeyFromIncPrivateKey(k)
+		m, _ := consensus_v2.GetMiningKeyFromPrivateSeed(p)
+		node := &benchNode{
+			id: string(rune('a' + i)),
+			chain: &slowChain{
+				Chain:            NewChain(0, "shard0", committee),
+				preValidateDelay: preValidateDelay,
+				validateDelay:    validateDelay,
+				insertDelay:      insertDelay * time.Duration(i+1) / time.Duration(len(benchCommittee)), // nodes do not insert at the same speed
+			},
+		}
+		node.engine = blsbftv2.NewInstance(node.chain, "shard0", 0, node, logger)
+		node.engine.LoadUserKeys([]signatureschemes.MiningKey{*m})
+		node.engine.SetSerial(isSerial)
+		nodes = append(nodes, node)
+	}
+	for _, n := range nodes {
+		n.peers = nodes
+		n.engine.Start()
+	}
+	time.Sleep(time.Duration(timeSlots) * time.Duration(common.TIMESLOT) * time.Second)
+	for _, n := range nodes {
+		n.engine.Stop()
+		n.engine.Destroy()
+	}
+	return nodes[0].chain.GetBestView().GetHeight() - 1
+}
+
+func benchmarkPipelinedVoting(b *testing.B, isSerial bool, preValidateDelay, validateDelay, insertDelay time.Duration) {
+	common.TIMESLOT = 1
+	timeSlots := 10
+	committed := uint64(0)
+	for i := 0; i < b.N; i++ {
+		committed += runPipelineNetwork(b, timeSlots, isSerial, preValidateDelay, validateDelay, insertDelay)
+	}
+	b.ReportMetric(float64(committed)/float64(b.N*timeSlots), "blocks/slot")
+}
+
+func BenchmarkSerialVoting_FastCommit(b *testing.B) {
+	benchmarkPipelinedVoting(b, true, 0, 0, 0)
+}
+
+func BenchmarkPipelinedVoting_FastCommit(b *testing.B) {
+	benchmarkPipelinedVoting(b, false, 0, 0, 0)
+}
+
+// validation and insertion together take longer than a timeslot, without pipelining a block is validated
+// only after the previous one is inserted and misses the timeslot of its proposer
+func BenchmarkSerialVoting_SlowCommit(b *testing.B) {
+	benchmarkPipelinedVoting(b, true, 300*time.Millisecond, 50*time.Millisecond, 800*time.Millisecond)
+}
+
+func BenchmarkPipelinedVoting_SlowCommit(b *testing.B) {
+	benchmarkPipelinedVoting(b, false, 300*time.Millisecond, 50*time.Millisecond, 800*time.Millisecond)
+}
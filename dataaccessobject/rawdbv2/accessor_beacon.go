@@ -96,3 +96,27 @@ func GetBeaconViews(db incdb.KeyValueReader) ([]byte, error) {
 	}
 	return block, nil
 }
+
+func DeleteBeaconRootsHash(db incdb.KeyValueWriter, hash common.Hash) error {
+	key := GetBeaconRootsHashKey(hash)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteBeaconRootsHashError, err)
+	}
+	return nil
+}
+
+func DeleteBeaconBlockByHash(db incdb.KeyValueWriter, hash common.Hash) error {
+	keyHash := GetBeaconHashToBlockKey(hash)
+	if err := db.Delete(keyHash); err != nil {
+		return NewRawdbError(DeleteBeaconBlockError, err)
+	}
+	return nil
+}
+
+func DeleteFinalizedBeaconBlockHashByIndex(db incdb.KeyValueWriter, index uint64) error {
+	keyHash := GetBeaconIndexToBlockHashKey(index)
+	if err := db.Delete(keyHash); err != nil {
+		return NewRawdbError(DeleteBeaconBlockIndexError, err)
+	}
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/incognitochain/incognito-chain/incdb"
 )
 
-func StoreLastBeaconStateConfirmCrossShard(db incdb.Database, state interface{}) error {
+func StoreLastBeaconStateConfirmCrossShard(db incdb.KeyValueWriter, state interface{}) error {
 	key := GetLastBeaconHeightConfirmCrossShardKey()
 	val, _ := json.Marshal(state)
 	if err := db.Put(key, val); err != nil {
@@ -33,6 +33,14 @@ func StoreCrossShardNextHeight(db incdb.KeyValueWriter, fromShard byte, toShard
 	return nil
 }
 
+func DeleteCrossShardNextHeight(db incdb.KeyValueWriter, fromShard byte, toShard byte, curHeight uint64) error {
+	key := GetCrossShardNextHeightKey(fromShard, toShard, curHeight)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteCrossShardNextHeightError, err)
+	}
+	return nil
+}
+
 func hasCrossShardNextHeight(db incdb.KeyValueReader, key []byte) (bool, error) {
 	exist, err := db.Has(key)
 	if err != nil {
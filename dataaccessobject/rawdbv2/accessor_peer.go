@@ -0,0 +1,43 @@
+package rawdbv2
+
+import (
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// StorePeerScores - Store reputation of peers as a json in byte format
+func StorePeerScores(db incdb.KeyValueWriter, val []byte) error {
+	key := GetPeerScoreKey()
+	if err := db.Put(key, val); err != nil {
+		return NewRawdbError(StorePeerScoresError, err)
+	}
+	return nil
+}
+
+// GetPeerScores - Get reputation of peers as a json in byte format
+func GetPeerScores(db incdb.KeyValueReader) ([]byte, error) {
+	key := GetPeerScoreKey()
+	res, err := db.Get(key)
+	if err != nil {
+		return nil, NewRawdbError(GetPeerScoresError, err)
+	}
+	return res, nil
+}
+
+// StoreDirectPeers - Store libp2p addresses of peers to sync blocks from without highway
+func StoreDirectPeers(db incdb.KeyValueWriter, val []byte) error {
+	key := GetDirectPeerKey()
+	if err := db.Put(key, val); err != nil {
+		return NewRawdbError(StoreDirectPeersError, err)
+	}
+	return nil
+}
+
+// GetDirectPeers - Get libp2p addresses of peers to sync blocks from without highway
+func GetDirectPeers(db incdb.KeyValueReader) ([]byte, error) {
+	key := GetDirectPeerKey()
+	res, err := db.Get(key)
+	if err != nil {
+		return nil, NewRawdbError(GetDirectPeersError, err)
+	}
+	return res, nil
+}
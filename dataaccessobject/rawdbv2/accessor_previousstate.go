@@ -53,3 +53,55 @@ func CleanUpPreviousShardBestState(db incdb.Database, shardID byte) error {
 	}
 	return nil
 }
+
+// StorePreviousBeaconBestStateByHeight stores the beacon best state of a finalized height, used to roll back beacon chain
+func StorePreviousBeaconBestStateByHeight(db incdb.KeyValueWriter, height uint64, data []byte) error {
+	key := GetPreviousBestStateByHeightKey(-1, height)
+	if err := db.Put(key, data); err != nil {
+		return NewRawdbError(StorePreviousBestStateByHeightError, err)
+	}
+	return nil
+}
+
+func GetPreviousBeaconBestStateByHeight(db incdb.KeyValueReader, height uint64) ([]byte, error) {
+	key := GetPreviousBestStateByHeightKey(-1, height)
+	res, err := db.Get(key)
+	if err != nil {
+		return nil, NewRawdbError(GetPreviousBestStateByHeightError, err)
+	}
+	return res, nil
+}
+
+func DeletePreviousBeaconBestStateByHeight(db incdb.KeyValueWriter, height uint64) error {
+	key := GetPreviousBestStateByHeightKey(-1, height)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeletePreviousBestStateByHeightError, err)
+	}
+	return nil
+}
+
+// StorePreviousShardBestStateByHeight stores the shard best state of a finalized height, used to roll back shard chain
+func StorePreviousShardBestStateByHeight(db incdb.KeyValueWriter, shardID byte, height uint64, data []byte) error {
+	key := GetPreviousBestStateByHeightKey(int(shardID), height)
+	if err := db.Put(key, data); err != nil {
+		return NewRawdbError(StorePreviousBestStateByHeightError, err)
+	}
+	return nil
+}
+
+func GetPreviousShardBestStateByHeight(db incdb.KeyValueReader, shardID byte, height uint64) ([]byte, error) {
+	key := GetPreviousBestStateByHeightKey(int(shardID), height)
+	res, err := db.Get(key)
+	if err != nil {
+		return nil, NewRawdbError(GetPreviousBestStateByHeightError, err)
+	}
+	return res, nil
+}
+
+func DeletePreviousShardBestStateByHeight(db incdb.KeyValueWriter, shardID byte, height uint64) error {
+	key := GetPreviousBestStateByHeightKey(int(shardID), height)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeletePreviousBestStateByHeightError, err)
+	}
+	return nil
+}
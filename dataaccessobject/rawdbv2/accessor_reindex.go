@@ -0,0 +1,64 @@
+package rawdbv2
+
+import (
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// StoreReindexCheckpoint stores the progress of an index rebuilt from blocks of a chain, chainID is -1 for beacon
+func StoreReindexCheckpoint(db incdb.KeyValueWriter, index string, chainID int, data []byte) error {
+	key := GetReindexCheckpointKey(index, chainID)
+	if err := db.Put(key, data); err != nil {
+		return NewRawdbError(StoreReindexCheckpointError, err)
+	}
+	return nil
+}
+
+// GetReindexCheckpoint returns nil data if the index has no checkpoint
+func GetReindexCheckpoint(db incdb.KeyValueReader, index string, chainID int) ([]byte, error) {
+	key := GetReindexCheckpointKey(index, chainID)
+	if has, err := db.Has(key); err != nil {
+		return nil, NewRawdbError(GetReindexCheckpointError, err)
+	} else if !has {
+		return nil, nil
+	}
+	res, err := db.Get(key)
+	if err != nil {
+		return nil, NewRawdbError(GetReindexCheckpointError, err)
+	}
+	return res, nil
+}
+
+func DeleteReindexCheckpoint(db incdb.KeyValueWriter, index string, chainID int) error {
+	key := GetReindexCheckpointKey(index, chainID)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteReindexCheckpointError, err)
+	}
+	return nil
+}
+
+// DeleteIndex deletes all keys of a secondary index by its prefix, deletions are written every IdealBatchSize keys
+func DeleteIndex(db incdb.Database, prefix []byte) error {
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	batch := db.NewBatch()
+	for iterator.Next() {
+		key := make([]byte, len(iterator.Key()))
+		copy(key, iterator.Key())
+		if err := batch.Delete(key); err != nil {
+			return NewRawdbError(DeleteIndexError, err)
+		}
+		if batch.ValueSize() >= incdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return NewRawdbError(DeleteIndexError, err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return NewRawdbError(DeleteIndexError, err)
+	}
+	if err := batch.Write(); err != nil {
+		return NewRawdbError(DeleteIndexError, err)
+	}
+	return nil
+}
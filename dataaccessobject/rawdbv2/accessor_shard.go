@@ -123,3 +123,27 @@ func GetShardRootsHash(db incdb.KeyValueReader, shardID byte, hash common.Hash)
 	key := GetShardRootsHashKey(shardID, hash)
 	return db.Get(key)
 }
+
+func DeleteShardRootsHash(db incdb.KeyValueWriter, shardID byte, hash common.Hash) error {
+	key := GetShardRootsHashKey(shardID, hash)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteShardRootsHashError, err)
+	}
+	return nil
+}
+
+func DeleteShardBlock(db incdb.KeyValueWriter, hash common.Hash) error {
+	keyHash := GetShardHashToBlockKey(hash)
+	if err := db.Delete(keyHash); err != nil {
+		return NewRawdbError(DeleteShardBlockError, err)
+	}
+	return nil
+}
+
+func DeleteFinalizedShardBlockHashByIndex(db incdb.KeyValueWriter, sid byte, index uint64) error {
+	keyHash := GetShardIndexToBlockHashPrefix(sid, index)
+	if err := db.Delete(keyHash); err != nil {
+		return NewRawdbError(DeleteShardBlockIndexError, err)
+	}
+	return nil
+}
@@ -8,7 +8,7 @@ import (
 	"strings"
 )
 
-func StoreTransactionIndex(db incdb.Database, txHash common.Hash, blockHash common.Hash, index int) error {
+func StoreTransactionIndex(db incdb.KeyValueWriter, txHash common.Hash, blockHash common.Hash, index int) error {
 	key := GetTransactionHashKey(txHash)
 	value := []byte(blockHash.String() + string(splitter) + strconv.Itoa(index))
 	if err := db.Put(key, value); err != nil {
@@ -41,7 +41,7 @@ func GetTransactionByHash(db incdb.Database, txHash common.Hash) (common.Hash, i
 	return *blockHash, index, nil
 }
 
-func DeleteTransactionIndex(db incdb.Database, txHash common.Hash) error {
+func DeleteTransactionIndex(db incdb.KeyValueWriter, txHash common.Hash) error {
 	key := GetTransactionHashKey(txHash)
 	err := db.Delete(key)
 	if err != nil {
@@ -50,13 +50,49 @@ func DeleteTransactionIndex(db incdb.Database, txHash common.Hash) error {
 	return nil
 }
 
+// StoreTokenTx indexes a transaction of a privacy token, the index is kept out of transaction state
+// so it can be rebuilt from blocks
+func StoreTokenTx(db incdb.KeyValueWriter, tokenID common.Hash, txHash common.Hash) error {
+	key := GetTokenTxKey(tokenID, txHash)
+	if err := db.Put(key, []byte{}); err != nil {
+		return NewRawdbError(StoreTokenTxError, err)
+	}
+	return nil
+}
+
+func GetTokenTxs(db incdb.Database, tokenID common.Hash) ([]common.Hash, error) {
+	prefix := GetTokenTxPrefix(tokenID)
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	txHashes := []common.Hash{}
+	for iterator.Next() {
+		txHash := common.Hash{}
+		if err := txHash.SetBytes(iterator.Key()[len(prefix):]); err != nil {
+			return nil, NewRawdbError(GetTokenTxError, err)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, NewRawdbError(GetTokenTxError, err)
+	}
+	return txHashes, nil
+}
+
+func DeleteTokenTx(db incdb.KeyValueWriter, tokenID common.Hash, txHash common.Hash) error {
+	key := GetTokenTxKey(tokenID, txHash)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteTokenTxError, err)
+	}
+	return nil
+}
+
 // StoreTxByPublicKey - store txID by public key of receiver,
 // use this data to get tx which send to receiver
 // key format:
 // 1st 33b bytes for pubkey
 // 2nd 32 bytes fir txID which receiver get from
 // 3nd 1 byte for shardID where sender send to receiver
-func StoreTxByPublicKey(db incdb.Database, publicKey []byte, txID common.Hash, shardID byte) error {
+func StoreTxByPublicKey(db incdb.KeyValueWriter, publicKey []byte, txID common.Hash, shardID byte) error {
 	key := GetStoreTxByPublicKey(publicKey, txID, shardID)
 	value := []byte{}
 	if err := db.Put(key, value); err != nil {
@@ -65,6 +101,15 @@ func StoreTxByPublicKey(db incdb.Database, publicKey []byte, txID common.Hash, s
 	return nil
 }
 
+// DeleteTxByPublicKey - delete txID stored by public key of receiver, see StoreTxByPublicKey
+func DeleteTxByPublicKey(db incdb.KeyValueWriter, publicKey []byte, txID common.Hash, shardID byte) error {
+	key := GetStoreTxByPublicKey(publicKey, txID, shardID)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteTxByPublicKeyError, err, txID.String(), publicKey, shardID)
+	}
+	return nil
+}
+
 // GetTxByPublicKey -  from public key, use this function to get list all txID which someone send use by txID from any shardID
 func GetTxByPublicKey(db incdb.Database, publicKey []byte) (map[byte][]common.Hash, error) {
 	iterator := db.NewIteratorWithPrefix(GetStoreTxByPublicPrefix(publicKey))
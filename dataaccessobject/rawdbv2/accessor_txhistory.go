@@ -0,0 +1,133 @@
+package rawdbv2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+const (
+	TxHistoryIn  = byte(0)
+	TxHistoryOut = byte(1)
+
+	TxHistoryCursorSize = 13
+)
+
+// TxHistoryRecord is a transaction sending coins to (in) or spending coins of (out) a public key,
+// TokenIDs are the tokens the public key receives or spends in the transaction
+type TxHistoryRecord struct {
+	Height       uint64 `json:"-"`
+	TxIndex      uint32 `json:"-"`
+	Direction    byte   `json:"-"`
+	TxHash       common.Hash
+	TokenIDs     []common.Hash
+	MetadataType int
+}
+
+func StoreTxHistory(db incdb.KeyValueWriter, publicKey []byte, record *TxHistoryRecord) error {
+	key := GetTxHistoryKey(publicKey, record.Height, record.TxIndex, record.Direction)
+	value, err := json.Marshal(record)
+	if err != nil {
+		return NewRawdbError(StoreTxHistoryError, err)
+	}
+	if err := db.Put(key, value); err != nil {
+		return NewRawdbError(StoreTxHistoryError, err)
+	}
+	return nil
+}
+
+func DeleteTxHistory(db incdb.KeyValueWriter, publicKey []byte, height uint64, txIndex uint32, direction byte) error {
+	key := GetTxHistoryKey(publicKey, height, txIndex, direction)
+	if err := db.Delete(key); err != nil {
+		return NewRawdbError(DeleteTxHistoryError, err)
+	}
+	return nil
+}
+
+// GetTxHistory returns at most limit records of a public key from the newest, which are in [fromHeight, toHeight] (toHeight 0 is no limit)
+// and accepted by filter (nil accepts all). Records start after cursor (nil to start from the newest),
+// the returned cursor is used to get the next records, it is nil when there is no more record
+func GetTxHistory(
+	db incdb.Database,
+	publicKey []byte,
+	cursor []byte,
+	fromHeight uint64,
+	toHeight uint64,
+	limit int,
+	filter func(record *TxHistoryRecord) bool,
+) ([]*TxHistoryRecord, []byte, error) {
+	if limit <= 0 {
+		return nil, nil, NewRawdbError(GetTxHistoryError, fmt.Errorf("limit %+v is invalid", limit))
+	}
+	prefix := GetTxHistoryPrefix(publicKey)
+	start := prefix
+	if toHeight > 0 {
+		start = GetTxHistoryKey(publicKey, toHeight, math.MaxUint32, TxHistoryIn)
+	}
+	if cursor != nil {
+		if len(cursor) != TxHistoryCursorSize {
+			return nil, nil, NewRawdbError(GetTxHistoryError, fmt.Errorf("cursor %+v is invalid", cursor))
+		}
+		if afterCursor := append(GetTxHistoryPrefix(publicKey), cursor...); bytes.Compare(afterCursor, start) > 0 {
+			start = afterCursor
+		}
+	}
+	iterator := db.NewIteratorWithStart(start)
+	defer iterator.Release()
+	records := []*TxHistoryRecord{}
+	var nextCursor []byte
+	for iterator.Next() {
+		key := iterator.Key()
+		if !bytes.HasPrefix(key, prefix) || len(key) != len(prefix)+TxHistoryCursorSize {
+			break
+		}
+		keyCursor := key[len(prefix):]
+		if cursor != nil && bytes.Equal(keyCursor, cursor) {
+			continue
+		}
+		height := math.MaxUint64 - binary.BigEndian.Uint64(keyCursor)
+		if height < fromHeight {
+			break
+		}
+		record := &TxHistoryRecord{}
+		if err := json.Unmarshal(iterator.Value(), record); err != nil {
+			return nil, nil, NewRawdbError(GetTxHistoryError, err)
+		}
+		record.Height = height
+		record.TxIndex = math.MaxUint32 - binary.BigEndian.Uint32(keyCursor[8:])
+		record.Direction = keyCursor[12]
+		if filter != nil && !filter(record) {
+			continue
+		}
+		if len(records) == limit {
+			nextCursor = GetTxHistoryCursor(records[limit-1].Height, records[limit-1].TxIndex, records[limit-1].Direction)
+			break
+		}
+		records = append(records, record)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, nil, NewRawdbError(GetTxHistoryError, err)
+	}
+	return records, nextCursor, nil
+}
+
+// StoreTxHistoryMigrated marks tx history of blocks stored before the index existed is built
+func StoreTxHistoryMigrated(db incdb.KeyValueWriter, shardID byte) error {
+	if err := db.Put(GetTxHistoryMigratedKey(shardID), []byte{1}); err != nil {
+		return NewRawdbError(StoreTxHistoryError, err)
+	}
+	return nil
+}
+
+func HasTxHistoryMigrated(db incdb.KeyValueReader, shardID byte) (bool, error) {
+	has, err := db.Has(GetTxHistoryMigratedKey(shardID))
+	if err != nil {
+		return false, NewRawdbError(GetTxHistoryError, err)
+	}
+	return has, nil
+}
@@ -0,0 +1,123 @@
+package rawdbv2
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// TxReceiptAmount is an amount of a token in a receipt
+type TxReceiptAmount struct {
+	TokenID string
+	Amount  uint64
+}
+
+// TxReceiptEvent is a result of processing a request tx by beacon, from an instruction of a beacon block.
+// It is stored in the beacon database under the request tx hash
+type TxReceiptEvent struct {
+	BeaconHeight    uint64 `json:"-"`
+	InstIndex       uint32 `json:"-"`
+	BeaconBlockHash common.Hash
+	MetadataType    int
+	Status          string
+	Amounts         []TxReceiptAmount
+	RefundedAmounts []TxReceiptAmount
+}
+
+// TxReceiptResponse is a response tx of a request tx, it is stored in the database of the shard of the response tx
+// under the request tx hash
+type TxReceiptResponse struct {
+	ResponseTxHash common.Hash `json:"-"`
+	MetadataType   int
+	ShardID        byte
+	BlockHash      common.Hash
+	BlockHeight    uint64
+	Amounts        []TxReceiptAmount
+}
+
+func StoreTxReceiptEvent(db incdb.KeyValueWriter, reqTxHash common.Hash, event *TxReceiptEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return NewRawdbError(StoreTxReceiptError, err)
+	}
+	if err := db.Put(GetTxReceiptEventKey(reqTxHash, event.BeaconHeight, event.InstIndex), value); err != nil {
+		return NewRawdbError(StoreTxReceiptError, err)
+	}
+	return nil
+}
+
+func DeleteTxReceiptEvent(db incdb.KeyValueWriter, reqTxHash common.Hash, beaconHeight uint64, instIndex uint32) error {
+	if err := db.Delete(GetTxReceiptEventKey(reqTxHash, beaconHeight, instIndex)); err != nil {
+		return NewRawdbError(DeleteTxReceiptError, err)
+	}
+	return nil
+}
+
+// GetTxReceiptEvents returns events of a request tx in beacon height order
+func GetTxReceiptEvents(db incdb.Database, reqTxHash common.Hash) ([]*TxReceiptEvent, error) {
+	prefix := GetTxReceiptEventPrefix(reqTxHash)
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	events := []*TxReceiptEvent{}
+	for iterator.Next() {
+		key := iterator.Key()
+		if len(key) != len(prefix)+12 {
+			continue
+		}
+		event := &TxReceiptEvent{}
+		if err := json.Unmarshal(iterator.Value(), event); err != nil {
+			return nil, NewRawdbError(GetTxReceiptError, err)
+		}
+		event.BeaconHeight = binary.BigEndian.Uint64(key[len(prefix):])
+		event.InstIndex = binary.BigEndian.Uint32(key[len(prefix)+8:])
+		events = append(events, event)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, NewRawdbError(GetTxReceiptError, err)
+	}
+	return events, nil
+}
+
+func StoreTxReceiptResponse(db incdb.KeyValueWriter, reqTxHash common.Hash, response *TxReceiptResponse) error {
+	value, err := json.Marshal(response)
+	if err != nil {
+		return NewRawdbError(StoreTxReceiptError, err)
+	}
+	if err := db.Put(GetTxReceiptResponseKey(reqTxHash, response.ResponseTxHash), value); err != nil {
+		return NewRawdbError(StoreTxReceiptError, err)
+	}
+	return nil
+}
+
+func DeleteTxReceiptResponse(db incdb.KeyValueWriter, reqTxHash common.Hash, responseTxHash common.Hash) error {
+	if err := db.Delete(GetTxReceiptResponseKey(reqTxHash, responseTxHash)); err != nil {
+		return NewRawdbError(DeleteTxReceiptError, err)
+	}
+	return nil
+}
+
+// GetTxReceiptResponses returns response txs of a request tx stored in a shard database
+func GetTxReceiptResponses(db incdb.Database, reqTxHash common.Hash) ([]*TxReceiptResponse, error) {
+	prefix := GetTxReceiptResponsePrefix(reqTxHash)
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	responses := []*TxReceiptResponse{}
+	for iterator.Next() {
+		key := iterator.Key()
+		if len(key) != len(prefix)+common.HashSize {
+			continue
+		}
+		response := &TxReceiptResponse{}
+		if err := json.Unmarshal(iterator.Value(), response); err != nil {
+			return nil, NewRawdbError(GetTxReceiptError, err)
+		}
+		copy(response.ResponseTxHash[:], key[len(prefix):])
+		responses = append(responses, response)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, NewRawdbError(GetTxReceiptError, err)
+	}
+	return responses, nil
+}
@@ -0,0 +1,104 @@
+package rawdbv2
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// ValidatorEvent is a punishment of a validator found in a beacon block
+type ValidatorEvent struct {
+	Type           string
+	PunishedEpochs uint8
+	TxReqID        string
+}
+
+// ValidatorBlockStats is what a block tells about a validator of a committee, it is stored in the database
+// of the chain of the block under the committee public key of the validator and the epoch of the stats
+type ValidatorBlockStats struct {
+	Epoch          uint64      `json:"-"`
+	BlockChainID   int         `json:"-"`
+	BlockHeight    uint64      `json:"-"`
+	BlockHash      common.Hash `json:"-"`
+	ChainID        int
+	BlocksProposed uint64
+	SlotsMissed    uint64
+	VotesCast      uint64
+	VotesExpected  uint64
+	Rewards        []TxReceiptAmount
+	Events         []ValidatorEvent
+}
+
+func StoreValidatorBlockStats(db incdb.KeyValueWriter, committeePublicKey string, stats *ValidatorBlockStats) error {
+	value, err := json.Marshal(stats)
+	if err != nil {
+		return NewRawdbError(StoreValidatorStatsError, err)
+	}
+	key := GetValidatorStatsKey(committeePublicKey, stats.Epoch, stats.BlockChainID, stats.BlockHeight, stats.BlockHash)
+	if err := db.Put(key, value); err != nil {
+		return NewRawdbError(StoreValidatorStatsError, err)
+	}
+	return nil
+}
+
+// GetValidatorBlockStats returns stats of a validator from fromEpoch to toEpoch in epoch order
+func GetValidatorBlockStats(db incdb.Database, committeePublicKey string, fromEpoch uint64, toEpoch uint64) ([]*ValidatorBlockStats, error) {
+	prefix := GetValidatorStatsPrefix(committeePublicKey)
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	res := []*ValidatorBlockStats{}
+	for iterator.Next() {
+		key := iterator.Key()
+		if len(key) != len(prefix)+17+common.HashSize {
+			continue
+		}
+		epoch := binary.BigEndian.Uint64(key[len(prefix):])
+		if epoch < fromEpoch {
+			continue
+		}
+		if epoch > toEpoch {
+			break
+		}
+		stats := &ValidatorBlockStats{}
+		if err := json.Unmarshal(iterator.Value(), stats); err != nil {
+			return nil, NewRawdbError(GetValidatorStatsError, err)
+		}
+		stats.Epoch = epoch
+		stats.BlockChainID = int(int8(key[len(prefix)+8]))
+		stats.BlockHeight = binary.BigEndian.Uint64(key[len(prefix)+9:])
+		copy(stats.BlockHash[:], key[len(prefix)+17:])
+		res = append(res, stats)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, NewRawdbError(GetValidatorStatsError, err)
+	}
+	return res, nil
+}
+
+// DeleteValidatorBlockStatsOfBlocks deletes stats of all validators recorded by blocks of a chain,
+// records are keyed by validator so all stats of the database are scanned
+func DeleteValidatorBlockStatsOfBlocks(db incdb.Database, batch incdb.KeyValueWriter, blockChainID int, blockHashes map[common.Hash]bool) error {
+	iterator := db.NewIteratorWithPrefix(validatorStatsPrefix)
+	defer iterator.Release()
+	keyLen := len(validatorStatsPrefix) + common.HashSize + 17 + common.HashSize
+	for iterator.Next() {
+		key := iterator.Key()
+		if len(key) != keyLen {
+			continue
+		}
+		blockHash := common.Hash{}
+		copy(blockHash[:], key[keyLen-common.HashSize:])
+		if int(int8(key[keyLen-common.HashSize-9])) != blockChainID || !blockHashes[blockHash] {
+			continue
+		}
+		if err := batch.Delete(common.CopyBytes(key)); err != nil {
+			return NewRawdbError(DeleteValidatorStatsError, err)
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return NewRawdbError(DeleteValidatorStatsError, err)
+	}
+	return nil
+}
@@ -83,11 +83,46 @@ const (
 	DeleteTransactionByHashError
 	StoreTxByPublicKeyError
 	GetTxByPublicKeyError
+	StoreTxHistoryError
+	GetTxHistoryError
+	DeleteTxHistoryError
+	StoreTxReceiptError
+	GetTxReceiptError
+	DeleteTxReceiptError
+	StoreValidatorStatsError
+	GetValidatorStatsError
+	StoreTokenTxError
+	GetTokenTxError
+	DeleteTokenTxError
 
 	// relaying - portal
 	StoreRelayingBNBHeaderError
 	GetRelayingBNBHeaderError
 	GetBNBDataHashError
+
+	// peer
+	StorePeerScoresError
+	GetPeerScoresError
+	StoreDirectPeersError
+	GetDirectPeersError
+
+	// rollback
+	StorePreviousBestStateByHeightError
+	GetPreviousBestStateByHeightError
+	DeletePreviousBestStateByHeightError
+	DeleteBeaconBlockIndexError
+	DeleteBeaconRootsHashError
+	DeleteShardBlockIndexError
+	DeleteShardRootsHashError
+	DeleteCrossShardNextHeightError
+	DeleteTxByPublicKeyError
+	DeleteValidatorStatsError
+
+	// reindex
+	StoreReindexCheckpointError
+	GetReindexCheckpointError
+	DeleteReindexCheckpointError
+	DeleteIndexError
 )
 
 var ErrCodeMessage = map[int]struct {
@@ -133,6 +168,17 @@ var ErrCodeMessage = map[int]struct {
 	StoreTxByPublicKeyError:      {-3002, "Store Tx By PublicKey Error"},
 	GetTxByPublicKeyError:        {-3003, "Get Tx By Public Key Error"},
 	DeleteTransactionByHashError: {-3004, "Delete Transaction By Hash Error"},
+	StoreTxHistoryError:          {-3005, "Store Tx History Error"},
+	GetTxHistoryError:            {-3006, "Get Tx History Error"},
+	DeleteTxHistoryError:         {-3007, "Delete Tx History Error"},
+	StoreTxReceiptError:          {-3008, "Store Tx Receipt Error"},
+	GetTxReceiptError:            {-3009, "Get Tx Receipt Error"},
+	DeleteTxReceiptError:         {-3010, "Delete Tx Receipt Error"},
+	StoreValidatorStatsError:     {-3011, "Store Validator Stats Error"},
+	GetValidatorStatsError:       {-3012, "Get Validator Stats Error"},
+	StoreTokenTxError:            {-3013, "Store Token Tx Error"},
+	GetTokenTxError:              {-3014, "Get Token Tx Error"},
+	DeleteTokenTxError:           {-3015, "Delete Token Tx Error"},
 
 	StoreBeaconConsensusRootHashError:       {-4000, "Store Beacon Consensus Root Hash Error"},
 	GetBeaconConsensusRootHashError:         {-4001, "Get Beacon Consensus Root Hash Error"},
@@ -173,6 +219,30 @@ var ErrCodeMessage = map[int]struct {
 	StoreRelayingBNBHeaderError: {-5001, "Store relaying header bnb error"},
 	GetRelayingBNBHeaderError:   {-5002, "Get relaying header bnb error"},
 	GetBNBDataHashError:         {-5003, "Get bnb data hash by block height error"},
+
+	// peer
+	StorePeerScoresError:  {-6000, "Store Peer Scores Error"},
+	GetPeerScoresError:    {-6001, "Get Peer Scores Error"},
+	StoreDirectPeersError: {-6002, "Store Direct Peers Error"},
+	GetDirectPeersError:   {-6003, "Get Direct Peers Error"},
+
+	// rollback
+	StorePreviousBestStateByHeightError:  {-7000, "Store Previous Best State By Height Error"},
+	GetPreviousBestStateByHeightError:    {-7001, "Get Previous Best State By Height Error"},
+	DeletePreviousBestStateByHeightError: {-7002, "Delete Previous Best State By Height Error"},
+	DeleteBeaconBlockIndexError:          {-7003, "Delete Beacon Block Index Error"},
+	DeleteBeaconRootsHashError:           {-7004, "Delete Beacon Roots Hash Error"},
+	DeleteShardBlockIndexError:           {-7005, "Delete Shard Block Index Error"},
+	DeleteShardRootsHashError:            {-7006, "Delete Shard Roots Hash Error"},
+	DeleteCrossShardNextHeightError:      {-7007, "Delete Cross Shard Next Height Error"},
+	DeleteTxByPublicKeyError:             {-7008, "Delete Tx By Public Key Error"},
+	DeleteValidatorStatsError:            {-7009, "Delete Validator Stats Error"},
+
+	// reindex
+	StoreReindexCheckpointError:  {-8000, "Store Reindex Checkpoint Error"},
+	GetReindexCheckpointError:    {-8001, "Get Reindex Checkpoint Error"},
+	DeleteReindexCheckpointError: {-8002, "Delete Reindex Checkpoint Error"},
+	DeleteIndexError:             {-8003, "Delete Index Error"},
 }
 
 type RawdbError struct {
@@ -1,6 +1,9 @@
 package rawdbv2
 
 import (
+	"encoding/binary"
+	"math"
+
 	"github.com/incognitochain/incognito-chain/common"
 )
 
@@ -22,6 +25,7 @@ var (
 	lastBeaconHeightConfirmCrossShard  = []byte("p-c-c-s" + string(splitter))
 	feeEstimatorPrefix                 = []byte("fee-est" + string(splitter))
 	txByPublicKeyPrefix                = []byte("tx-pb")
+	tokenTxPrefix                      = []byte("tx-tk" + string(splitter))
 	rootHashPrefix                     = []byte("R-H-")
 	shardRootHashPrefix                = []byte("S-R-H-")
 	beaconRootHashPrefix               = []byte("B-R-H-")
@@ -35,6 +39,14 @@ var (
 	shardSlashRootHashPrefix           = []byte("s-sl" + string(splitter))
 	shardFeatureRootHashPrefix         = []byte("s-fe" + string(splitter))
 	previousBestStatePrefix            = []byte("previous-best-state" + string(splitter))
+	peerScorePrefix                    = []byte("peer-score" + string(splitter))
+	directPeerPrefix                   = []byte("direct-peer" + string(splitter))
+	reindexCheckpointPrefix            = []byte("reindex-checkpoint" + string(splitter))
+	txHistoryPrefix                    = []byte("tx-hist" + string(splitter))
+	txHistoryMigratedPrefix            = []byte("tx-hist-migrated" + string(splitter))
+	txReceiptEventPrefix               = []byte("tx-rcpt-ev" + string(splitter))
+	txReceiptResponsePrefix            = []byte("tx-rcpt-res" + string(splitter))
+	validatorStatsPrefix               = []byte("validator-stats" + string(splitter))
 	splitter                           = []byte("-[-]-")
 )
 
@@ -151,6 +163,18 @@ func GetFeeEstimatorPrefix(shardID byte) []byte {
 	return append(temp, shardID)
 }
 
+func GetPeerScoreKey() []byte {
+	temp := make([]byte, 0, len(peerScorePrefix))
+	temp = append(temp, peerScorePrefix...)
+	return temp
+}
+
+func GetDirectPeerKey() []byte {
+	temp := make([]byte, 0, len(directPeerPrefix))
+	temp = append(temp, directPeerPrefix...)
+	return temp
+}
+
 func GetStoreTxByPublicKey(publicKey []byte, txID common.Hash, shardID byte) []byte {
 	temp := make([]byte, 0, len(txByPublicKeyPrefix))
 	temp = append(temp, txByPublicKeyPrefix...)
@@ -166,6 +190,84 @@ func GetStoreTxByPublicPrefix(publicKey []byte) []byte {
 	return append(temp, publicKey...)
 }
 
+func GetTokenTxKey(tokenID common.Hash, txHash common.Hash) []byte {
+	return append(GetTokenTxPrefix(tokenID), txHash[:]...)
+}
+
+func GetTokenTxPrefix(tokenID common.Hash) []byte {
+	temp := make([]byte, 0, len(tokenTxPrefix)+2*common.HashSize)
+	temp = append(temp, tokenTxPrefix...)
+	return append(temp, tokenID[:]...)
+}
+
+// GetTxHistoryKey orders records of a public key from the newest block and the last transaction in block
+func GetTxHistoryKey(publicKey []byte, height uint64, txIndex uint32, direction byte) []byte {
+	return append(GetTxHistoryPrefix(publicKey), GetTxHistoryCursor(height, txIndex, direction)...)
+}
+
+func GetTxHistoryPrefix(publicKey []byte) []byte {
+	temp := make([]byte, 0, len(txHistoryPrefix)+len(publicKey)+TxHistoryCursorSize)
+	temp = append(temp, txHistoryPrefix...)
+	return append(temp, publicKey...)
+}
+
+// GetTxHistoryCursor returns the part of a tx history key after the public key
+func GetTxHistoryCursor(height uint64, txIndex uint32, direction byte) []byte {
+	cursor := make([]byte, TxHistoryCursorSize)
+	binary.BigEndian.PutUint64(cursor, math.MaxUint64-height)
+	binary.BigEndian.PutUint32(cursor[8:], math.MaxUint32-txIndex)
+	cursor[12] = direction
+	return cursor
+}
+
+func GetTxHistoryMigratedKey(shardID byte) []byte {
+	temp := make([]byte, 0, len(txHistoryMigratedPrefix)+1)
+	temp = append(temp, txHistoryMigratedPrefix...)
+	return append(temp, shardID)
+}
+
+// GetTxReceiptEventKey orders events of a request tx by beacon height and index of instruction in block
+func GetTxReceiptEventKey(reqTxHash common.Hash, beaconHeight uint64, instIndex uint32) []byte {
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf, beaconHeight)
+	binary.BigEndian.PutUint32(buf[8:], instIndex)
+	return append(GetTxReceiptEventPrefix(reqTxHash), buf...)
+}
+
+func GetTxReceiptEventPrefix(reqTxHash common.Hash) []byte {
+	temp := make([]byte, 0, len(txReceiptEventPrefix)+common.HashSize+12)
+	temp = append(temp, txReceiptEventPrefix...)
+	return append(temp, reqTxHash[:]...)
+}
+
+func GetTxReceiptResponseKey(reqTxHash common.Hash, responseTxHash common.Hash) []byte {
+	return append(GetTxReceiptResponsePrefix(reqTxHash), responseTxHash[:]...)
+}
+
+func GetTxReceiptResponsePrefix(reqTxHash common.Hash) []byte {
+	temp := make([]byte, 0, len(txReceiptResponsePrefix)+2*common.HashSize)
+	temp = append(temp, txReceiptResponsePrefix...)
+	return append(temp, reqTxHash[:]...)
+}
+
+// GetValidatorStatsKey orders records of a validator by epoch, the chain storing the record is kept in the key
+// because a beacon block also records rewards of shard committees
+func GetValidatorStatsKey(committeePublicKey string, epoch uint64, chainID int, height uint64, blockHash common.Hash) []byte {
+	buf := make([]byte, 17, 17+common.HashSize)
+	binary.BigEndian.PutUint64(buf, epoch)
+	buf[8] = byte(chainID)
+	binary.BigEndian.PutUint64(buf[9:], height)
+	buf = append(buf, blockHash[:]...)
+	return append(GetValidatorStatsPrefix(committeePublicKey), buf...)
+}
+
+func GetValidatorStatsPrefix(committeePublicKey string) []byte {
+	keyHash := common.HashH([]byte(committeePublicKey))
+	temp := make([]byte, 0, len(validatorStatsPrefix)+2*common.HashSize+17)
+	temp = append(temp, validatorStatsPrefix...)
+	return append(temp, keyHash[:]...)
+}
+
 // ============================= Cross Shard =======================================
 func GetCrossShardNextHeightKey(fromShard byte, toShard byte, height uint64) []byte {
 	buf := common.Uint64ToBytes(height)
@@ -308,6 +410,52 @@ func GetPreviousBestStateKey(shardID int) []byte {
 	return append(temp, byte(shardID))
 }
 
+// GetPreviousBestStateByHeightKey returns key of the best state of a finalized height, shardID is -1 for beacon
+func GetPreviousBestStateByHeightKey(shardID int, height uint64) []byte {
+	key := GetPreviousBestStateKey(shardID)
+	key = append(key, splitter...)
+	return append(key, common.Uint64ToBytes(height)...)
+}
+
+// GetReindexCheckpointKey returns key of the checkpoint of an index rebuilt from blocks of a chain, chainID is -1 for beacon
+func GetReindexCheckpointKey(index string, chainID int) []byte {
+	temp := make([]byte, 0, len(reindexCheckpointPrefix))
+	temp = append(temp, reindexCheckpointPrefix...)
+	key := append(temp, []byte(index)...)
+	key = append(key, splitter...)
+	return append(key, byte(chainID))
+}
+
+// ============================= Reindex =======================================
+// prefixes of all keys of secondary indexes, an index is cleared with its prefix before it is rebuilt from blocks
+func GetTransactionHashIndexPrefix() []byte {
+	return append([]byte{}, txHashPrefix...)
+}
+
+func GetTxByPublicKeyIndexPrefix() []byte {
+	return append([]byte{}, txByPublicKeyPrefix...)
+}
+
+func GetTokenTxIndexPrefix() []byte {
+	return append([]byte{}, tokenTxPrefix...)
+}
+
+func GetTxHistoryIndexPrefix() []byte {
+	return append([]byte{}, txHistoryPrefix...)
+}
+
+func GetTxReceiptEventIndexPrefix() []byte {
+	return append([]byte{}, txReceiptEventPrefix...)
+}
+
+func GetTxReceiptResponseIndexPrefix() []byte {
+	return append([]byte{}, txReceiptResponsePrefix...)
+}
+
+func GetCrossShardNextHeightIndexPrefix() []byte {
+	return append([]byte{}, crossShardNextHeightPrefix...)
+}
+
 func GetLastBeaconHeightConfirmCrossShardKey() []byte {
 	temp := make([]byte, 0, len(lastBeaconHeightConfirmCrossShard))
 	temp = append(temp, lastBeaconHeightConfirmCrossShard...)
@@ -0,0 +1,34 @@
+package statedb
+
+import (
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+func StoreAutoWithdrawPolicy(stateDB *StateDB, receiver privacy.PaymentAddress, interval uint64, threshold uint64) error {
+	key := GenerateAutoWithdrawPolicyObjectKey(receiver.Pk)
+	value := NewAutoWithdrawPolicyStateWithValue(receiver, interval, threshold)
+	if err := stateDB.SetStateObject(AutoWithdrawPolicyObjectType, key, value); err != nil {
+		return NewStatedbError(StoreAutoWithdrawPolicyError, err)
+	}
+	return nil
+}
+
+func GetAutoWithdrawPolicy(stateDB *StateDB, receiverPk []byte) (*AutoWithdrawPolicyState, bool, error) {
+	policy, has, err := stateDB.getAutoWithdrawPolicyState(GenerateAutoWithdrawPolicyObjectKey(receiverPk))
+	if err != nil {
+		return nil, false, NewStatedbError(GetAutoWithdrawPolicyError, err)
+	}
+	return policy, has, nil
+}
+
+func GetAllAutoWithdrawPolicies(stateDB *StateDB) ([]*AutoWithdrawPolicyState, error) {
+	policies, err := stateDB.getAllAutoWithdrawPolicyStates()
+	if err != nil {
+		return nil, NewStatedbError(GetAutoWithdrawPolicyError, err)
+	}
+	return policies, nil
+}
+
+func DeleteAutoWithdrawPolicy(stateDB *StateDB, receiverPk []byte) {
+	stateDB.MarkDeleteStateObject(AutoWithdrawPolicyObjectType, GenerateAutoWithdrawPolicyObjectKey(receiverPk))
+}
@@ -418,6 +418,28 @@ func GetStakerInfo(stateDB *StateDB, stakerPubkey string) (*StakerInfo, bool, er
 	return stateDB.getStakerInfo(key)
 }
 
+// StoreStakingAmount records the amount of the staking tx of a staker, it returns false if the staker info
+// is not of this staking tx (e.g. the stake was rejected by beacon)
+func StoreStakingAmount(stateDB *StateDB, stakerPubkey string, txStakingID common.Hash, stakingAmount uint64) (bool, error) {
+	pubKey := incognitokey.NewCommitteePublicKey()
+	err := pubKey.FromString(stakerPubkey)
+	if err != nil {
+		return false, err
+	}
+	pubKeyBytes, _ := pubKey.RawBytes()
+	key := GetStakerInfoKey(pubKeyBytes)
+	stakerInfo, has, err := stateDB.getStakerInfo(key)
+	if err != nil {
+		return false, err
+	}
+	if !has || stakerInfo.TxStakingID() != txStakingID {
+		return false, nil
+	}
+	value := *stakerInfo
+	value.SetStakingAmount(stakingAmount)
+	return true, stateDB.SetStateObject(StakerObjectType, key, &value)
+}
+
 func deleteCommittee(stateDB *StateDB, shardID int, role int, committees []incognitokey.CommitteePublicKey) error {
 	for _, committee := range committees {
 		key, err := GenerateCommitteeObjectKeyWithRole(role, shardID, committee)
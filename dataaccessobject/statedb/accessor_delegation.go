@@ -0,0 +1,159 @@
+package statedb
+
+import (
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+func getCommitteePublicKeyRawBytes(committeePublicKey string) ([]byte, error) {
+	pubKey := incognitokey.NewCommitteePublicKey()
+	if err := pubKey.FromString(committeePublicKey); err != nil {
+		return nil, err
+	}
+	return pubKey.RawBytes()
+}
+
+// StoreStakingPool create the staking pool of a validator, or update the operator of an existing pool and change
+// its commission from epoch changeEpoch, total delegated amount is kept
+func StoreStakingPool(stateDB *StateDB, committeePublicKey string, operator privacy.PaymentAddress, commission uint64, currentEpoch uint64, changeEpoch uint64) error {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return NewStatedbError(StoreStakingPoolError, err)
+	}
+	key := GenerateStakingPoolObjectKey(pubKeyBytes)
+	pool, has, err := stateDB.getStakingPoolState(key)
+	if err != nil {
+		return NewStatedbError(StoreStakingPoolError, err)
+	}
+	value := NewStakingPoolStateWithValue(committeePublicKey, operator, commission, 0)
+	if has {
+		value.SetCommission(pool.CommissionAt(currentEpoch))
+		value.SetTotalDelegated(pool.TotalDelegated())
+		value.SetPendingCommission(commission, changeEpoch)
+	}
+	if err := stateDB.SetStateObject(StakingPoolObjectType, key, value); err != nil {
+		return NewStatedbError(StoreStakingPoolError, err)
+	}
+	return nil
+}
+
+func GetStakingPool(stateDB *StateDB, committeePublicKey string) (*StakingPoolState, bool, error) {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return nil, false, NewStatedbError(GetStakingPoolError, err)
+	}
+	pool, has, err := stateDB.getStakingPoolState(GenerateStakingPoolObjectKey(pubKeyBytes))
+	if err != nil {
+		return nil, false, NewStatedbError(GetStakingPoolError, err)
+	}
+	return pool, has, nil
+}
+
+func GetAllStakingPools(stateDB *StateDB) ([]*StakingPoolState, error) {
+	pools, err := stateDB.getAllStakingPoolStates()
+	if err != nil {
+		return nil, NewStatedbError(GetStakingPoolError, err)
+	}
+	return pools, nil
+}
+
+// AddDelegation add amount to the delegation of delegator in the pool of a validator and to the pool total
+func AddDelegation(stateDB *StateDB, committeePublicKey string, delegator privacy.PaymentAddress, amount uint64) error {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	poolKey := GenerateStakingPoolObjectKey(pubKeyBytes)
+	pool, has, err := stateDB.getStakingPoolState(poolKey)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	if !has {
+		return NewStatedbError(StoreDelegationError, fmt.Errorf("staking pool of %+v not found", committeePublicKey))
+	}
+	key := GenerateDelegationObjectKey(pubKeyBytes, delegator.Pk)
+	delegation, has, err := stateDB.getDelegationState(key)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	delegated := amount
+	if has {
+		delegated += delegation.Amount()
+	}
+	value := NewDelegationStateWithValue(committeePublicKey, delegator, delegated)
+	if err := stateDB.SetStateObject(DelegationObjectType, key, value); err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	newPool := *pool
+	newPool.SetTotalDelegated(pool.TotalDelegated() + amount)
+	if err := stateDB.SetStateObject(StakingPoolObjectType, poolKey, &newPool); err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	return nil
+}
+
+// RemoveDelegation subtract amount from the delegation of delegator in the pool of a validator and from the pool total,
+// the delegation is deleted when nothing is left
+func RemoveDelegation(stateDB *StateDB, committeePublicKey string, delegator privacy.PaymentAddress, amount uint64) error {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	poolKey := GenerateStakingPoolObjectKey(pubKeyBytes)
+	pool, has, err := stateDB.getStakingPoolState(poolKey)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	if !has {
+		return NewStatedbError(StoreDelegationError, fmt.Errorf("staking pool of %+v not found", committeePublicKey))
+	}
+	key := GenerateDelegationObjectKey(pubKeyBytes, delegator.Pk)
+	delegation, has, err := stateDB.getDelegationState(key)
+	if err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	if !has || delegation.Amount() < amount || pool.TotalDelegated() < amount {
+		return NewStatedbError(StoreDelegationError, fmt.Errorf("delegation of %+v to %+v is less than %+v", delegator.String(), committeePublicKey, amount))
+	}
+	if delegation.Amount() == amount {
+		stateDB.MarkDeleteStateObject(DelegationObjectType, key)
+	} else {
+		value := NewDelegationStateWithValue(committeePublicKey, delegator, delegation.Amount()-amount)
+		if err := stateDB.SetStateObject(DelegationObjectType, key, value); err != nil {
+			return NewStatedbError(StoreDelegationError, err)
+		}
+	}
+	newPool := *pool
+	newPool.SetTotalDelegated(pool.TotalDelegated() - amount)
+	if err := stateDB.SetStateObject(StakingPoolObjectType, poolKey, &newPool); err != nil {
+		return NewStatedbError(StoreDelegationError, err)
+	}
+	return nil
+}
+
+func GetDelegation(stateDB *StateDB, committeePublicKey string, delegatorPublicKey []byte) (*DelegationState, bool, error) {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return nil, false, NewStatedbError(GetDelegationError, err)
+	}
+	delegation, has, err := stateDB.getDelegationState(GenerateDelegationObjectKey(pubKeyBytes, delegatorPublicKey))
+	if err != nil {
+		return nil, false, NewStatedbError(GetDelegationError, err)
+	}
+	return delegation, has, nil
+}
+
+// GetDelegations returns all delegations to the pool of a validator
+func GetDelegations(stateDB *StateDB, committeePublicKey string) ([]*DelegationState, error) {
+	pubKeyBytes, err := getCommitteePublicKeyRawBytes(committeePublicKey)
+	if err != nil {
+		return nil, NewStatedbError(GetDelegationError, err)
+	}
+	delegations, err := stateDB.getAllDelegationStates(GetDelegationPrefix(pubKeyBytes))
+	if err != nil {
+		return nil, NewStatedbError(GetDelegationError, err)
+	}
+	return delegations, nil
+}
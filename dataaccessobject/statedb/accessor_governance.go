@@ -0,0 +1,62 @@
+package statedb
+
+import (
+	"sort"
+
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+func StoreGovernanceProposal(stateDB *StateDB, proposal *GovernanceProposalState) error {
+	key := GenerateGovernanceProposalObjectKey(proposal.ProposalID())
+	if err := stateDB.SetStateObject(GovernanceProposalObjectType, key, proposal); err != nil {
+		return NewStatedbError(StoreGovernanceProposalError, err)
+	}
+	return nil
+}
+
+func GetGovernanceProposal(stateDB *StateDB, proposalID common.Hash) (*GovernanceProposalState, bool, error) {
+	proposal, has, err := stateDB.getGovernanceProposalState(GenerateGovernanceProposalObjectKey(proposalID))
+	if err != nil {
+		return nil, false, NewStatedbError(GetGovernanceProposalError, err)
+	}
+	return proposal, has, nil
+}
+
+// GetAllGovernanceProposals returns proposals in order of voting end height then proposal id
+func GetAllGovernanceProposals(stateDB *StateDB) ([]*GovernanceProposalState, error) {
+	proposals, err := stateDB.getAllGovernanceProposalStates()
+	if err != nil {
+		return nil, NewStatedbError(GetGovernanceProposalError, err)
+	}
+	sort.Slice(proposals, func(i, j int) bool {
+		if proposals[i].VotingEndHeight() != proposals[j].VotingEndHeight() {
+			return proposals[i].VotingEndHeight() < proposals[j].VotingEndHeight()
+		}
+		proposalIDi, proposalIDj := proposals[i].ProposalID(), proposals[j].ProposalID()
+		return proposalIDi.String() < proposalIDj.String()
+	})
+	return proposals, nil
+}
+
+// AddGovernanceParamActivation stores a value of a chain param activated from a beacon height
+func AddGovernanceParamActivation(stateDB *StateDB, name string, activation GovernanceParamActivation) error {
+	key := GenerateGovernanceParamObjectKey(name)
+	param, _, err := stateDB.getGovernanceParamState(key)
+	if err != nil {
+		return NewStatedbError(StoreGovernanceParamError, err)
+	}
+	param.SetName(name)
+	param.AddActivation(activation)
+	if err := stateDB.SetStateObject(GovernanceParamObjectType, key, param); err != nil {
+		return NewStatedbError(StoreGovernanceParamError, err)
+	}
+	return nil
+}
+
+func GetGovernanceParam(stateDB *StateDB, name string) (*GovernanceParamState, bool, error) {
+	param, has, err := stateDB.getGovernanceParamState(GenerateGovernanceParamObjectKey(name))
+	if err != nil {
+		return nil, false, NewStatedbError(GetGovernanceParamError, err)
+	}
+	return param, has, nil
+}
@@ -0,0 +1,47 @@
+package statedb
+
+import (
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+func StoreUnbonding(stateDB *StateDB, unbonding *UnbondingState) error {
+	key := GenerateUnbondingObjectKey(unbonding.TxReqID())
+	if err := stateDB.SetStateObject(UnbondingObjectType, key, unbonding); err != nil {
+		return NewStatedbError(StoreUnbondingError, err)
+	}
+	return nil
+}
+
+func GetUnbonding(stateDB *StateDB, txReqID common.Hash) (*UnbondingState, bool, error) {
+	unbonding, has, err := stateDB.getUnbondingState(GenerateUnbondingObjectKey(txReqID))
+	if err != nil {
+		return nil, false, NewStatedbError(GetUnbondingError, err)
+	}
+	return unbonding, has, nil
+}
+
+func GetAllUnbondings(stateDB *StateDB) ([]*UnbondingState, error) {
+	unbondings, err := stateDB.getAllUnbondingStates()
+	if err != nil {
+		return nil, NewStatedbError(GetUnbondingError, err)
+	}
+	return unbondings, nil
+}
+
+// GetValidatorUnbonding returns the unbonding stake of an unstaked validator
+func GetValidatorUnbonding(stateDB *StateDB, committeePublicKey string) (*UnbondingState, bool, error) {
+	unbondings, err := GetAllUnbondings(stateDB)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, unbonding := range unbondings {
+		if !unbonding.IsDelegation() && unbonding.CommitteePublicKey() == committeePublicKey {
+			return unbonding, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func DeleteUnbonding(stateDB *StateDB, txReqID common.Hash) {
+	stateDB.MarkDeleteStateObject(UnbondingObjectType, GenerateUnbondingObjectKey(txReqID))
+}
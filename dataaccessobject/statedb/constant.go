@@ -52,6 +52,18 @@ const (
 	PortalExternalTxObjectType
 	PortalConfirmProofObjectType
 	PortalUnlockOverRateCollaterals
+
+	// delegation
+	StakingPoolObjectType
+	DelegationObjectType
+	UnbondingObjectType
+
+	// reward
+	AutoWithdrawPolicyObjectType
+
+	// governance
+	GovernanceProposalObjectType
+	GovernanceParamObjectType
 )
 
 // Prefix length
@@ -45,6 +45,12 @@ const (
 	ErrInvalidBlockHashType                      = "invalid block hash type"
 	ErrInvalidPortalExternalTxStateType          = "invalid portal external tx state type"
 	ErrInvalidPortalConfirmProofStateType        = "invalid portal confirm proof state type"
+	ErrInvalidStakingPoolStateType               = "invalid staking pool state type"
+	ErrInvalidDelegationStateType                = "invalid delegation state type"
+	ErrInvalidUnbondingStateType                 = "invalid unbonding state type"
+	ErrInvalidAutoWithdrawPolicyStateType        = "invalid auto withdraw policy state type"
+	ErrInvalidGovernanceProposalStateType        = "invalid governance proposal state type"
+	ErrInvalidGovernanceParamStateType           = "invalid governance param state type"
 )
 const (
 	InvalidByteArrayTypeError = iota
@@ -192,6 +198,24 @@ const (
 	GetWithdrawCollateralConfirmError
 	StorePortalUnlockOverRateCollateralsError
 	GetPortalUnlockOverRateCollateralsStatusError
+
+	// delegation
+	StoreStakingPoolError
+	GetStakingPoolError
+	StoreDelegationError
+	GetDelegationError
+	StoreUnbondingError
+	GetUnbondingError
+
+	// reward
+	StoreAutoWithdrawPolicyError
+	GetAutoWithdrawPolicyError
+
+	// governance
+	StoreGovernanceProposalError
+	GetGovernanceProposalError
+	StoreGovernanceParamError
+	GetGovernanceParamError
 )
 
 var ErrCodeMessage = map[int]struct {
@@ -335,6 +359,21 @@ var ErrCodeMessage = map[int]struct {
 	GetAllRewardFeatureError:             {-15002, "Get all reward feature state error"},
 	GetRewardFeatureAmountByTokenIDError: {-15004, "Get reward feature amount by tokenID error"},
 	InvalidStakerInfoTypeError:           {-15005, "Staker info invalid"},
+	// delegation
+	StoreStakingPoolError: {-16000, "Store staking pool error"},
+	GetStakingPoolError:   {-16001, "Get staking pool error"},
+	StoreDelegationError:  {-16002, "Store delegation error"},
+	GetDelegationError:    {-16003, "Get delegation error"},
+	StoreUnbondingError:   {-16004, "Store unbonding error"},
+	GetUnbondingError:     {-16005, "Get unbonding error"},
+	// reward
+	StoreAutoWithdrawPolicyError: {-16100, "Store auto withdraw policy error"},
+	GetAutoWithdrawPolicyError:   {-16101, "Get auto withdraw policy error"},
+	// governance
+	StoreGovernanceProposalError: {-16200, "Store governance proposal error"},
+	GetGovernanceProposalError:   {-16201, "Get governance proposal error"},
+	StoreGovernanceParamError:    {-16202, "Store governance param error"},
+	GetGovernanceParamError:      {-16203, "Get governance param error"},
 }
 
 type StatedbError struct {
@@ -43,6 +43,12 @@ var (
 	bridgeStatusPrefix                 = []byte("bri-status-")
 	burnPrefix                         = []byte("burn-")
 	stakerInfoPrefix                   = common.HashB([]byte("stk-info-"))[:prefixHashKeyLength]
+	stakingPoolPrefix                  = []byte("stk-pool-")
+	delegationPrefix                   = []byte("stk-delegation-")
+	unbondingPrefix                    = []byte("stk-unbonding-")
+	autoWithdrawPolicyPrefix           = []byte("auto-withdraw-policy-")
+	governanceProposalPrefix           = []byte("gov-proposal-")
+	governanceParamPrefix              = []byte("gov-param-")
 
 	// portal
 	portalFinaExchangeRatesStatePrefix                   = []byte("portalfinalexchangeratesstate-")
@@ -141,6 +147,36 @@ func GetStakerInfoKey(stakerPublicKey []byte) common.Hash {
 	return *finalHash
 }
 
+func GetStakingPoolPrefix() []byte {
+	h := common.HashH(stakingPoolPrefix)
+	return h[:][:prefixHashKeyLength]
+}
+
+func GetDelegationPrefix(committeePublicKeyBytes []byte) []byte {
+	h := common.HashH(append(delegationPrefix, committeePublicKeyBytes...))
+	return h[:][:prefixHashKeyLength]
+}
+
+func GetUnbondingPrefix() []byte {
+	h := common.HashH(unbondingPrefix)
+	return h[:][:prefixHashKeyLength]
+}
+
+func GetAutoWithdrawPolicyPrefix() []byte {
+	h := common.HashH(autoWithdrawPolicyPrefix)
+	return h[:][:prefixHashKeyLength]
+}
+
+func GetGovernanceProposalPrefix() []byte {
+	h := common.HashH(governanceProposalPrefix)
+	return h[:][:prefixHashKeyLength]
+}
+
+func GetGovernanceParamPrefix() []byte {
+	h := common.HashH(governanceParamPrefix)
+	return h[:][:prefixHashKeyLength]
+}
+
 func GetCommitteeRewardPrefix() []byte {
 	h := common.HashH(committeeRewardPrefix)
 	return h[:][:prefixHashKeyLength]
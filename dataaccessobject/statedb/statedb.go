@@ -1690,3 +1690,159 @@ func (stateDB *StateDB) getPortalConfirmProofState(key common.Hash) (*PortalConf
 	}
 	return NewPortalConfirmProofState(), false, nil
 }
+
+// ================================= Staking pool OBJECT =======================================
+func (stateDB *StateDB) getStakingPoolState(key common.Hash) (*StakingPoolState, bool, error) {
+	stakingPoolState, err := stateDB.getStateObject(StakingPoolObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if stakingPoolState != nil {
+		return stakingPoolState.GetValue().(*StakingPoolState), true, nil
+	}
+	return NewStakingPoolState(), false, nil
+}
+
+func (stateDB *StateDB) getAllStakingPoolStates() ([]*StakingPoolState, error) {
+	res := []*StakingPoolState{}
+	temp := stateDB.trie.NodeIterator(GetStakingPoolPrefix())
+	it := trie.NewIterator(temp)
+	for it.Next() {
+		value := it.Value
+		newValue := make([]byte, len(value))
+		copy(newValue, value)
+		stakingPoolState := NewStakingPoolState()
+		if err := json.Unmarshal(newValue, stakingPoolState); err != nil {
+			return nil, err
+		}
+		res = append(res, stakingPoolState)
+	}
+	return res, nil
+}
+
+// ================================= Delegation OBJECT =======================================
+func (stateDB *StateDB) getDelegationState(key common.Hash) (*DelegationState, bool, error) {
+	delegationState, err := stateDB.getStateObject(DelegationObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if delegationState != nil {
+		return delegationState.GetValue().(*DelegationState), true, nil
+	}
+	return NewDelegationState(), false, nil
+}
+
+func (stateDB *StateDB) getAllDelegationStates(prefix []byte) ([]*DelegationState, error) {
+	res := []*DelegationState{}
+	temp := stateDB.trie.NodeIterator(prefix)
+	it := trie.NewIterator(temp)
+	for it.Next() {
+		value := it.Value
+		newValue := make([]byte, len(value))
+		copy(newValue, value)
+		delegationState := NewDelegationState()
+		if err := json.Unmarshal(newValue, delegationState); err != nil {
+			return nil, err
+		}
+		res = append(res, delegationState)
+	}
+	return res, nil
+}
+
+// ================================= Unbonding OBJECT =======================================
+func (stateDB *StateDB) getUnbondingState(key common.Hash) (*UnbondingState, bool, error) {
+	unbondingState, err := stateDB.getStateObject(UnbondingObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if unbondingState != nil {
+		return unbondingState.GetValue().(*UnbondingState), true, nil
+	}
+	return NewUnbondingState(), false, nil
+}
+
+func (stateDB *StateDB) getAllUnbondingStates() ([]*UnbondingState, error) {
+	res := []*UnbondingState{}
+	temp := stateDB.trie.NodeIterator(GetUnbondingPrefix())
+	it := trie.NewIterator(temp)
+	for it.Next() {
+		value := it.Value
+		newValue := make([]byte, len(value))
+		copy(newValue, value)
+		unbondingState := NewUnbondingState()
+		if err := json.Unmarshal(newValue, unbondingState); err != nil {
+			return nil, err
+		}
+		res = append(res, unbondingState)
+	}
+	return res, nil
+}
+
+// ================================= Auto Withdraw Policy OBJECT =======================================
+func (stateDB *StateDB) getAutoWithdrawPolicyState(key common.Hash) (*AutoWithdrawPolicyState, bool, error) {
+	autoWithdrawPolicyState, err := stateDB.getStateObject(AutoWithdrawPolicyObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if autoWithdrawPolicyState != nil {
+		return autoWithdrawPolicyState.GetValue().(*AutoWithdrawPolicyState), true, nil
+	}
+	return NewAutoWithdrawPolicyState(), false, nil
+}
+
+func (stateDB *StateDB) getAllAutoWithdrawPolicyStates() ([]*AutoWithdrawPolicyState, error) {
+	res := []*AutoWithdrawPolicyState{}
+	temp := stateDB.trie.NodeIterator(GetAutoWithdrawPolicyPrefix())
+	it := trie.NewIterator(temp)
+	for it.Next() {
+		value := it.Value
+		newValue := make([]byte, len(value))
+		copy(newValue, value)
+		autoWithdrawPolicyState := NewAutoWithdrawPolicyState()
+		if err := json.Unmarshal(newValue, autoWithdrawPolicyState); err != nil {
+			return nil, err
+		}
+		res = append(res, autoWithdrawPolicyState)
+	}
+	return res, nil
+}
+
+// ================================= Governance OBJECT =======================================
+func (stateDB *StateDB) getGovernanceProposalState(key common.Hash) (*GovernanceProposalState, bool, error) {
+	governanceProposalState, err := stateDB.getStateObject(GovernanceProposalObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if governanceProposalState != nil {
+		return governanceProposalState.GetValue().(*GovernanceProposalState), true, nil
+	}
+	return NewGovernanceProposalState(), false, nil
+}
+
+func (stateDB *StateDB) getAllGovernanceProposalStates() ([]*GovernanceProposalState, error) {
+	res := []*GovernanceProposalState{}
+	temp := stateDB.trie.NodeIterator(GetGovernanceProposalPrefix())
+	it := trie.NewIterator(temp)
+	for it.Next() {
+		value := it.Value
+		newValue := make([]byte, len(value))
+		copy(newValue, value)
+		governanceProposalState := NewGovernanceProposalState()
+		if err := json.Unmarshal(newValue, governanceProposalState); err != nil {
+			return nil, err
+		}
+		res = append(res, governanceProposalState)
+	}
+	return res, nil
+}
+
+func (stateDB *StateDB) getGovernanceParamState(key common.Hash) (*GovernanceParamState, bool, error) {
+	governanceParamState, err := stateDB.getStateObject(GovernanceParamObjectType, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if governanceParamState != nil {
+		return governanceParamState.GetValue().(*GovernanceParamState), true, nil
+	}
+	return NewGovernanceParamState(), false, nil
+}
@@ -91,6 +91,18 @@ func newStateObjectWithValue(db *StateDB, objectType int, hash common.Hash, valu
 		return newPortalConfirmProofStateObjectWithValue(db, hash, value)
 	case StakerObjectType:
 		return newStakerObjectWithValue(db, hash, value)
+	case StakingPoolObjectType:
+		return newStakingPoolObjectWithValue(db, hash, value)
+	case DelegationObjectType:
+		return newDelegationObjectWithValue(db, hash, value)
+	case UnbondingObjectType:
+		return newUnbondingObjectWithValue(db, hash, value)
+	case AutoWithdrawPolicyObjectType:
+		return newAutoWithdrawPolicyObjectWithValue(db, hash, value)
+	case GovernanceProposalObjectType:
+		return newGovernanceProposalObjectWithValue(db, hash, value)
+	case GovernanceParamObjectType:
+		return newGovernanceParamObjectWithValue(db, hash, value)
 	default:
 		panic("state object type not exist")
 	}
@@ -162,6 +174,18 @@ func newStateObject(db *StateDB, objectType int, hash common.Hash) StateObject {
 		return newPortalConfirmProofStateObject(db, hash)
 	case StakerObjectType:
 		return newStakerObject(db, hash)
+	case StakingPoolObjectType:
+		return newStakingPoolObject(db, hash)
+	case DelegationObjectType:
+		return newDelegationObject(db, hash)
+	case UnbondingObjectType:
+		return newUnbondingObject(db, hash)
+	case AutoWithdrawPolicyObjectType:
+		return newAutoWithdrawPolicyObject(db, hash)
+	case GovernanceProposalObjectType:
+		return newGovernanceProposalObject(db, hash)
+	case GovernanceParamObjectType:
+		return newGovernanceParamObject(db, hash)
 	default:
 		panic("state object type not exist")
 	}
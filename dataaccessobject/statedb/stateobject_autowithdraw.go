@@ -0,0 +1,220 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// AutoWithdrawPolicyState is the auto withdraw policy of a reward receiver,
+// its PRV reward is withdrawn every interval epochs (every epoch if interval is 0) once it reaches threshold
+type AutoWithdrawPolicyState struct {
+	receiver  privacy.PaymentAddress
+	interval  uint64
+	threshold uint64
+}
+
+func NewAutoWithdrawPolicyState() *AutoWithdrawPolicyState {
+	return &AutoWithdrawPolicyState{}
+}
+
+func NewAutoWithdrawPolicyStateWithValue(receiver privacy.PaymentAddress, interval uint64, threshold uint64) *AutoWithdrawPolicyState {
+	return &AutoWithdrawPolicyState{
+		receiver:  receiver,
+		interval:  interval,
+		threshold: threshold,
+	}
+}
+
+func (s AutoWithdrawPolicyState) Receiver() privacy.PaymentAddress {
+	return s.receiver
+}
+
+func (s *AutoWithdrawPolicyState) SetReceiver(receiver privacy.PaymentAddress) {
+	s.receiver = receiver
+}
+
+func (s AutoWithdrawPolicyState) Interval() uint64 {
+	return s.interval
+}
+
+func (s *AutoWithdrawPolicyState) SetInterval(interval uint64) {
+	s.interval = interval
+}
+
+func (s AutoWithdrawPolicyState) Threshold() uint64 {
+	return s.threshold
+}
+
+func (s *AutoWithdrawPolicyState) SetThreshold(threshold uint64) {
+	s.threshold = threshold
+}
+
+func (s AutoWithdrawPolicyState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		Receiver  privacy.PaymentAddress
+		Interval  uint64
+		Threshold uint64
+	}{
+		Receiver:  s.receiver,
+		Interval:  s.interval,
+		Threshold: s.threshold,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *AutoWithdrawPolicyState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		Receiver  privacy.PaymentAddress
+		Interval  uint64
+		Threshold uint64
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.receiver = temp.Receiver
+	s.interval = temp.Interval
+	s.threshold = temp.Threshold
+	return nil
+}
+
+type AutoWithdrawPolicyObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version                 int
+	autoWithdrawPolicyHash  common.Hash
+	autoWithdrawPolicyState *AutoWithdrawPolicyState
+	objectType              int
+	deleted                 bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newAutoWithdrawPolicyObject(db *StateDB, hash common.Hash) *AutoWithdrawPolicyObject {
+	return &AutoWithdrawPolicyObject{
+		version:                 defaultVersion,
+		db:                      db,
+		autoWithdrawPolicyHash:  hash,
+		autoWithdrawPolicyState: NewAutoWithdrawPolicyState(),
+		objectType:              AutoWithdrawPolicyObjectType,
+		deleted:                 false,
+	}
+}
+
+func newAutoWithdrawPolicyObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*AutoWithdrawPolicyObject, error) {
+	var newAutoWithdrawPolicyState = NewAutoWithdrawPolicyState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newAutoWithdrawPolicyState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newAutoWithdrawPolicyState, ok = data.(*AutoWithdrawPolicyState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidAutoWithdrawPolicyStateType, reflect.TypeOf(data))
+		}
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newAutoWithdrawPolicyState.receiver); err != nil {
+		return nil, fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	return &AutoWithdrawPolicyObject{
+		version:                 defaultVersion,
+		autoWithdrawPolicyHash:  key,
+		autoWithdrawPolicyState: newAutoWithdrawPolicyState,
+		db:                      db,
+		objectType:              AutoWithdrawPolicyObjectType,
+		deleted:                 false,
+	}, nil
+}
+
+// GenerateAutoWithdrawPolicyObjectKey returns the key of the auto withdraw policy of a reward receiver
+func GenerateAutoWithdrawPolicyObjectKey(receiverPk []byte) common.Hash {
+	prefixHash := GetAutoWithdrawPolicyPrefix()
+	valueHash := common.HashH(receiverPk)
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c AutoWithdrawPolicyObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *AutoWithdrawPolicyObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c AutoWithdrawPolicyObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *AutoWithdrawPolicyObject) SetValue(data interface{}) error {
+	newAutoWithdrawPolicyState, ok := data.(*AutoWithdrawPolicyState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidAutoWithdrawPolicyStateType, reflect.TypeOf(data))
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newAutoWithdrawPolicyState.receiver); err != nil {
+		return fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	c.autoWithdrawPolicyState = newAutoWithdrawPolicyState
+	return nil
+}
+
+func (c AutoWithdrawPolicyObject) GetValue() interface{} {
+	return c.autoWithdrawPolicyState
+}
+
+func (c AutoWithdrawPolicyObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal auto withdraw policy state")
+	}
+	return value
+}
+
+func (c AutoWithdrawPolicyObject) GetHash() common.Hash {
+	return c.autoWithdrawPolicyHash
+}
+
+func (c AutoWithdrawPolicyObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *AutoWithdrawPolicyObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all auto withdraw policy value into default value
+func (c *AutoWithdrawPolicyObject) Reset() bool {
+	c.autoWithdrawPolicyState = NewAutoWithdrawPolicyState()
+	return true
+}
+
+func (c AutoWithdrawPolicyObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c AutoWithdrawPolicyObject) IsEmpty() bool {
+	temp := NewAutoWithdrawPolicyState()
+	return reflect.DeepEqual(temp, c.autoWithdrawPolicyState) || c.autoWithdrawPolicyState == nil
+}
@@ -0,0 +1,223 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// DelegationState is the amount a delegator deposited to the staking pool of a validator
+type DelegationState struct {
+	committeePublicKey string
+	delegator          privacy.PaymentAddress
+	amount             uint64
+}
+
+func NewDelegationState() *DelegationState {
+	return &DelegationState{}
+}
+
+func NewDelegationStateWithValue(
+	committeePublicKey string,
+	delegator privacy.PaymentAddress,
+	amount uint64,
+) *DelegationState {
+	return &DelegationState{
+		committeePublicKey: committeePublicKey,
+		delegator:          delegator,
+		amount:             amount,
+	}
+}
+
+func (s DelegationState) CommitteePublicKey() string {
+	return s.committeePublicKey
+}
+
+func (s *DelegationState) SetCommitteePublicKey(committeePublicKey string) {
+	s.committeePublicKey = committeePublicKey
+}
+
+func (s DelegationState) Delegator() privacy.PaymentAddress {
+	return s.delegator
+}
+
+func (s *DelegationState) SetDelegator(delegator privacy.PaymentAddress) {
+	s.delegator = delegator
+}
+
+func (s DelegationState) Amount() uint64 {
+	return s.amount
+}
+
+func (s *DelegationState) SetAmount(amount uint64) {
+	s.amount = amount
+}
+
+func (s DelegationState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		CommitteePublicKey string
+		Delegator          privacy.PaymentAddress
+		Amount             uint64
+	}{
+		CommitteePublicKey: s.committeePublicKey,
+		Delegator:          s.delegator,
+		Amount:             s.amount,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *DelegationState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		CommitteePublicKey string
+		Delegator          privacy.PaymentAddress
+		Amount             uint64
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.committeePublicKey = temp.CommitteePublicKey
+	s.delegator = temp.Delegator
+	s.amount = temp.Amount
+	return nil
+}
+
+type DelegationObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version         int
+	delegationHash  common.Hash
+	delegationState *DelegationState
+	objectType      int
+	deleted         bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newDelegationObject(db *StateDB, hash common.Hash) *DelegationObject {
+	return &DelegationObject{
+		version:         defaultVersion,
+		db:              db,
+		delegationHash:  hash,
+		delegationState: NewDelegationState(),
+		objectType:      DelegationObjectType,
+		deleted:         false,
+	}
+}
+
+func newDelegationObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*DelegationObject, error) {
+	var newDelegationState = NewDelegationState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newDelegationState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newDelegationState, ok = data.(*DelegationState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidDelegationStateType, reflect.TypeOf(data))
+		}
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newDelegationState.delegator); err != nil {
+		return nil, fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	return &DelegationObject{
+		version:         defaultVersion,
+		delegationHash:  key,
+		delegationState: newDelegationState,
+		db:              db,
+		objectType:      DelegationObjectType,
+		deleted:         false,
+	}, nil
+}
+
+// GenerateDelegationObjectKey returns the key of a delegation, delegations of a pool share the prefix of the pool
+func GenerateDelegationObjectKey(committeePublicKeyBytes []byte, delegatorPublicKey []byte) common.Hash {
+	prefixHash := GetDelegationPrefix(committeePublicKeyBytes)
+	valueHash := common.HashH(delegatorPublicKey)
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c DelegationObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *DelegationObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c DelegationObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *DelegationObject) SetValue(data interface{}) error {
+	newDelegationState, ok := data.(*DelegationState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidDelegationStateType, reflect.TypeOf(data))
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newDelegationState.delegator); err != nil {
+		return fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	c.delegationState = newDelegationState
+	return nil
+}
+
+func (c DelegationObject) GetValue() interface{} {
+	return c.delegationState
+}
+
+func (c DelegationObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal delegation state")
+	}
+	return value
+}
+
+func (c DelegationObject) GetHash() common.Hash {
+	return c.delegationHash
+}
+
+func (c DelegationObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *DelegationObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all delegation value into default value
+func (c *DelegationObject) Reset() bool {
+	c.delegationState = NewDelegationState()
+	return true
+}
+
+func (c DelegationObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c DelegationObject) IsEmpty() bool {
+	temp := NewDelegationState()
+	return reflect.DeepEqual(temp, c.delegationState) || c.delegationState == nil
+}
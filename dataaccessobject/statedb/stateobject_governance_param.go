@@ -0,0 +1,232 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+// GovernanceParamActivation is a value of a chain param activated by an approved proposal,
+// the value is used from beacon height Height
+type GovernanceParamActivation struct {
+	Height     uint64
+	Value      uint64
+	ProposalID common.Hash
+}
+
+// GovernanceParamState keeps the values of a chain param activated by governance in order of activation height
+type GovernanceParamState struct {
+	name        string
+	activations []GovernanceParamActivation
+}
+
+func NewGovernanceParamState() *GovernanceParamState {
+	return &GovernanceParamState{}
+}
+
+func NewGovernanceParamStateWithValue(name string, activations []GovernanceParamActivation) *GovernanceParamState {
+	return &GovernanceParamState{
+		name:        name,
+		activations: activations,
+	}
+}
+
+func (s GovernanceParamState) Name() string {
+	return s.name
+}
+
+func (s *GovernanceParamState) SetName(name string) {
+	s.name = name
+}
+
+func (s GovernanceParamState) Activations() []GovernanceParamActivation {
+	return s.activations
+}
+
+func (s *GovernanceParamState) SetActivations(activations []GovernanceParamActivation) {
+	s.activations = activations
+}
+
+// AddActivation keeps activations in order of height, a value activated at the height of
+// a former one replaces it
+func (s *GovernanceParamState) AddActivation(activation GovernanceParamActivation) {
+	i := len(s.activations)
+	for i > 0 && s.activations[i-1].Height > activation.Height {
+		i--
+	}
+	if i > 0 && s.activations[i-1].Height == activation.Height {
+		s.activations[i-1] = activation
+		return
+	}
+	s.activations = append(s.activations, GovernanceParamActivation{})
+	copy(s.activations[i+1:], s.activations[i:])
+	s.activations[i] = activation
+}
+
+// ValueAt returns the value of the param at a beacon height, false if no value is activated yet
+func (s GovernanceParamState) ValueAt(beaconHeight uint64) (uint64, bool) {
+	for i := len(s.activations) - 1; i >= 0; i-- {
+		if s.activations[i].Height <= beaconHeight {
+			return s.activations[i].Value, true
+		}
+	}
+	return 0, false
+}
+
+func (s GovernanceParamState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		Name        string
+		Activations []GovernanceParamActivation
+	}{
+		Name:        s.name,
+		Activations: s.activations,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *GovernanceParamState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		Name        string
+		Activations []GovernanceParamActivation
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.name = temp.Name
+	s.activations = temp.Activations
+	return nil
+}
+
+type GovernanceParamObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version              int
+	governanceParamHash  common.Hash
+	governanceParamState *GovernanceParamState
+	objectType           int
+	deleted              bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newGovernanceParamObject(db *StateDB, hash common.Hash) *GovernanceParamObject {
+	return &GovernanceParamObject{
+		version:              defaultVersion,
+		db:                   db,
+		governanceParamHash:  hash,
+		governanceParamState: NewGovernanceParamState(),
+		objectType:           GovernanceParamObjectType,
+		deleted:              false,
+	}
+}
+
+func newGovernanceParamObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*GovernanceParamObject, error) {
+	var newGovernanceParamState = NewGovernanceParamState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newGovernanceParamState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newGovernanceParamState, ok = data.(*GovernanceParamState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidGovernanceParamStateType, reflect.TypeOf(data))
+		}
+	}
+	return &GovernanceParamObject{
+		version:              defaultVersion,
+		governanceParamHash:  key,
+		governanceParamState: newGovernanceParamState,
+		db:                   db,
+		objectType:           GovernanceParamObjectType,
+		deleted:              false,
+	}, nil
+}
+
+// GenerateGovernanceParamObjectKey returns the key of a chain param by its name
+func GenerateGovernanceParamObjectKey(name string) common.Hash {
+	prefixHash := GetGovernanceParamPrefix()
+	valueHash := common.HashH([]byte(name))
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c GovernanceParamObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *GovernanceParamObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c GovernanceParamObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *GovernanceParamObject) SetValue(data interface{}) error {
+	newGovernanceParamState, ok := data.(*GovernanceParamState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidGovernanceParamStateType, reflect.TypeOf(data))
+	}
+	c.governanceParamState = newGovernanceParamState
+	return nil
+}
+
+func (c GovernanceParamObject) GetValue() interface{} {
+	return c.governanceParamState
+}
+
+func (c GovernanceParamObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal governance param state")
+	}
+	return value
+}
+
+func (c GovernanceParamObject) GetHash() common.Hash {
+	return c.governanceParamHash
+}
+
+func (c GovernanceParamObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *GovernanceParamObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all governance param value into default value
+func (c *GovernanceParamObject) Reset() bool {
+	c.governanceParamState = NewGovernanceParamState()
+	return true
+}
+
+func (c GovernanceParamObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c GovernanceParamObject) IsEmpty() bool {
+	temp := NewGovernanceParamState()
+	return reflect.DeepEqual(temp, c.governanceParamState) || c.governanceParamState == nil
+}
@@ -0,0 +1,313 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+// GovernanceProposalState is a proposal to change chain params, stakers vote on it until votingEndHeight,
+// the stake of approving voters is compared with the total stake at the end of voting
+// and params of an approved proposal are activated at activationHeight
+type GovernanceProposalState struct {
+	proposalID       common.Hash
+	proposer         string
+	params           map[string]uint64
+	activationHeight uint64
+	votingEndHeight  uint64
+	votes            map[string]bool
+	status           string
+	approvedStake    uint64
+	totalStake       uint64
+}
+
+func NewGovernanceProposalState() *GovernanceProposalState {
+	return &GovernanceProposalState{
+		params: make(map[string]uint64),
+		votes:  make(map[string]bool),
+	}
+}
+
+func NewGovernanceProposalStateWithValue(
+	proposalID common.Hash,
+	proposer string,
+	params map[string]uint64,
+	activationHeight uint64,
+	votingEndHeight uint64,
+	status string,
+) *GovernanceProposalState {
+	return &GovernanceProposalState{
+		proposalID:       proposalID,
+		proposer:         proposer,
+		params:           params,
+		activationHeight: activationHeight,
+		votingEndHeight:  votingEndHeight,
+		votes:            make(map[string]bool),
+		status:           status,
+	}
+}
+
+func (s GovernanceProposalState) ProposalID() common.Hash {
+	return s.proposalID
+}
+
+func (s *GovernanceProposalState) SetProposalID(proposalID common.Hash) {
+	s.proposalID = proposalID
+}
+
+func (s GovernanceProposalState) Proposer() string {
+	return s.proposer
+}
+
+func (s *GovernanceProposalState) SetProposer(proposer string) {
+	s.proposer = proposer
+}
+
+func (s GovernanceProposalState) Params() map[string]uint64 {
+	return s.params
+}
+
+func (s *GovernanceProposalState) SetParams(params map[string]uint64) {
+	s.params = params
+}
+
+func (s GovernanceProposalState) ActivationHeight() uint64 {
+	return s.activationHeight
+}
+
+func (s *GovernanceProposalState) SetActivationHeight(activationHeight uint64) {
+	s.activationHeight = activationHeight
+}
+
+func (s GovernanceProposalState) VotingEndHeight() uint64 {
+	return s.votingEndHeight
+}
+
+func (s *GovernanceProposalState) SetVotingEndHeight(votingEndHeight uint64) {
+	s.votingEndHeight = votingEndHeight
+}
+
+// Votes returns approval of voters by committee public key
+func (s GovernanceProposalState) Votes() map[string]bool {
+	return s.votes
+}
+
+func (s *GovernanceProposalState) SetVotes(votes map[string]bool) {
+	s.votes = votes
+}
+
+func (s GovernanceProposalState) Status() string {
+	return s.status
+}
+
+func (s *GovernanceProposalState) SetStatus(status string) {
+	s.status = status
+}
+
+func (s GovernanceProposalState) ApprovedStake() uint64 {
+	return s.approvedStake
+}
+
+func (s *GovernanceProposalState) SetApprovedStake(approvedStake uint64) {
+	s.approvedStake = approvedStake
+}
+
+func (s GovernanceProposalState) TotalStake() uint64 {
+	return s.totalStake
+}
+
+func (s *GovernanceProposalState) SetTotalStake(totalStake uint64) {
+	s.totalStake = totalStake
+}
+
+func (s GovernanceProposalState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		ProposalID       common.Hash
+		Proposer         string
+		Params           map[string]uint64
+		ActivationHeight uint64
+		VotingEndHeight  uint64
+		Votes            map[string]bool
+		Status           string
+		ApprovedStake    uint64
+		TotalStake       uint64
+	}{
+		ProposalID:       s.proposalID,
+		Proposer:         s.proposer,
+		Params:           s.params,
+		ActivationHeight: s.activationHeight,
+		VotingEndHeight:  s.votingEndHeight,
+		Votes:            s.votes,
+		Status:           s.status,
+		ApprovedStake:    s.approvedStake,
+		TotalStake:       s.totalStake,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *GovernanceProposalState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		ProposalID       common.Hash
+		Proposer         string
+		Params           map[string]uint64
+		ActivationHeight uint64
+		VotingEndHeight  uint64
+		Votes            map[string]bool
+		Status           string
+		ApprovedStake    uint64
+		TotalStake       uint64
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.proposalID = temp.ProposalID
+	s.proposer = temp.Proposer
+	s.params = temp.Params
+	if s.params == nil {
+		s.params = make(map[string]uint64)
+	}
+	s.activationHeight = temp.ActivationHeight
+	s.votingEndHeight = temp.VotingEndHeight
+	s.votes = temp.Votes
+	if s.votes == nil {
+		s.votes = make(map[string]bool)
+	}
+	s.status = temp.Status
+	s.approvedStake = temp.ApprovedStake
+	s.totalStake = temp.TotalStake
+	return nil
+}
+
+type GovernanceProposalObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version                 int
+	governanceProposalHash  common.Hash
+	governanceProposalState *GovernanceProposalState
+	objectType              int
+	deleted                 bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newGovernanceProposalObject(db *StateDB, hash common.Hash) *GovernanceProposalObject {
+	return &GovernanceProposalObject{
+		version:                 defaultVersion,
+		db:                      db,
+		governanceProposalHash:  hash,
+		governanceProposalState: NewGovernanceProposalState(),
+		objectType:              GovernanceProposalObjectType,
+		deleted:                 false,
+	}
+}
+
+func newGovernanceProposalObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*GovernanceProposalObject, error) {
+	var newGovernanceProposalState = NewGovernanceProposalState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newGovernanceProposalState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newGovernanceProposalState, ok = data.(*GovernanceProposalState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidGovernanceProposalStateType, reflect.TypeOf(data))
+		}
+	}
+	return &GovernanceProposalObject{
+		version:                 defaultVersion,
+		governanceProposalHash:  key,
+		governanceProposalState: newGovernanceProposalState,
+		db:                      db,
+		objectType:              GovernanceProposalObjectType,
+		deleted:                 false,
+	}, nil
+}
+
+// GenerateGovernanceProposalObjectKey returns the key of a proposal by the hash of its proposal tx
+func GenerateGovernanceProposalObjectKey(proposalID common.Hash) common.Hash {
+	prefixHash := GetGovernanceProposalPrefix()
+	valueHash := common.HashH(proposalID[:])
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c GovernanceProposalObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *GovernanceProposalObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c GovernanceProposalObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *GovernanceProposalObject) SetValue(data interface{}) error {
+	newGovernanceProposalState, ok := data.(*GovernanceProposalState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidGovernanceProposalStateType, reflect.TypeOf(data))
+	}
+	c.governanceProposalState = newGovernanceProposalState
+	return nil
+}
+
+func (c GovernanceProposalObject) GetValue() interface{} {
+	return c.governanceProposalState
+}
+
+func (c GovernanceProposalObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal governance proposal state")
+	}
+	return value
+}
+
+func (c GovernanceProposalObject) GetHash() common.Hash {
+	return c.governanceProposalHash
+}
+
+func (c GovernanceProposalObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *GovernanceProposalObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all governance proposal value into default value
+func (c *GovernanceProposalObject) Reset() bool {
+	c.governanceProposalState = NewGovernanceProposalState()
+	return true
+}
+
+func (c GovernanceProposalObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c GovernanceProposalObject) IsEmpty() bool {
+	temp := NewGovernanceProposalState()
+	return reflect.DeepEqual(temp, c.governanceProposalState) || c.governanceProposalState == nil
+}
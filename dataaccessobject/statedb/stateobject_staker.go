@@ -14,7 +14,8 @@ type StakerInfo struct {
 	// funderAddress  privacy.PaymentAddress
 	txStakingID common.Hash
 	autoStaking bool
-	// stakingAmount  uint64
+	// stakingAmount is the amount of the staking tx, 0 for stakers staked before it was recorded
+	stakingAmount uint64
 }
 
 func NewStakerInfo() *StakerInfo {
@@ -43,13 +44,13 @@ func (c StakerInfo) MarshalJSON() ([]byte, error) {
 		AutoStaking    bool
 		TxStakingID    common.Hash
 		// FunderAddress  privacy.PaymentAddress
-		// StakingAmount  uint64
+		StakingAmount uint64 `json:",omitempty"`
 	}{
 		RewardReceiver: c.rewardReceiver,
 		TxStakingID:    c.txStakingID,
 		// FunderAddress:  c.funderAddress,
-		AutoStaking: c.autoStaking,
-		// StakingAmount:  c.stakingAmount,
+		AutoStaking:   c.autoStaking,
+		StakingAmount: c.stakingAmount,
 	})
 	if err != nil {
 		return []byte{}, err
@@ -63,6 +64,7 @@ func (c *StakerInfo) UnmarshalJSON(data []byte) error {
 		AutoStaking    bool
 		TxStakingID    common.Hash
 		FunderAddress  privacy.PaymentAddress
+		StakingAmount  uint64
 	}{}
 	err := json.Unmarshal(data, &temp)
 	if err != nil {
@@ -72,6 +74,7 @@ func (c *StakerInfo) UnmarshalJSON(data []byte) error {
 	c.rewardReceiver = temp.RewardReceiver
 	// c.funderAddress = temp.FunderAddress
 	c.autoStaking = temp.AutoStaking
+	c.stakingAmount = temp.StakingAmount
 	return nil
 }
 
@@ -91,6 +94,10 @@ func (s *StakerInfo) SetAutoStaking(a bool) {
 	s.autoStaking = a
 }
 
+func (s *StakerInfo) SetStakingAmount(a uint64) {
+	s.stakingAmount = a
+}
+
 func (s StakerInfo) RewardReceiver() privacy.PaymentAddress {
 	return s.rewardReceiver
 }
@@ -107,9 +114,9 @@ func (s StakerInfo) AutoStaking() bool {
 	return s.autoStaking
 }
 
-// func (s StakerInfo) StakingAmount() uint64 {
-// 	return s.stakingAmount
-// }
+func (s StakerInfo) StakingAmount() uint64 {
+	return s.stakingAmount
+}
 
 type StakerObject struct {
 	db *StateDB
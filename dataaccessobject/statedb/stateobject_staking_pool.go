@@ -0,0 +1,267 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// StakingPoolState is a delegation pool of a shard validator, its operator (funder of the staking tx) gets
+// commission (in basis points of CommissionBase) of the pool rewards, the rest is split to delegators pro rata.
+// A commission change applies from pendingCommissionEpoch so that delegators can leave the pool before
+type StakingPoolState struct {
+	committeePublicKey     string
+	operator               privacy.PaymentAddress
+	commission             uint64
+	totalDelegated         uint64
+	pendingCommission      uint64
+	pendingCommissionEpoch uint64
+}
+
+func NewStakingPoolState() *StakingPoolState {
+	return &StakingPoolState{}
+}
+
+func NewStakingPoolStateWithValue(
+	committeePublicKey string,
+	operator privacy.PaymentAddress,
+	commission uint64,
+	totalDelegated uint64,
+) *StakingPoolState {
+	return &StakingPoolState{
+		committeePublicKey: committeePublicKey,
+		operator:           operator,
+		commission:         commission,
+		totalDelegated:     totalDelegated,
+	}
+}
+
+func (s StakingPoolState) CommitteePublicKey() string {
+	return s.committeePublicKey
+}
+
+func (s *StakingPoolState) SetCommitteePublicKey(committeePublicKey string) {
+	s.committeePublicKey = committeePublicKey
+}
+
+func (s StakingPoolState) Operator() privacy.PaymentAddress {
+	return s.operator
+}
+
+func (s *StakingPoolState) SetOperator(operator privacy.PaymentAddress) {
+	s.operator = operator
+}
+
+func (s StakingPoolState) Commission() uint64 {
+	return s.commission
+}
+
+func (s *StakingPoolState) SetCommission(commission uint64) {
+	s.commission = commission
+}
+
+// CommissionAt returns the commission of the pool applied to rewards of epoch
+func (s StakingPoolState) CommissionAt(epoch uint64) uint64 {
+	if s.pendingCommissionEpoch != 0 && epoch >= s.pendingCommissionEpoch {
+		return s.pendingCommission
+	}
+	return s.commission
+}
+
+func (s StakingPoolState) PendingCommission() (uint64, uint64) {
+	return s.pendingCommission, s.pendingCommissionEpoch
+}
+
+// SetPendingCommission changes the commission of the pool from epoch, a change not applied yet is replaced
+func (s *StakingPoolState) SetPendingCommission(commission uint64, epoch uint64) {
+	s.pendingCommission = commission
+	s.pendingCommissionEpoch = epoch
+}
+
+func (s StakingPoolState) TotalDelegated() uint64 {
+	return s.totalDelegated
+}
+
+func (s *StakingPoolState) SetTotalDelegated(totalDelegated uint64) {
+	s.totalDelegated = totalDelegated
+}
+
+func (s StakingPoolState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		CommitteePublicKey     string
+		Operator               privacy.PaymentAddress
+		Commission             uint64
+		TotalDelegated         uint64
+		PendingCommission      uint64
+		PendingCommissionEpoch uint64
+	}{
+		CommitteePublicKey:     s.committeePublicKey,
+		Operator:               s.operator,
+		Commission:             s.commission,
+		TotalDelegated:         s.totalDelegated,
+		PendingCommission:      s.pendingCommission,
+		PendingCommissionEpoch: s.pendingCommissionEpoch,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *StakingPoolState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		CommitteePublicKey     string
+		Operator               privacy.PaymentAddress
+		Commission             uint64
+		TotalDelegated         uint64
+		PendingCommission      uint64
+		PendingCommissionEpoch uint64
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.committeePublicKey = temp.CommitteePublicKey
+	s.operator = temp.Operator
+	s.commission = temp.Commission
+	s.totalDelegated = temp.TotalDelegated
+	s.pendingCommission = temp.PendingCommission
+	s.pendingCommissionEpoch = temp.PendingCommissionEpoch
+	return nil
+}
+
+type StakingPoolObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version          int
+	stakingPoolHash  common.Hash
+	stakingPoolState *StakingPoolState
+	objectType       int
+	deleted          bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newStakingPoolObject(db *StateDB, hash common.Hash) *StakingPoolObject {
+	return &StakingPoolObject{
+		version:          defaultVersion,
+		db:               db,
+		stakingPoolHash:  hash,
+		stakingPoolState: NewStakingPoolState(),
+		objectType:       StakingPoolObjectType,
+		deleted:          false,
+	}
+}
+
+func newStakingPoolObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*StakingPoolObject, error) {
+	var newStakingPoolState = NewStakingPoolState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newStakingPoolState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newStakingPoolState, ok = data.(*StakingPoolState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidStakingPoolStateType, reflect.TypeOf(data))
+		}
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newStakingPoolState.operator); err != nil {
+		return nil, fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	return &StakingPoolObject{
+		version:          defaultVersion,
+		stakingPoolHash:  key,
+		stakingPoolState: newStakingPoolState,
+		db:               db,
+		objectType:       StakingPoolObjectType,
+		deleted:          false,
+	}, nil
+}
+
+func GenerateStakingPoolObjectKey(committeePublicKeyBytes []byte) common.Hash {
+	prefixHash := GetStakingPoolPrefix()
+	valueHash := common.HashH(committeePublicKeyBytes)
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c StakingPoolObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *StakingPoolObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c StakingPoolObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *StakingPoolObject) SetValue(data interface{}) error {
+	newStakingPoolState, ok := data.(*StakingPoolState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidStakingPoolStateType, reflect.TypeOf(data))
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newStakingPoolState.operator); err != nil {
+		return fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	c.stakingPoolState = newStakingPoolState
+	return nil
+}
+
+func (c StakingPoolObject) GetValue() interface{} {
+	return c.stakingPoolState
+}
+
+func (c StakingPoolObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal staking pool state")
+	}
+	return value
+}
+
+func (c StakingPoolObject) GetHash() common.Hash {
+	return c.stakingPoolHash
+}
+
+func (c StakingPoolObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *StakingPoolObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all staking pool value into default value
+func (c *StakingPoolObject) Reset() bool {
+	c.stakingPoolState = NewStakingPoolState()
+	return true
+}
+
+func (c StakingPoolObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c StakingPoolObject) IsEmpty() bool {
+	temp := NewStakingPoolState()
+	return reflect.DeepEqual(temp, c.stakingPoolState) || c.stakingPoolState == nil
+}
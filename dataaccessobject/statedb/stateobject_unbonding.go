@@ -0,0 +1,269 @@
+package statedb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
+)
+
+// UnbondingState is a stake (of a validator or of a delegation) locked after an unstake request,
+// release epoch is 0 while the unstaked validator is not swapped out yet
+type UnbondingState struct {
+	txReqID            common.Hash
+	committeePublicKey string
+	receiver           privacy.PaymentAddress
+	amount             uint64
+	isDelegation       bool
+	releaseEpoch       uint64
+}
+
+func NewUnbondingState() *UnbondingState {
+	return &UnbondingState{}
+}
+
+func NewUnbondingStateWithValue(
+	txReqID common.Hash,
+	committeePublicKey string,
+	receiver privacy.PaymentAddress,
+	amount uint64,
+	isDelegation bool,
+	releaseEpoch uint64,
+) *UnbondingState {
+	return &UnbondingState{
+		txReqID:            txReqID,
+		committeePublicKey: committeePublicKey,
+		receiver:           receiver,
+		amount:             amount,
+		isDelegation:       isDelegation,
+		releaseEpoch:       releaseEpoch,
+	}
+}
+
+func (s UnbondingState) TxReqID() common.Hash {
+	return s.txReqID
+}
+
+func (s *UnbondingState) SetTxReqID(txReqID common.Hash) {
+	s.txReqID = txReqID
+}
+
+func (s UnbondingState) CommitteePublicKey() string {
+	return s.committeePublicKey
+}
+
+func (s *UnbondingState) SetCommitteePublicKey(committeePublicKey string) {
+	s.committeePublicKey = committeePublicKey
+}
+
+func (s UnbondingState) Receiver() privacy.PaymentAddress {
+	return s.receiver
+}
+
+func (s *UnbondingState) SetReceiver(receiver privacy.PaymentAddress) {
+	s.receiver = receiver
+}
+
+func (s UnbondingState) Amount() uint64 {
+	return s.amount
+}
+
+func (s *UnbondingState) SetAmount(amount uint64) {
+	s.amount = amount
+}
+
+func (s UnbondingState) IsDelegation() bool {
+	return s.isDelegation
+}
+
+func (s *UnbondingState) SetIsDelegation(isDelegation bool) {
+	s.isDelegation = isDelegation
+}
+
+func (s UnbondingState) ReleaseEpoch() uint64 {
+	return s.releaseEpoch
+}
+
+func (s *UnbondingState) SetReleaseEpoch(releaseEpoch uint64) {
+	s.releaseEpoch = releaseEpoch
+}
+
+func (s UnbondingState) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(struct {
+		TxReqID            common.Hash
+		CommitteePublicKey string
+		Receiver           privacy.PaymentAddress
+		Amount             uint64
+		IsDelegation       bool
+		ReleaseEpoch       uint64
+	}{
+		TxReqID:            s.txReqID,
+		CommitteePublicKey: s.committeePublicKey,
+		Receiver:           s.receiver,
+		Amount:             s.amount,
+		IsDelegation:       s.isDelegation,
+		ReleaseEpoch:       s.releaseEpoch,
+	})
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (s *UnbondingState) UnmarshalJSON(data []byte) error {
+	temp := struct {
+		TxReqID            common.Hash
+		CommitteePublicKey string
+		Receiver           privacy.PaymentAddress
+		Amount             uint64
+		IsDelegation       bool
+		ReleaseEpoch       uint64
+	}{}
+	err := json.Unmarshal(data, &temp)
+	if err != nil {
+		return err
+	}
+	s.txReqID = temp.TxReqID
+	s.committeePublicKey = temp.CommitteePublicKey
+	s.receiver = temp.Receiver
+	s.amount = temp.Amount
+	s.isDelegation = temp.IsDelegation
+	s.releaseEpoch = temp.ReleaseEpoch
+	return nil
+}
+
+type UnbondingObject struct {
+	db *StateDB
+	// Write caches.
+	trie Trie // storage trie, which becomes non-nil on first access
+
+	version        int
+	unbondingHash  common.Hash
+	unbondingState *UnbondingState
+	objectType     int
+	deleted        bool
+
+	// DB error.
+	// State objects are used by the consensus core and VM which are
+	// unable to deal with database-level errors. Any error that occurs
+	// during a database read is memoized here and will eventually be returned
+	// by StateDB.Commit.
+	dbErr error
+}
+
+func newUnbondingObject(db *StateDB, hash common.Hash) *UnbondingObject {
+	return &UnbondingObject{
+		version:        defaultVersion,
+		db:             db,
+		unbondingHash:  hash,
+		unbondingState: NewUnbondingState(),
+		objectType:     UnbondingObjectType,
+		deleted:        false,
+	}
+}
+
+func newUnbondingObjectWithValue(db *StateDB, key common.Hash, data interface{}) (*UnbondingObject, error) {
+	var newUnbondingState = NewUnbondingState()
+	var ok bool
+	var dataBytes []byte
+	if dataBytes, ok = data.([]byte); ok {
+		err := json.Unmarshal(dataBytes, newUnbondingState)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		newUnbondingState, ok = data.(*UnbondingState)
+		if !ok {
+			return nil, fmt.Errorf("%+v, got type %+v", ErrInvalidUnbondingStateType, reflect.TypeOf(data))
+		}
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newUnbondingState.receiver); err != nil {
+		return nil, fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	return &UnbondingObject{
+		version:        defaultVersion,
+		unbondingHash:  key,
+		unbondingState: newUnbondingState,
+		db:             db,
+		objectType:     UnbondingObjectType,
+		deleted:        false,
+	}, nil
+}
+
+// GenerateUnbondingObjectKey returns the key of the unbonding stake of an unstake request
+func GenerateUnbondingObjectKey(txReqID common.Hash) common.Hash {
+	prefixHash := GetUnbondingPrefix()
+	valueHash := common.HashH(txReqID[:])
+	return common.BytesToHash(append(prefixHash, valueHash[:][:prefixKeyLength]...))
+}
+
+func (c UnbondingObject) GetVersion() int {
+	return c.version
+}
+
+// setError remembers the first non-nil error it is called with.
+func (c *UnbondingObject) SetError(err error) {
+	if c.dbErr == nil {
+		c.dbErr = err
+	}
+}
+
+func (c UnbondingObject) GetTrie(db DatabaseAccessWarper) Trie {
+	return c.trie
+}
+
+func (c *UnbondingObject) SetValue(data interface{}) error {
+	newUnbondingState, ok := data.(*UnbondingState)
+	if !ok {
+		return fmt.Errorf("%+v, got type %+v", ErrInvalidUnbondingStateType, reflect.TypeOf(data))
+	}
+	if err := SoValidation.ValidatePaymentAddressSanity(newUnbondingState.receiver); err != nil {
+		return fmt.Errorf("%+v, got err %+v", ErrInvalidPaymentAddressType, err)
+	}
+	c.unbondingState = newUnbondingState
+	return nil
+}
+
+func (c UnbondingObject) GetValue() interface{} {
+	return c.unbondingState
+}
+
+func (c UnbondingObject) GetValueBytes() []byte {
+	data := c.GetValue()
+	value, err := json.Marshal(data)
+	if err != nil {
+		panic("failed to marshal unbonding state")
+	}
+	return value
+}
+
+func (c UnbondingObject) GetHash() common.Hash {
+	return c.unbondingHash
+}
+
+func (c UnbondingObject) GetType() int {
+	return c.objectType
+}
+
+// MarkDelete will delete an object in trie
+func (c *UnbondingObject) MarkDelete() {
+	c.deleted = true
+}
+
+// reset all unbonding value into default value
+func (c *UnbondingObject) Reset() bool {
+	c.unbondingState = NewUnbondingState()
+	return true
+}
+
+func (c UnbondingObject) IsDeleted() bool {
+	return c.deleted
+}
+
+// value is either default or nil
+func (c UnbondingObject) IsEmpty() bool {
+	temp := NewUnbondingState()
+	return reflect.DeepEqual(temp, c.unbondingState) || c.unbondingState == nil
+}
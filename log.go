@@ -22,6 +22,7 @@ import (
 	"github.com/incognitochain/incognito-chain/mempool"
 	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/netsync"
+	"github.com/incognitochain/incognito-chain/notescanner"
 	"github.com/incognitochain/incognito-chain/peer"
 	"github.com/incognitochain/incognito-chain/peerv2"
 	"github.com/incognitochain/incognito-chain/peerv2/wrapper"
@@ -46,6 +47,7 @@ var (
 	rpcLogger              = backendLog.Logger("RPC log", false)
 	rpcServiceLogger       = backendLog.Logger("RPC service log", false)
 	rpcServiceBridgeLogger = backendLog.Logger("RPC service DeBridge log", false)
+	rpcAuditLogger         = backendLog.Logger("RPC audit log", false)
 	netsyncLogger          = backendLog.Logger("Netsync log", false)
 	peerLogger             = backendLog.Logger("Peer log", true)
 	dbLogger               = backendLog.Logger("Database log", false)
@@ -66,6 +68,7 @@ var (
 	daov2Logger            = backendLog.Logger("DAO log", false)
 	btcRelayingLogger      = backendLog.Logger("BTC relaying log", false)
 	synckerLogger          = backendLog.Logger("Syncker log ", false)
+	noteScannerLogger      = backendLog.Logger("Note scanner log", false)
 )
 
 // logWriter implements an io.Writer that outputs to both standard output and
@@ -86,6 +89,7 @@ func init() {
 	connmanager.Logger.Init(connManagerLogger)
 	addrmanager.Logger.Init(addrManagerLoger)
 	rpcserver.Logger.Init(rpcLogger)
+	rpcserver.ALogger.Init(rpcAuditLogger)
 	rpcservice.Logger.Init(rpcServiceLogger)
 	rpcservice.BLogger.Init(rpcServiceBridgeLogger)
 	netsync.Logger.Init(netsyncLogger)
@@ -109,6 +113,7 @@ func init() {
 	dataaccessobject.Logger.Init(daov2Logger)
 	btcRelaying.Logger.Init(btcRelayingLogger)
 	syncker.Logger.Init(synckerLogger)
+	notescanner.Logger.Init(noteScannerLogger)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -120,6 +125,7 @@ var subsystemLoggers = map[string]common.Logger{
 	"RPCS":              rpcLogger,
 	"RPCSservice":       rpcServiceLogger,
 	"RPCSbridgeservice": rpcServiceBridgeLogger,
+	"RPCA":              rpcAuditLogger,
 	"NSYN":              netsyncLogger,
 	"PEER":              peerLogger,
 	"DABA":              dbLogger,
@@ -138,6 +144,7 @@ var subsystemLoggers = map[string]common.Logger{
 	"DAO":               daov2Logger,
 	"BTCRELAYING":       btcRelayingLogger,
 	"SYNCKER":           synckerLogger,
+	"NOTESCANNER":       noteScannerLogger,
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
@@ -36,16 +36,17 @@ type Config struct {
 	ConsensusEngine interface {
 		IsCommitteeInShard(shardID byte) bool
 	}
-	BlockChain        *blockchain.BlockChain       // Block chain of node
-	DataBase          map[int]incdb.Database       // main database of blockchain
-	DataBaseMempool   databasemp.DatabaseInterface // database is used for storage data in mempool into lvdb
-	ChainParams       *blockchain.Params
-	FeeEstimator      map[byte]*FeeEstimator // FeeEstimatator provides a feeEstimator. If it is not nil, the mempool records all new transactions it observes into the feeEstimator.
-	TxLifeTime        uint                   // Transaction life time in pool
-	MaxTx             uint64                 //Max transaction pool may have
-	IsLoadFromMempool bool                   //Reset mempool database when run node
-	PersistMempool    bool
-	RelayShards       []byte
+	BlockChain         *blockchain.BlockChain       // Block chain of node
+	DataBase           map[int]incdb.Database       // main database of blockchain
+	DataBaseMempool    databasemp.DatabaseInterface // database is used for storage data in mempool into lvdb
+	ChainParams        *blockchain.Params
+	FeeEstimator       map[byte]*FeeEstimator // FeeEstimatator provides a feeEstimator. If it is not nil, the mempool records all new transactions it observes into the feeEstimator.
+	TxLifeTime         uint                   // Transaction life time in pool
+	MaxTx              uint64                 //Max transaction pool may have
+	IsLoadFromMempool  bool                   //Reset mempool database when run node
+	PersistMempool     bool
+	RelayShards        []byte
+	BatchVerifyWorkers int // number of goroutines verifying transactions of a batch, default is number of CPUs
 	// UserKeyset            *incognitokey.KeySet
 	PubSubManager interface {
 		PublishMessage(message *pubsub.Message)
@@ -303,24 +304,28 @@ func (tp *TxPool) maybeAcceptBatchTransaction(shardView *blockchain.ShardBestSta
 	txDescs := []*metadata.TxDesc{}
 	txHashes := []common.Hash{}
 	batch := transaction.NewBatchTransaction(txs)
+	batch.SetWorkers(tp.config.BatchVerifyWorkers)
 
 	boolParams := make(map[string]bool)
 	boolParams["isNewTransaction"] = false
 	boolParams["isBatch"] = true
 	boolParams["isNewZKP"] = tp.config.BlockChain.IsAfterNewZKPCheckPoint(uint64(beaconHeight))
 
-	ok, err, _ := batch.Validate(shardView.GetCopiedTransactionStateDB(), beaconView.GetBeaconFeatureStateDB(), boolParams)
-	if err != nil {
-		return nil, nil, err
-	}
+	ok, err, index := batch.Validate(shardView.GetCopiedTransactionStateDB(), beaconView.GetBeaconFeatureStateDB(), boolParams)
 	if !ok {
-		return nil, nil, fmt.Errorf("Verify Batch Transaction failed %+v", txs)
+		if err == nil {
+			err = fmt.Errorf("Verify Batch Transaction failed %+v", txs)
+		}
+		if index >= 0 {
+			return nil, nil, &transaction.BatchTxError{TxIndex: index, Err: err}
+		}
+		return nil, nil, err
 	}
-	for _, tx := range txs {
+	for index, tx := range txs {
 		// validate tx
 		err := tp.validateTransaction(shardView, beaconView, tx, beaconHeight, true, false)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, &transaction.BatchTxError{TxIndex: index, Err: err}
 		}
 		shardID := common.GetShardIDFromLastByte(tx.GetSenderAddrLastByte())
 		bestHeight := tp.config.BlockChain.GetBestStateShard(byte(shardID)).BestBlock.Header.Height
@@ -0,0 +1,228 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// AutoWithdrawRewardPolicy registers the auto withdraw policy of a reward receiver, the tx is sent by the receiver.
+// The PRV reward is withdrawn every Interval epochs (every epoch if Interval is 0) once it reaches Threshold,
+// the withdraw fee is taken from the reward. Interval and Threshold both 0 removes the policy
+type AutoWithdrawRewardPolicy struct {
+	MetadataBase
+	PaymentAddress string
+	Interval       uint64
+	Threshold      uint64
+}
+
+type AutoWithdrawRewardPolicyAction struct {
+	Meta    AutoWithdrawRewardPolicy
+	TxReqID common.Hash
+	ShardID byte
+}
+
+type AutoWithdrawRewardPolicyContent struct {
+	PaymentAddress string
+	Interval       uint64
+	Threshold      uint64
+	TxReqID        common.Hash
+	ShardID        byte
+}
+
+// AutoWithdrawRewardContent is the content of the beacon instructions of due auto withdrawals
+type AutoWithdrawRewardContent struct {
+	PaymentAddress string
+	Threshold      uint64
+	Fee            uint64
+	Epoch          uint64
+}
+
+func NewAutoWithdrawRewardPolicy(metaType int, paymentAddress string, interval uint64, threshold uint64) (*AutoWithdrawRewardPolicy, error) {
+	if metaType != AutoWithdrawRewardPolicyMeta {
+		return nil, errors.New("invalid auto withdraw reward policy type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &AutoWithdrawRewardPolicy{
+		MetadataBase:   *metadataBase,
+		PaymentAddress: paymentAddress,
+		Interval:       interval,
+		Threshold:      threshold,
+	}, nil
+}
+
+func (req *AutoWithdrawRewardPolicy) ValidateMetadataByItself() bool {
+	if req.Type != AutoWithdrawRewardPolicyMeta {
+		return false
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(req.PaymentAddress)
+	return err == nil && len(keyWallet.KeySet.PaymentAddress.Pk) == common.PublicKeySize
+}
+
+// ValidateTxWithBlockChain Validate Condition to Register Auto Withdraw Reward Policy With Blockchain
+// - Requester (sender of tx) is the owner of PaymentAddress
+func (req AutoWithdrawRewardPolicy) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	policyMeta, ok := tx.GetMetadata().(*AutoWithdrawRewardPolicy)
+	if !ok {
+		return false, NewMetadataTxError(AutoWithdrawRewardPolicyTypeAssertionError, fmt.Errorf("Expect *AutoWithdrawRewardPolicy type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(policyMeta.PaymentAddress)
+	if err != nil {
+		return false, NewMetadataTxError(AutoWithdrawRewardPolicyInvalidTransactionSenderError, err)
+	}
+	if !bytes.Equal(keyWallet.KeySet.PaymentAddress.Pk, tx.GetSender()) {
+		return false, NewMetadataTxError(AutoWithdrawRewardPolicyInvalidTransactionSenderError, fmt.Errorf("Expect %+v to send auto withdraw reward policy but get %+v", keyWallet.KeySet.PaymentAddress.Pk, tx.GetSender()))
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req AutoWithdrawRewardPolicy) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount != AutoWithdrawRewardPolicyAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(req.PaymentAddress)
+	if err != nil || keyWallet == nil {
+		return false, false, errors.New("Invalid Payment Address, Failed to Deserialized Into Key Wallet")
+	}
+	if len(keyWallet.KeySet.PaymentAddress.Pk) != common.PublicKeySize {
+		return false, false, errors.New("Invalid Public Key of Payment Address")
+	}
+	return true, true, nil
+}
+
+func (req *AutoWithdrawRewardPolicy) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	return buildStakingPoolReqAction(req.Type, AutoWithdrawRewardPolicyAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	})
+}
+
+func (req AutoWithdrawRewardPolicy) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.PaymentAddress
+	record += strconv.FormatUint(req.Interval, 10)
+	record += strconv.FormatUint(req.Threshold, 10)
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *AutoWithdrawRewardPolicy) CalculateSize() uint64 {
+	return calculateSize(req)
+}
+
+// AutoWithdrawRewardResponse pays the PRV reward of PaymentAddress without Fee for a due auto withdrawal of Epoch
+type AutoWithdrawRewardResponse struct {
+	MetadataBase
+	PaymentAddress string
+	Epoch          uint64
+	Fee            uint64
+}
+
+func NewAutoWithdrawRewardResponse(paymentAddress string, epoch uint64, fee uint64, metaType int) *AutoWithdrawRewardResponse {
+	return &AutoWithdrawRewardResponse{
+		MetadataBase:   MetadataBase{Type: metaType},
+		PaymentAddress: paymentAddress,
+		Epoch:          epoch,
+		Fee:            fee,
+	}
+}
+
+func (iRes AutoWithdrawRewardResponse) CheckTransactionFee(tr Transaction, minFee uint64, beaconHeight int64, db *statedb.StateDB) bool {
+	// no need to have fee for this tx
+	return true
+}
+
+func (iRes AutoWithdrawRewardResponse) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	// no need to validate tx with blockchain, just need to validate with due auto withdraw instruction
+	return false, nil
+}
+
+func (iRes AutoWithdrawRewardResponse) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	return false, true, nil
+}
+
+func (iRes AutoWithdrawRewardResponse) ValidateMetadataByItself() bool {
+	return iRes.Type == AutoWithdrawRewardResponseMeta
+}
+
+func (iRes AutoWithdrawRewardResponse) Hash() *common.Hash {
+	record := iRes.PaymentAddress
+	record += strconv.FormatUint(iRes.Epoch, 10)
+	record += strconv.FormatUint(iRes.Fee, 10)
+	record += iRes.MetadataBase.Hash().String()
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (iRes *AutoWithdrawRewardResponse) CalculateSize() uint64 {
+	return calculateSize(iRes)
+}
+
+// VerifyMinerCreatedTxBeforeGettingInBlock the response must match a due auto withdraw instruction
+// and pay the whole PRV reward of the receiver, which reaches the threshold, without the fee
+func (iRes AutoWithdrawRewardResponse) VerifyMinerCreatedTxBeforeGettingInBlock(txsInBlock []Transaction, txsUsed []int, insts [][]string, instUsed []int, shardID byte, tx Transaction, chainRetriever ChainRetriever, ac *AccumulatedValues, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever) (bool, error) {
+	idx := -1
+	for i, inst := range insts {
+		if len(inst) < 4 { // this is not auto withdraw reward instruction
+			continue
+		}
+		if instUsed[i] > 0 ||
+			inst[0] != strconv.Itoa(AutoWithdrawRewardPolicyMeta) ||
+			inst[1] != strconv.Itoa(int(shardID)) ||
+			inst[2] != common.AutoWithdrawRewardDueChainStatus {
+			continue
+		}
+		var content AutoWithdrawRewardContent
+		err := json.Unmarshal([]byte(inst[3]), &content)
+		if err != nil {
+			Logger.log.Error("WARNING - VALIDATION: an error occured while parsing instruction content: ", err)
+			continue
+		}
+		if content.PaymentAddress != iRes.PaymentAddress ||
+			content.Epoch != iRes.Epoch ||
+			content.Fee != iRes.Fee {
+			continue
+		}
+		key, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+		if err != nil {
+			Logger.log.Info("WARNING - VALIDATION: an error occured while deserializing payment address string: ", err)
+			continue
+		}
+		_, pk, amount, assetID := tx.GetTransferData()
+		if !bytes.Equal(key.KeySet.PaymentAddress.Pk[:], pk[:]) ||
+			assetID.String() != common.PRVIDStr {
+			continue
+		}
+		tempPublicKey := base58.Base58Check{}.Encode(pk, common.Base58Version)
+		reward, err := statedb.GetCommitteeReward(shardViewRetriever.GetShardRewardStateDB(), tempPublicKey, common.PRVCoinID)
+		if err != nil {
+			return false, err
+		}
+		if reward < content.Threshold || reward != amount+content.Fee {
+			return false, fmt.Errorf("auto withdraw reward of %+v want reward %+v, got amount %+v and fee %+v", content.PaymentAddress, reward, amount, content.Fee)
+		}
+		idx = i
+		break
+	}
+	if idx == -1 {
+		return false, fmt.Errorf("no due auto withdraw reward instruction found for the AutoWithdrawRewardResponse tx %s", tx.Hash().String())
+	}
+	instUsed[idx] = 1
+	return true, nil
+}
@@ -32,131 +32,8 @@ func ParseMetadata(meta interface{}) (Metadata, error) {
 	if err != nil {
 		return nil, err
 	}
-	var md Metadata
-	switch int(mtTemp["Type"].(float64)) {
-	case IssuingRequestMeta:
-		md = &IssuingRequest{}
-	case IssuingResponseMeta:
-		md = &IssuingResponse{}
-	case ContractingRequestMeta:
-		md = &ContractingRequest{}
-	case IssuingETHRequestMeta:
-		md = &IssuingETHRequest{}
-	case IssuingETHResponseMeta:
-		md = &IssuingETHResponse{}
-	case BeaconSalaryResponseMeta:
-		md = &BeaconBlockSalaryRes{}
-	case BurningRequestMeta:
-		md = &BurningRequest{}
-	case BurningRequestMetaV2:
-		md = &BurningRequest{}
-	case ShardStakingMeta:
-		md = &StakingMetadata{}
-	case BeaconStakingMeta:
-		md = &StakingMetadata{}
-	case ReturnStakingMeta:
-		md = &ReturnStakingMetadata{}
-	case WithDrawRewardRequestMeta:
-		md = &WithDrawRewardRequest{}
-	case WithDrawRewardResponseMeta:
-		md = &WithDrawRewardResponse{}
-	case StopAutoStakingMeta:
-		md = &StopAutoStakingMetadata{}
-	case PDEContributionMeta:
-		md = &PDEContribution{}
-	case PDEPRVRequiredContributionRequestMeta:
-		md = &PDEContribution{}
-	case PDETradeRequestMeta:
-		md = &PDETradeRequest{}
-	case PDETradeResponseMeta:
-		md = &PDETradeResponse{}
-	case PDECrossPoolTradeRequestMeta:
-		md = &PDECrossPoolTradeRequest{}
-	case PDECrossPoolTradeResponseMeta:
-		md = &PDECrossPoolTradeResponse{}
-	case PDEWithdrawalRequestMeta:
-		md = &PDEWithdrawalRequest{}
-	case PDEWithdrawalResponseMeta:
-		md = &PDEWithdrawalResponse{}
-	case PDEFeeWithdrawalRequestMeta:
-		md = &PDEFeeWithdrawalRequest{}
-	case PDEFeeWithdrawalResponseMeta:
-		md = &PDEFeeWithdrawalResponse{}
-	case PDEContributionResponseMeta:
-		md = &PDEContributionResponse{}
-	case PortalCustodianDepositMeta:
-		md = &PortalCustodianDeposit{}
-	case PortalRequestPortingMeta, PortalRequestPortingMetaV3:
-		md = &PortalUserRegister{}
-	case PortalUserRequestPTokenMeta:
-		md = &PortalRequestPTokens{}
-	case PortalCustodianDepositResponseMeta:
-		md = &PortalCustodianDepositResponse{}
-	case PortalUserRequestPTokenResponseMeta:
-		md = &PortalRequestPTokensResponse{}
-	case PortalRedeemRequestMeta, PortalRedeemRequestMetaV3:
-		md = &PortalRedeemRequest{}
-	case PortalRedeemRequestResponseMeta:
-		md = &PortalRedeemRequestResponse{}
-	case PortalRequestUnlockCollateralMeta, PortalRequestUnlockCollateralMetaV3:
-		md = &PortalRequestUnlockCollateral{}
-	case PortalExchangeRatesMeta:
-		md = &PortalExchangeRates{}
-	case PortalUnlockOverRateCollateralsMeta:
-		md = &PortalUnlockOverRateCollaterals{}
-	case RelayingBNBHeaderMeta:
-		md = &RelayingHeader{}
-	case RelayingBTCHeaderMeta:
-		md = &RelayingHeader{}
-	case PortalCustodianWithdrawRequestMeta:
-		md = &PortalCustodianWithdrawRequest{}
-	case PortalCustodianWithdrawResponseMeta:
-		md = &PortalCustodianWithdrawResponse{}
-	case PortalLiquidateCustodianMeta, PortalLiquidateCustodianMetaV3:
-		md = &PortalLiquidateCustodian{}
-	case PortalLiquidateCustodianResponseMeta:
-		md = &PortalLiquidateCustodianResponse{}
-	case PortalRequestWithdrawRewardMeta:
-		md = &PortalRequestWithdrawReward{}
-	case PortalRequestWithdrawRewardResponseMeta:
-		md = &PortalWithdrawRewardResponse{}
-	case PortalRedeemFromLiquidationPoolMeta:
-		md = &PortalRedeemLiquidateExchangeRates{}
-	case PortalRedeemFromLiquidationPoolResponseMeta:
-		md = &PortalRedeemLiquidateExchangeRatesResponse{}
-	case PortalCustodianTopupMetaV2:
-		md = &PortalLiquidationCustodianDepositV2{}
-	case PortalCustodianTopupResponseMetaV2:
-		md = &PortalLiquidationCustodianDepositResponseV2{}
-	case PortalCustodianTopupMeta:
-		md = &PortalLiquidationCustodianDeposit{}
-	case PortalCustodianTopupResponseMeta:
-		md = &PortalLiquidationCustodianDepositResponse{}
-	case BurningForDepositToSCRequestMeta:
-		md = &BurningRequest{}
-	case BurningForDepositToSCRequestMetaV2:
-		md = &BurningRequest{}
-	case PortalPortingResponseMeta:
-		md = &PortalFeeRefundResponse{}
-	case PortalReqMatchingRedeemMeta:
-		md = &PortalReqMatchingRedeem{}
-	case PortalTopUpWaitingPortingRequestMeta:
-		md = &PortalTopUpWaitingPortingRequest{}
-	case PortalTopUpWaitingPortingResponseMeta:
-		md = &PortalTopUpWaitingPortingResponse{}
-	case PortalCustodianDepositMetaV3:
-		md = &PortalCustodianDepositV3{}
-	case PortalCustodianWithdrawRequestMetaV3:
-		md = &PortalCustodianWithdrawRequestV3{}
-	case PortalRedeemFromLiquidationPoolMetaV3:
-		md = &PortalRedeemFromLiquidationPoolV3{}
-	case PortalRedeemFromLiquidationPoolResponseMetaV3:
-		md = &PortalRedeemFromLiquidationPoolResponseV3{}
-	case PortalCustodianTopupMetaV3:
-		md = &PortalLiquidationCustodianDepositV3{}
-	case PortalTopUpWaitingPortingRequestMetaV3:
-		md = &PortalTopUpWaitingPortingRequestV3{}
-	default:
+	md, ok := newMetadataByType(int(mtTemp["Type"].(float64)))
+	if !ok {
 		Logger.log.Debug("[db] parse meta err: %+v\n", meta)
 		return nil, errors.Errorf("Could not parse metadata with type: %d", int(mtTemp["Type"].(float64)))
 	}
@@ -27,6 +27,26 @@ const (
 	StopAutoStakingMeta = 127
 	BeaconStakingMeta   = 64
 
+	// slashing
+	SlashEquivocationRequestMeta = 150
+
+	// delegation
+	StakingPoolRegisterMeta       = 151
+	StakingPoolDelegateMeta       = 152
+	StakingPoolDelegateRefundMeta = 159
+
+	// unstake
+	UnstakeRequestMeta  = 153
+	UnstakeResponseMeta = 154
+
+	// auto withdraw reward
+	AutoWithdrawRewardPolicyMeta   = 155
+	AutoWithdrawRewardResponseMeta = 156
+
+	// governance
+	ParamChangeProposalMeta = 157
+	ParamChangeVoteMeta     = 158
+
 	// Incognito -> Ethereum bridge
 	BeaconSwapConfirmMeta = 70
 	BridgeSwapConfirmMeta = 71
@@ -140,6 +160,9 @@ var minerCreatedMetaTypes = []int{
 	PortalPortingResponseMeta,
 	PortalTopUpWaitingPortingResponseMeta,
 	PortalRedeemFromLiquidationPoolResponseMetaV3,
+	UnstakeResponseMeta,
+	AutoWithdrawRewardResponseMeta,
+	StakingPoolDelegateRefundMeta,
 }
 
 // Special rules for shardID: stored as 2nd param of instruction of BeaconBlock
@@ -172,8 +195,13 @@ var (
 //	EthereumLightNodePort     = "8545"
 //)
 const (
-	StopAutoStakingAmount = 0
-	ETHConfirmationBlocks = 15
+	StopAutoStakingAmount          = 0
+	SlashEquivocationRequestAmount = 0
+	StakingPoolRegisterAmount      = 0
+	UnstakeRequestAmount           = 0
+	AutoWithdrawRewardPolicyAmount = 0
+	GovernanceRequestAmount        = 0
+	ETHConfirmationBlocks          = 15
 )
 
 var AcceptedWithdrawRewardRequestVersion = []int{0, 1}
@@ -35,6 +35,25 @@ const (
 	StopAutoStakingRequestTypeAssertionError
 	StopAutoStakingRequestAlreadyStopError
 
+	SlashEquivocationRequestTypeAssertionError
+	SlashEquivocationRequestNotInCommitteeListError
+	SlashEquivocationRequestInvalidEvidenceError
+
+	StakingPoolRequestTypeAssertionError
+	StakingPoolRequestNotInCommitteeListError
+	StakingPoolRequestInvalidTransactionSenderError
+	StakingPoolRequestPoolNotFoundError
+	UnstakeRequestTypeAssertionError
+	UnstakeRequestNotInCommitteeListError
+	UnstakeRequestInvalidTransactionSenderError
+	UnstakeRequestInvalidAmountError
+	AutoWithdrawRewardPolicyTypeAssertionError
+	AutoWithdrawRewardPolicyInvalidTransactionSenderError
+	GovernanceRequestTypeAssertionError
+	GovernanceRequestNotStakerError
+	GovernanceRequestInvalidTransactionSenderError
+	GovernanceRequestProposalNotFoundError
+
 	WrongIncognitoDAOPaymentAddressError
 
 	// pde
@@ -93,6 +112,23 @@ var ErrCodeMessage = map[int]struct {
 	StopAutoStakingRequestNoAutoStakingAvaiableError:      {-4003, "Stop Auto-Staking Request No Auto Staking Avaliable Error"},
 	StopAutoStakingRequestTypeAssertionError:              {-4004, "Stop Auto-Staking Request Type Assertion Error"},
 	StopAutoStakingRequestAlreadyStopError:                {-4005, "Stop Auto Staking Request Already Stop Error"},
+	SlashEquivocationRequestTypeAssertionError:            {-4100, "Slash Equivocation Request Type Assertion Error"},
+	SlashEquivocationRequestNotInCommitteeListError:       {-4101, "Slash Equivocation Request Offender Not In Committee List Error"},
+	SlashEquivocationRequestInvalidEvidenceError:          {-4102, "Slash Equivocation Request Invalid Evidence Error"},
+	StakingPoolRequestTypeAssertionError:                  {-4200, "Staking Pool Request Type Assertion Error"},
+	StakingPoolRequestNotInCommitteeListError:             {-4201, "Staking Pool Request Validator Not In Committee List Error"},
+	StakingPoolRequestInvalidTransactionSenderError:       {-4202, "Staking Pool Request Invalid Transaction Sender Error"},
+	StakingPoolRequestPoolNotFoundError:                   {-4203, "Staking Pool Request Pool Not Found Error"},
+	UnstakeRequestTypeAssertionError:                      {-4204, "Unstake Request Type Assertion Error"},
+	UnstakeRequestNotInCommitteeListError:                 {-4205, "Unstake Request Validator Not In Committee List Error"},
+	UnstakeRequestInvalidTransactionSenderError:           {-4206, "Unstake Request Invalid Transaction Sender Error"},
+	UnstakeRequestInvalidAmountError:                      {-4207, "Unstake Request Invalid Amount Error"},
+	AutoWithdrawRewardPolicyTypeAssertionError:            {-4208, "Auto Withdraw Reward Policy Type Assertion Error"},
+	AutoWithdrawRewardPolicyInvalidTransactionSenderError: {-4209, "Auto Withdraw Reward Policy Invalid Transaction Sender Error"},
+	GovernanceRequestTypeAssertionError:                   {-4210, "Governance Request Type Assertion Error"},
+	GovernanceRequestNotStakerError:                       {-4211, "Governance Request Not Staker Error"},
+	GovernanceRequestInvalidTransactionSenderError:        {-4212, "Governance Request Invalid Transaction Sender Error"},
+	GovernanceRequestProposalNotFoundError:                {-4213, "Governance Request Proposal Not Found Error"},
 
 	// -5xxx dev reward error
 	WrongIncognitoDAOPaymentAddressError: {-5001, "Invalid dev account"},
@@ -0,0 +1,260 @@
+package metadata
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+)
+
+// ParamChangeProposal proposes new values of chain params (by name) to be used from ActivationHeight,
+// it is sent by the funder of the staking tx of a staker (CommitteePublicKey). Stakers vote on it
+// during the voting period, beacon rejects it if a param is unknown or ActivationHeight is before the end of voting
+type ParamChangeProposal struct {
+	MetadataBase
+	CommitteePublicKey string
+	Params             map[string]uint64
+	ActivationHeight   uint64
+}
+
+type ParamChangeProposalAction struct {
+	Meta    ParamChangeProposal
+	TxReqID common.Hash
+	ShardID byte
+}
+
+// ParamChangeProposalContent is the content of the beacon instructions of a proposal,
+// ApprovedStake and TotalStake are set once voting ends. ShardID is the shard of the proposal tx,
+// it is 0 in instructions of the end of voting and of the activation, which have no response tx
+type ParamChangeProposalContent struct {
+	ProposalID         common.Hash
+	CommitteePublicKey string
+	Params             map[string]uint64
+	ActivationHeight   uint64
+	VotingEndHeight    uint64
+	ApprovedStake      uint64
+	TotalStake         uint64
+	ShardID            byte
+}
+
+// ParamChangeVote approves or disapproves a proposal in voting, it is sent by the funder of the staking tx
+// of a staker (CommitteePublicKey), each staker votes once and its vote weights its stake at the end of voting
+type ParamChangeVote struct {
+	MetadataBase
+	CommitteePublicKey string
+	ProposalID         common.Hash
+	Approve            bool
+}
+
+type ParamChangeVoteAction struct {
+	Meta    ParamChangeVote
+	TxReqID common.Hash
+	ShardID byte
+}
+
+type ParamChangeVoteContent struct {
+	CommitteePublicKey string
+	ProposalID         common.Hash
+	Approve            bool
+	TxReqID            common.Hash
+	ShardID            byte
+}
+
+func NewParamChangeProposal(metaType int, committeePublicKey string, params map[string]uint64, activationHeight uint64) (*ParamChangeProposal, error) {
+	if metaType != ParamChangeProposalMeta {
+		return nil, errors.New("invalid param change proposal type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &ParamChangeProposal{
+		MetadataBase:       *metadataBase,
+		CommitteePublicKey: committeePublicKey,
+		Params:             params,
+		ActivationHeight:   activationHeight,
+	}, nil
+}
+
+func NewParamChangeVote(metaType int, committeePublicKey string, proposalID common.Hash, approve bool) (*ParamChangeVote, error) {
+	if metaType != ParamChangeVoteMeta {
+		return nil, errors.New("invalid param change vote type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &ParamChangeVote{
+		MetadataBase:       *metadataBase,
+		CommitteePublicKey: committeePublicKey,
+		ProposalID:         proposalID,
+		Approve:            approve,
+	}, nil
+}
+
+// validateStakerTxSender checks a staker is in candidate, pending validator or committee list
+// and the tx is sent by the funder of its staking tx
+func validateStakerTxSender(tx Transaction, committeePublicKey string, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte) error {
+	committees, err := beaconViewRetriever.GetAllCommitteeValidatorCandidateFlattenListFromDatabase()
+	if err != nil {
+		return NewMetadataTxError(GovernanceRequestNotStakerError, err)
+	}
+	if !(common.IndexOfStr(committeePublicKey, committees) > -1) {
+		return NewMetadataTxError(GovernanceRequestNotStakerError, fmt.Errorf("Committee Publickey %+v not found in any committee list of current beacon beststate", committeePublicKey))
+	}
+	tempStakingTxHash, ok := shardViewRetriever.GetStakingTx()[committeePublicKey]
+	if !ok {
+		return NewMetadataTxError(GovernanceRequestInvalidTransactionSenderError, fmt.Errorf("No Committe Publickey %+v found in StakingTx of Shard %+v", committeePublicKey, shardID))
+	}
+	stakingTxHash, err := common.Hash{}.NewHashFromStr(tempStakingTxHash)
+	if err != nil {
+		return err
+	}
+	_, _, _, _, stakingTx, err := chainRetriever.GetTransactionByHash(*stakingTxHash)
+	if err != nil {
+		return NewMetadataTxError(GovernanceRequestInvalidTransactionSenderError, err)
+	}
+	if !bytes.Equal(stakingTx.GetSender(), tx.GetSender()) {
+		return NewMetadataTxError(GovernanceRequestInvalidTransactionSenderError, fmt.Errorf("Expect %+v to send governance request but get %+v", stakingTx.GetSender(), tx.GetSender()))
+	}
+	return nil
+}
+
+func (req *ParamChangeProposal) ValidateMetadataByItself() bool {
+	if req.Type != ParamChangeProposalMeta || len(req.Params) == 0 {
+		return false
+	}
+	return validateCommitteePublicKey(req.CommitteePublicKey) == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Propose Param Change With Blockchain
+// - Proposer is in candidate, pending validator or committee list
+// - Requester (sender of tx) must be address, which create staking transaction for proposer
+func (req ParamChangeProposal) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	proposalMeta, ok := tx.GetMetadata().(*ParamChangeProposal)
+	if !ok {
+		return false, NewMetadataTxError(GovernanceRequestTypeAssertionError, fmt.Errorf("Expect *ParamChangeProposal type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	if err := validateStakerTxSender(tx, proposalMeta.CommitteePublicKey, chainRetriever, shardViewRetriever, beaconViewRetriever, shardID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req ParamChangeProposal) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount != GovernanceRequestAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	if len(req.Params) == 0 {
+		return false, false, errors.New("proposal should change at least one param")
+	}
+	if req.ActivationHeight <= beaconHeight {
+		return false, false, fmt.Errorf("activation height %+v should be after beacon height %+v", req.ActivationHeight, beaconHeight)
+	}
+	if err := validateCommitteePublicKey(req.CommitteePublicKey); err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (req *ParamChangeProposal) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	return buildStakingPoolReqAction(req.Type, ParamChangeProposalAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	})
+}
+
+func (req ParamChangeProposal) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.CommitteePublicKey
+	names := []string{}
+	for name := range req.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		record += name
+		record += strconv.FormatUint(req.Params[name], 10)
+	}
+	record += strconv.FormatUint(req.ActivationHeight, 10)
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *ParamChangeProposal) CalculateSize() uint64 {
+	return calculateSize(req)
+}
+
+func (req *ParamChangeVote) ValidateMetadataByItself() bool {
+	if req.Type != ParamChangeVoteMeta {
+		return false
+	}
+	return validateCommitteePublicKey(req.CommitteePublicKey) == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Vote Param Change With Blockchain
+// - Proposal is in voting
+// - Voter is in candidate, pending validator or committee list
+// - Requester (sender of tx) must be address, which create staking transaction for voter
+func (req ParamChangeVote) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	voteMeta, ok := tx.GetMetadata().(*ParamChangeVote)
+	if !ok {
+		return false, NewMetadataTxError(GovernanceRequestTypeAssertionError, fmt.Errorf("Expect *ParamChangeVote type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	proposal, has, err := statedb.GetGovernanceProposal(beaconViewRetriever.GetBeaconConsensusStateDB(), voteMeta.ProposalID)
+	if err != nil {
+		return false, NewMetadataTxError(GovernanceRequestProposalNotFoundError, err)
+	}
+	if !has || proposal.Status() != common.GovernanceProposalVotingStatus {
+		return false, NewMetadataTxError(GovernanceRequestProposalNotFoundError, fmt.Errorf("Proposal %+v not found in voting", voteMeta.ProposalID.String()))
+	}
+	if err := validateStakerTxSender(tx, voteMeta.CommitteePublicKey, chainRetriever, shardViewRetriever, beaconViewRetriever, shardID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req ParamChangeVote) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount != GovernanceRequestAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	if err := validateCommitteePublicKey(req.CommitteePublicKey); err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (req *ParamChangeVote) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	return buildStakingPoolReqAction(req.Type, ParamChangeVoteAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	})
+}
+
+func (req ParamChangeVote) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.CommitteePublicKey
+	record += req.ProposalID.String()
+	record += strconv.FormatBool(req.Approve)
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *ParamChangeVote) CalculateSize() uint64 {
+	return calculateSize(req)
+}
@@ -56,11 +56,12 @@ type MempoolRetriever interface {
 }
 
 type ChainRetriever interface {
-	GetETHRemoveBridgeSigEpoch() uint64
-	GetBCHeightBreakPointPortalV3() uint64
-	GetStakingAmountShard() uint64
+	IsFeatureActive(feature common.Feature, beaconHeight uint64) bool
+	IsFeatureActiveAtEpoch(feature common.Feature, epoch uint64) bool
+	IsFeatureActiveInView(feature common.Feature, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64) bool
+	GetFeatureActivationHeight(feature common.Feature) (uint64, bool)
+	GetStakingAmountShard(beaconViewRetriever BeaconViewRetriever, beaconHeight uint64) uint64
 	GetCentralizedWebsitePaymentAddress(uint64) string
-	GetBeaconHeightBreakPointBurnAddr() uint64
 	GetBurningAddress(blockHeight uint64) string
 	GetTransactionByHash(common.Hash) (byte, common.Hash, uint64, int, Transaction, error)
 	ListPrivacyTokenAndBridgeTokenAndPRVByShardID(byte) ([]common.Hash, error)
@@ -81,6 +82,7 @@ type BeaconViewRetriever interface {
 	GetBeaconFeatureStateDB() *statedb.StateDB
 	GetBeaconRewardStateDB() *statedb.StateDB
 	GetBeaconSlashStateDB() *statedb.StateDB
+	GetBeaconConsensusStateDB() *statedb.StateDB
 }
 
 type ShardViewRetriever interface {
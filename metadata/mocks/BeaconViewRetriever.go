@@ -1,4 +1,4 @@
-// Code generated by mockery v2.0.0. DO NOT EDIT.
+// Code generated by mockery. DO NOT EDIT.
 
 package mocks
 
@@ -16,11 +16,19 @@ type BeaconViewRetriever struct {
 	mock.Mock
 }
 
-// GetAllBridgeTokens provides a mock function with given fields:
+// GetAllBridgeTokens provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetAllBridgeTokens() ([]common.Hash, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllBridgeTokens")
+	}
+
 	var r0 []common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]common.Hash, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []common.Hash); ok {
 		r0 = rf()
 	} else {
@@ -29,7 +37,6 @@ func (_m *BeaconViewRetriever) GetAllBridgeTokens() ([]common.Hash, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -39,11 +46,26 @@ func (_m *BeaconViewRetriever) GetAllBridgeTokens() ([]common.Hash, error) {
 	return r0, r1
 }
 
-// GetAllCommitteeValidatorCandidate provides a mock function with given fields:
+// GetAllCommitteeValidatorCandidate provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]incognitokey.CommitteePublicKey, map[byte][]incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCommitteeValidatorCandidate")
+	}
+
 	var r0 map[byte][]incognitokey.CommitteePublicKey
+	var r1 map[byte][]incognitokey.CommitteePublicKey
+	var r2 []incognitokey.CommitteePublicKey
+	var r3 []incognitokey.CommitteePublicKey
+	var r4 []incognitokey.CommitteePublicKey
+	var r5 []incognitokey.CommitteePublicKey
+	var r6 []incognitokey.CommitteePublicKey
+	var r7 []incognitokey.CommitteePublicKey
+	var r8 error
+	if rf, ok := ret.Get(0).(func() (map[byte][]incognitokey.CommitteePublicKey, map[byte][]incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, []incognitokey.CommitteePublicKey, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() map[byte][]incognitokey.CommitteePublicKey); ok {
 		r0 = rf()
 	} else {
@@ -52,7 +74,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r1 map[byte][]incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(1).(func() map[byte][]incognitokey.CommitteePublicKey); ok {
 		r1 = rf()
 	} else {
@@ -61,7 +82,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r2 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(2).(func() []incognitokey.CommitteePublicKey); ok {
 		r2 = rf()
 	} else {
@@ -70,7 +90,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r3 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(3).(func() []incognitokey.CommitteePublicKey); ok {
 		r3 = rf()
 	} else {
@@ -79,7 +98,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r4 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(4).(func() []incognitokey.CommitteePublicKey); ok {
 		r4 = rf()
 	} else {
@@ -88,7 +106,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r5 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(5).(func() []incognitokey.CommitteePublicKey); ok {
 		r5 = rf()
 	} else {
@@ -97,7 +114,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r6 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(6).(func() []incognitokey.CommitteePublicKey); ok {
 		r6 = rf()
 	} else {
@@ -106,7 +122,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r7 []incognitokey.CommitteePublicKey
 	if rf, ok := ret.Get(7).(func() []incognitokey.CommitteePublicKey); ok {
 		r7 = rf()
 	} else {
@@ -115,7 +130,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 		}
 	}
 
-	var r8 error
 	if rf, ok := ret.Get(8).(func() error); ok {
 		r8 = rf()
 	} else {
@@ -125,11 +139,19 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidate() (map[byte][]i
 	return r0, r1, r2, r3, r4, r5, r6, r7, r8
 }
 
-// GetAllCommitteeValidatorCandidateFlattenListFromDatabase provides a mock function with given fields:
+// GetAllCommitteeValidatorCandidateFlattenListFromDatabase provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidateFlattenListFromDatabase() ([]string, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCommitteeValidatorCandidateFlattenListFromDatabase")
+	}
+
 	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []string); ok {
 		r0 = rf()
 	} else {
@@ -138,7 +160,6 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidateFlattenListFromD
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -148,10 +169,14 @@ func (_m *BeaconViewRetriever) GetAllCommitteeValidatorCandidateFlattenListFromD
 	return r0, r1
 }
 
-// GetAutoStakingList provides a mock function with given fields:
+// GetAutoStakingList provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetAutoStakingList() map[string]bool {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAutoStakingList")
+	}
+
 	var r0 map[string]bool
 	if rf, ok := ret.Get(0).(func() map[string]bool); ok {
 		r0 = rf()
@@ -164,10 +189,34 @@ func (_m *BeaconViewRetriever) GetAutoStakingList() map[string]bool {
 	return r0
 }
 
-// GetBeaconFeatureStateDB provides a mock function with given fields:
+// GetBeaconConsensusStateDB provides a mock function with no fields
+func (_m *BeaconViewRetriever) GetBeaconConsensusStateDB() *statedb.StateDB {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBeaconConsensusStateDB")
+	}
+
+	var r0 *statedb.StateDB
+	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statedb.StateDB)
+		}
+	}
+
+	return r0
+}
+
+// GetBeaconFeatureStateDB provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetBeaconFeatureStateDB() *statedb.StateDB {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBeaconFeatureStateDB")
+	}
+
 	var r0 *statedb.StateDB
 	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
 		r0 = rf()
@@ -180,10 +229,14 @@ func (_m *BeaconViewRetriever) GetBeaconFeatureStateDB() *statedb.StateDB {
 	return r0
 }
 
-// GetBeaconRewardStateDB provides a mock function with given fields:
+// GetBeaconRewardStateDB provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetBeaconRewardStateDB() *statedb.StateDB {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBeaconRewardStateDB")
+	}
+
 	var r0 *statedb.StateDB
 	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
 		r0 = rf()
@@ -196,10 +249,14 @@ func (_m *BeaconViewRetriever) GetBeaconRewardStateDB() *statedb.StateDB {
 	return r0
 }
 
-// GetBeaconSlashStateDB provides a mock function with given fields:
+// GetBeaconSlashStateDB provides a mock function with no fields
 func (_m *BeaconViewRetriever) GetBeaconSlashStateDB() *statedb.StateDB {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBeaconSlashStateDB")
+	}
+
 	var r0 *statedb.StateDB
 	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
 		r0 = rf()
@@ -211,3 +268,17 @@ func (_m *BeaconViewRetriever) GetBeaconSlashStateDB() *statedb.StateDB {
 
 	return r0
 }
+
+// NewBeaconViewRetriever creates a new instance of BeaconViewRetriever. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBeaconViewRetriever(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BeaconViewRetriever {
+	mock := &BeaconViewRetriever{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
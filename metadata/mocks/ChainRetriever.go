@@ -1,4 +1,4 @@
-// Code generated by mockery v2.0.0. DO NOT EDIT.
+// Code generated by mockery. DO NOT EDIT.
 
 package mocks
 
@@ -18,10 +18,14 @@ type ChainRetriever struct {
 	mock.Mock
 }
 
-// GetBNBChainID provides a mock function with given fields:
+// GetBNBChainID provides a mock function with no fields
 func (_m *ChainRetriever) GetBNBChainID() string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBNBChainID")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
@@ -32,10 +36,14 @@ func (_m *ChainRetriever) GetBNBChainID() string {
 	return r0
 }
 
-// GetBTCChainID provides a mock function with given fields:
+// GetBTCChainID provides a mock function with no fields
 func (_m *ChainRetriever) GetBTCChainID() string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBTCChainID")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
@@ -46,10 +54,14 @@ func (_m *ChainRetriever) GetBTCChainID() string {
 	return r0
 }
 
-// GetBTCHeaderChain provides a mock function with given fields:
+// GetBTCHeaderChain provides a mock function with no fields
 func (_m *ChainRetriever) GetBTCHeaderChain() *btcrelaying.BlockChain {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBTCHeaderChain")
+	}
+
 	var r0 *btcrelaying.BlockChain
 	if rf, ok := ret.Get(0).(func() *btcrelaying.BlockChain); ok {
 		r0 = rf()
@@ -62,24 +74,14 @@ func (_m *ChainRetriever) GetBTCHeaderChain() *btcrelaying.BlockChain {
 	return r0
 }
 
-// GetBeaconHeightBreakPointBurnAddr provides a mock function with given fields:
-func (_m *ChainRetriever) GetBeaconHeightBreakPointBurnAddr() uint64 {
-	ret := _m.Called()
-
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(uint64)
-	}
-
-	return r0
-}
-
 // GetBurningAddress provides a mock function with given fields: blockHeight
 func (_m *ChainRetriever) GetBurningAddress(blockHeight uint64) string {
 	ret := _m.Called(blockHeight)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBurningAddress")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func(uint64) string); ok {
 		r0 = rf(blockHeight)
@@ -94,6 +96,10 @@ func (_m *ChainRetriever) GetBurningAddress(blockHeight uint64) string {
 func (_m *ChainRetriever) GetCentralizedWebsitePaymentAddress(_a0 uint64) string {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetCentralizedWebsitePaymentAddress")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func(uint64) string); ok {
 		r0 = rf(_a0)
@@ -104,10 +110,42 @@ func (_m *ChainRetriever) GetCentralizedWebsitePaymentAddress(_a0 uint64) string
 	return r0
 }
 
+// GetFeatureActivationHeight provides a mock function with given fields: feature
+func (_m *ChainRetriever) GetFeatureActivationHeight(feature common.Feature) (uint64, bool) {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureActivationHeight")
+	}
+
+	var r0 uint64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(common.Feature) (uint64, bool)); ok {
+		return rf(feature)
+	}
+	if rf, ok := ret.Get(0).(func(common.Feature) uint64); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Feature) bool); ok {
+		r1 = rf(feature)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetFixedRandomForShardIDCommitment provides a mock function with given fields: beaconHeight
 func (_m *ChainRetriever) GetFixedRandomForShardIDCommitment(beaconHeight uint64) *privacy.Scalar {
 	ret := _m.Called(beaconHeight)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetFixedRandomForShardIDCommitment")
+	}
+
 	var r0 *privacy.Scalar
 	if rf, ok := ret.Get(0).(func(uint64) *privacy.Scalar); ok {
 		r0 = rf(beaconHeight)
@@ -120,10 +158,14 @@ func (_m *ChainRetriever) GetFixedRandomForShardIDCommitment(beaconHeight uint64
 	return r0
 }
 
-// GetPortalFeederAddress provides a mock function with given fields:
-func (_m *ChainRetriever) GetPortalFeederAddress() string {
+// GetPortalETHContractAddrStr provides a mock function with no fields
+func (_m *ChainRetriever) GetPortalETHContractAddrStr() string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetPortalETHContractAddrStr")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
@@ -134,13 +176,35 @@ func (_m *ChainRetriever) GetPortalFeederAddress() string {
 	return r0
 }
 
-// GetStakingAmountShard provides a mock function with given fields:
-func (_m *ChainRetriever) GetStakingAmountShard() uint64 {
+// GetPortalFeederAddress provides a mock function with no fields
+func (_m *ChainRetriever) GetPortalFeederAddress() string {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	if len(ret) == 0 {
+		panic("no return value specified for GetPortalFeederAddress")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetStakingAmountShard provides a mock function with given fields: beaconViewRetriever, beaconHeight
+func (_m *ChainRetriever) GetStakingAmountShard(beaconViewRetriever metadata.BeaconViewRetriever, beaconHeight uint64) uint64 {
+	ret := _m.Called(beaconViewRetriever, beaconHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStakingAmountShard")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(metadata.BeaconViewRetriever, uint64) uint64); ok {
+		r0 = rf(beaconViewRetriever, beaconHeight)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
@@ -148,18 +212,49 @@ func (_m *ChainRetriever) GetStakingAmountShard() uint64 {
 	return r0
 }
 
+// GetSupportedCollateralTokenIDs provides a mock function with given fields: beaconHeight
+func (_m *ChainRetriever) GetSupportedCollateralTokenIDs(beaconHeight uint64) []string {
+	ret := _m.Called(beaconHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSupportedCollateralTokenIDs")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(uint64) []string); ok {
+		r0 = rf(beaconHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // GetTransactionByHash provides a mock function with given fields: _a0
 func (_m *ChainRetriever) GetTransactionByHash(_a0 common.Hash) (byte, common.Hash, uint64, int, metadata.Transaction, error) {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByHash")
+	}
+
 	var r0 byte
+	var r1 common.Hash
+	var r2 uint64
+	var r3 int
+	var r4 metadata.Transaction
+	var r5 error
+	if rf, ok := ret.Get(0).(func(common.Hash) (byte, common.Hash, uint64, int, metadata.Transaction, error)); ok {
+		return rf(_a0)
+	}
 	if rf, ok := ret.Get(0).(func(common.Hash) byte); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Get(0).(byte)
 	}
 
-	var r1 common.Hash
 	if rf, ok := ret.Get(1).(func(common.Hash) common.Hash); ok {
 		r1 = rf(_a0)
 	} else {
@@ -168,21 +263,18 @@ func (_m *ChainRetriever) GetTransactionByHash(_a0 common.Hash) (byte, common.Ha
 		}
 	}
 
-	var r2 uint64
 	if rf, ok := ret.Get(2).(func(common.Hash) uint64); ok {
 		r2 = rf(_a0)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
-	var r3 int
 	if rf, ok := ret.Get(3).(func(common.Hash) int); ok {
 		r3 = rf(_a0)
 	} else {
 		r3 = ret.Get(3).(int)
 	}
 
-	var r4 metadata.Transaction
 	if rf, ok := ret.Get(4).(func(common.Hash) metadata.Transaction); ok {
 		r4 = rf(_a0)
 	} else {
@@ -191,7 +283,6 @@ func (_m *ChainRetriever) GetTransactionByHash(_a0 common.Hash) (byte, common.Ha
 		}
 	}
 
-	var r5 error
 	if rf, ok := ret.Get(5).(func(common.Hash) error); ok {
 		r5 = rf(_a0)
 	} else {
@@ -201,11 +292,73 @@ func (_m *ChainRetriever) GetTransactionByHash(_a0 common.Hash) (byte, common.Ha
 	return r0, r1, r2, r3, r4, r5
 }
 
+// IsFeatureActive provides a mock function with given fields: feature, beaconHeight
+func (_m *ChainRetriever) IsFeatureActive(feature common.Feature, beaconHeight uint64) bool {
+	ret := _m.Called(feature, beaconHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFeatureActive")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Feature, uint64) bool); ok {
+		r0 = rf(feature, beaconHeight)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsFeatureActiveAtEpoch provides a mock function with given fields: feature, epoch
+func (_m *ChainRetriever) IsFeatureActiveAtEpoch(feature common.Feature, epoch uint64) bool {
+	ret := _m.Called(feature, epoch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFeatureActiveAtEpoch")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Feature, uint64) bool); ok {
+		r0 = rf(feature, epoch)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsFeatureActiveInView provides a mock function with given fields: feature, beaconViewRetriever, beaconHeight
+func (_m *ChainRetriever) IsFeatureActiveInView(feature common.Feature, beaconViewRetriever metadata.BeaconViewRetriever, beaconHeight uint64) bool {
+	ret := _m.Called(feature, beaconViewRetriever, beaconHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFeatureActiveInView")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Feature, metadata.BeaconViewRetriever, uint64) bool); ok {
+		r0 = rf(feature, beaconViewRetriever, beaconHeight)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // ListPrivacyTokenAndBridgeTokenAndPRVByShardID provides a mock function with given fields: _a0
 func (_m *ChainRetriever) ListPrivacyTokenAndBridgeTokenAndPRVByShardID(_a0 byte) ([]common.Hash, error) {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrivacyTokenAndBridgeTokenAndPRVByShardID")
+	}
+
 	var r0 []common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(byte) ([]common.Hash, error)); ok {
+		return rf(_a0)
+	}
 	if rf, ok := ret.Get(0).(func(byte) []common.Hash); ok {
 		r0 = rf(_a0)
 	} else {
@@ -214,7 +367,6 @@ func (_m *ChainRetriever) ListPrivacyTokenAndBridgeTokenAndPRVByShardID(_a0 byte
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(byte) error); ok {
 		r1 = rf(_a0)
 	} else {
@@ -223,3 +375,17 @@ func (_m *ChainRetriever) ListPrivacyTokenAndBridgeTokenAndPRVByShardID(_a0 byte
 
 	return r0, r1
 }
+
+// NewChainRetriever creates a new instance of ChainRetriever. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChainRetriever(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChainRetriever {
+	mock := &ChainRetriever{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.0.0. DO NOT EDIT.
+// Code generated by mockery. DO NOT EDIT.
 
 package mocks
 
@@ -15,10 +15,14 @@ type ShardViewRetriever struct {
 	mock.Mock
 }
 
-// GetBeaconHeight provides a mock function with given fields:
+// GetBeaconHeight provides a mock function with no fields
 func (_m *ShardViewRetriever) GetBeaconHeight() uint64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetBeaconHeight")
+	}
+
 	var r0 uint64
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
@@ -29,10 +33,14 @@ func (_m *ShardViewRetriever) GetBeaconHeight() uint64 {
 	return r0
 }
 
-// GetCopiedFeatureStateDB provides a mock function with given fields:
+// GetCopiedFeatureStateDB provides a mock function with no fields
 func (_m *ShardViewRetriever) GetCopiedFeatureStateDB() *statedb.StateDB {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetCopiedFeatureStateDB")
+	}
+
 	var r0 *statedb.StateDB
 	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
 		r0 = rf()
@@ -45,10 +53,50 @@ func (_m *ShardViewRetriever) GetCopiedFeatureStateDB() *statedb.StateDB {
 	return r0
 }
 
-// GetShardRewardStateDB provides a mock function with given fields:
+// GetEpoch provides a mock function with no fields
+func (_m *ShardViewRetriever) GetEpoch() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEpoch")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// GetHeight provides a mock function with no fields
+func (_m *ShardViewRetriever) GetHeight() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeight")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// GetShardRewardStateDB provides a mock function with no fields
 func (_m *ShardViewRetriever) GetShardRewardStateDB() *statedb.StateDB {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetShardRewardStateDB")
+	}
+
 	var r0 *statedb.StateDB
 	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
 		r0 = rf()
@@ -61,10 +109,14 @@ func (_m *ShardViewRetriever) GetShardRewardStateDB() *statedb.StateDB {
 	return r0
 }
 
-// GetStakingTx provides a mock function with given fields:
+// GetStakingTx provides a mock function with no fields
 func (_m *ShardViewRetriever) GetStakingTx() map[string]string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetStakingTx")
+	}
+
 	var r0 map[string]string
 	if rf, ok := ret.Get(0).(func() map[string]string); ok {
 		r0 = rf()
@@ -77,10 +129,14 @@ func (_m *ShardViewRetriever) GetStakingTx() map[string]string {
 	return r0
 }
 
-// ListShardPrivacyTokenAndPRV provides a mock function with given fields:
+// ListShardPrivacyTokenAndPRV provides a mock function with no fields
 func (_m *ShardViewRetriever) ListShardPrivacyTokenAndPRV() []common.Hash {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for ListShardPrivacyTokenAndPRV")
+	}
+
 	var r0 []common.Hash
 	if rf, ok := ret.Get(0).(func() []common.Hash); ok {
 		r0 = rf()
@@ -92,3 +148,17 @@ func (_m *ShardViewRetriever) ListShardPrivacyTokenAndPRV() []common.Hash {
 
 	return r0
 }
+
+// NewShardViewRetriever creates a new instance of ShardViewRetriever. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShardViewRetriever(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShardViewRetriever {
+	mock := &ShardViewRetriever{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
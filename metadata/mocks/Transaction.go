@@ -1,4 +1,4 @@
-// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+// Code generated by mockery. DO NOT EDIT.
 
 package mocks
 
@@ -22,14 +22,21 @@ type Transaction struct {
 func (_m *Transaction) CalculateBurningTxValue(bcr metadata.ChainRetriever, retriever metadata.ShardViewRetriever, viewRetriever metadata.BeaconViewRetriever, beaconHeight uint64) (bool, uint64) {
 	ret := _m.Called(bcr, retriever, viewRetriever, beaconHeight)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CalculateBurningTxValue")
+	}
+
 	var r0 bool
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) (bool, uint64)); ok {
+		return rf(bcr, retriever, viewRetriever, beaconHeight)
+	}
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) bool); ok {
 		r0 = rf(bcr, retriever, viewRetriever, beaconHeight)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 uint64
 	if rf, ok := ret.Get(1).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) uint64); ok {
 		r1 = rf(bcr, retriever, viewRetriever, beaconHeight)
 	} else {
@@ -39,10 +46,14 @@ func (_m *Transaction) CalculateBurningTxValue(bcr metadata.ChainRetriever, retr
 	return r0, r1
 }
 
-// CalculateTxValue provides a mock function with given fields:
+// CalculateTxValue provides a mock function with no fields
 func (_m *Transaction) CalculateTxValue() uint64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for CalculateTxValue")
+	}
+
 	var r0 uint64
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
@@ -57,6 +68,10 @@ func (_m *Transaction) CalculateTxValue() uint64 {
 func (_m *Transaction) CheckTxVersion(_a0 int8) bool {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTxVersion")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func(int8) bool); ok {
 		r0 = rf(_a0)
@@ -67,18 +82,25 @@ func (_m *Transaction) CheckTxVersion(_a0 int8) bool {
 	return r0
 }
 
-// GetFullTxValues provides a mock function with given fields:
+// GetFullTxValues provides a mock function with no fields
 func (_m *Transaction) GetFullTxValues() (uint64, uint64) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetFullTxValues")
+	}
+
 	var r0 uint64
+	var r1 uint64
+	if rf, ok := ret.Get(0).(func() (uint64, uint64)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	var r1 uint64
 	if rf, ok := ret.Get(1).(func() uint64); ok {
 		r1 = rf()
 	} else {
@@ -88,10 +110,14 @@ func (_m *Transaction) GetFullTxValues() (uint64, uint64) {
 	return r0, r1
 }
 
-// GetInfo provides a mock function with given fields:
+// GetInfo provides a mock function with no fields
 func (_m *Transaction) GetInfo() []byte {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfo")
+	}
+
 	var r0 []byte
 	if rf, ok := ret.Get(0).(func() []byte); ok {
 		r0 = rf()
@@ -104,10 +130,14 @@ func (_m *Transaction) GetInfo() []byte {
 	return r0
 }
 
-// GetLockTime provides a mock function with given fields:
+// GetLockTime provides a mock function with no fields
 func (_m *Transaction) GetLockTime() int64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetLockTime")
+	}
+
 	var r0 int64
 	if rf, ok := ret.Get(0).(func() int64); ok {
 		r0 = rf()
@@ -118,10 +148,14 @@ func (_m *Transaction) GetLockTime() int64 {
 	return r0
 }
 
-// GetMetadata provides a mock function with given fields:
+// GetMetadata provides a mock function with no fields
 func (_m *Transaction) GetMetadata() metadata.Metadata {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadata")
+	}
+
 	var r0 metadata.Metadata
 	if rf, ok := ret.Get(0).(func() metadata.Metadata); ok {
 		r0 = rf()
@@ -138,7 +172,15 @@ func (_m *Transaction) GetMetadata() metadata.Metadata {
 func (_m *Transaction) GetMetadataFromVinsTx(_a0 metadata.ChainRetriever, _a1 metadata.ShardViewRetriever, _a2 metadata.BeaconViewRetriever) (metadata.Metadata, error) {
 	ret := _m.Called(_a0, _a1, _a2)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadataFromVinsTx")
+	}
+
 	var r0 metadata.Metadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) (metadata.Metadata, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) metadata.Metadata); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
@@ -147,7 +189,6 @@ func (_m *Transaction) GetMetadataFromVinsTx(_a0 metadata.ChainRetriever, _a1 me
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) error); ok {
 		r1 = rf(_a0, _a1, _a2)
 	} else {
@@ -157,10 +198,14 @@ func (_m *Transaction) GetMetadataFromVinsTx(_a0 metadata.ChainRetriever, _a1 me
 	return r0, r1
 }
 
-// GetMetadataType provides a mock function with given fields:
+// GetMetadataType provides a mock function with no fields
 func (_m *Transaction) GetMetadataType() int {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadataType")
+	}
+
 	var r0 int
 	if rf, ok := ret.Get(0).(func() int); ok {
 		r0 = rf()
@@ -171,10 +216,14 @@ func (_m *Transaction) GetMetadataType() int {
 	return r0
 }
 
-// GetProof provides a mock function with given fields:
+// GetProof provides a mock function with no fields
 func (_m *Transaction) GetProof() *zkp.PaymentProof {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetProof")
+	}
+
 	var r0 *zkp.PaymentProof
 	if rf, ok := ret.Get(0).(func() *zkp.PaymentProof); ok {
 		r0 = rf()
@@ -187,11 +236,19 @@ func (_m *Transaction) GetProof() *zkp.PaymentProof {
 	return r0
 }
 
-// GetReceivers provides a mock function with given fields:
+// GetReceivers provides a mock function with no fields
 func (_m *Transaction) GetReceivers() ([][]byte, []uint64) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceivers")
+	}
+
 	var r0 [][]byte
+	var r1 []uint64
+	if rf, ok := ret.Get(0).(func() ([][]byte, []uint64)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() [][]byte); ok {
 		r0 = rf()
 	} else {
@@ -200,7 +257,6 @@ func (_m *Transaction) GetReceivers() ([][]byte, []uint64) {
 		}
 	}
 
-	var r1 []uint64
 	if rf, ok := ret.Get(1).(func() []uint64); ok {
 		r1 = rf()
 	} else {
@@ -212,10 +268,14 @@ func (_m *Transaction) GetReceivers() ([][]byte, []uint64) {
 	return r0, r1
 }
 
-// GetSender provides a mock function with given fields:
+// GetSender provides a mock function with no fields
 func (_m *Transaction) GetSender() []byte {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetSender")
+	}
+
 	var r0 []byte
 	if rf, ok := ret.Get(0).(func() []byte); ok {
 		r0 = rf()
@@ -228,10 +288,14 @@ func (_m *Transaction) GetSender() []byte {
 	return r0
 }
 
-// GetSenderAddrLastByte provides a mock function with given fields:
+// GetSenderAddrLastByte provides a mock function with no fields
 func (_m *Transaction) GetSenderAddrLastByte() byte {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetSenderAddrLastByte")
+	}
+
 	var r0 byte
 	if rf, ok := ret.Get(0).(func() byte); ok {
 		r0 = rf()
@@ -242,10 +306,14 @@ func (_m *Transaction) GetSenderAddrLastByte() byte {
 	return r0
 }
 
-// GetSigPubKey provides a mock function with given fields:
+// GetSigPubKey provides a mock function with no fields
 func (_m *Transaction) GetSigPubKey() []byte {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetSigPubKey")
+	}
+
 	var r0 []byte
 	if rf, ok := ret.Get(0).(func() []byte); ok {
 		r0 = rf()
@@ -258,10 +326,14 @@ func (_m *Transaction) GetSigPubKey() []byte {
 	return r0
 }
 
-// GetTokenID provides a mock function with given fields:
+// GetTokenID provides a mock function with no fields
 func (_m *Transaction) GetTokenID() *common.Hash {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenID")
+	}
+
 	var r0 *common.Hash
 	if rf, ok := ret.Get(0).(func() *common.Hash); ok {
 		r0 = rf()
@@ -274,11 +346,19 @@ func (_m *Transaction) GetTokenID() *common.Hash {
 	return r0
 }
 
-// GetTokenReceivers provides a mock function with given fields:
+// GetTokenReceivers provides a mock function with no fields
 func (_m *Transaction) GetTokenReceivers() ([][]byte, []uint64) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenReceivers")
+	}
+
 	var r0 [][]byte
+	var r1 []uint64
+	if rf, ok := ret.Get(0).(func() ([][]byte, []uint64)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() [][]byte); ok {
 		r0 = rf()
 	} else {
@@ -287,7 +367,6 @@ func (_m *Transaction) GetTokenReceivers() ([][]byte, []uint64) {
 		}
 	}
 
-	var r1 []uint64
 	if rf, ok := ret.Get(1).(func() []uint64); ok {
 		r1 = rf()
 	} else {
@@ -299,18 +378,26 @@ func (_m *Transaction) GetTokenReceivers() ([][]byte, []uint64) {
 	return r0, r1
 }
 
-// GetTokenUniqueReceiver provides a mock function with given fields:
+// GetTokenUniqueReceiver provides a mock function with no fields
 func (_m *Transaction) GetTokenUniqueReceiver() (bool, []byte, uint64) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenUniqueReceiver")
+	}
+
 	var r0 bool
+	var r1 []byte
+	var r2 uint64
+	if rf, ok := ret.Get(0).(func() (bool, []byte, uint64)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 []byte
 	if rf, ok := ret.Get(1).(func() []byte); ok {
 		r1 = rf()
 	} else {
@@ -319,7 +406,6 @@ func (_m *Transaction) GetTokenUniqueReceiver() (bool, []byte, uint64) {
 		}
 	}
 
-	var r2 uint64
 	if rf, ok := ret.Get(2).(func() uint64); ok {
 		r2 = rf()
 	} else {
@@ -329,18 +415,27 @@ func (_m *Transaction) GetTokenUniqueReceiver() (bool, []byte, uint64) {
 	return r0, r1, r2
 }
 
-// GetTransferData provides a mock function with given fields:
+// GetTransferData provides a mock function with no fields
 func (_m *Transaction) GetTransferData() (bool, []byte, uint64, *common.Hash) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransferData")
+	}
+
 	var r0 bool
+	var r1 []byte
+	var r2 uint64
+	var r3 *common.Hash
+	if rf, ok := ret.Get(0).(func() (bool, []byte, uint64, *common.Hash)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 []byte
 	if rf, ok := ret.Get(1).(func() []byte); ok {
 		r1 = rf()
 	} else {
@@ -349,14 +444,12 @@ func (_m *Transaction) GetTransferData() (bool, []byte, uint64, *common.Hash) {
 		}
 	}
 
-	var r2 uint64
 	if rf, ok := ret.Get(2).(func() uint64); ok {
 		r2 = rf()
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
-	var r3 *common.Hash
 	if rf, ok := ret.Get(3).(func() *common.Hash); ok {
 		r3 = rf()
 	} else {
@@ -368,10 +461,14 @@ func (_m *Transaction) GetTransferData() (bool, []byte, uint64, *common.Hash) {
 	return r0, r1, r2, r3
 }
 
-// GetTxActualSize provides a mock function with given fields:
+// GetTxActualSize provides a mock function with no fields
 func (_m *Transaction) GetTxActualSize() uint64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxActualSize")
+	}
+
 	var r0 uint64
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
@@ -382,10 +479,14 @@ func (_m *Transaction) GetTxActualSize() uint64 {
 	return r0
 }
 
-// GetTxFee provides a mock function with given fields:
+// GetTxFee provides a mock function with no fields
 func (_m *Transaction) GetTxFee() uint64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxFee")
+	}
+
 	var r0 uint64
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
@@ -396,10 +497,14 @@ func (_m *Transaction) GetTxFee() uint64 {
 	return r0
 }
 
-// GetTxFeeToken provides a mock function with given fields:
+// GetTxFeeToken provides a mock function with no fields
 func (_m *Transaction) GetTxFeeToken() uint64 {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxFeeToken")
+	}
+
 	var r0 uint64
 	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
@@ -410,10 +515,14 @@ func (_m *Transaction) GetTxFeeToken() uint64 {
 	return r0
 }
 
-// GetType provides a mock function with given fields:
+// GetType provides a mock function with no fields
 func (_m *Transaction) GetType() string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetType")
+	}
+
 	var r0 string
 	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
@@ -424,18 +533,26 @@ func (_m *Transaction) GetType() string {
 	return r0
 }
 
-// GetUniqueReceiver provides a mock function with given fields:
+// GetUniqueReceiver provides a mock function with no fields
 func (_m *Transaction) GetUniqueReceiver() (bool, []byte, uint64) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetUniqueReceiver")
+	}
+
 	var r0 bool
+	var r1 []byte
+	var r2 uint64
+	if rf, ok := ret.Get(0).(func() (bool, []byte, uint64)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 []byte
 	if rf, ok := ret.Get(1).(func() []byte); ok {
 		r1 = rf()
 	} else {
@@ -444,7 +561,6 @@ func (_m *Transaction) GetUniqueReceiver() (bool, []byte, uint64) {
 		}
 	}
 
-	var r2 uint64
 	if rf, ok := ret.Get(2).(func() uint64); ok {
 		r2 = rf()
 	} else {
@@ -454,10 +570,14 @@ func (_m *Transaction) GetUniqueReceiver() (bool, []byte, uint64) {
 	return r0, r1, r2
 }
 
-// Hash provides a mock function with given fields:
+// Hash provides a mock function with no fields
 func (_m *Transaction) Hash() *common.Hash {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for Hash")
+	}
+
 	var r0 *common.Hash
 	if rf, ok := ret.Get(0).(func() *common.Hash); ok {
 		r0 = rf()
@@ -474,6 +594,10 @@ func (_m *Transaction) Hash() *common.Hash {
 func (_m *Transaction) IsCoinsBurning(_a0 metadata.ChainRetriever, _a1 metadata.ShardViewRetriever, _a2 metadata.BeaconViewRetriever, _a3 uint64) bool {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
 
+	if len(ret) == 0 {
+		panic("no return value specified for IsCoinsBurning")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3)
@@ -488,6 +612,10 @@ func (_m *Transaction) IsCoinsBurning(_a0 metadata.ChainRetriever, _a1 metadata.
 func (_m *Transaction) IsFullBurning(_a0 metadata.ChainRetriever, _a1 metadata.ShardViewRetriever, _a2 metadata.BeaconViewRetriever, _a3 uint64) bool {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
 
+	if len(ret) == 0 {
+		panic("no return value specified for IsFullBurning")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3)
@@ -498,10 +626,14 @@ func (_m *Transaction) IsFullBurning(_a0 metadata.ChainRetriever, _a1 metadata.S
 	return r0
 }
 
-// IsPrivacy provides a mock function with given fields:
+// IsPrivacy provides a mock function with no fields
 func (_m *Transaction) IsPrivacy() bool {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for IsPrivacy")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
@@ -512,10 +644,14 @@ func (_m *Transaction) IsPrivacy() bool {
 	return r0
 }
 
-// IsSalaryTx provides a mock function with given fields:
+// IsSalaryTx provides a mock function with no fields
 func (_m *Transaction) IsSalaryTx() bool {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for IsSalaryTx")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
@@ -526,10 +662,14 @@ func (_m *Transaction) IsSalaryTx() bool {
 	return r0
 }
 
-// ListSNDOutputsHashH provides a mock function with given fields:
+// ListSNDOutputsHashH provides a mock function with no fields
 func (_m *Transaction) ListSNDOutputsHashH() []common.Hash {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for ListSNDOutputsHashH")
+	}
+
 	var r0 []common.Hash
 	if rf, ok := ret.Get(0).(func() []common.Hash); ok {
 		r0 = rf()
@@ -542,10 +682,14 @@ func (_m *Transaction) ListSNDOutputsHashH() []common.Hash {
 	return r0
 }
 
-// ListSerialNumbersHashH provides a mock function with given fields:
+// ListSerialNumbersHashH provides a mock function with no fields
 func (_m *Transaction) ListSerialNumbersHashH() []common.Hash {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for ListSerialNumbersHashH")
+	}
+
 	var r0 []common.Hash
 	if rf, ok := ret.Get(0).(func() []common.Hash); ok {
 		r0 = rf()
@@ -567,6 +711,10 @@ func (_m *Transaction) SetMetadata(_a0 metadata.Metadata) {
 func (_m *Transaction) ValidateDoubleSpendWithBlockchain(_a0 byte, _a1 *statedb.StateDB, _a2 *common.Hash) error {
 	ret := _m.Called(_a0, _a1, _a2)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateDoubleSpendWithBlockchain")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(byte, *statedb.StateDB, *common.Hash) error); ok {
 		r0 = rf(_a0, _a1, _a2)
@@ -581,14 +729,21 @@ func (_m *Transaction) ValidateDoubleSpendWithBlockchain(_a0 byte, _a1 *statedb.
 func (_m *Transaction) ValidateSanityData(_a0 metadata.ChainRetriever, _a1 metadata.ShardViewRetriever, _a2 metadata.BeaconViewRetriever, _a3 uint64) (bool, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSanityData")
+	}
+
 	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) (bool, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, uint64) error); ok {
 		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
@@ -602,14 +757,21 @@ func (_m *Transaction) ValidateSanityData(_a0 metadata.ChainRetriever, _a1 metad
 func (_m *Transaction) ValidateTransaction(_a0 map[string]bool, _a1 *statedb.StateDB, _a2 *statedb.StateDB, _a3 byte, _a4 *common.Hash) (bool, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTransaction")
+	}
+
 	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, byte, *common.Hash) (bool, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
 	if rf, ok := ret.Get(0).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, byte, *common.Hash) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, byte, *common.Hash) error); ok {
 		r1 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
@@ -623,14 +785,21 @@ func (_m *Transaction) ValidateTransaction(_a0 map[string]bool, _a1 *statedb.Sta
 func (_m *Transaction) ValidateTxByItself(_a0 map[string]bool, _a1 *statedb.StateDB, _a2 *statedb.StateDB, _a3 metadata.ChainRetriever, _a4 byte, _a5 metadata.ShardViewRetriever, _a6 metadata.BeaconViewRetriever) (bool, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTxByItself")
+	}
+
 	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, metadata.ChainRetriever, byte, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) (bool, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	}
 	if rf, ok := ret.Get(0).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, metadata.ChainRetriever, byte, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(map[string]bool, *statedb.StateDB, *statedb.StateDB, metadata.ChainRetriever, byte, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) error); ok {
 		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
@@ -644,6 +813,10 @@ func (_m *Transaction) ValidateTxByItself(_a0 map[string]bool, _a1 *statedb.Stat
 func (_m *Transaction) ValidateTxWithBlockChain(chainRetriever metadata.ChainRetriever, shardViewRetriever metadata.ShardViewRetriever, beaconViewRetriever metadata.BeaconViewRetriever, shardID byte, stateDB *statedb.StateDB) error {
 	ret := _m.Called(chainRetriever, shardViewRetriever, beaconViewRetriever, shardID, stateDB)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTxWithBlockChain")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(metadata.ChainRetriever, metadata.ShardViewRetriever, metadata.BeaconViewRetriever, byte, *statedb.StateDB) error); ok {
 		r0 = rf(chainRetriever, shardViewRetriever, beaconViewRetriever, shardID, stateDB)
@@ -658,6 +831,10 @@ func (_m *Transaction) ValidateTxWithBlockChain(chainRetriever metadata.ChainRet
 func (_m *Transaction) ValidateTxWithCurrentMempool(_a0 metadata.MempoolRetriever) error {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTxWithCurrentMempool")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(metadata.MempoolRetriever) error); ok {
 		r0 = rf(_a0)
@@ -668,10 +845,14 @@ func (_m *Transaction) ValidateTxWithCurrentMempool(_a0 metadata.MempoolRetrieve
 	return r0
 }
 
-// ValidateType provides a mock function with given fields:
+// ValidateType provides a mock function with no fields
 func (_m *Transaction) ValidateType() bool {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateType")
+	}
+
 	var r0 bool
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
@@ -686,14 +867,21 @@ func (_m *Transaction) ValidateType() bool {
 func (_m *Transaction) VerifyMinerCreatedTxBeforeGettingInBlock(_a0 []metadata.Transaction, _a1 []int, _a2 [][]string, _a3 []int, _a4 byte, _a5 metadata.ChainRetriever, _a6 *metadata.AccumulatedValues, _a7 metadata.ShardViewRetriever, _a8 metadata.BeaconViewRetriever) (bool, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8)
 
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMinerCreatedTxBeforeGettingInBlock")
+	}
+
 	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]metadata.Transaction, []int, [][]string, []int, byte, metadata.ChainRetriever, *metadata.AccumulatedValues, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) (bool, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8)
+	}
 	if rf, ok := ret.Get(0).(func([]metadata.Transaction, []int, [][]string, []int, byte, metadata.ChainRetriever, *metadata.AccumulatedValues, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) bool); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func([]metadata.Transaction, []int, [][]string, []int, byte, metadata.ChainRetriever, *metadata.AccumulatedValues, metadata.ShardViewRetriever, metadata.BeaconViewRetriever) error); ok {
 		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8)
 	} else {
@@ -702,3 +890,17 @@ func (_m *Transaction) VerifyMinerCreatedTxBeforeGettingInBlock(_a0 []metadata.T
 
 	return r0, r1
 }
+
+// NewTransaction creates a new instance of Transaction. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransaction(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Transaction {
+	mock := &Transaction{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
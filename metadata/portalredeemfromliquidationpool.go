@@ -123,9 +123,10 @@ func (redeemReq PortalRedeemLiquidateExchangeRates) ValidateSanityData(chainRetr
 		return false, false, NewMetadataTxError(PortalRedeemLiquidateExchangeRatesParamError, errors.New("TokenID is not in portal tokens list"))
 	}
 
-	// reject Redeem Request from Liquidation pool from BCHeightBreakPointPortalV3
-	if beaconHeight >= chainRetriever.GetBCHeightBreakPointPortalV3() {
-		return false, false, NewMetadataTxError(PortalRedeemLiquidateExchangeRatesParamError, fmt.Errorf("Should create redeem request from liquidation pool v3 after epoch %v", chainRetriever.GetBCHeightBreakPointPortalV3()))
+	// reject Redeem Request from Liquidation pool from the activation of PortalV3Feature
+	if chainRetriever.IsFeatureActiveInView(common.PortalV3Feature, beaconViewRetriever, beaconHeight) {
+		activationHeight, _ := chainRetriever.GetFeatureActivationHeight(common.PortalV3Feature)
+		return false, false, NewMetadataTxError(PortalRedeemLiquidateExchangeRatesParamError, fmt.Errorf("Should create redeem request from liquidation pool v3 after epoch %v", activationHeight))
 	}
 	return true, true, nil
 }
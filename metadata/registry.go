@@ -0,0 +1,183 @@
+package metadata
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Features registering metadata types, a feature module of blockchain dispatches
+// the actions, instructions and response txs of the metadata types it registers
+const (
+	BridgeFeature     = "bridge"
+	StakingFeature    = "staking"
+	RewardFeature     = "reward"
+	SlashFeature      = "slash"
+	PDEFeature        = "pde"
+	PortalFeature     = "portal"
+	RelayingFeature   = "relaying"
+	GovernanceFeature = "governance"
+)
+
+type registeredMetadataType struct {
+	feature     string
+	newMetadata func() Metadata
+}
+
+var metadataRegistry = struct {
+	sync.RWMutex
+	types map[int]registeredMetadataType
+}{
+	types: map[int]registeredMetadataType{},
+}
+
+// RegisterMetadataTypes registers metadata types of a feature with constructors of their metadata,
+// none of them is registered if one is already registered
+func RegisterMetadataTypes(feature string, types map[int]func() Metadata) error {
+	metadataRegistry.Lock()
+	defer metadataRegistry.Unlock()
+	for metaType := range types {
+		if registered, ok := metadataRegistry.types[metaType]; ok {
+			return fmt.Errorf("metadata type %+v of feature %+v is already registered by feature %+v", metaType, feature, registered.feature)
+		}
+	}
+	for metaType, newMetadata := range types {
+		metadataRegistry.types[metaType] = registeredMetadataType{
+			feature:     feature,
+			newMetadata: newMetadata,
+		}
+	}
+	return nil
+}
+
+func mustRegisterMetadataTypes(feature string, types map[int]func() Metadata) {
+	if err := RegisterMetadataTypes(feature, types); err != nil {
+		panic(err)
+	}
+}
+
+// GetMetadataFeature returns the feature which registers a metadata type
+func GetMetadataFeature(metaType int) (string, bool) {
+	metadataRegistry.RLock()
+	defer metadataRegistry.RUnlock()
+	registered, ok := metadataRegistry.types[metaType]
+	return registered.feature, ok
+}
+
+// GetRegisteredMetadataTypes returns the metadata types registered by a feature in order
+func GetRegisteredMetadataTypes(feature string) []int {
+	metadataRegistry.RLock()
+	defer metadataRegistry.RUnlock()
+	res := []int{}
+	for metaType, registered := range metadataRegistry.types {
+		if registered.feature == feature {
+			res = append(res, metaType)
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+func newMetadataByType(metaType int) (Metadata, bool) {
+	metadataRegistry.RLock()
+	defer metadataRegistry.RUnlock()
+	registered, ok := metadataRegistry.types[metaType]
+	if !ok {
+		return nil, false
+	}
+	return registered.newMetadata(), true
+}
+
+func init() {
+	mustRegisterMetadataTypes(BridgeFeature, map[int]func() Metadata{
+		IssuingRequestMeta:                 func() Metadata { return &IssuingRequest{} },
+		IssuingResponseMeta:                func() Metadata { return &IssuingResponse{} },
+		ContractingRequestMeta:             func() Metadata { return &ContractingRequest{} },
+		IssuingETHRequestMeta:              func() Metadata { return &IssuingETHRequest{} },
+		IssuingETHResponseMeta:             func() Metadata { return &IssuingETHResponse{} },
+		BurningRequestMeta:                 func() Metadata { return &BurningRequest{} },
+		BurningRequestMetaV2:               func() Metadata { return &BurningRequest{} },
+		BurningForDepositToSCRequestMeta:   func() Metadata { return &BurningRequest{} },
+		BurningForDepositToSCRequestMetaV2: func() Metadata { return &BurningRequest{} },
+	})
+	mustRegisterMetadataTypes(StakingFeature, map[int]func() Metadata{
+		ShardStakingMeta:              func() Metadata { return &StakingMetadata{} },
+		BeaconStakingMeta:             func() Metadata { return &StakingMetadata{} },
+		ReturnStakingMeta:             func() Metadata { return &ReturnStakingMetadata{} },
+		StopAutoStakingMeta:           func() Metadata { return &StopAutoStakingMetadata{} },
+		StakingPoolRegisterMeta:       func() Metadata { return &StakingPoolRegister{} },
+		StakingPoolDelegateMeta:       func() Metadata { return &StakingPoolDelegate{} },
+		StakingPoolDelegateRefundMeta: func() Metadata { return &StakingPoolDelegateRefund{} },
+		UnstakeRequestMeta:            func() Metadata { return &UnstakeRequest{} },
+		UnstakeResponseMeta:           func() Metadata { return &UnstakeResponse{} },
+	})
+	mustRegisterMetadataTypes(RewardFeature, map[int]func() Metadata{
+		BeaconSalaryResponseMeta:       func() Metadata { return &BeaconBlockSalaryRes{} },
+		WithDrawRewardRequestMeta:      func() Metadata { return &WithDrawRewardRequest{} },
+		WithDrawRewardResponseMeta:     func() Metadata { return &WithDrawRewardResponse{} },
+		AutoWithdrawRewardPolicyMeta:   func() Metadata { return &AutoWithdrawRewardPolicy{} },
+		AutoWithdrawRewardResponseMeta: func() Metadata { return &AutoWithdrawRewardResponse{} },
+	})
+	mustRegisterMetadataTypes(SlashFeature, map[int]func() Metadata{
+		SlashEquivocationRequestMeta: func() Metadata { return &SlashEquivocationRequest{} },
+	})
+	mustRegisterMetadataTypes(PDEFeature, map[int]func() Metadata{
+		PDEContributionMeta:                   func() Metadata { return &PDEContribution{} },
+		PDEPRVRequiredContributionRequestMeta: func() Metadata { return &PDEContribution{} },
+		PDETradeRequestMeta:                   func() Metadata { return &PDETradeRequest{} },
+		PDETradeResponseMeta:                  func() Metadata { return &PDETradeResponse{} },
+		PDECrossPoolTradeRequestMeta:          func() Metadata { return &PDECrossPoolTradeRequest{} },
+		PDECrossPoolTradeResponseMeta:         func() Metadata { return &PDECrossPoolTradeResponse{} },
+		PDEWithdrawalRequestMeta:              func() Metadata { return &PDEWithdrawalRequest{} },
+		PDEWithdrawalResponseMeta:             func() Metadata { return &PDEWithdrawalResponse{} },
+		PDEFeeWithdrawalRequestMeta:           func() Metadata { return &PDEFeeWithdrawalRequest{} },
+		PDEFeeWithdrawalResponseMeta:          func() Metadata { return &PDEFeeWithdrawalResponse{} },
+		PDEContributionResponseMeta:           func() Metadata { return &PDEContributionResponse{} },
+	})
+	mustRegisterMetadataTypes(PortalFeature, map[int]func() Metadata{
+		PortalCustodianDepositMeta:                    func() Metadata { return &PortalCustodianDeposit{} },
+		PortalRequestPortingMeta:                      func() Metadata { return &PortalUserRegister{} },
+		PortalRequestPortingMetaV3:                    func() Metadata { return &PortalUserRegister{} },
+		PortalUserRequestPTokenMeta:                   func() Metadata { return &PortalRequestPTokens{} },
+		PortalCustodianDepositResponseMeta:            func() Metadata { return &PortalCustodianDepositResponse{} },
+		PortalUserRequestPTokenResponseMeta:           func() Metadata { return &PortalRequestPTokensResponse{} },
+		PortalRedeemRequestMeta:                       func() Metadata { return &PortalRedeemRequest{} },
+		PortalRedeemRequestMetaV3:                     func() Metadata { return &PortalRedeemRequest{} },
+		PortalRedeemRequestResponseMeta:               func() Metadata { return &PortalRedeemRequestResponse{} },
+		PortalRequestUnlockCollateralMeta:             func() Metadata { return &PortalRequestUnlockCollateral{} },
+		PortalRequestUnlockCollateralMetaV3:           func() Metadata { return &PortalRequestUnlockCollateral{} },
+		PortalExchangeRatesMeta:                       func() Metadata { return &PortalExchangeRates{} },
+		PortalUnlockOverRateCollateralsMeta:           func() Metadata { return &PortalUnlockOverRateCollaterals{} },
+		PortalCustodianWithdrawRequestMeta:            func() Metadata { return &PortalCustodianWithdrawRequest{} },
+		PortalCustodianWithdrawResponseMeta:           func() Metadata { return &PortalCustodianWithdrawResponse{} },
+		PortalLiquidateCustodianMeta:                  func() Metadata { return &PortalLiquidateCustodian{} },
+		PortalLiquidateCustodianMetaV3:                func() Metadata { return &PortalLiquidateCustodian{} },
+		PortalLiquidateCustodianResponseMeta:          func() Metadata { return &PortalLiquidateCustodianResponse{} },
+		PortalRequestWithdrawRewardMeta:               func() Metadata { return &PortalRequestWithdrawReward{} },
+		PortalRequestWithdrawRewardResponseMeta:       func() Metadata { return &PortalWithdrawRewardResponse{} },
+		PortalRedeemFromLiquidationPoolMeta:           func() Metadata { return &PortalRedeemLiquidateExchangeRates{} },
+		PortalRedeemFromLiquidationPoolResponseMeta:   func() Metadata { return &PortalRedeemLiquidateExchangeRatesResponse{} },
+		PortalCustodianTopupMetaV2:                    func() Metadata { return &PortalLiquidationCustodianDepositV2{} },
+		PortalCustodianTopupResponseMetaV2:            func() Metadata { return &PortalLiquidationCustodianDepositResponseV2{} },
+		PortalCustodianTopupMeta:                      func() Metadata { return &PortalLiquidationCustodianDeposit{} },
+		PortalCustodianTopupResponseMeta:              func() Metadata { return &PortalLiquidationCustodianDepositResponse{} },
+		PortalPortingResponseMeta:                     func() Metadata { return &PortalFeeRefundResponse{} },
+		PortalReqMatchingRedeemMeta:                   func() Metadata { return &PortalReqMatchingRedeem{} },
+		PortalTopUpWaitingPortingRequestMeta:          func() Metadata { return &PortalTopUpWaitingPortingRequest{} },
+		PortalTopUpWaitingPortingResponseMeta:         func() Metadata { return &PortalTopUpWaitingPortingResponse{} },
+		PortalCustodianDepositMetaV3:                  func() Metadata { return &PortalCustodianDepositV3{} },
+		PortalCustodianWithdrawRequestMetaV3:          func() Metadata { return &PortalCustodianWithdrawRequestV3{} },
+		PortalRedeemFromLiquidationPoolMetaV3:         func() Metadata { return &PortalRedeemFromLiquidationPoolV3{} },
+		PortalRedeemFromLiquidationPoolResponseMetaV3: func() Metadata { return &PortalRedeemFromLiquidationPoolResponseV3{} },
+		PortalCustodianTopupMetaV3:                    func() Metadata { return &PortalLiquidationCustodianDepositV3{} },
+		PortalTopUpWaitingPortingRequestMetaV3:        func() Metadata { return &PortalTopUpWaitingPortingRequestV3{} },
+	})
+	mustRegisterMetadataTypes(RelayingFeature, map[int]func() Metadata{
+		RelayingBNBHeaderMeta: func() Metadata { return &RelayingHeader{} },
+		RelayingBTCHeaderMeta: func() Metadata { return &RelayingHeader{} },
+	})
+	mustRegisterMetadataTypes(GovernanceFeature, map[int]func() Metadata{
+		ParamChangeProposalMeta: func() Metadata { return &ParamChangeProposal{} },
+		ParamChangeVoteMeta:     func() Metadata { return &ParamChangeVote{} },
+	})
+}
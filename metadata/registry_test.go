@@ -0,0 +1,114 @@
+package metadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+func TestRegisterMetadataTypes(t *testing.T) {
+	const (
+		testFeature      = "test"
+		otherFeature     = "other"
+		testMetaType     = 10001
+		conflictMetaType = 10002
+	)
+	err := metadata.RegisterMetadataTypes(testFeature, map[int]func() metadata.Metadata{
+		testMetaType: func() metadata.Metadata { return &metadata.AutoWithdrawRewardPolicy{} },
+	})
+	if err != nil {
+		t.Fatalf("RegisterMetadataTypes() error = %v", err)
+	}
+	if feature, ok := metadata.GetMetadataFeature(testMetaType); !ok || feature != testFeature {
+		t.Errorf("GetMetadataFeature() = %v, %v, want %v", feature, ok, testFeature)
+	}
+	if got := metadata.GetRegisteredMetadataTypes(testFeature); !reflect.DeepEqual(got, []int{testMetaType}) {
+		t.Errorf("GetRegisteredMetadataTypes() = %v, want %v", got, []int{testMetaType})
+	}
+	meta, err := metadata.ParseMetadata(map[string]interface{}{"Type": testMetaType, "Interval": 2, "Threshold": 100})
+	if err != nil {
+		t.Fatalf("ParseMetadata() error = %v", err)
+	}
+	if policy, ok := meta.(*metadata.AutoWithdrawRewardPolicy); !ok || policy.Interval != 2 || policy.Threshold != 100 {
+		t.Errorf("ParseMetadata() = %+v, want the registered metadata", meta)
+	}
+
+	tests := []struct {
+		name    string
+		feature string
+		types   []int
+	}{
+		{
+			name:    "type of the same feature",
+			feature: testFeature,
+			types:   []int{testMetaType},
+		},
+		{
+			name:    "type of a builtin feature",
+			feature: otherFeature,
+			types:   []int{conflictMetaType, metadata.ShardStakingMeta},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types := map[int]func() metadata.Metadata{}
+			for _, metaType := range tt.types {
+				types[metaType] = func() metadata.Metadata { return &metadata.AutoWithdrawRewardPolicy{} }
+			}
+			if err := metadata.RegisterMetadataTypes(tt.feature, types); err == nil {
+				t.Fatal("RegisterMetadataTypes() of a registered type should fail")
+			}
+		})
+	}
+	// nothing of a failed registration is registered
+	if _, ok := metadata.GetMetadataFeature(conflictMetaType); ok {
+		t.Errorf("metadata type %v should not be registered", conflictMetaType)
+	}
+	if feature, _ := metadata.GetMetadataFeature(metadata.ShardStakingMeta); feature != metadata.StakingFeature {
+		t.Errorf("GetMetadataFeature(ShardStakingMeta) = %v, want %v", feature, metadata.StakingFeature)
+	}
+	if _, err := metadata.ParseMetadata(map[string]interface{}{"Type": conflictMetaType}); err == nil {
+		t.Error("ParseMetadata() of an unregistered type should fail")
+	}
+}
+
+func TestBuiltinMetadataTypes(t *testing.T) {
+	want := []int{
+		metadata.ShardStakingMeta,
+		metadata.BeaconStakingMeta,
+		metadata.ReturnStakingMeta,
+		metadata.StopAutoStakingMeta,
+		metadata.StakingPoolRegisterMeta,
+		metadata.StakingPoolDelegateMeta,
+		metadata.UnstakeRequestMeta,
+		metadata.UnstakeResponseMeta,
+		metadata.StakingPoolDelegateRefundMeta,
+	}
+	got := metadata.GetRegisteredMetadataTypes(metadata.StakingFeature)
+	if len(got) != len(want) {
+		t.Fatalf("GetRegisteredMetadataTypes(%v) = %v, want %v", metadata.StakingFeature, got, want)
+	}
+	for _, metaType := range want {
+		if feature, _ := metadata.GetMetadataFeature(metaType); feature != metadata.StakingFeature {
+			t.Errorf("GetMetadataFeature(%v) = %v, want %v", metaType, feature, metadata.StakingFeature)
+		}
+	}
+	for _, feature := range []string{
+		metadata.BridgeFeature,
+		metadata.StakingFeature,
+		metadata.RewardFeature,
+		metadata.SlashFeature,
+		metadata.PDEFeature,
+		metadata.PortalFeature,
+		metadata.RelayingFeature,
+		metadata.GovernanceFeature,
+	} {
+		for _, metaType := range metadata.GetRegisteredMetadataTypes(feature) {
+			meta, err := metadata.ParseMetadata(map[string]interface{}{"Type": metaType})
+			if err != nil || meta == nil {
+				t.Errorf("ParseMetadata() of type %v of feature %v = %v, %v", metaType, feature, meta, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+const (
+	// EquivocationPropose - proposer signed two different blocks at the same height and timeslot
+	EquivocationPropose = "propose"
+	// EquivocationVote - validator voted for two different blocks at the same height and timeslot
+	EquivocationVote = "vote"
+)
+
+// SignedBlockHeader is a block header together with the signature its signer produced on it.
+// For a propose message Signature is the producer signature on the block hash.
+// For a vote message Signature is the vote confirmation on (block hash, BLS, BRI).
+type SignedBlockHeader struct {
+	Header    json.RawMessage
+	BLS       []byte
+	BRI       []byte
+	Signature []byte
+}
+
+// EquivocationEvidence proves that Validator signed two conflicting messages at the same height and timeslot
+type EquivocationEvidence struct {
+	ChainID   int // -1 for beacon
+	Type      string
+	Validator string // committee public key (base58) of the offender
+	First     SignedBlockHeader
+	Second    SignedBlockHeader
+}
+
+func (evidence EquivocationEvidence) Hash() *common.Hash {
+	record := strconv.Itoa(evidence.ChainID)
+	record += evidence.Type
+	record += evidence.Validator
+	for _, signed := range []SignedBlockHeader{evidence.First, evidence.Second} {
+		record += string(signed.Header)
+		record += string(signed.BLS)
+		record += string(signed.BRI)
+		record += string(signed.Signature)
+	}
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+// ValidateSanity checks the evidence structure, it does not verify signatures
+func (evidence EquivocationEvidence) ValidateSanity() error {
+	if evidence.ChainID < -1 || evidence.ChainID >= common.MaxShardNumber {
+		return fmt.Errorf("invalid chain id %+v", evidence.ChainID)
+	}
+	if evidence.Type != EquivocationPropose && evidence.Type != EquivocationVote {
+		return fmt.Errorf("invalid equivocation type %+v", evidence.Type)
+	}
+	committeePublicKey := new(incognitokey.CommitteePublicKey)
+	if err := committeePublicKey.FromString(evidence.Validator); err != nil {
+		return err
+	}
+	if !committeePublicKey.CheckSanityData() {
+		return errors.New("invalid committee public key of offender")
+	}
+	for _, signed := range []SignedBlockHeader{evidence.First, evidence.Second} {
+		if len(signed.Header) == 0 || len(signed.Signature) == 0 {
+			return errors.New("signed header must have header and signature")
+		}
+		if evidence.Type == EquivocationVote && len(signed.BLS) == 0 {
+			return errors.New("vote must have BLS signature")
+		}
+	}
+	if bytes.Equal(evidence.First.Header, evidence.Second.Header) {
+		return errors.New("evidence must contain two different headers")
+	}
+	return nil
+}
+
+type SlashEquivocationRequest struct {
+	MetadataBase
+	Evidence EquivocationEvidence
+}
+
+type SlashEquivocationRequestAction struct {
+	Meta    SlashEquivocationRequest
+	TxReqID common.Hash
+	ShardID byte
+}
+
+func NewSlashEquivocationRequest(metaType int, evidence EquivocationEvidence) (*SlashEquivocationRequest, error) {
+	if metaType != SlashEquivocationRequestMeta {
+		return nil, errors.New("invalid slash equivocation request type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &SlashEquivocationRequest{
+		MetadataBase: *metadataBase,
+		Evidence:     evidence,
+	}, nil
+}
+
+func (req SlashEquivocationRequest) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.Evidence.Hash().String()
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *SlashEquivocationRequest) ValidateMetadataByItself() bool {
+	if req.Type != SlashEquivocationRequestMeta {
+		return false
+	}
+	return req.Evidence.ValidateSanity() == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Request Slash Equivocation With Blockchain
+// - Offender is in candidate, pending validator or committee list
+// - Signatures and headers are verified later by beacon when building instruction
+func (req SlashEquivocationRequest) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	slashMeta, ok := tx.GetMetadata().(*SlashEquivocationRequest)
+	if !ok {
+		return false, NewMetadataTxError(SlashEquivocationRequestTypeAssertionError, fmt.Errorf("Expect *SlashEquivocationRequest type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	committees, err := beaconViewRetriever.GetAllCommitteeValidatorCandidateFlattenListFromDatabase()
+	if err != nil {
+		return false, NewMetadataTxError(SlashEquivocationRequestNotInCommitteeListError, err)
+	}
+	if !(common.IndexOfStr(slashMeta.Evidence.Validator, committees) > -1) {
+		return false, NewMetadataTxError(SlashEquivocationRequestNotInCommitteeListError, fmt.Errorf("Committee Publickey %+v not found in any committee list of current beacon beststate", slashMeta.Evidence.Validator))
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req SlashEquivocationRequest) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	if tx.IsPrivacy() {
+		return false, false, errors.New("Slash Equivocation Request Transaction Is No Privacy Transaction")
+	}
+	onlyOne, pubkey, amount := tx.GetUniqueReceiver()
+	if !onlyOne {
+		return false, false, errors.New("Slash Equivocation Request Transaction Should Have 1 Output Amount crossponding to 1 Receiver")
+	}
+	burningAddress := chainRetriever.GetBurningAddress(beaconHeight)
+	keyWalletBurningAdd, err := wallet.Base58CheckDeserialize(burningAddress)
+	if err != nil {
+		return false, false, err
+	}
+	if !bytes.Equal(pubkey, keyWalletBurningAdd.KeySet.PaymentAddress.Pk) {
+		return false, false, errors.New("receiver Should be Burning Address")
+	}
+	if amount != SlashEquivocationRequestAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	if err := req.Evidence.ValidateSanity(); err != nil {
+		return false, false, NewMetadataTxError(SlashEquivocationRequestInvalidEvidenceError, err)
+	}
+	return true, true, nil
+}
+
+func (req *SlashEquivocationRequest) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	actionContent := SlashEquivocationRequestAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	}
+	actionContentBytes, err := json.Marshal(actionContent)
+	if err != nil {
+		return [][]string{}, err
+	}
+	actionContentBase64Str := base64.StdEncoding.EncodeToString(actionContentBytes)
+	action := []string{strconv.Itoa(req.Type), actionContentBase64Str}
+	return [][]string{action}, nil
+}
+
+func (req *SlashEquivocationRequest) CalculateSize() uint64 {
+	return calculateSize(req)
+}
@@ -0,0 +1,66 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/metadata"
+)
+
+func TestEquivocationEvidence_ValidateSanity(t *testing.T) {
+	validator := "121VhftSAygpEJZ6i9jGkCFHRkD4yhxxccAqVjQTWR9gy7skM1KcNf3uGLpX1NvojmHqs9bWwsPfvyBmer39YNBPwBHpgXg1Qku4EDhtUBZnGw2PZGMF7DMCrYa27GNS97uA9WC5z55YuCDA4WsnKfoEEuCFDNUN3iSCeUyrQ4SF5smx9CwBYX6AWAMAvNDPKf4tCuc7Wiafv9xkLKuHSFr7jaxBfg4rdaxtwXzR5eMpFDDpiXz6hQmdcee8xSXQRKceiafg9RMiuqLxDzx9tmLKvBD5TJq4G76LB3rrVmsYwMo1fY4RZLpiYn6AstAfca5EVnMeexueSAE5sam3Lsq8mq5poJfsW6KXzAbsmFPSsSjhmQ4wGhSXoKSap331gBMuuy7KtmVwQAPpwuFPo9hi7RBgrrn1ssdCdjYSwE226Ekc"
+	first := metadata.SignedBlockHeader{Header: []byte(`{"Height":10}`), BLS: []byte{1}, Signature: []byte{1}}
+	second := metadata.SignedBlockHeader{Header: []byte(`{"Height":10,"Round":2}`), BLS: []byte{2}, Signature: []byte{2}}
+	tests := []struct {
+		name     string
+		evidence metadata.EquivocationEvidence
+		wantErr  bool
+	}{
+		{
+			name:     "valid propose evidence",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: metadata.EquivocationPropose, Validator: validator, First: first, Second: second},
+			wantErr:  false,
+		},
+		{
+			name:     "valid vote evidence of beacon",
+			evidence: metadata.EquivocationEvidence{ChainID: -1, Type: metadata.EquivocationVote, Validator: validator, First: first, Second: second},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid chain id",
+			evidence: metadata.EquivocationEvidence{ChainID: -2, Type: metadata.EquivocationPropose, Validator: validator, First: first, Second: second},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid type",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: "double", Validator: validator, First: first, Second: second},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid validator",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: metadata.EquivocationPropose, Validator: "validator", First: first, Second: second},
+			wantErr:  true,
+		},
+		{
+			name:     "missing signature",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: metadata.EquivocationPropose, Validator: validator, First: first, Second: metadata.SignedBlockHeader{Header: second.Header}},
+			wantErr:  true,
+		},
+		{
+			name:     "vote without BLS signature",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: metadata.EquivocationVote, Validator: validator, First: first, Second: metadata.SignedBlockHeader{Header: second.Header, Signature: []byte{2}}},
+			wantErr:  true,
+		},
+		{
+			name:     "same header",
+			evidence: metadata.EquivocationEvidence{ChainID: 0, Type: metadata.EquivocationPropose, Validator: validator, First: first, Second: first},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.evidence.ValidateSanity(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSanity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
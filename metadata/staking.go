@@ -2,7 +2,11 @@ package metadata
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"strconv"
+
 	"github.com/incognitochain/incognito-chain/common"
 	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
 	"github.com/incognitochain/incognito-chain/incognitokey"
@@ -20,6 +24,22 @@ type StakingMetadata struct {
 	// CommitteePublicKey string <= encode byte <= mashal struct
 }
 
+// StakingAction is the stateful action of a staking tx, beacon records the staked amount of the staker from it
+// to weight its governance votes
+type StakingAction struct {
+	Meta    StakingMetadata
+	TxReqID common.Hash
+	ShardID byte
+	Amount  uint64
+}
+
+type StakingAmountContent struct {
+	CommitteePublicKey string
+	TxReqID            common.Hash
+	StakingAmount      uint64
+	ShardID            byte
+}
+
 func NewStakingMetadata(
 	stakingType int,
 	funderPaymentAddress string,
@@ -120,10 +140,11 @@ func (stakingMetadata StakingMetadata) ValidateSanityData(chainRetriever ChainRe
 		return false, false, errors.New("receiver Should be Burning Address")
 	}
 
-	if stakingMetadata.Type == ShardStakingMeta && amount != chainRetriever.GetStakingAmountShard() {
+	stakingAmountShard := chainRetriever.GetStakingAmountShard(beaconViewRetriever, beaconHeight)
+	if stakingMetadata.Type == ShardStakingMeta && amount != stakingAmountShard {
 		return false, false, errors.New("invalid Stake Shard Amount")
 	}
-	if stakingMetadata.Type == BeaconStakingMeta && amount != chainRetriever.GetStakingAmountShard()*3 {
+	if stakingMetadata.Type == BeaconStakingMeta && amount != stakingAmountShard*3 {
 		return false, false, errors.New("invalid Stake Beacon Amount")
 	}
 
@@ -152,6 +173,21 @@ func (stakingMetadata StakingMetadata) ValidateSanityData(chainRetriever ChainRe
 	}
 	return true, true, nil
 }
+func (stakingMetadata *StakingMetadata) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	_, _, amount := tx.GetUniqueReceiver()
+	actionContentBytes, err := json.Marshal(StakingAction{
+		Meta:    *stakingMetadata,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+		Amount:  amount,
+	})
+	if err != nil {
+		return [][]string{}, err
+	}
+	actionContentBase64Str := base64.StdEncoding.EncodeToString(actionContentBytes)
+	return [][]string{{strconv.Itoa(stakingMetadata.Type), actionContentBase64Str}}, nil
+}
+
 func (stakingMetadata StakingMetadata) GetType() int {
 	return stakingMetadata.Type
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/metadata/mocks"
 	"github.com/incognitochain/incognito-chain/trie"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -252,7 +253,7 @@ func TestStakingMetadata_ValidateSanityData(t *testing.T) {
 
 	bcrGetStakingAmountShardError := &mocks.ChainRetriever{}
 	bcrGetStakingAmountShardError.On("GetBurningAddress", uint64(0)).Return("15pABFiJVeh9D5uiQEhQX4SVibGGbdAVipQxBdxkmDqAJaoG1EdFKHBrNfs")
-	bcrGetStakingAmountShardError.On("GetStakingAmountShard").Return(uint64(1750000000000))
+	bcrGetStakingAmountShardError.On("GetStakingAmountShard", mock.Anything, uint64(0)).Return(uint64(1750000000000))
 	txGetStakingAmountShardError := &mocks.Transaction{}
 	txGetStakingAmountShardError.On("IsPrivacy").Return(false)
 	txGetStakingAmountShardError.On("GetUniqueReceiver").Return(true, []byte{99, 183, 246, 161, 68, 172, 228, 222, 153, 9, 172, 39, 208, 245, 167, 79, 11, 2, 114, 65, 241, 69, 85, 40, 193, 104, 199, 79, 70, 4, 53, 0}, uint64(1650000000000))
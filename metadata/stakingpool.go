@@ -0,0 +1,373 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// StakingPoolCommissionBase commission of a staking pool is in basis points of pool rewards
+const StakingPoolCommissionBase = 10000
+
+// StakingPoolCommissionChangeDelay is the number of epochs after the epoch of a register request updating
+// an existing pool before its new commission applies, delegators can unstake in between
+const StakingPoolCommissionChangeDelay = 2
+
+// StakingPoolRegister opens (or updates the commission of) the delegation pool of a shard validator,
+// it must be sent by the funder of the staking tx of the validator
+type StakingPoolRegister struct {
+	MetadataBase
+	CommitteePublicKey string
+	Commission         uint64
+}
+
+type StakingPoolRegisterAction struct {
+	Meta    StakingPoolRegister
+	TxReqID common.Hash
+	ShardID byte
+}
+
+// StakingPoolDelegate deposits the PRV amount burnt by the tx to the pool of a validator,
+// the delegator gets its share of the pool rewards at DelegatorPaymentAddress
+type StakingPoolDelegate struct {
+	MetadataBase
+	CommitteePublicKey      string
+	DelegatorPaymentAddress string
+}
+
+type StakingPoolDelegateAction struct {
+	Meta    StakingPoolDelegate
+	TxReqID common.Hash
+	ShardID byte
+	Amount  uint64
+}
+
+func NewStakingPoolRegister(metaType int, committeePublicKey string, commission uint64) (*StakingPoolRegister, error) {
+	if metaType != StakingPoolRegisterMeta {
+		return nil, errors.New("invalid staking pool register type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &StakingPoolRegister{
+		MetadataBase:       *metadataBase,
+		CommitteePublicKey: committeePublicKey,
+		Commission:         commission,
+	}, nil
+}
+
+func NewStakingPoolDelegate(metaType int, committeePublicKey string, delegatorPaymentAddress string) (*StakingPoolDelegate, error) {
+	if metaType != StakingPoolDelegateMeta {
+		return nil, errors.New("invalid staking pool delegate type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &StakingPoolDelegate{
+		MetadataBase:            *metadataBase,
+		CommitteePublicKey:      committeePublicKey,
+		DelegatorPaymentAddress: delegatorPaymentAddress,
+	}, nil
+}
+
+func validateCommitteePublicKey(committeePublicKeyStr string) error {
+	committeePublicKey := new(incognitokey.CommitteePublicKey)
+	if err := committeePublicKey.FromString(committeePublicKeyStr); err != nil {
+		return err
+	}
+	if !committeePublicKey.CheckSanityData() {
+		return errors.New("Invalid Commitee Public Key of Validator")
+	}
+	return nil
+}
+
+// validateBurningReceiver checks the tx is not privacy and burns its only output, it returns the burnt amount
+func validateBurningReceiver(chainRetriever ChainRetriever, beaconHeight uint64, tx Transaction) (uint64, error) {
+	if tx.IsPrivacy() {
+		return 0, errors.New("Staking Pool Request Transaction Is No Privacy Transaction")
+	}
+	onlyOne, pubkey, amount := tx.GetUniqueReceiver()
+	if !onlyOne {
+		return 0, errors.New("Staking Pool Request Transaction Should Have 1 Output Amount crossponding to 1 Receiver")
+	}
+	burningAddress := chainRetriever.GetBurningAddress(beaconHeight)
+	keyWalletBurningAdd, err := wallet.Base58CheckDeserialize(burningAddress)
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(pubkey, keyWalletBurningAdd.KeySet.PaymentAddress.Pk) {
+		return 0, errors.New("receiver Should be Burning Address")
+	}
+	return amount, nil
+}
+
+func buildStakingPoolReqAction(metaType int, actionContent interface{}) ([][]string, error) {
+	actionContentBytes, err := json.Marshal(actionContent)
+	if err != nil {
+		return [][]string{}, err
+	}
+	actionContentBase64Str := base64.StdEncoding.EncodeToString(actionContentBytes)
+	action := []string{strconv.Itoa(metaType), actionContentBase64Str}
+	return [][]string{action}, nil
+}
+
+func (req *StakingPoolRegister) ValidateMetadataByItself() bool {
+	if req.Type != StakingPoolRegisterMeta {
+		return false
+	}
+	return req.Commission <= StakingPoolCommissionBase && validateCommitteePublicKey(req.CommitteePublicKey) == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Register Staking Pool With Blockchain
+// - Requested Committee Publickey is in candidate, pending validator or committee list
+// - Requester (sender of tx) must be address, which create staking transaction for requested committee public key
+func (req StakingPoolRegister) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	registerMeta, ok := tx.GetMetadata().(*StakingPoolRegister)
+	if !ok {
+		return false, NewMetadataTxError(StakingPoolRequestTypeAssertionError, fmt.Errorf("Expect *StakingPoolRegister type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	committees, err := beaconViewRetriever.GetAllCommitteeValidatorCandidateFlattenListFromDatabase()
+	if err != nil {
+		return false, NewMetadataTxError(StakingPoolRequestNotInCommitteeListError, err)
+	}
+	if !(common.IndexOfStr(registerMeta.CommitteePublicKey, committees) > -1) {
+		return false, NewMetadataTxError(StakingPoolRequestNotInCommitteeListError, fmt.Errorf("Committee Publickey %+v not found in any committee list of current beacon beststate", registerMeta.CommitteePublicKey))
+	}
+	tempStakingTxHash, ok := shardViewRetriever.GetStakingTx()[registerMeta.CommitteePublicKey]
+	if !ok {
+		return false, NewMetadataTxError(StakingPoolRequestInvalidTransactionSenderError, fmt.Errorf("No Committe Publickey %+v found in StakingTx of Shard %+v", registerMeta.CommitteePublicKey, shardID))
+	}
+	stakingTxHash, err := common.Hash{}.NewHashFromStr(tempStakingTxHash)
+	if err != nil {
+		return false, err
+	}
+	_, _, _, _, stakingTx, err := chainRetriever.GetTransactionByHash(*stakingTxHash)
+	if err != nil {
+		return false, NewMetadataTxError(StakingPoolRequestInvalidTransactionSenderError, err)
+	}
+	if !bytes.Equal(stakingTx.GetSender(), tx.GetSender()) {
+		return false, NewMetadataTxError(StakingPoolRequestInvalidTransactionSenderError, fmt.Errorf("Expect %+v to send staking pool register request but get %+v", stakingTx.GetSender(), tx.GetSender()))
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req StakingPoolRegister) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount != StakingPoolRegisterAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	if req.Commission > StakingPoolCommissionBase {
+		return false, false, fmt.Errorf("commission must be at most %+v", StakingPoolCommissionBase)
+	}
+	if err := validateCommitteePublicKey(req.CommitteePublicKey); err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (req *StakingPoolRegister) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	return buildStakingPoolReqAction(req.Type, StakingPoolRegisterAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	})
+}
+
+func (req StakingPoolRegister) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.CommitteePublicKey
+	record += strconv.FormatUint(req.Commission, 10)
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *StakingPoolRegister) CalculateSize() uint64 {
+	return calculateSize(req)
+}
+
+func (req *StakingPoolDelegate) ValidateMetadataByItself() bool {
+	if req.Type != StakingPoolDelegateMeta {
+		return false
+	}
+	delegatorWallet, err := wallet.Base58CheckDeserialize(req.DelegatorPaymentAddress)
+	if err != nil || len(delegatorWallet.KeySet.PaymentAddress.Pk) != common.PublicKeySize {
+		return false
+	}
+	return validateCommitteePublicKey(req.CommitteePublicKey) == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Delegate With Blockchain
+// - The validator has a staking pool
+func (req StakingPoolDelegate) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	delegateMeta, ok := tx.GetMetadata().(*StakingPoolDelegate)
+	if !ok {
+		return false, NewMetadataTxError(StakingPoolRequestTypeAssertionError, fmt.Errorf("Expect *StakingPoolDelegate type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	_, has, err := statedb.GetStakingPool(beaconViewRetriever.GetBeaconConsensusStateDB(), delegateMeta.CommitteePublicKey)
+	if err != nil {
+		return false, NewMetadataTxError(StakingPoolRequestPoolNotFoundError, err)
+	}
+	if !has {
+		return false, NewMetadataTxError(StakingPoolRequestPoolNotFoundError, fmt.Errorf("Staking pool of %+v not found", delegateMeta.CommitteePublicKey))
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver, it is the delegated amount
+// Receiver Is Burning Address
+func (req StakingPoolDelegate) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount == 0 {
+		return false, false, errors.New("delegated amount should be greater than zero")
+	}
+	delegatorWallet, err := wallet.Base58CheckDeserialize(req.DelegatorPaymentAddress)
+	if err != nil || delegatorWallet == nil {
+		return false, false, errors.New("Invalid Delegator Payment Address, Failed to Deserialized Into Key Wallet")
+	}
+	if len(delegatorWallet.KeySet.PaymentAddress.Pk) != common.PublicKeySize {
+		return false, false, errors.New("Invalid Public Key of Delegator Payment Address")
+	}
+	if err := validateCommitteePublicKey(req.CommitteePublicKey); err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (req *StakingPoolDelegate) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	_, _, amount := tx.GetUniqueReceiver()
+	return buildStakingPoolReqAction(req.Type, StakingPoolDelegateAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+		Amount:  amount,
+	})
+}
+
+func (req StakingPoolDelegate) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.CommitteePublicKey
+	record += req.DelegatorPaymentAddress
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *StakingPoolDelegate) CalculateSize() uint64 {
+	return calculateSize(req)
+}
+
+// StakingPoolRegisterContent is the content of the beacon instruction of a staking pool register request
+type StakingPoolRegisterContent struct {
+	CommitteePublicKey string
+	Commission         uint64
+	OperatorAddress    string
+	TxReqID            common.Hash
+	ShardID            byte
+}
+
+// StakingPoolDelegateContent is the content of the beacon instruction of a staking pool delegate request
+type StakingPoolDelegateContent struct {
+	CommitteePublicKey string
+	DelegatorAddress   string
+	Amount             uint64
+	TxReqID            common.Hash
+	ShardID            byte
+}
+
+// StakingPoolDelegateRefund returns the burnt amount of a delegate request rejected by beacon
+type StakingPoolDelegateRefund struct {
+	MetadataBase
+	RequestedTxID common.Hash
+}
+
+func NewStakingPoolDelegateRefund(requestedTxID common.Hash, metaType int) *StakingPoolDelegateRefund {
+	return &StakingPoolDelegateRefund{
+		MetadataBase:  MetadataBase{Type: metaType},
+		RequestedTxID: requestedTxID,
+	}
+}
+
+func (iRes StakingPoolDelegateRefund) CheckTransactionFee(tr Transaction, minFee uint64, beaconHeight int64, db *statedb.StateDB) bool {
+	// no need to have fee for this tx
+	return true
+}
+
+func (iRes StakingPoolDelegateRefund) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	// no need to validate tx with blockchain, just need to validate with requested tx (via RequestedTxID)
+	return false, nil
+}
+
+func (iRes StakingPoolDelegateRefund) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	return false, true, nil
+}
+
+func (iRes StakingPoolDelegateRefund) ValidateMetadataByItself() bool {
+	return iRes.Type == StakingPoolDelegateRefundMeta
+}
+
+func (iRes StakingPoolDelegateRefund) Hash() *common.Hash {
+	record := iRes.RequestedTxID.String()
+	record += iRes.MetadataBase.Hash().String()
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (iRes *StakingPoolDelegateRefund) CalculateSize() uint64 {
+	return calculateSize(iRes)
+}
+
+func (iRes StakingPoolDelegateRefund) VerifyMinerCreatedTxBeforeGettingInBlock(txsInBlock []Transaction, txsUsed []int, insts [][]string, instUsed []int, shardID byte, tx Transaction, chainRetriever ChainRetriever, ac *AccumulatedValues, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever) (bool, error) {
+	idx := -1
+	for i, inst := range insts {
+		if len(inst) < 4 { // this is not staking pool delegate instruction
+			continue
+		}
+		if instUsed[i] > 0 ||
+			inst[0] != strconv.Itoa(StakingPoolDelegateMeta) ||
+			inst[2] != common.StakingPoolRequestRejectedChainStatus {
+			continue
+		}
+		var content StakingPoolDelegateContent
+		err := json.Unmarshal([]byte(inst[3]), &content)
+		if err != nil {
+			Logger.log.Error("WARNING - VALIDATION: an error occured while parsing instruction content: ", err)
+			continue
+		}
+		if !bytes.Equal(iRes.RequestedTxID[:], content.TxReqID[:]) ||
+			shardID != content.ShardID {
+			continue
+		}
+		key, err := wallet.Base58CheckDeserialize(content.DelegatorAddress)
+		if err != nil {
+			Logger.log.Info("WARNING - VALIDATION: an error occured while deserializing delegator address string: ", err)
+			continue
+		}
+		_, pk, amount, assetID := tx.GetTransferData()
+		if !bytes.Equal(key.KeySet.PaymentAddress.Pk[:], pk[:]) ||
+			content.Amount != amount ||
+			assetID.String() != common.PRVIDStr {
+			continue
+		}
+		idx = i
+		break
+	}
+	if idx == -1 {
+		return false, fmt.Errorf("no rejected staking pool delegate instruction found for the StakingPoolDelegateRefund tx %s", tx.Hash().String())
+	}
+	instUsed[idx] = 1
+	return true, nil
+}
@@ -0,0 +1,279 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+// UnstakeRequest unstakes a validator (Amount is 0) or a part of a delegation to its staking pool (Amount > 0).
+// The stake is locked during the unbonding period and returned to PaymentAddress after it:
+//	- to unstake a validator, the tx is sent by the funder of the staking tx, PaymentAddress is the funder address,
+//	StakingAmount is the amount of the staking tx (the stake returned), the unbonding period starts when the validator
+//	is swapped out
+//	- to unstake a delegation, the tx is sent by the delegator, PaymentAddress is the delegator address,
+//	the unbonding period starts right away
+type UnstakeRequest struct {
+	MetadataBase
+	CommitteePublicKey string
+	PaymentAddress     string
+	Amount             uint64
+	StakingAmount      uint64
+}
+
+type UnstakeRequestAction struct {
+	Meta    UnstakeRequest
+	TxReqID common.Hash
+	ShardID byte
+}
+
+// UnstakeRequestContent is the content of the beacon instructions of an unstake request,
+// ReleaseEpoch is 0 until the unstaked validator is swapped out
+type UnstakeRequestContent struct {
+	CommitteePublicKey string
+	PaymentAddress     string
+	Amount             uint64
+	IsDelegation       bool
+	ReleaseEpoch       uint64
+	TxReqID            common.Hash
+	ShardID            byte
+}
+
+func NewUnstakeRequest(metaType int, committeePublicKey string, paymentAddress string, amount uint64, stakingAmount uint64) (*UnstakeRequest, error) {
+	if metaType != UnstakeRequestMeta {
+		return nil, errors.New("invalid unstake request type")
+	}
+	metadataBase := NewMetadataBase(metaType)
+	return &UnstakeRequest{
+		MetadataBase:       *metadataBase,
+		CommitteePublicKey: committeePublicKey,
+		PaymentAddress:     paymentAddress,
+		Amount:             amount,
+		StakingAmount:      stakingAmount,
+	}, nil
+}
+
+func (req UnstakeRequest) IsDelegation() bool {
+	return req.Amount > 0
+}
+
+func (req *UnstakeRequest) ValidateMetadataByItself() bool {
+	if req.Type != UnstakeRequestMeta {
+		return false
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(req.PaymentAddress)
+	if err != nil || len(keyWallet.KeySet.PaymentAddress.Pk) != common.PublicKeySize {
+		return false
+	}
+	if req.IsDelegation() == (req.StakingAmount > 0) {
+		return false
+	}
+	return validateCommitteePublicKey(req.CommitteePublicKey) == nil
+}
+
+// ValidateTxWithBlockChain Validate Condition to Request Unstake With Blockchain
+// - Requester (sender of tx) is the owner of PaymentAddress
+// - Unstake a validator: it is in candidate, pending validator or committee list, not unstaked yet,
+// requester is the sender of its staking tx, PaymentAddress is the funder of it and StakingAmount is its amount
+// - Unstake a delegation: delegation of requester to the pool of the validator is at least Amount
+func (req UnstakeRequest) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	unstakeMeta, ok := tx.GetMetadata().(*UnstakeRequest)
+	if !ok {
+		return false, NewMetadataTxError(UnstakeRequestTypeAssertionError, fmt.Errorf("Expect *UnstakeRequest type but get %+v", reflect.TypeOf(tx.GetMetadata())))
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(unstakeMeta.PaymentAddress)
+	if err != nil {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, err)
+	}
+	if !bytes.Equal(keyWallet.KeySet.PaymentAddress.Pk, tx.GetSender()) {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, fmt.Errorf("Expect %+v to send unstake request but get %+v", keyWallet.KeySet.PaymentAddress.Pk, tx.GetSender()))
+	}
+	beaconConsensusStateDB := beaconViewRetriever.GetBeaconConsensusStateDB()
+	if unstakeMeta.IsDelegation() {
+		delegation, has, err := statedb.GetDelegation(beaconConsensusStateDB, unstakeMeta.CommitteePublicKey, keyWallet.KeySet.PaymentAddress.Pk)
+		if err != nil {
+			return false, NewMetadataTxError(UnstakeRequestInvalidAmountError, err)
+		}
+		if !has || delegation.Amount() < unstakeMeta.Amount {
+			return false, NewMetadataTxError(UnstakeRequestInvalidAmountError, fmt.Errorf("Delegation of %+v to %+v is less than %+v", unstakeMeta.PaymentAddress, unstakeMeta.CommitteePublicKey, unstakeMeta.Amount))
+		}
+		return true, nil
+	}
+	committees, err := beaconViewRetriever.GetAllCommitteeValidatorCandidateFlattenListFromDatabase()
+	if err != nil {
+		return false, NewMetadataTxError(UnstakeRequestNotInCommitteeListError, err)
+	}
+	if !(common.IndexOfStr(unstakeMeta.CommitteePublicKey, committees) > -1) {
+		return false, NewMetadataTxError(UnstakeRequestNotInCommitteeListError, fmt.Errorf("Committee Publickey %+v not found in any committee list of current beacon beststate", unstakeMeta.CommitteePublicKey))
+	}
+	if _, has, err := statedb.GetValidatorUnbonding(beaconConsensusStateDB, unstakeMeta.CommitteePublicKey); err != nil || has {
+		return false, NewMetadataTxError(UnstakeRequestNotInCommitteeListError, fmt.Errorf("Committee Publickey %+v is already unstaked, error %+v", unstakeMeta.CommitteePublicKey, err))
+	}
+	tempStakingTxHash, ok := shardViewRetriever.GetStakingTx()[unstakeMeta.CommitteePublicKey]
+	if !ok {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, fmt.Errorf("No Committe Publickey %+v found in StakingTx of Shard %+v", unstakeMeta.CommitteePublicKey, shardID))
+	}
+	stakingTxHash, err := common.Hash{}.NewHashFromStr(tempStakingTxHash)
+	if err != nil {
+		return false, err
+	}
+	_, _, _, _, stakingTx, err := chainRetriever.GetTransactionByHash(*stakingTxHash)
+	if err != nil {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, err)
+	}
+	if !bytes.Equal(stakingTx.GetSender(), tx.GetSender()) {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, fmt.Errorf("Expect %+v to send unstake request but get %+v", stakingTx.GetSender(), tx.GetSender()))
+	}
+	stakingMeta, ok := stakingTx.GetMetadata().(*StakingMetadata)
+	if !ok {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, fmt.Errorf("Expect *StakingMetadata type but get %+v", reflect.TypeOf(stakingTx.GetMetadata())))
+	}
+	funderWallet, err := wallet.Base58CheckDeserialize(stakingMeta.FunderPaymentAddress)
+	if err != nil || !bytes.Equal(funderWallet.KeySet.PaymentAddress.Pk, keyWallet.KeySet.PaymentAddress.Pk) {
+		return false, NewMetadataTxError(UnstakeRequestInvalidTransactionSenderError, fmt.Errorf("Expect funder %+v as payment address but get %+v", stakingMeta.FunderPaymentAddress, unstakeMeta.PaymentAddress))
+	}
+	if stakingMeta.StakingAmountShard != unstakeMeta.StakingAmount {
+		return false, NewMetadataTxError(UnstakeRequestInvalidAmountError, fmt.Errorf("Expect staking amount %+v of the staking tx but get %+v", stakingMeta.StakingAmountShard, unstakeMeta.StakingAmount))
+	}
+	return true, nil
+}
+
+// Have only one receiver
+// Have only one amount corresponding to receiver
+// Receiver Is Burning Address
+func (req UnstakeRequest) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	amount, err := validateBurningReceiver(chainRetriever, beaconHeight, tx)
+	if err != nil {
+		return false, false, err
+	}
+	if amount != UnstakeRequestAmount {
+		return false, false, errors.New("receiver amount should be zero")
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(req.PaymentAddress)
+	if err != nil || keyWallet == nil {
+		return false, false, errors.New("Invalid Payment Address, Failed to Deserialized Into Key Wallet")
+	}
+	if len(keyWallet.KeySet.PaymentAddress.Pk) != common.PublicKeySize {
+		return false, false, errors.New("Invalid Public Key of Payment Address")
+	}
+	if req.IsDelegation() == (req.StakingAmount > 0) {
+		return false, false, errors.New("staking amount should be set only to unstake a validator")
+	}
+	if err := validateCommitteePublicKey(req.CommitteePublicKey); err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (req *UnstakeRequest) BuildReqActions(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, shardHeight uint64) ([][]string, error) {
+	return buildStakingPoolReqAction(req.Type, UnstakeRequestAction{
+		Meta:    *req,
+		TxReqID: *tx.Hash(),
+		ShardID: shardID,
+	})
+}
+
+func (req UnstakeRequest) Hash() *common.Hash {
+	record := req.MetadataBase.Hash().String()
+	record += req.CommitteePublicKey
+	record += req.PaymentAddress
+	record += strconv.FormatUint(req.Amount, 10)
+	record += strconv.FormatUint(req.StakingAmount, 10)
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (req *UnstakeRequest) CalculateSize() uint64 {
+	return calculateSize(req)
+}
+
+// UnstakeResponse returns the unbonded stake of an unstake request
+type UnstakeResponse struct {
+	MetadataBase
+	RequestedTxID common.Hash
+}
+
+func NewUnstakeResponse(requestedTxID common.Hash, metaType int) *UnstakeResponse {
+	return &UnstakeResponse{
+		MetadataBase:  MetadataBase{Type: metaType},
+		RequestedTxID: requestedTxID,
+	}
+}
+
+func (iRes UnstakeResponse) CheckTransactionFee(tr Transaction, minFee uint64, beaconHeight int64, db *statedb.StateDB) bool {
+	// no need to have fee for this tx
+	return true
+}
+
+func (iRes UnstakeResponse) ValidateTxWithBlockChain(tx Transaction, chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, shardID byte, transactionStateDB *statedb.StateDB) (bool, error) {
+	// no need to validate tx with blockchain, just need to validate with requested tx (via RequestedTxID)
+	return false, nil
+}
+
+func (iRes UnstakeResponse) ValidateSanityData(chainRetriever ChainRetriever, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever, beaconHeight uint64, tx Transaction) (bool, bool, error) {
+	return false, true, nil
+}
+
+func (iRes UnstakeResponse) ValidateMetadataByItself() bool {
+	return iRes.Type == UnstakeResponseMeta
+}
+
+func (iRes UnstakeResponse) Hash() *common.Hash {
+	record := iRes.RequestedTxID.String()
+	record += iRes.MetadataBase.Hash().String()
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (iRes *UnstakeResponse) CalculateSize() uint64 {
+	return calculateSize(iRes)
+}
+
+func (iRes UnstakeResponse) VerifyMinerCreatedTxBeforeGettingInBlock(txsInBlock []Transaction, txsUsed []int, insts [][]string, instUsed []int, shardID byte, tx Transaction, chainRetriever ChainRetriever, ac *AccumulatedValues, shardViewRetriever ShardViewRetriever, beaconViewRetriever BeaconViewRetriever) (bool, error) {
+	idx := -1
+	for i, inst := range insts {
+		if len(inst) < 4 { // this is not unstake release instruction
+			continue
+		}
+		if instUsed[i] > 0 ||
+			inst[0] != strconv.Itoa(UnstakeRequestMeta) ||
+			inst[2] != common.UnstakeReleasedChainStatus {
+			continue
+		}
+		var content UnstakeRequestContent
+		err := json.Unmarshal([]byte(inst[3]), &content)
+		if err != nil {
+			Logger.log.Error("WARNING - VALIDATION: an error occured while parsing instruction content: ", err)
+			continue
+		}
+		if !bytes.Equal(iRes.RequestedTxID[:], content.TxReqID[:]) ||
+			shardID != content.ShardID {
+			continue
+		}
+		key, err := wallet.Base58CheckDeserialize(content.PaymentAddress)
+		if err != nil {
+			Logger.log.Info("WARNING - VALIDATION: an error occured while deserializing payment address string: ", err)
+			continue
+		}
+		_, pk, amount, assetID := tx.GetTransferData()
+		if !bytes.Equal(key.KeySet.PaymentAddress.Pk[:], pk[:]) ||
+			content.Amount != amount ||
+			assetID.String() != common.PRVIDStr {
+			continue
+		}
+		idx = i
+		break
+	}
+	if idx == -1 {
+		return false, fmt.Errorf("no unstake release instruction found for the UnstakeResponse tx %s", tx.Hash().String())
+	}
+	instUsed[idx] = 1
+	return true, nil
+}
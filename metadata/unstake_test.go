@@ -0,0 +1,90 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/metadata/mocks"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+func TestUnstakeRequest_ValidateTxWithBlockChain(t *testing.T) {
+	funderWallet, err := wallet.Base58CheckDeserialize(validPaymentAddresses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	funderPk := []byte(funderWallet.KeySet.PaymentAddress.Pk)
+	stakingTxHash := common.HashH([]byte("staking tx"))
+	stakingTx := &mocks.Transaction{}
+	stakingTx.On("GetSender").Return(funderPk)
+	stakingTx.On("GetMetadata").Return(&metadata.StakingMetadata{
+		MetadataBase:         metadata.MetadataBase{Type: metadata.ShardStakingMeta},
+		FunderPaymentAddress: validPaymentAddresses[0],
+		StakingAmountShard:   1750,
+	})
+	chainRetriever := &mocks.ChainRetriever{}
+	chainRetriever.On("GetTransactionByHash", stakingTxHash).Return(byte(0), common.Hash{}, uint64(0), 0, stakingTx, nil)
+	shardViewRetriever := &mocks.ShardViewRetriever{}
+	shardViewRetriever.On("GetStakingTx").Return(map[string]string{validCommitteePublicKeys[0]: stakingTxHash.String()})
+	beaconViewRetriever := &mocks.BeaconViewRetriever{}
+	beaconViewRetriever.On("GetBeaconConsensusStateDB").Return(emptyStateDB)
+	beaconViewRetriever.On("GetAllCommitteeValidatorCandidateFlattenListFromDatabase").Return([]string{validCommitteePublicKeys[0]}, nil)
+
+	tests := []struct {
+		name          string
+		stakingAmount uint64
+		want          bool
+	}{
+		{
+			name:          "amount of the staking tx",
+			stakingAmount: 1750,
+			want:          true,
+		},
+		{
+			name:          "staking amount param changed since the staking tx",
+			stakingAmount: 3500,
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := metadata.NewUnstakeRequest(metadata.UnstakeRequestMeta, validCommitteePublicKeys[0], validPaymentAddresses[0], 0, tt.stakingAmount)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tx := &mocks.Transaction{}
+			tx.On("GetMetadata").Return(req)
+			tx.On("GetSender").Return(funderPk)
+			got, err := req.ValidateTxWithBlockChain(tx, chainRetriever, shardViewRetriever, beaconViewRetriever, 0, emptyStateDB)
+			if got != tt.want || (err == nil) != tt.want {
+				t.Errorf("ValidateTxWithBlockChain() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnstakeRequest_ValidateMetadataByItself(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        uint64
+		stakingAmount uint64
+		want          bool
+	}{
+		{name: "unstake a validator", amount: 0, stakingAmount: 1750, want: true},
+		{name: "unstake a validator without staking amount", amount: 0, stakingAmount: 0, want: false},
+		{name: "unstake a delegation", amount: 100, stakingAmount: 0, want: true},
+		{name: "unstake a delegation with staking amount", amount: 100, stakingAmount: 1750, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := metadata.NewUnstakeRequest(metadata.UnstakeRequestMeta, validCommitteePublicKeys[0], validPaymentAddresses[0], tt.amount, tt.stakingAmount)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := req.ValidateMetadataByItself(); got != tt.want {
+				t.Errorf("ValidateMetadataByItself() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
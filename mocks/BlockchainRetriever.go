@@ -138,6 +138,22 @@ func (_m *BlockchainRetriever) GetAutoStakingList() map[string]bool {
 	return r0
 }
 
+// GetBeaconConsensusStateDB provides a mock function with given fields:
+func (_m *BlockchainRetriever) GetBeaconConsensusStateDB() *statedb.StateDB {
+	ret := _m.Called()
+
+	var r0 *statedb.StateDB
+	if rf, ok := ret.Get(0).(func() *statedb.StateDB); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statedb.StateDB)
+		}
+	}
+
+	return r0
+}
+
 // GetBeaconFeatureStateDB provides a mock function with given fields:
 func (_m *BlockchainRetriever) GetBeaconFeatureStateDB() *statedb.StateDB {
 	ret := _m.Called()
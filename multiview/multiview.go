@@ -51,9 +51,12 @@ func NewMultiView() *MultiView {
 
 }
 
+//Reset remove all views, views restored after a rollback must not be compared with the previous best and final view
 func (multiView *MultiView) Reset() {
 	multiView.viewByHash = make(map[common.Hash]View)
 	multiView.viewByPrevHash = make(map[common.Hash][]View)
+	multiView.bestView = nil
+	multiView.finalView = nil
 }
 
 func (multiView *MultiView) removeOutdatedView() {
@@ -0,0 +1,56 @@
+package notescanner
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Registered keys are stored encrypted with the passphrase of the scanner, as wallet does for its keys
+
+// deriveKey returns a 32-byte AES key of passphrase with pbkdf2, a random 8-byte salt is generated if salt is empty
+func deriveKey(passphrase string, salt []byte) ([]byte, []byte) {
+	if len(salt) == 0 {
+		salt = make([]byte, 8)
+		rand.Read(salt)
+	}
+	return pbkdf2.Key([]byte(passphrase), salt, 1000, common.AESKeySize, sha256.New), salt
+}
+
+// encryptByPassphrase returns hex encoded salt and ciphertext of plaintext, separated by '-'
+func encryptByPassphrase(passphrase string, plaintext []byte) (string, error) {
+	key, salt := deriveKey(passphrase, nil)
+	aes := common.AES{
+		Key: key,
+	}
+	cipherText, err := aes.Encrypt(plaintext)
+	if err != nil {
+		return common.EmptyString, err
+	}
+	return hex.EncodeToString(salt) + "-" + hex.EncodeToString(cipherText), nil
+}
+
+func decryptByPassphrase(passphrase string, cipherText string) ([]byte, error) {
+	arr := strings.Split(cipherText, "-")
+	if len(arr) != 2 {
+		return nil, errors.New("ciphertext is invalid")
+	}
+	salt, err := hex.DecodeString(arr[0])
+	if err != nil {
+		return nil, err
+	}
+	data, err := hex.DecodeString(arr[1])
+	if err != nil {
+		return nil, err
+	}
+	key, _ := deriveKey(passphrase, salt)
+	aes := common.AES{
+		Key: key,
+	}
+	return aes.Decrypt(data)
+}
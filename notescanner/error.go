@@ -0,0 +1,54 @@
+package notescanner
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	UnexpectedError = iota
+	AlreadyStartError
+	InvalidScanKeyError
+	ScanKeyNotFoundError
+	MaxScanKeysError
+	StoreScanKeyError
+	LoadScanKeyError
+	ScanBlockError
+	GetScannedDataError
+	SpentNotTrackedError
+)
+
+var ErrCodeMessage = map[int]struct {
+	Code    int
+	Message string
+}{
+	UnexpectedError:      {-1, "Unexpected error"},
+	AlreadyStartError:    {-2, "Already started"},
+	InvalidScanKeyError:  {-3, "Invalid scan key"},
+	ScanKeyNotFoundError: {-4, "Scan key is not registered"},
+	MaxScanKeysError:     {-5, "Number of registered scan keys reaches limit"},
+	StoreScanKeyError:    {-6, "Store scan key error"},
+	LoadScanKeyError:     {-7, "Load scan key error"},
+	ScanBlockError:       {-8, "Scan block error"},
+	GetScannedDataError:  {-9, "Get scanned data error"},
+	SpentNotTrackedError: {-10, "Spending is not tracked without private key"},
+}
+
+type NoteScannerError struct {
+	Code    int
+	Message string
+	err     error
+}
+
+func (e NoteScannerError) Error() string {
+	return fmt.Sprintf("%d: %s %+v", e.Code, e.Message, e.err)
+}
+
+func NewNoteScannerError(key int, err error) *NoteScannerError {
+	return &NoteScannerError{
+		Code:    ErrCodeMessage[key].Code,
+		Message: ErrCodeMessage[key].Message,
+		err:     errors.Wrap(err, ErrCodeMessage[key].Message),
+	}
+}
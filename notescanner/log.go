@@ -0,0 +1,14 @@
+package notescanner
+
+import "github.com/incognitochain/incognito-chain/common"
+
+type NoteScannerLogger struct {
+	log common.Logger
+}
+
+func (noteScannerLogger *NoteScannerLogger) Init(inst common.Logger) {
+	noteScannerLogger.log = inst
+}
+
+// Global instant to use
+var Logger = NoteScannerLogger{}
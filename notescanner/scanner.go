@@ -0,0 +1,871 @@
+package notescanner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+const (
+	DefaultMaxScanKeys = 1000
+	MaxPageSize        = 100
+	scanInterval       = 10 * time.Second
+	progressInterval   = 1000
+)
+
+// Scanner indexes output coins of registered keys from finalized shard blocks, so balances and history of a key
+// are answered without scanning all output coins of its public key on each request
+//	- A key is a read-only key, or a private key to also track spending of coins (serial numbers are derived from private key)
+//	- Keys are stored encrypted with the passphrase of the scanner, scanned data is stored in plain
+//	- Each key is scanned on the shard of its public key from its start height, blocks are scanned incrementally
+//	by the scan worker when new shard blocks are finalized
+type Scanner struct {
+	started  int32
+	shutdown int32
+	cQuit    chan struct{}
+	cScan    chan struct{} // wakes up the scan worker when shards are pending
+
+	config        *Config
+	keys          map[string]*scanKey
+	keysLock      sync.RWMutex
+	scanLock      sync.Mutex // scanning a block and unregistering keys are serialized
+	pendingShards map[byte]bool
+	pendingLock   sync.Mutex
+}
+
+type Config struct {
+	BlockChain    *blockchain.BlockChain
+	PubSubManager *pubsub.PubSubManager
+	DataBase      incdb.Database
+	Passphrase    string
+	MaxScanKeys   int
+}
+
+type scanKey struct {
+	id            string
+	shardID       byte
+	keySet        *incognitokey.KeySet
+	startHeight   uint64
+	scannedHeight uint64
+}
+
+// ScanKeyInfo describes a registered key
+type ScanKeyInfo struct {
+	ScanKeyID     string
+	ShardID       byte
+	StartHeight   uint64
+	ScannedHeight uint64
+	SpentTracked  bool
+}
+
+// ScannedCoin is an output coin of a registered key
+type ScannedCoin struct {
+	TokenID      string
+	Value        uint64
+	Commitment   string
+	SNDerivator  string
+	SerialNumber string `json:",omitempty"`
+	Info         string
+	Height       uint64
+	TxHash       string `json:",omitempty"` // empty for coins received from cross shard block
+	FromShardID  byte
+	Spent        bool
+	SpentHeight  uint64 `json:",omitempty"`
+	SpentTxHash  string `json:",omitempty"`
+}
+
+// ScannedTx is the change of a token balance of a registered key by a transaction
+// (or by a cross shard block, then TxHash is empty and CrossShardBlockHash is set)
+type ScannedTx struct {
+	Height              uint64
+	Index               uint32 `json:"-"` // index of the change in the block, set when read
+	TxHash              string `json:",omitempty"`
+	CrossShardBlockHash string `json:",omitempty"`
+	FromShardID         byte
+	TokenID             string
+	Received            uint64
+	Spent               uint64
+}
+
+func NewScanner(cfg *Config) (*Scanner, error) {
+	if cfg.Passphrase == "" {
+		return nil, NewNoteScannerError(UnexpectedError, errors.New("passphrase to encrypt scan keys is empty"))
+	}
+	if cfg.MaxScanKeys <= 0 {
+		cfg.MaxScanKeys = DefaultMaxScanKeys
+	}
+	scanner := &Scanner{
+		cQuit:         make(chan struct{}),
+		cScan:         make(chan struct{}, 1),
+		config:        cfg,
+		keys:          make(map[string]*scanKey),
+		pendingShards: make(map[byte]bool),
+	}
+	records, err := loadScanKeys(cfg.DataBase)
+	if err != nil {
+		return nil, NewNoteScannerError(LoadScanKeyError, err)
+	}
+	for id, encrypted := range records {
+		data, err := decryptByPassphrase(cfg.Passphrase, encrypted)
+		if err != nil {
+			return nil, NewNoteScannerError(LoadScanKeyError, err)
+		}
+		record := keyRecord{}
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, NewNoteScannerError(LoadScanKeyError, fmt.Errorf("can not decrypt scan key %+v, passphrase may be wrong", id))
+		}
+		key, err := newScanKey(record.Key, record.StartHeight)
+		if err != nil || key.id != id {
+			return nil, NewNoteScannerError(LoadScanKeyError, fmt.Errorf("can not decrypt scan key %+v, passphrase may be wrong", id))
+		}
+		if key.scannedHeight, err = getScannedHeight(cfg.DataBase, id); err != nil {
+			return nil, NewNoteScannerError(LoadScanKeyError, err)
+		}
+		scanner.keys[id] = key
+	}
+	Logger.log.Infof("Loaded %+v scan keys", len(scanner.keys))
+	return scanner, nil
+}
+
+// newScanKey parses a serialized read-only key or private key
+func newScanKey(keyStr string, startHeight uint64) (*scanKey, error) {
+	keyWallet, err := wallet.Base58CheckDeserialize(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	keySet := &incognitokey.KeySet{}
+	if len(keyWallet.KeySet.PrivateKey) > 0 {
+		if err := keySet.InitFromPrivateKey(&keyWallet.KeySet.PrivateKey); err != nil {
+			return nil, err
+		}
+	} else if len(keyWallet.KeySet.ReadonlyKey.Rk) > 0 {
+		keySet.ReadonlyKey = keyWallet.KeySet.ReadonlyKey
+		keySet.PaymentAddress.Pk = keyWallet.KeySet.ReadonlyKey.Pk
+	} else {
+		return nil, errors.New("key must be a read-only key or a private key")
+	}
+	if len(keySet.PaymentAddress.Pk) == 0 {
+		return nil, errors.New("public key is empty")
+	}
+	if startHeight == 0 {
+		startHeight = 1
+	}
+	pk := keySet.PaymentAddress.Pk
+	return &scanKey{
+		id:            hex.EncodeToString(common.HashB(append(append([]byte{}, pk...), keySet.ReadonlyKey.Rk...))),
+		shardID:       common.GetShardIDFromLastByte(pk[len(pk)-1]),
+		keySet:        keySet,
+		startHeight:   startHeight,
+		scannedHeight: startHeight - 1,
+	}, nil
+}
+
+func (key *scanKey) info() *ScanKeyInfo {
+	return &ScanKeyInfo{
+		ScanKeyID:     key.id,
+		ShardID:       key.shardID,
+		StartHeight:   key.startHeight,
+		ScannedHeight: key.scannedHeight,
+		SpentTracked:  len(key.keySet.PrivateKey) > 0,
+	}
+}
+
+func (scanner *Scanner) Start() error {
+	if atomic.AddInt32(&scanner.started, 1) != 1 {
+		return NewNoteScannerError(AlreadyStartError, errors.New("Already started"))
+	}
+	go scanner.scanHandler()
+	go scanner.scanWorker()
+	return nil
+}
+
+func (scanner *Scanner) Stop() {
+	if atomic.AddInt32(&scanner.shutdown, 1) != 1 {
+		Logger.log.Warn("Note scanner is already in the process of shutting down")
+		return
+	}
+	Logger.log.Warn("Note scanner shutting down")
+	close(scanner.cQuit)
+}
+
+// scanHandler requests scanning a shard when one of its blocks is inserted,
+// all shards having keys are also requested periodically
+func (scanner *Scanner) scanHandler() {
+	subID, subChan, err := scanner.config.PubSubManager.RegisterNewSubscriber(pubsub.NewShardblockTopic)
+	if err != nil {
+		Logger.log.Error(err)
+	} else {
+		defer scanner.config.PubSubManager.Unsubscribe(pubsub.NewShardblockTopic, subID)
+	}
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-scanner.cQuit:
+			return
+		case msg := <-subChan:
+			if shardBlock, ok := msg.Value.(*blockchain.ShardBlock); ok {
+				scanner.requestScan(shardBlock.Header.ShardID)
+			}
+		case <-ticker.C:
+			for _, shardID := range scanner.getShardIDs() {
+				scanner.requestScan(shardID)
+			}
+		}
+	}
+}
+
+// requestScan marks a shard pending for the scan worker, requests of a shard already pending are merged
+func (scanner *Scanner) requestScan(shardID byte) {
+	scanner.pendingLock.Lock()
+	scanner.pendingShards[shardID] = true
+	scanner.pendingLock.Unlock()
+	select {
+	case scanner.cScan <- struct{}{}:
+	default:
+	}
+}
+
+// scanWorker scans pending shards one by one
+func (scanner *Scanner) scanWorker() {
+	for {
+		select {
+		case <-scanner.cQuit:
+			return
+		case <-scanner.cScan:
+			for _, shardID := range scanner.takePendingShards() {
+				scanner.scanShard(shardID)
+			}
+		}
+	}
+}
+
+func (scanner *Scanner) takePendingShards() []byte {
+	scanner.pendingLock.Lock()
+	defer scanner.pendingLock.Unlock()
+	shardIDs := []byte{}
+	for shardID := range scanner.pendingShards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	scanner.pendingShards = make(map[byte]bool)
+	return shardIDs
+}
+
+func (scanner *Scanner) getShardIDs() []byte {
+	scanner.keysLock.RLock()
+	defer scanner.keysLock.RUnlock()
+	existed := make(map[byte]bool)
+	shardIDs := []byte{}
+	for _, key := range scanner.keys {
+		if !existed[key.shardID] {
+			existed[key.shardID] = true
+			shardIDs = append(shardIDs, key.shardID)
+		}
+	}
+	return shardIDs
+}
+
+// scanShard scans finalized blocks of a shard for its keys, from the lowest scanned height of the keys.
+// Keys unregistered between two blocks are not scanned anymore
+func (scanner *Scanner) scanShard(shardID byte) {
+	bc := scanner.config.BlockChain
+	if int(shardID) >= len(bc.ShardChain) {
+		return
+	}
+	finalHeight := bc.ShardChain[shardID].GetFinalView().GetHeight()
+
+	scanner.keysLock.RLock()
+	keys := []*scanKey{}
+	fromHeight := finalHeight
+	for _, key := range scanner.keys {
+		if key.shardID == shardID && key.scannedHeight < finalHeight {
+			keys = append(keys, key)
+			if key.scannedHeight < fromHeight {
+				fromHeight = key.scannedHeight
+			}
+		}
+	}
+	scanner.keysLock.RUnlock()
+	if len(keys) == 0 {
+		return
+	}
+
+	// subscribers of activity of keys are notified once the blocks are scanned
+	scannedBlocks := 0
+	defer func() {
+		if scannedBlocks > 0 {
+			go scanner.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.NoteScannedTopic, shardID))
+		}
+	}()
+	db := bc.GetShardChainDatabase(shardID)
+	for height := fromHeight + 1; height <= finalHeight; height++ {
+		select {
+		case <-scanner.cQuit:
+			return
+		default:
+		}
+		hash, err := rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, height)
+		if err != nil {
+			Logger.log.Error(NewNoteScannerError(ScanBlockError, err))
+			return
+		}
+		shardBlock, _, err := bc.GetShardBlockByHashWithShardID(*hash, shardID)
+		if err != nil {
+			Logger.log.Error(NewNoteScannerError(ScanBlockError, err))
+			return
+		}
+		if keys, err = scanner.scanKeysOfBlock(keys, shardBlock); err != nil {
+			Logger.log.Error(NewNoteScannerError(ScanBlockError, err))
+			return
+		}
+		if len(keys) == 0 {
+			return
+		}
+		scannedBlocks++
+		if height%progressInterval == 0 {
+			Logger.log.Infof("SHARD %+v | Scanned height %+v/%+v for %+v keys", shardID, height, finalHeight, len(keys))
+		}
+	}
+}
+
+// scanKeysOfBlock scans a block for the keys still registered and returns them
+func (scanner *Scanner) scanKeysOfBlock(keys []*scanKey, shardBlock *blockchain.ShardBlock) ([]*scanKey, error) {
+	scanner.scanLock.Lock()
+	defer scanner.scanLock.Unlock()
+	height := shardBlock.Header.Height
+	registered := []*scanKey{}
+	scanner.keysLock.RLock()
+	for _, key := range keys {
+		if scanner.keys[key.id] == key {
+			registered = append(registered, key)
+		}
+	}
+	scanner.keysLock.RUnlock()
+
+	batch := scanner.config.DataBase.NewBatch()
+	scanned := []*scanKey{}
+	for _, key := range registered {
+		if key.scannedHeight >= height {
+			continue
+		}
+		if err := scanner.scanBlock(batch, key, shardBlock); err != nil {
+			return nil, fmt.Errorf("scan key %+v block %+v failed: %+v", key.id, height, err)
+		}
+		if err := storeScannedHeight(batch, key.id, height); err != nil {
+			return nil, err
+		}
+		scanned = append(scanned, key)
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	scanner.keysLock.Lock()
+	for _, key := range scanned {
+		key.scannedHeight = height
+	}
+	scanner.keysLock.Unlock()
+	return registered, nil
+}
+
+// coinsOfToken are the coins of a token spent and created by a transaction or a cross shard block
+type coinsOfToken struct {
+	tokenID     common.Hash
+	inputCoins  []*privacy.InputCoin
+	outputCoins []*privacy.OutputCoin
+}
+
+// scanBlock stores coins received and spent by a key in a block, balances and history of the key are updated
+func (scanner *Scanner) scanBlock(batch incdb.KeyValueWriter, key *scanKey, shardBlock *blockchain.ShardBlock) error {
+	height := shardBlock.Header.Height
+	balances := make(map[common.Hash]uint64)
+	loadBalance := func(tokenID common.Hash) (uint64, error) {
+		if balance, ok := balances[tokenID]; ok {
+			return balance, nil
+		}
+		return getBalance(scanner.config.DataBase, key.id, tokenID)
+	}
+	coinIndex, txIndex := uint32(0), uint32(0)
+
+	// process coins changed by a transaction (txHash) or a cross shard block (crossShardBlockHash),
+	// return the tokens the key spends
+	process := func(changes []coinsOfToken, txHash string, crossShardBlockHash string, fromShardID byte) ([]common.Hash, error) {
+		spentTokenIDs := []common.Hash{}
+		for _, change := range changes {
+			balance, err := loadBalance(change.tokenID)
+			if err != nil {
+				return nil, err
+			}
+			history := &ScannedTx{Height: height, TxHash: txHash, CrossShardBlockHash: crossShardBlockHash, FromShardID: fromShardID, TokenID: change.tokenID.String()}
+			if len(key.keySet.PrivateKey) > 0 {
+				for _, inputCoin := range change.inputCoins {
+					if inputCoin == nil || inputCoin.CoinDetails == nil || inputCoin.CoinDetails.GetSerialNumber() == nil {
+						continue
+					}
+					spent, err := scanner.spendCoin(batch, key, inputCoin.CoinDetails.GetSerialNumber().ToBytesS(), height, txHash)
+					if err != nil {
+						return nil, err
+					}
+					history.Spent += spent
+				}
+				if history.Spent > 0 {
+					spentTokenIDs = append(spentTokenIDs, change.tokenID)
+				}
+			}
+			for _, outputCoin := range change.outputCoins {
+				coin := scanner.receiveCoin(key, outputCoin, change.tokenID, shardBlock.Header.ShardID)
+				if coin == nil {
+					continue
+				}
+				coin.Height = height
+				coin.TxHash = txHash
+				coin.FromShardID = fromShardID
+				coinKey := getOrderedKey(coinPrefix, key.id, height, coinIndex)
+				coinIndex++
+				if err := storeRecord(batch, coinKey, coin); err != nil {
+					return nil, err
+				}
+				if coin.SerialNumber != "" {
+					serialNumber, _, _ := base58.Base58Check{}.Decode(coin.SerialNumber)
+					if err := batch.Put(getSerialNumberKey(key.id, serialNumber), coinKey); err != nil {
+						return nil, err
+					}
+				}
+				history.Received += coin.Value
+			}
+			if history.Received == 0 && history.Spent == 0 {
+				continue
+			}
+			balances[change.tokenID] = balance + history.Received - history.Spent
+			if err := storeRecord(batch, getOrderedKey(historyPrefix, key.id, height, txIndex), history); err != nil {
+				return nil, err
+			}
+			txIndex++
+		}
+		return spentTokenIDs, nil
+	}
+
+	for index, tx := range shardBlock.Body.Transactions {
+		spentTokenIDs, err := process(getTxCoins(tx), tx.Hash().String(), "", shardBlock.Header.ShardID)
+		if err != nil {
+			return err
+		}
+		if len(spentTokenIDs) == 0 {
+			continue
+		}
+		// the chain does not know the sender of a transaction with privacy, the key records it is spending
+		record := &rawdbv2.TxHistoryRecord{
+			Height:       height,
+			TxIndex:      uint32(index),
+			Direction:    rawdbv2.TxHistoryOut,
+			TxHash:       *tx.Hash(),
+			TokenIDs:     spentTokenIDs,
+			MetadataType: tx.GetMetadataType(),
+		}
+		if err := rawdbv2.StoreTxHistory(batch, key.keySet.PaymentAddress.Pk, record); err != nil {
+			return err
+		}
+	}
+	fromShardIDs := []int{}
+	for fromShardID := range shardBlock.Body.CrossTransactions {
+		fromShardIDs = append(fromShardIDs, int(fromShardID))
+	}
+	sort.Ints(fromShardIDs)
+	for _, fromShardID := range fromShardIDs {
+		for _, crossTransaction := range shardBlock.Body.CrossTransactions[byte(fromShardID)] {
+			changes := []coinsOfToken{{tokenID: common.PRVCoinID}}
+			for i := range crossTransaction.OutputCoin {
+				changes[0].outputCoins = append(changes[0].outputCoins, &crossTransaction.OutputCoin[i])
+			}
+			for _, tokenData := range crossTransaction.TokenPrivacyData {
+				change := coinsOfToken{tokenID: tokenData.PropertyID}
+				for i := range tokenData.OutputCoin {
+					change.outputCoins = append(change.outputCoins, &tokenData.OutputCoin[i])
+				}
+				changes = append(changes, change)
+			}
+			if _, err := process(changes, "", crossTransaction.BlockHash.String(), byte(fromShardID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for tokenID, balance := range balances {
+		if err := storeBalance(batch, key.id, tokenID, balance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTxCoins returns coins of PRV and privacy token spent and created by a transaction
+func getTxCoins(tx interface{ GetType() string }) []coinsOfToken {
+	proofs := []*zkp.PaymentProof{}
+	tokenIDs := []common.Hash{}
+	switch tx.GetType() {
+	case common.TxNormalType, common.TxRewardType, common.TxReturnStakingType:
+		if normalTx, ok := tx.(*transaction.Tx); ok {
+			proofs = append(proofs, normalTx.Proof)
+			tokenIDs = append(tokenIDs, common.PRVCoinID)
+		}
+	case common.TxCustomTokenPrivacyType:
+		if tokenTx, ok := tx.(*transaction.TxCustomTokenPrivacy); ok {
+			proofs = append(proofs, tokenTx.Proof, tokenTx.TxPrivacyTokenData.TxNormal.Proof)
+			tokenIDs = append(tokenIDs, common.PRVCoinID, tokenTx.TxPrivacyTokenData.PropertyID)
+		}
+	}
+	result := []coinsOfToken{}
+	for i, proof := range proofs {
+		if proof == nil {
+			continue
+		}
+		result = append(result, coinsOfToken{tokenID: tokenIDs[i], inputCoins: proof.GetInputCoins(), outputCoins: proof.GetOutputCoins()})
+	}
+	return result
+}
+
+// receiveCoin returns the decrypted coin if it belongs to key, serial number is derived when the private key is known
+func (scanner *Scanner) receiveCoin(key *scanKey, outputCoin *privacy.OutputCoin, tokenID common.Hash, shardID byte) *ScannedCoin {
+	if outputCoin == nil || outputCoin.CoinDetails == nil || outputCoin.CoinDetails.GetPublicKey() == nil {
+		return nil
+	}
+	if !bytes.Equal(outputCoin.CoinDetails.GetPublicKey().ToBytesS(), key.keySet.PaymentAddress.Pk) {
+		return nil
+	}
+	// decrypt with read-only key only, spending is tracked by serial numbers of next blocks
+	readonlyKeySet := &incognitokey.KeySet{PaymentAddress: key.keySet.PaymentAddress, ReadonlyKey: key.keySet.ReadonlyKey}
+	decrypted := blockchain.DecryptOutputCoinByKey(nil, outputCoin, readonlyKeySet, &tokenID, shardID)
+	if decrypted == nil {
+		return nil
+	}
+	details := decrypted.CoinDetails
+	coin := &ScannedCoin{
+		TokenID: tokenID.String(),
+		Value:   details.GetValue(),
+		Info:    base58.Base58Check{}.Encode(details.GetInfo(), common.ZeroByte),
+	}
+	if details.GetCoinCommitment() != nil {
+		coin.Commitment = base58.Base58Check{}.Encode(details.GetCoinCommitment().ToBytesS(), common.ZeroByte)
+	}
+	if details.GetSNDerivator() != nil {
+		coin.SNDerivator = base58.Base58Check{}.Encode(details.GetSNDerivator().ToBytesS(), common.ZeroByte)
+		if len(key.keySet.PrivateKey) > 0 {
+			serialNumber := new(privacy.Point).Derive(
+				privacy.PedCom.G[privacy.PedersenPrivateKeyIndex],
+				new(privacy.Scalar).FromBytesS(key.keySet.PrivateKey),
+				details.GetSNDerivator())
+			coin.SerialNumber = base58.Base58Check{}.Encode(serialNumber.ToBytesS(), common.ZeroByte)
+		}
+	}
+	return coin
+}
+
+// spendCoin marks the coin of a serial number as spent, it returns value of the coin or 0 if the serial number is not of the key
+func (scanner *Scanner) spendCoin(batch incdb.KeyValueWriter, key *scanKey, serialNumber []byte, height uint64, txHash string) (uint64, error) {
+	db := scanner.config.DataBase
+	snKey := getSerialNumberKey(key.id, serialNumber)
+	if has, err := db.Has(snKey); err != nil || !has {
+		return 0, err
+	}
+	coinKey, err := db.Get(snKey)
+	if err != nil {
+		return 0, err
+	}
+	value, err := db.Get(coinKey)
+	if err != nil {
+		return 0, err
+	}
+	coin := &ScannedCoin{}
+	if err := json.Unmarshal(value, coin); err != nil {
+		return 0, err
+	}
+	if coin.Spent {
+		return 0, nil
+	}
+	coin.Spent = true
+	coin.SpentHeight = height
+	coin.SpentTxHash = txHash
+	if err := storeRecord(batch, coinKey, coin); err != nil {
+		return 0, err
+	}
+	return coin.Value, nil
+}
+
+// RegisterKey registers a read-only key or private key to be scanned from startHeight (1 if 0) of its shard.
+// Registering the private key of a key registered with its read-only key rescans it to track spending
+func (scanner *Scanner) RegisterKey(keyStr string, startHeight uint64) (*ScanKeyInfo, error) {
+	key, err := newScanKey(keyStr, startHeight)
+	if err != nil {
+		return nil, NewNoteScannerError(InvalidScanKeyError, err)
+	}
+	scanner.keysLock.RLock()
+	existed, ok := scanner.keys[key.id]
+	replaced := ok && len(existed.keySet.PrivateKey) == 0 && len(key.keySet.PrivateKey) > 0
+	scanner.keysLock.RUnlock()
+	if replaced {
+		if err := scanner.UnregisterKey(keyStr); err != nil {
+			return nil, err
+		}
+	}
+	info, added, err := scanner.addKey(keyStr, key)
+	if err != nil || !added {
+		return info, err
+	}
+	scanner.requestScan(key.shardID)
+	Logger.log.Infof("Registered scan key %+v of shard %+v from height %+v", key.id, key.shardID, key.startHeight)
+	return info, nil
+}
+
+// addKey stores a key which is not registered yet, the limit of keys is checked under the same lock
+func (scanner *Scanner) addKey(keyStr string, key *scanKey) (*ScanKeyInfo, bool, error) {
+	scanner.keysLock.Lock()
+	defer scanner.keysLock.Unlock()
+	if existed, ok := scanner.keys[key.id]; ok {
+		return existed.info(), false, nil
+	}
+	if len(scanner.keys) >= scanner.config.MaxScanKeys {
+		return nil, false, NewNoteScannerError(MaxScanKeysError, fmt.Errorf("limit %+v", scanner.config.MaxScanKeys))
+	}
+
+	data, err := json.Marshal(keyRecord{Key: keyStr, StartHeight: key.startHeight})
+	if err != nil {
+		return nil, false, NewNoteScannerError(StoreScanKeyError, err)
+	}
+	encrypted, err := encryptByPassphrase(scanner.config.Passphrase, data)
+	if err != nil {
+		return nil, false, NewNoteScannerError(StoreScanKeyError, err)
+	}
+	batch := scanner.config.DataBase.NewBatch()
+	if err := storeScanKey(batch, key.id, encrypted); err != nil {
+		return nil, false, NewNoteScannerError(StoreScanKeyError, err)
+	}
+	if err := storeScannedHeight(batch, key.id, key.scannedHeight); err != nil {
+		return nil, false, NewNoteScannerError(StoreScanKeyError, err)
+	}
+	if err := batch.Write(); err != nil {
+		return nil, false, NewNoteScannerError(StoreScanKeyError, err)
+	}
+	scanner.keys[key.id] = key
+	return key.info(), true, nil
+}
+
+// UnregisterKey removes a key and all its scanned data
+func (scanner *Scanner) UnregisterKey(keyStr string) error {
+	key, err := scanner.getKey(keyStr)
+	if err != nil {
+		return err
+	}
+	scanner.scanLock.Lock()
+	defer scanner.scanLock.Unlock()
+	if err := deleteScanKeyData(scanner.config.DataBase, key.id, key.keySet.PaymentAddress.Pk); err != nil {
+		return NewNoteScannerError(StoreScanKeyError, err)
+	}
+	scanner.keysLock.Lock()
+	delete(scanner.keys, key.id)
+	scanner.keysLock.Unlock()
+	Logger.log.Infof("Unregistered scan key %+v", key.id)
+	return nil
+}
+
+// getKey returns the registered key of a read-only key or private key
+func (scanner *Scanner) getKey(keyStr string) (*scanKey, error) {
+	key, err := newScanKey(keyStr, 0)
+	if err != nil {
+		return nil, NewNoteScannerError(InvalidScanKeyError, err)
+	}
+	scanner.keysLock.RLock()
+	defer scanner.keysLock.RUnlock()
+	registered, ok := scanner.keys[key.id]
+	if !ok {
+		return nil, NewNoteScannerError(ScanKeyNotFoundError, fmt.Errorf("scan key %+v", key.id))
+	}
+	return registered, nil
+}
+
+// GetKeyInfo returns info of a registered key
+func (scanner *Scanner) GetKeyInfo(keyStr string) (*ScanKeyInfo, error) {
+	key, err := scanner.getKey(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	scanner.keysLock.RLock()
+	defer scanner.keysLock.RUnlock()
+	return key.info(), nil
+}
+
+// GetPublicKey returns the public key of a registered key
+func (scanner *Scanner) GetPublicKey(keyStr string) ([]byte, error) {
+	key, err := scanner.getKey(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	return key.keySet.PaymentAddress.Pk, nil
+}
+
+// GetBalances returns balances of tokens of a registered key up to its scanned height.
+// Without private key spending is not tracked, balances are unavailable (nil) and totals of received coins are
+// returned instead, received is nil when balances are returned
+func (scanner *Scanner) GetBalances(keyStr string) (*ScanKeyInfo, map[string]uint64, map[string]uint64, error) {
+	info, err := scanner.GetKeyInfo(keyStr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	amounts, err := getBalances(scanner.config.DataBase, info.ScanKeyID)
+	if err != nil {
+		return nil, nil, nil, NewNoteScannerError(GetScannedDataError, err)
+	}
+	result := make(map[string]uint64)
+	for tokenID, amount := range amounts {
+		result[tokenID.String()] = amount
+	}
+	if !info.SpentTracked {
+		return info, nil, result, nil
+	}
+	return info, result, nil, nil
+}
+
+// ListCoins returns a page of coins of a registered key in height order and the total number of matched coins,
+// coins are filtered by tokenID if it is not nil, and spent coins are skipped if unspentOnly is set
+func (scanner *Scanner) ListCoins(keyStr string, tokenID *common.Hash, unspentOnly bool, offset int, limit int) ([]*ScannedCoin, int, error) {
+	info, err := scanner.GetKeyInfo(keyStr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkPage(offset, limit); err != nil {
+		return nil, 0, err
+	}
+	coins := []*ScannedCoin{}
+	total := 0
+	err = iterateRecords(scanner.config.DataBase, coinPrefix, info.ScanKeyID, func(key []byte, value []byte) (bool, error) {
+		coin := &ScannedCoin{}
+		if err := json.Unmarshal(value, coin); err != nil {
+			return false, err
+		}
+		if (tokenID != nil && coin.TokenID != tokenID.String()) || (unspentOnly && coin.Spent) {
+			return true, nil
+		}
+		if total >= offset && len(coins) < limit {
+			coins = append(coins, coin)
+		}
+		total++
+		return true, nil
+	})
+	if err != nil {
+		return nil, 0, NewNoteScannerError(GetScannedDataError, err)
+	}
+	return coins, total, nil
+}
+
+// ListHistory returns a page of balance changes of a registered key in height order and the total number of changes
+func (scanner *Scanner) ListHistory(keyStr string, offset int, limit int) ([]*ScannedTx, int, error) {
+	info, err := scanner.GetKeyInfo(keyStr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkPage(offset, limit); err != nil {
+		return nil, 0, err
+	}
+	txs := []*ScannedTx{}
+	total := 0
+	err = iterateRecords(scanner.config.DataBase, historyPrefix, info.ScanKeyID, func(key []byte, value []byte) (bool, error) {
+		if total >= offset && len(txs) < limit {
+			tx := &ScannedTx{}
+			if err := json.Unmarshal(value, tx); err != nil {
+				return false, err
+			}
+			txs = append(txs, tx)
+		}
+		total++
+		return true, nil
+	})
+	if err != nil {
+		return nil, 0, NewNoteScannerError(GetScannedDataError, err)
+	}
+	return txs, total, nil
+}
+
+// ListHistoryFrom returns at most limit balance changes of a registered key from a position (height and index
+// of the change in the block) in height order, changes are returned only when their blocks are all scanned
+func (scanner *Scanner) ListHistoryFrom(keyStr string, height uint64, index uint32, limit int) ([]*ScannedTx, error) {
+	info, err := scanner.GetKeyInfo(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPage(0, limit); err != nil {
+		return nil, err
+	}
+	prefix := getPrefixWithID(historyPrefix, info.ScanKeyID)
+	iterator := scanner.config.DataBase.NewIteratorWithStart(getOrderedKey(historyPrefix, info.ScanKeyID, height, index))
+	defer iterator.Release()
+	txs := []*ScannedTx{}
+	for len(txs) < limit && iterator.Next() {
+		key := iterator.Key()
+		if !bytes.HasPrefix(key, prefix) || len(key) != len(prefix)+12 {
+			break
+		}
+		tx := &ScannedTx{}
+		if err := json.Unmarshal(iterator.Value(), tx); err != nil {
+			return nil, NewNoteScannerError(GetScannedDataError, err)
+		}
+		if tx.Height > info.ScannedHeight {
+			break
+		}
+		tx.Index = binary.BigEndian.Uint32(key[len(prefix)+8:])
+		txs = append(txs, tx)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, NewNoteScannerError(GetScannedDataError, err)
+	}
+	return txs, nil
+}
+
+// GetSpentTxHistory returns out tx history records of a registered key tracking spending, they are paged like
+// rawdbv2.GetTxHistory and are merged with the tx history index of the shard of the key, which lists no sender of
+// transactions with privacy. Only transactions of blocks from the start height of the key to its scanned height are recorded
+func (scanner *Scanner) GetSpentTxHistory(
+	keyStr string,
+	cursor []byte,
+	fromHeight uint64,
+	toHeight uint64,
+	limit int,
+	filter func(record *rawdbv2.TxHistoryRecord) bool,
+) ([]*rawdbv2.TxHistoryRecord, []byte, error) {
+	key, err := scanner.getKey(keyStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(key.keySet.PrivateKey) == 0 {
+		return nil, nil, NewNoteScannerError(SpentNotTrackedError, fmt.Errorf("scan key %+v is registered without private key", key.id))
+	}
+	records, nextCursor, err := rawdbv2.GetTxHistory(scanner.config.DataBase, key.keySet.PaymentAddress.Pk, cursor, fromHeight, toHeight, limit, filter)
+	if err != nil {
+		return nil, nil, NewNoteScannerError(GetScannedDataError, err)
+	}
+	return records, nextCursor, nil
+}
+
+func checkPage(offset int, limit int) error {
+	if offset < 0 || limit <= 0 || limit > MaxPageSize {
+		return NewNoteScannerError(GetScannedDataError, fmt.Errorf("offset must not be negative and limit must be in [1, %+v]", MaxPageSize))
+	}
+	return nil
+}
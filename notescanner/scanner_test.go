@@ -0,0 +1,317 @@
+package notescanner
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	_ "github.com/incognitochain/incognito-chain/incdb/lvdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = func() (_ struct{}) {
+	Logger.Init(common.NewBackend(nil).Logger("test", true))
+	privacy.Logger.Init(common.NewBackend(nil).Logger("test", true))
+	return
+}()
+
+func newTestScanner(t *testing.T, dbPath string, passphrase string) (*Scanner, incdb.Database) {
+	db, err := incdb.Open("leveldb", dbPath)
+	if err != nil {
+		t.Fatalf("could not open db path: %s, %+v", dbPath, err)
+	}
+	scanner, err := NewScanner(&Config{DataBase: db, Passphrase: passphrase, MaxScanKeys: 2})
+	if err != nil {
+		db.Close()
+		t.Fatal(err)
+	}
+	return scanner, db
+}
+
+func newTestKeys(t *testing.T, seed byte) (string, string, *wallet.KeyWallet) {
+	keyWallet, err := wallet.NewMasterKey([]byte{seed, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := keyWallet.KeySet.InitFromPrivateKey(&keyWallet.KeySet.PrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	return keyWallet.Base58CheckSerialize(wallet.ReadonlyKeyType), keyWallet.Base58CheckSerialize(wallet.PriKeyType), keyWallet
+}
+
+func newTestCoin(pk []byte, value uint64) *privacy.OutputCoin {
+	publicKey, _ := new(privacy.Point).FromBytesS(pk)
+	coin := new(privacy.OutputCoin).Init()
+	coin.CoinDetails.SetPublicKey(publicKey)
+	coin.CoinDetails.SetValue(value)
+	coin.CoinDetails.SetSNDerivator(privacy.RandomScalar())
+	coin.CoinDetails.SetRandomness(privacy.RandomScalar())
+	coin.CoinDetails.CommitAll()
+	return coin
+}
+
+func newTestBlock(height uint64, shardID byte, txs ...metadata.Transaction) *blockchain.ShardBlock {
+	shardBlock := blockchain.NewShardBlock()
+	shardBlock.Header.Height = height
+	shardBlock.Header.ShardID = shardID
+	shardBlock.Body.Transactions = txs
+	return shardBlock
+}
+
+func TestEncryptByPassphrase(t *testing.T) {
+	encrypted, err := encryptByPassphrase("passphrase", []byte("scan key"))
+	assert.Nil(t, err)
+	data, err := decryptByPassphrase("passphrase", encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("scan key"), data)
+	data, err = decryptByPassphrase("wrong passphrase", encrypted)
+	assert.True(t, err != nil || string(data) != "scan key")
+}
+
+func TestRegisterKey(t *testing.T) {
+	dbPath, err := ioutil.TempDir(os.TempDir(), "test_notescanner")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dbPath)
+	readonlyKey, privateKey, keyWallet := newTestKeys(t, 1)
+	readonlyKey2, _, _ := newTestKeys(t, 2)
+	readonlyKey3, _, _ := newTestKeys(t, 3)
+
+	scanner, db := newTestScanner(t, dbPath, "passphrase")
+	info, err := scanner.RegisterKey(readonlyKey, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), info.StartHeight)
+	assert.Equal(t, uint64(9), info.ScannedHeight)
+	assert.False(t, info.SpentTracked)
+
+	// registering again is a no-op
+	info2, err := scanner.RegisterKey(readonlyKey, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, info, info2)
+
+	// payment address can not be registered
+	_, err = scanner.RegisterKey(keyWallet.Base58CheckSerialize(wallet.PaymentAddressType), 0)
+	assert.Equal(t, ErrCodeMessage[InvalidScanKeyError].Code, err.(*NoteScannerError).Code)
+
+	_, err = scanner.RegisterKey(readonlyKey2, 0)
+	assert.Nil(t, err)
+	_, err = scanner.RegisterKey(readonlyKey3, 0)
+	assert.Equal(t, ErrCodeMessage[MaxScanKeysError].Code, err.(*NoteScannerError).Code)
+
+	// private key replaces read-only key of the same account
+	info, err = scanner.RegisterKey(privateKey, 5)
+	assert.Nil(t, err)
+	assert.True(t, info.SpentTracked)
+	assert.Equal(t, uint64(5), info.StartHeight)
+	db.Close()
+
+	// keys are loaded with the passphrase they are encrypted with
+	db, err = incdb.Open("leveldb", dbPath)
+	assert.Nil(t, err)
+	_, err = NewScanner(&Config{DataBase: db, Passphrase: "wrong passphrase"})
+	assert.Equal(t, ErrCodeMessage[LoadScanKeyError].Code, err.(*NoteScannerError).Code)
+	db.Close()
+	scanner, db = newTestScanner(t, dbPath, "passphrase")
+	defer db.Close()
+	info, err = scanner.GetKeyInfo(readonlyKey)
+	assert.Nil(t, err)
+	assert.True(t, info.SpentTracked)
+
+	assert.Nil(t, scanner.UnregisterKey(readonlyKey2))
+	_, err = scanner.GetKeyInfo(readonlyKey2)
+	assert.Equal(t, ErrCodeMessage[ScanKeyNotFoundError].Code, err.(*NoteScannerError).Code)
+	assert.Nil(t, deleteScanKeyData(db, "unknown", []byte("unknown")))
+}
+
+func TestRegisterKeyConcurrently(t *testing.T) {
+	dbPath, err := ioutil.TempDir(os.TempDir(), "test_notescanner")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dbPath)
+	scanner, db := newTestScanner(t, dbPath, "passphrase")
+	defer db.Close()
+
+	// the limit of keys holds when keys are registered at the same time
+	keys := []string{}
+	for seed := byte(1); seed <= 10; seed++ {
+		readonlyKey, _, _ := newTestKeys(t, seed)
+		keys = append(keys, readonlyKey)
+	}
+	errs := make(chan error, len(keys))
+	wg := sync.WaitGroup{}
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, err := scanner.RegisterKey(key, 0)
+			errs <- err
+		}(key)
+	}
+	wg.Wait()
+	close(errs)
+	registered := 0
+	for err := range errs {
+		if err == nil {
+			registered++
+			continue
+		}
+		assert.Equal(t, ErrCodeMessage[MaxScanKeysError].Code, err.(*NoteScannerError).Code)
+	}
+	assert.Equal(t, 2, registered)
+	assert.Equal(t, 2, len(scanner.keys))
+	records, err := loadScanKeys(db)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+
+	// requests of a shard are merged until the scan worker takes them
+	assert.NotEmpty(t, scanner.takePendingShards())
+	scanner.requestScan(3)
+	scanner.requestScan(1)
+	scanner.requestScan(3)
+	assert.Equal(t, []byte{1, 3}, scanner.takePendingShards())
+	assert.Equal(t, []byte{}, scanner.takePendingShards())
+}
+
+func TestScanBlockReadonlyKey(t *testing.T) {
+	dbPath, err := ioutil.TempDir(os.TempDir(), "test_notescanner")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dbPath)
+	readonlyKey, _, keyWallet := newTestKeys(t, 1)
+	scanner, db := newTestScanner(t, dbPath, "passphrase")
+	defer db.Close()
+	_, err = scanner.RegisterKey(readonlyKey, 1)
+	assert.Nil(t, err)
+	key, err := scanner.getKey(readonlyKey)
+	assert.Nil(t, err)
+	pk := keyWallet.KeySet.PaymentAddress.Pk
+	shardID := common.GetShardIDFromLastByte(pk[len(pk)-1])
+
+	proof := new(zkp.PaymentProof)
+	proof.Init()
+	proof.SetOutputCoins([]*privacy.OutputCoin{newTestCoin(pk, 100), newTestCoin(pk, 50)})
+	tx := &transaction.Tx{Type: common.TxNormalType, Proof: proof}
+	batch := db.NewBatch()
+	assert.Nil(t, scanner.scanBlock(batch, key, newTestBlock(1, shardID, tx)))
+	assert.Nil(t, batch.Write())
+
+	// spending is not tracked without private key, only totals of received coins are known
+	info, balances, received, err := scanner.GetBalances(readonlyKey)
+	assert.Nil(t, err)
+	assert.False(t, info.SpentTracked)
+	assert.Nil(t, balances)
+	assert.Equal(t, uint64(150), received[common.PRVCoinID.String()])
+	_, _, err = scanner.GetSpentTxHistory(readonlyKey, nil, 0, 0, 10, nil)
+	assert.Equal(t, ErrCodeMessage[SpentNotTrackedError].Code, err.(*NoteScannerError).Code)
+}
+
+func TestScanBlock(t *testing.T) {
+	dbPath, err := ioutil.TempDir(os.TempDir(), "test_notescanner")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dbPath)
+	_, privateKey, keyWallet := newTestKeys(t, 1)
+	_, _, otherKeyWallet := newTestKeys(t, 2)
+	scanner, db := newTestScanner(t, dbPath, "passphrase")
+	defer db.Close()
+	_, err = scanner.RegisterKey(privateKey, 1)
+	assert.Nil(t, err)
+	key, err := scanner.getKey(privateKey)
+	assert.Nil(t, err)
+	pk := keyWallet.KeySet.PaymentAddress.Pk
+	shardID := common.GetShardIDFromLastByte(pk[len(pk)-1])
+
+	// height 1 receives 2 coins, a coin of other key is skipped
+	received := []*privacy.OutputCoin{newTestCoin(pk, 100), newTestCoin(pk, 50), newTestCoin(otherKeyWallet.KeySet.PaymentAddress.Pk, 10)}
+	proof := new(zkp.PaymentProof)
+	proof.Init()
+	proof.SetOutputCoins(received)
+	tx := &transaction.Tx{Type: common.TxNormalType, Proof: proof}
+	batch := db.NewBatch()
+	assert.Nil(t, scanner.scanBlock(batch, key, newTestBlock(1, shardID, tx)))
+	assert.Nil(t, batch.Write())
+
+	_, balances, receivedTotals, err := scanner.GetBalances(privateKey)
+	assert.Nil(t, err)
+	assert.Nil(t, receivedTotals)
+	assert.Equal(t, uint64(150), balances[common.PRVCoinID.String()])
+
+	// height 2 spends the coin of 100 and receives change of 30
+	inputCoin := new(privacy.InputCoin).Init()
+	inputCoin.CoinDetails.SetSerialNumber(new(privacy.Point).Derive(
+		privacy.PedCom.G[privacy.PedersenPrivateKeyIndex],
+		new(privacy.Scalar).FromBytesS(keyWallet.KeySet.PrivateKey),
+		received[0].CoinDetails.GetSNDerivator()))
+	proof2 := new(zkp.PaymentProof)
+	proof2.Init()
+	proof2.SetInputCoins([]*privacy.InputCoin{inputCoin})
+	proof2.SetOutputCoins([]*privacy.OutputCoin{newTestCoin(pk, 30)})
+	tx2 := &transaction.Tx{Type: common.TxNormalType, Proof: proof2, LockTime: 1}
+	batch = db.NewBatch()
+	assert.Nil(t, scanner.scanBlock(batch, key, newTestBlock(2, shardID, tx2)))
+	assert.Nil(t, batch.Write())
+
+	_, balances, _, err = scanner.GetBalances(privateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(80), balances[common.PRVCoinID.String()])
+
+	coins, total, err := scanner.ListCoins(privateKey, nil, false, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []uint64{100, 50, 30}, []uint64{coins[0].Value, coins[1].Value, coins[2].Value})
+	assert.True(t, coins[0].Spent)
+	assert.Equal(t, uint64(2), coins[0].SpentHeight)
+
+	coins, total, err = scanner.ListCoins(privateKey, &common.PRVCoinID, true, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 1, len(coins))
+	assert.Equal(t, uint64(30), coins[0].Value)
+
+	history, total, err := scanner.ListHistory(privateKey, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, uint64(150), history[0].Received)
+	assert.Equal(t, uint64(100), history[1].Spent)
+	assert.Equal(t, uint64(30), history[1].Received)
+
+	_, _, err = scanner.ListHistory(privateKey, 0, MaxPageSize+1)
+	assert.NotNil(t, err)
+
+	// changes of blocks not marked scanned are not listed from a position
+	key.scannedHeight = 1
+	history, err = scanner.ListHistoryFrom(privateKey, 1, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+	key.scannedHeight = 2
+	history, err = scanner.ListHistoryFrom(privateKey, 1, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, uint64(2), history[0].Height)
+	assert.Equal(t, uint32(0), history[0].Index)
+
+	// the spending tx is recorded as out tx history of the public key, it is removed with the key
+	records, nextCursor, err := scanner.GetSpentTxHistory(privateKey, nil, 0, 0, 10, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, nextCursor)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, rawdbv2.TxHistoryRecord{Height: 2, TxIndex: 0, Direction: rawdbv2.TxHistoryOut, TxHash: *tx2.Hash(), TokenIDs: []common.Hash{common.PRVCoinID}, MetadataType: tx2.GetMetadataType()}, *records[0])
+	assert.Nil(t, scanner.UnregisterKey(privateKey))
+	records, _, err = rawdbv2.GetTxHistory(db, pk, nil, 0, 0, 10, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, records)
+}
@@ -0,0 +1,175 @@
+package notescanner
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+)
+
+// Database of the scanner, every record of a scan key is prefixed with the key ID
+//	- key: encrypted key and start height
+//	- height: last scanned height of the key
+//	- coin: output coins of the key, ordered by height
+//	- sn: serial number of a coin of the key to the coin record (only when spending is tracked)
+//	- tx: history of the key, ordered by height
+//	- balance: balance of each token of the key
+// Transactions spending coins of a key with private key are also stored in the format of the tx history index,
+// by public key of the key (rawdbv2.StoreTxHistory)
+var (
+	scanKeyPrefix       = []byte("ns-key-")
+	scannedHeightPrefix = []byte("ns-height-")
+	coinPrefix          = []byte("ns-coin-")
+	serialNumberPrefix  = []byte("ns-sn-")
+	historyPrefix       = []byte("ns-tx-")
+	balancePrefix       = []byte("ns-balance-")
+)
+
+// keyRecord is the plaintext of an encrypted key record
+type keyRecord struct {
+	Key         string
+	StartHeight uint64
+}
+
+func getPrefixWithID(prefix []byte, id string) []byte {
+	key := make([]byte, 0, len(prefix)+len(id)+1)
+	key = append(key, prefix...)
+	key = append(key, id...)
+	return append(key, '-')
+}
+
+// getOrderedKey returns key of a record of a block, big endian height keeps records iterated in height order
+func getOrderedKey(prefix []byte, id string, height uint64, index uint32) []byte {
+	key := getPrefixWithID(prefix, id)
+	buf := make([]byte, 12)
+	binary.BigEndian.PutUint64(buf, height)
+	binary.BigEndian.PutUint32(buf[8:], index)
+	return append(key, buf...)
+}
+
+func getSerialNumberKey(id string, serialNumber []byte) []byte {
+	return append(getPrefixWithID(serialNumberPrefix, id), serialNumber...)
+}
+
+func getBalanceKey(id string, tokenID common.Hash) []byte {
+	return append(getPrefixWithID(balancePrefix, id), tokenID[:]...)
+}
+
+func storeScanKey(db incdb.KeyValueWriter, id string, encrypted string) error {
+	return db.Put(getPrefixWithID(scanKeyPrefix, id), []byte(encrypted))
+}
+
+// loadScanKeys returns encrypted key records by key ID
+func loadScanKeys(db incdb.Database) (map[string]string, error) {
+	iterator := db.NewIteratorWithPrefix(scanKeyPrefix)
+	defer iterator.Release()
+	result := make(map[string]string)
+	for iterator.Next() {
+		key := iterator.Key()
+		id := string(key[len(scanKeyPrefix) : len(key)-1])
+		result[id] = string(iterator.Value())
+	}
+	return result, iterator.Error()
+}
+
+func storeScannedHeight(db incdb.KeyValueWriter, id string, height uint64) error {
+	return db.Put(getPrefixWithID(scannedHeightPrefix, id), common.Uint64ToBytes(height))
+}
+
+func getScannedHeight(db incdb.KeyValueReader, id string) (uint64, error) {
+	key := getPrefixWithID(scannedHeightPrefix, id)
+	if has, err := db.Has(key); err != nil || !has {
+		return 0, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return common.BytesToUint64(value)
+}
+
+func storeBalance(db incdb.KeyValueWriter, id string, tokenID common.Hash, balance uint64) error {
+	return db.Put(getBalanceKey(id, tokenID), common.Uint64ToBytes(balance))
+}
+
+func getBalance(db incdb.KeyValueReader, id string, tokenID common.Hash) (uint64, error) {
+	key := getBalanceKey(id, tokenID)
+	if has, err := db.Has(key); err != nil || !has {
+		return 0, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return common.BytesToUint64(value)
+}
+
+func getBalances(db incdb.Database, id string) (map[common.Hash]uint64, error) {
+	prefix := getPrefixWithID(balancePrefix, id)
+	iterator := db.NewIteratorWithPrefix(prefix)
+	defer iterator.Release()
+	result := make(map[common.Hash]uint64)
+	for iterator.Next() {
+		tokenID := common.Hash{}
+		if err := tokenID.SetBytes(iterator.Key()[len(prefix):]); err != nil {
+			return nil, err
+		}
+		balance, err := common.BytesToUint64(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		result[tokenID] = balance
+	}
+	return result, iterator.Error()
+}
+
+func storeRecord(db incdb.KeyValueWriter, key []byte, record interface{}) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return db.Put(key, value)
+}
+
+// iterateRecords calls f on records of a key ID in order until f returns false
+func iterateRecords(db incdb.Database, prefix []byte, id string, f func(key []byte, value []byte) (bool, error)) error {
+	iterator := db.NewIteratorWithPrefix(getPrefixWithID(prefix, id))
+	defer iterator.Release()
+	for iterator.Next() {
+		next, err := f(iterator.Key(), iterator.Value())
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return iterator.Error()
+}
+
+// deleteScanKeyData deletes all records of a key ID and the tx history of its public key
+func deleteScanKeyData(db incdb.Database, id string, publicKey []byte) error {
+	batch := db.NewBatch()
+	prefixes := [][]byte{rawdbv2.GetTxHistoryPrefix(publicKey)}
+	for _, prefix := range [][]byte{scanKeyPrefix, scannedHeightPrefix, coinPrefix, serialNumberPrefix, historyPrefix, balancePrefix} {
+		prefixes = append(prefixes, getPrefixWithID(prefix, id))
+	}
+	for _, prefix := range prefixes {
+		iterator := db.NewIteratorWithPrefix(prefix)
+		for iterator.Next() {
+			key := make([]byte, len(iterator.Key()))
+			copy(key, iterator.Key())
+			if err := batch.Delete(key); err != nil {
+				iterator.Release()
+				return err
+			}
+		}
+		iterator.Release()
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"io"
 	"reflect"
+	"sync/atomic"
 	"time"
 
 	"github.com/incognitochain/incognito-chain/blockchain"
@@ -33,6 +34,7 @@ func NewConnManager(
 	relayShard []byte,
 ) *ConnManager {
 	pubkey, _ := ikey.ToBase58()
+	scorer := NewPeerScoreKeeper()
 	return &ConnManager{
 		info: info{
 			consensusData: cd,
@@ -42,6 +44,8 @@ func NewConnManager(
 			peerID:        host.Host.ID(),
 		},
 		keeper:               NewAddrKeeper(),
+		Scorer:               scorer,
+		DirectPeers:          NewDirectPeerKeeper(host, scorer),
 		LocalHost:            host,
 		DiscoverPeersAddress: dpa,
 		discoverer:           new(rpcclient.RPCClient),
@@ -128,6 +132,8 @@ func (cm *ConnManager) Start(ns NetSync) {
 	cm.Subscriber = NewSubManager(cm.info, cm.ps, cm.Requester, cm.messages)
 	cm.Provider = NewBlockProvider(cm.LocalHost.GRPC, ns)
 	go cm.manageRoleSubscription()
+	go cm.Scorer.keepSaving(cm.stop)
+	go cm.DirectPeers.keepPeers(cm.stop)
 	cm.process()
 }
 
@@ -185,11 +191,15 @@ type ConnManager struct {
 	registerRequests chan peer.ID
 
 	keeper     *AddrKeeper
+	Scorer     *PeerScoreKeeper
 	discoverer HighwayDiscoverer
 	disp       *Dispatcher
 	Requester  *BlockRequester
 	Provider   *BlockProvider
 
+	DirectPeers     *DirectPeerKeeper
+	directSyncUntil int64 // unix time, stream from direct peers first until this time
+
 	stop chan int
 }
 
@@ -201,6 +211,9 @@ func (cm *ConnManager) process() {
 	for {
 		select {
 		case msg := <-cm.messages:
+			if from := msg.GetFrom(); from != "" && cm.Scorer.IsBanned(from.String()) {
+				continue
+			}
 			err := cm.disp.processInMessageString(string(msg.Data))
 			if err != nil {
 				Logger.Warn(err)
@@ -467,8 +480,11 @@ func (conn *ConnManager) RequestShardBlocksByHashViaStream(ctx context.Context,
 
 func (conn *ConnManager) requestBlocksViaStream(ctx context.Context, peerID string, req *proto.BlockByHeightRequest) (blockCh chan common.BlockInterface, err error) {
 	Logger.Infof("[stream] Request Block type %v from peer %v from cID %v, [%v %v] ", req.Type, peerID, req.GetFrom(), req.Heights[0], req.Heights[len(req.Heights)-1])
+	if conn.Scorer.IsBanned(req.SyncFromPeer) {
+		req.SyncFromPeer = "" // let highway choose another peer
+	}
 	blockCh = make(chan common.BlockInterface, blockchain.DefaultMaxBlkReqPerPeer)
-	stream, err := conn.Requester.StreamBlockByHeight(ctx, req)
+	stream, direct, err := conn.streamBlockByHeight(ctx, req)
 	if err != nil {
 		Logger.Errorf("[stream] %v", err)
 		return nil, err
@@ -487,6 +503,7 @@ func (conn *ConnManager) requestBlocksViaStream(ctx context.Context, peerID stri
 			if err != nil {
 				if err != io.EOF {
 					Logger.Errorf("[stream] %v", err)
+					conn.reportStreamError(ctx, req.SyncFromPeer, direct)
 				}
 				closeChannel()
 				return
@@ -494,6 +511,7 @@ func (conn *ConnManager) requestBlocksViaStream(ctx context.Context, peerID stri
 
 			if len(blkData.Data) < 2 {
 				Logger.Errorf("[stream] received empty blk")
+				conn.Scorer.ReportInvalidMessage(req.SyncFromPeer, "empty block in stream")
 				closeChannel()
 				return
 			}
@@ -508,6 +526,7 @@ func (conn *ConnManager) requestBlocksViaStream(ctx context.Context, peerID stri
 			err = wrapper.DeCom(blkData.Data[1:], newBlk)
 			if err != nil {
 				Logger.Errorf("[stream] %v", err)
+				conn.Scorer.ReportInvalidMessage(req.SyncFromPeer, err.Error())
 				closeChannel()
 				return
 			}
@@ -527,8 +546,11 @@ func (conn *ConnManager) requestBlocksViaStream(ctx context.Context, peerID stri
 
 func (conn *ConnManager) requestBlocksByHashViaStream(ctx context.Context, peerID string, req *proto.BlockByHashRequest) (blockCh chan common.BlockInterface, err error) {
 	Logger.Infof("SYNCKER Request Block by hash from peerID %v, from CID %v, total %v blocks", peerID, req.From, len(req.Hashes))
+	if conn.Scorer.IsBanned(req.SyncFromPeer) {
+		req.SyncFromPeer = "" // let highway choose another peer
+	}
 	blockCh = make(chan common.BlockInterface, blockchain.DefaultMaxBlkReqPerPeer)
-	stream, err := conn.Requester.StreamBlockByHash(ctx, req)
+	stream, direct, err := conn.streamBlockByHash(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -544,11 +566,15 @@ func (conn *ConnManager) requestBlocksByHashViaStream(ctx context.Context, peerI
 		for {
 			blkData, err := stream.Recv()
 			if err != nil || err == io.EOF {
+				if err != io.EOF {
+					conn.reportStreamError(ctx, req.SyncFromPeer, direct)
+				}
 				closeChannel()
 				return
 			}
 
 			if len(blkData.Data) < 2 {
+				conn.Scorer.ReportInvalidMessage(req.SyncFromPeer, "empty block in stream")
 				closeChannel()
 				return
 			}
@@ -562,6 +588,7 @@ func (conn *ConnManager) requestBlocksByHashViaStream(ctx context.Context, peerI
 
 			err = wrapper.DeCom(blkData.Data[1:], newBlk)
 			if err != nil {
+				conn.Scorer.ReportInvalidMessage(req.SyncFromPeer, err.Error())
 				closeChannel()
 				return
 			}
@@ -578,3 +605,66 @@ func (conn *ConnManager) requestBlocksByHashViaStream(ctx context.Context, peerI
 
 	return blockCh, nil
 }
+
+// reportStreamError penalizes the peer serving a stream if the request could not finish in time.
+// If a highway stream broke by itself, direct peers are preferred for a while
+func (conn *ConnManager) reportStreamError(ctx context.Context, peerID string, direct bool) {
+	if ctx.Err() == context.DeadlineExceeded {
+		conn.Scorer.ReportTimeout(peerID)
+		return
+	}
+	if !direct && ctx.Err() == nil {
+		Logger.Warnf("[stream] Highway stream failed, prefer direct peers for %v", DirectSyncDuration)
+		atomic.StoreInt64(&conn.directSyncUntil, time.Now().Add(DirectSyncDuration).Unix())
+	}
+}
+
+func (conn *ConnManager) preferDirectSync() bool {
+	return atomic.LoadInt64(&conn.directSyncUntil) > time.Now().Unix()
+}
+
+// streamBlockByHeight opens a block stream through highway, falls back to direct peers if highway is unavailable
+func (conn *ConnManager) streamBlockByHeight(ctx context.Context, req *proto.BlockByHeightRequest) (stream proto.HighwayService_StreamBlockByHeightClient, direct bool, err error) {
+	highwayFirst := !conn.preferDirectSync()
+	if highwayFirst {
+		if stream, err = conn.Requester.StreamBlockByHeight(ctx, req); err == nil {
+			return stream, false, nil
+		}
+		Logger.Warnf("[stream] Highway is unavailable: %v, fallback to direct peers", err)
+	}
+	var peerID string
+	if stream, peerID, err = conn.DirectPeers.StreamBlockByHeight(ctx, req); err == nil {
+		req.SyncFromPeer = peerID
+		return stream, true, nil
+	}
+	if !highwayFirst {
+		Logger.Warnf("[stream] Direct peers are unavailable: %v, fallback to highway", err)
+		if stream, err = conn.Requester.StreamBlockByHeight(ctx, req); err == nil {
+			return stream, false, nil
+		}
+	}
+	return nil, false, err
+}
+
+// streamBlockByHash opens a block stream through highway, falls back to direct peers if highway is unavailable
+func (conn *ConnManager) streamBlockByHash(ctx context.Context, req *proto.BlockByHashRequest) (stream proto.HighwayService_StreamBlockByHashClient, direct bool, err error) {
+	highwayFirst := !conn.preferDirectSync()
+	if highwayFirst {
+		if stream, err = conn.Requester.StreamBlockByHash(ctx, req); err == nil {
+			return stream, false, nil
+		}
+		Logger.Warnf("[stream] Highway is unavailable: %v, fallback to direct peers", err)
+	}
+	var peerID string
+	if stream, peerID, err = conn.DirectPeers.StreamBlockByHash(ctx, req); err == nil {
+		req.SyncFromPeer = peerID
+		return stream, true, nil
+	}
+	if !highwayFirst {
+		Logger.Warnf("[stream] Direct peers are unavailable: %v, fallback to highway", err)
+		if stream, err = conn.Requester.StreamBlockByHash(ctx, req); err == nil {
+			return stream, false, nil
+		}
+	}
+	return nil, false, err
+}
@@ -26,4 +26,22 @@ var (
 
 	IgnoreRPCDuration = 60 * time.Minute  // Ignore an address after a failed RPC
 	IgnoreHWDuration  = 360 * time.Minute // Ignore a highway when cannot connect
+
+	PeerScoreSaveTimestep  = 1 * time.Minute  // Persist peer scores to database
+	PeerScoreDecayTimestep = 10 * time.Minute // Move score 1 point back to neutral after this time without update
+	AutoBanDuration        = 60 * time.Minute // Ban a peer when its score drop under MinPeerScore
+
+	DirectPeerRefreshTimestep = 10 * time.Minute // Remove expired direct peers and persist the rest
+	DirectPeerExpireDuration  = 24 * time.Hour   // Forget a direct peer not advertising itself for this long
+	DirectSyncDuration        = 1 * time.Minute  // Stream from direct peers first after a highway stream failed
+	MaxDirectPeersPerRequest  = 5                // Direct peers tried for one stream request
+)
+
+// peer reputation
+const (
+	MaxPeerScore          = 100
+	MinPeerScore          = -100 // peer is banned when its score drop under this
+	InvalidMessagePenalty = -20  // undecodable or invalid block/message
+	TimeoutPenalty        = -5   // stream request timeout
+	UsefulBlockReward     = 1    // block accepted to chain
 )
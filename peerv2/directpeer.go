@@ -0,0 +1,348 @@
+package peerv2
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/incognitochain/incognito-chain/peerv2/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+type directPeer struct {
+	Addrs    []string
+	LastSeen int64
+	Static   bool // added from config, never expire
+}
+
+// DirectPeerKeeper stores libp2p addresses of other nodes, learnt from their peer state
+// or from config, and keeps gRPC connections to them.
+// Every node serves BlockProvider on its libp2p host, so when highway is unavailable,
+// blocks can be streamed directly from these peers.
+type DirectPeerKeeper struct {
+	host   *Host
+	scorer *PeerScoreKeeper
+	peers  map[string]*directPeer // peerID -> addresses
+	conns  map[string]*grpc.ClientConn
+	db     incdb.Database
+	dirty  bool
+	lock   sync.Mutex
+
+	seeder func() []string // addresses of nodes known without highway, e.g. from addrmanager
+}
+
+func NewDirectPeerKeeper(host *Host, scorer *PeerScoreKeeper) *DirectPeerKeeper {
+	return &DirectPeerKeeper{
+		host:   host,
+		scorer: scorer,
+		peers:  map[string]*directPeer{},
+		conns:  map[string]*grpc.ClientConn{},
+	}
+}
+
+// SetDB restores direct peers saved in db and uses it to persist them from now on,
+// so that a restarting node can sync even if no highway is reachable
+func (keeper *DirectPeerKeeper) SetDB(db incdb.Database) error {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	keeper.db = db
+	data, err := rawdbv2.GetDirectPeers(db)
+	if err != nil {
+		return nil // nothing saved yet
+	}
+	peers := map[string]*directPeer{}
+	if err := json.Unmarshal(data, &peers); err != nil {
+		return err
+	}
+	for peerID, p := range peers {
+		if _, ok := keeper.peers[peerID]; !ok {
+			keeper.peers[peerID] = p
+		}
+	}
+	Logger.Infof("Restored %v direct peers", len(peers))
+	return nil
+}
+
+func (keeper *DirectPeerKeeper) save() error {
+	if keeper.db == nil || !keeper.dirty {
+		return nil
+	}
+	data, err := json.Marshal(keeper.peers)
+	if err != nil {
+		return err
+	}
+	if err := rawdbv2.StoreDirectPeers(keeper.db, data); err != nil {
+		return err
+	}
+	keeper.dirty = false
+	return nil
+}
+
+// AddStaticPeers adds full libp2p addresses (/ip4/.../tcp/.../p2p/<peerID>) given in config
+func (keeper *DirectPeerKeeper) AddStaticPeers(addrs []string) {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	for _, addr := range addrs {
+		addrInfo, err := getAddressInfo(addr)
+		if err != nil {
+			Logger.Errorf("Invalid direct peer address %v: %v", addr, err)
+			continue
+		}
+		keeper.peers[addrInfo.ID.Pretty()] = &directPeer{
+			Addrs:  []string{addr},
+			Static: true,
+		}
+	}
+}
+
+// SetSeeder sets the source of addresses known without highway, it is read on every refresh,
+// so that direct peers are discovered even when highway does not relay peer states
+func (keeper *DirectPeerKeeper) SetSeeder(seeder func() []string) {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	keeper.seeder = seeder
+}
+
+// AddSeedPeers adds full libp2p addresses of nodes known from previous connections,
+// the peer ID is taken from the address
+func (keeper *DirectPeerKeeper) AddSeedPeers(addrs []string) {
+	for _, addr := range addrs {
+		addrInfo, err := getAddressInfo(addr)
+		if err != nil {
+			continue
+		}
+		keeper.AddPeer(addrInfo.ID.Pretty(), []string{addr})
+	}
+}
+
+func (keeper *DirectPeerKeeper) seed() {
+	keeper.lock.Lock()
+	seeder := keeper.seeder
+	keeper.lock.Unlock()
+	if seeder != nil {
+		keeper.AddSeedPeers(seeder())
+	}
+}
+
+// AddPeer saves addresses advertised by a peer, addresses are full libp2p addresses
+func (keeper *DirectPeerKeeper) AddPeer(peerID string, addrs []string) {
+	if peerID == "" || len(addrs) == 0 || peerID == keeper.host.Host.ID().Pretty() {
+		return
+	}
+	valid := []string{}
+	for _, addr := range addrs {
+		addrInfo, err := getAddressInfo(addr)
+		if err != nil || addrInfo.ID.Pretty() != peerID {
+			continue
+		}
+		valid = append(valid, addr)
+	}
+	if len(valid) == 0 {
+		return
+	}
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	p, ok := keeper.peers[peerID]
+	if !ok {
+		p = &directPeer{}
+		keeper.peers[peerID] = p
+		keeper.dirty = true
+	}
+	if !p.Static {
+		p.Addrs = valid
+	}
+	p.LastSeen = time.Now().Unix()
+}
+
+// keepPeers periodically adds peers from the seeder, removes peers not seen for a long time and persists the rest
+func (keeper *DirectPeerKeeper) keepPeers(stop chan int) {
+	refreshTimestep := time.NewTicker(DirectPeerRefreshTimestep)
+	defer refreshTimestep.Stop()
+	keeper.seed()
+	for {
+		select {
+		case <-refreshTimestep.C:
+			keeper.seed()
+			keeper.lock.Lock()
+			for peerID, p := range keeper.peers {
+				if !p.Static && time.Since(time.Unix(p.LastSeen, 0)) > DirectPeerExpireDuration {
+					delete(keeper.peers, peerID)
+					keeper.closeConn(peerID)
+					keeper.dirty = true
+				}
+			}
+			if err := keeper.save(); err != nil {
+				Logger.Errorf("Failed saving direct peers: %v", err)
+			}
+			keeper.lock.Unlock()
+		case <-stop:
+			keeper.lock.Lock()
+			for peerID := range keeper.conns {
+				keeper.closeConn(peerID)
+			}
+			keeper.lock.Unlock()
+			return
+		}
+	}
+}
+
+// candidates return known peers to stream from: the wanted peer first if we know its address,
+// then the others ordered by score; banned peers are skipped
+func (keeper *DirectPeerKeeper) candidates(wanted string) []string {
+	keeper.lock.Lock()
+	peerIDs := []string{}
+	for peerID := range keeper.peers {
+		if peerID != wanted {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
+	_, knowWanted := keeper.peers[wanted]
+	keeper.lock.Unlock()
+
+	peerIDs = keeper.scorer.SortPeers(peerIDs)
+	if knowWanted && !keeper.scorer.IsBanned(wanted) {
+		peerIDs = append([]string{wanted}, peerIDs...)
+	}
+	if len(peerIDs) > MaxDirectPeersPerRequest {
+		peerIDs = peerIDs[:MaxDirectPeersPerRequest]
+	}
+	return peerIDs
+}
+
+func (keeper *DirectPeerKeeper) closeConn(peerID string) {
+	if conn, ok := keeper.conns[peerID]; ok {
+		if err := conn.Close(); err != nil {
+			Logger.Errorf("Failed closing direct connection to %v: %v", peerID, err)
+		}
+		delete(keeper.conns, peerID)
+	}
+}
+
+// getConn returns gRPC connection to a direct peer, dialing it through libp2p if needed.
+// The dial runs without holding the lock, so that a slow peer does not block the lookup of other peers
+func (keeper *DirectPeerKeeper) getConn(peerID string) (*grpc.ClientConn, error) {
+	keeper.lock.Lock()
+	if conn, ok := keeper.readyConn(peerID); ok {
+		keeper.lock.Unlock()
+		return conn, nil
+	}
+	p, ok := keeper.peers[peerID]
+	var addrs []string
+	if ok {
+		addrs = append(addrs, p.Addrs...)
+	}
+	keeper.lock.Unlock()
+	if !ok {
+		return nil, errors.Errorf("unknown direct peer %v", peerID)
+	}
+	var pid peer.ID
+	for _, addr := range addrs {
+		addrInfo, err := getAddressInfo(addr)
+		if err != nil {
+			continue
+		}
+		pid = addrInfo.ID
+		keeper.host.Host.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.TempAddrTTL)
+	}
+	if pid == "" {
+		return nil, errors.Errorf("no valid address for direct peer %v", peerID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DialTimeout)
+	defer cancel()
+	if keeper.host.Host.Network().Connectedness(pid) != network.Connected {
+		if err := keeper.host.Host.Connect(ctx, peer.AddrInfo{ID: pid}); err != nil {
+			return nil, errors.WithMessagef(err, "could not connect to direct peer %v", peerID)
+		}
+	}
+	// Do not use ctx for dialing: the stream context of libp2p grpc is the dial context
+	conn, err := keeper.host.GRPC.Dial(
+		context.Background(),
+		pid,
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "could not dial grpc to direct peer %v", peerID)
+	}
+
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	// another request may have dialed the peer meanwhile, keep a single connection
+	if existing, ok := keeper.readyConn(peerID); ok {
+		if err := conn.Close(); err != nil {
+			Logger.Errorf("Failed closing direct connection to %v: %v", peerID, err)
+		}
+		return existing, nil
+	}
+	keeper.conns[peerID] = conn
+	return conn, nil
+}
+
+// readyConn returns the stored connection to a direct peer if it is usable, and closes it otherwise. Caller must hold the lock
+func (keeper *DirectPeerKeeper) readyConn(peerID string) (*grpc.ClientConn, bool) {
+	conn, ok := keeper.conns[peerID]
+	if !ok {
+		return nil, false
+	}
+	if conn.GetState() == connectivity.Ready || conn.GetState() == connectivity.Idle {
+		return conn, true
+	}
+	keeper.closeConn(peerID)
+	return nil, false
+}
+
+// StreamBlockByHeight requests blocks directly from other nodes, trying them one by one until one of them accepts
+func (keeper *DirectPeerKeeper) StreamBlockByHeight(
+	ctx context.Context,
+	req *proto.BlockByHeightRequest,
+) (proto.HighwayService_StreamBlockByHeightClient, string, error) {
+	for _, peerID := range keeper.candidates(req.SyncFromPeer) {
+		conn, err := keeper.getConn(peerID)
+		if err != nil {
+			Logger.Warnf("[stream] %v", err)
+			keeper.scorer.ReportTimeout(peerID)
+			continue
+		}
+		req.UUID = genUUID()
+		stream, err := proto.NewHighwayServiceClient(conn).StreamBlockByHeight(ctx, req, grpc.MaxCallRecvMsgSize(MaxCallRecvMsgSize))
+		if err != nil {
+			Logger.Warnf("[stream] Direct peer %v not return stream for this request, got error %v", peerID, err)
+			continue
+		}
+		Logger.Infof("[stream] Stream block type %v from direct peer %v, uuid = %s", req.Type, peerID, req.UUID)
+		return stream, peerID, nil
+	}
+	return nil, "", errors.New("no direct peer available")
+}
+
+// StreamBlockByHash requests blocks directly from other nodes, trying them one by one until one of them accepts
+func (keeper *DirectPeerKeeper) StreamBlockByHash(
+	ctx context.Context,
+	req *proto.BlockByHashRequest,
+) (proto.HighwayService_StreamBlockByHashClient, string, error) {
+	for _, peerID := range keeper.candidates(req.SyncFromPeer) {
+		conn, err := keeper.getConn(peerID)
+		if err != nil {
+			Logger.Warnf("[stream] %v", err)
+			keeper.scorer.ReportTimeout(peerID)
+			continue
+		}
+		req.UUID = genUUID()
+		stream, err := proto.NewHighwayServiceClient(conn).StreamBlockByHash(ctx, req, grpc.MaxCallRecvMsgSize(MaxCallRecvMsgSize))
+		if err != nil {
+			Logger.Warnf("[stream] Direct peer %v not return stream for this request, got error %v", peerID, err)
+			continue
+		}
+		Logger.Infof("[stream] Stream block by hash type %v from direct peer %v, uuid = %s", req.Type, peerID, req.UUID)
+		return stream, peerID, nil
+	}
+	return nil, "", errors.New("no direct peer available")
+}
@@ -0,0 +1,108 @@
+package peerv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/peerv2/proto"
+	"github.com/incognitochain/incognito-chain/peerv2/wrapper"
+	"github.com/incognitochain/incognito-chain/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type directNetSync struct {
+	blocks []*blockchain.ShardBlock
+}
+
+func (ns *directNetSync) GetBlockShardByHash(blkHashes []common.Hash) []wire.Message {
+	return nil
+}
+
+func (ns *directNetSync) GetBlockBeaconByHash(blkHashes []common.Hash) []wire.Message {
+	return nil
+}
+
+func (ns *directNetSync) StreamBlockByHeight(fromPool bool, req *proto.BlockByHeightRequest) chan interface{} {
+	ch := make(chan interface{}, len(ns.blocks))
+	for _, blk := range ns.blocks {
+		ch <- blk
+	}
+	close(ch)
+	return ch
+}
+
+func (ns *directNetSync) StreamBlockByHash(fromPool bool, req *proto.BlockByHashRequest) chan interface{} {
+	ch := make(chan interface{})
+	close(ch)
+	return ch
+}
+
+// Peers known from the seeder are discovered without any peer state from highway
+func TestDirectPeerSeed(t *testing.T) {
+	host := NewHost("test", "127.0.0.1", 19452, "")
+	defer host.Host.Close()
+	other := NewHost("test", "127.0.0.1", 19453, "")
+	defer other.Host.Close()
+
+	keeper := NewDirectPeerKeeper(host, NewPeerScoreKeeper())
+	keeper.seed()
+	assert.Equal(t, 0, len(keeper.candidates("")))
+
+	keeper.SetSeeder(func() []string {
+		return append([]string{"invalid", "/ip4/127.0.0.1/tcp/1"}, append(host.P2PAddrs(), other.P2PAddrs()...)...)
+	})
+	keeper.seed()
+	assert.Equal(t, []string{other.Host.ID().Pretty()}, keeper.candidates(""))
+}
+
+// Only addresses matching the advertised peer ID are kept
+func TestDirectPeerAddPeer(t *testing.T) {
+	host := NewHost("test", "127.0.0.1", 19450, "")
+	defer host.Host.Close()
+	other := NewHost("test", "127.0.0.1", 19451, "")
+	defer other.Host.Close()
+
+	keeper := NewDirectPeerKeeper(host, NewPeerScoreKeeper())
+	keeper.AddPeer(other.Host.ID().Pretty(), []string{"/ip4/127.0.0.1/tcp/1"})
+	keeper.AddPeer(host.Host.ID().Pretty(), host.P2PAddrs())
+	assert.Equal(t, 0, len(keeper.candidates("")))
+
+	keeper.AddPeer(other.Host.ID().Pretty(), other.P2PAddrs())
+	assert.Equal(t, []string{other.Host.ID().Pretty()}, keeper.candidates(""))
+
+	keeper.scorer.Ban(other.Host.ID().Pretty(), time.Minute)
+	assert.Equal(t, 0, len(keeper.candidates(other.Host.ID().Pretty())))
+}
+
+// Streams blocks from the block provider of another node without any highway
+func TestDirectPeerStreamBlockByHeight(t *testing.T) {
+	blk := blockchain.NewShardBlock()
+	blk.Header.Height = 2
+	server := NewHost("test", "127.0.0.1", 19452, "")
+	defer server.Host.Close()
+	NewBlockProvider(server.GRPC, &directNetSync{blocks: []*blockchain.ShardBlock{blk}})
+
+	client := NewHost("test", "127.0.0.1", 19453, "")
+	defer client.Host.Close()
+	keeper := NewDirectPeerKeeper(client, NewPeerScoreKeeper())
+	keeper.AddStaticPeers(server.P2PAddrs())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, peerID, err := keeper.StreamBlockByHeight(ctx, &proto.BlockByHeightRequest{
+		Type:    proto.BlkType_BlkShard,
+		Heights: []uint64{2, 2},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, server.Host.ID().Pretty(), peerID)
+
+	blkData, err := stream.Recv()
+	assert.Nil(t, err)
+	expected, err := wrapper.EnCom(blk)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(proto.BlkType_BlkShard), blkData.Data[0])
+	assert.Equal(t, expected, blkData.Data[1:])
+}
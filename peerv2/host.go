@@ -89,6 +89,15 @@ func NewHost(version string, pubIP string, port int, privateKey string) *Host {
 	return node
 }
 
+// P2PAddrs returns full libp2p addresses (including peer ID) other nodes can use to connect to this host
+func (h *Host) P2PAddrs() []string {
+	addrs := []string{}
+	for _, addr := range h.SelfPeer.TargetAddress {
+		addrs = append(addrs, fmt.Sprintf("%s/p2p/%s", addr.String(), h.SelfPeer.PeerID.Pretty()))
+	}
+	return addrs
+}
+
 func catchError(err error) {
 	if err != nil {
 		panic(err)
@@ -0,0 +1,266 @@
+package peerv2
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	"github.com/pkg/errors"
+)
+
+// PeerScore is the reputation of a peer, built from the messages and blocks we received from it
+type PeerScore struct {
+	PeerID          string
+	Score           int
+	InvalidMessages uint64
+	Timeouts        uint64
+	UsefulBlocks    uint64
+	BannedUntil     int64 // unix time, 0 if not banned
+	LastUpdate      int64
+}
+
+func (ps *PeerScore) isBanned(now time.Time) bool {
+	return ps.BannedUntil > now.Unix()
+}
+
+// decay moves score back to neutral, so that old misbehaviour (or old good deeds) are forgotten over time
+func (ps *PeerScore) decay(now time.Time) {
+	if ps.LastUpdate == 0 {
+		ps.LastUpdate = now.Unix()
+		return
+	}
+	steps := int((now.Unix() - ps.LastUpdate) / int64(PeerScoreDecayTimestep/time.Second))
+	if steps <= 0 {
+		return
+	}
+	if ps.Score > 0 {
+		ps.Score -= steps
+		if ps.Score < 0 {
+			ps.Score = 0
+		}
+	} else if ps.Score < 0 {
+		ps.Score += steps
+		if ps.Score > 0 {
+			ps.Score = 0
+		}
+	}
+	ps.LastUpdate = now.Unix()
+}
+
+// PeerScoreKeeper keeps track of peer reputation.
+// Peers sending invalid messages or timing out on stream requests lose score, peers providing
+// useful blocks gain score. A peer is banned for AutoBanDuration when its score drop under MinPeerScore;
+// banned peers are ignored and the rest are ordered by score when choosing where to stream blocks from.
+type PeerScoreKeeper struct {
+	scores map[string]*PeerScore
+	db     incdb.Database
+	dirty  bool
+	lock   sync.RWMutex
+}
+
+func NewPeerScoreKeeper() *PeerScoreKeeper {
+	return &PeerScoreKeeper{
+		scores: map[string]*PeerScore{},
+	}
+}
+
+// SetDB restores peer scores saved in db and uses it to persist scores from now on
+func (keeper *PeerScoreKeeper) SetDB(db incdb.Database) error {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	keeper.db = db
+	data, err := rawdbv2.GetPeerScores(db)
+	if err != nil {
+		return nil // nothing saved yet
+	}
+	scores := []*PeerScore{}
+	if err := json.Unmarshal(data, &scores); err != nil {
+		return err
+	}
+	for _, ps := range scores {
+		keeper.scores[ps.PeerID] = ps
+	}
+	Logger.Infof("Restored score of %v peers", len(scores))
+	return nil
+}
+
+// Save persists peer scores if they changed since last save
+func (keeper *PeerScoreKeeper) Save() error {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	return keeper.save()
+}
+
+func (keeper *PeerScoreKeeper) save() error {
+	if keeper.db == nil || !keeper.dirty {
+		return nil
+	}
+	scores := []*PeerScore{}
+	for _, ps := range keeper.scores {
+		scores = append(scores, ps)
+	}
+	data, err := json.Marshal(scores)
+	if err != nil {
+		return err
+	}
+	if err := rawdbv2.StorePeerScores(keeper.db, data); err != nil {
+		return err
+	}
+	keeper.dirty = false
+	return nil
+}
+
+// keepSaving periodically persists peer scores until stop is closed
+func (keeper *PeerScoreKeeper) keepSaving(stop chan int) {
+	saveTimestep := time.NewTicker(PeerScoreSaveTimestep)
+	defer saveTimestep.Stop()
+	for {
+		select {
+		case <-saveTimestep.C:
+			if err := keeper.Save(); err != nil {
+				Logger.Errorf("Failed saving peer scores: %v", err)
+			}
+		case <-stop:
+			if err := keeper.Save(); err != nil {
+				Logger.Errorf("Failed saving peer scores: %v", err)
+			}
+			return
+		}
+	}
+}
+
+func (keeper *PeerScoreKeeper) get(peerID string, now time.Time) *PeerScore {
+	ps, ok := keeper.scores[peerID]
+	if !ok {
+		ps = &PeerScore{PeerID: peerID}
+		keeper.scores[peerID] = ps
+	}
+	ps.decay(now)
+	return ps
+}
+
+func (keeper *PeerScoreKeeper) update(peerID string, delta int, count func(ps *PeerScore)) {
+	if peerID == "" { // chosen by highway, we do not know who send it
+		return
+	}
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	now := time.Now()
+	ps := keeper.get(peerID, now)
+	count(ps)
+	ps.Score += delta
+	if ps.Score > MaxPeerScore {
+		ps.Score = MaxPeerScore
+	}
+	if ps.Score < MinPeerScore && !ps.isBanned(now) {
+		ps.BannedUntil = now.Add(AutoBanDuration).Unix()
+		ps.Score = MinPeerScore
+		Logger.Warnf("Peer %v is banned until %v", peerID, time.Unix(ps.BannedUntil, 0).Format(time.RFC3339))
+	}
+	ps.LastUpdate = now.Unix()
+	keeper.dirty = true
+}
+
+// ReportInvalidMessage penalizes a peer sending a message or block that can not be decoded or validated
+func (keeper *PeerScoreKeeper) ReportInvalidMessage(peerID string, reason string) {
+	Logger.Warnf("Peer %v sent invalid message: %v", peerID, reason)
+	keeper.update(peerID, InvalidMessagePenalty, func(ps *PeerScore) { ps.InvalidMessages++ })
+}
+
+// ReportTimeout penalizes a peer not finishing a stream request in time
+func (keeper *PeerScoreKeeper) ReportTimeout(peerID string) {
+	keeper.update(peerID, TimeoutPenalty, func(ps *PeerScore) { ps.Timeouts++ })
+}
+
+// ReportUsefulBlock rewards a peer providing a block accepted to chain
+func (keeper *PeerScoreKeeper) ReportUsefulBlock(peerID string) {
+	keeper.update(peerID, UsefulBlockReward, func(ps *PeerScore) { ps.UsefulBlocks++ })
+}
+
+// Ban bans a peer for the given duration regardless of its score
+func (keeper *PeerScoreKeeper) Ban(peerID string, duration time.Duration) error {
+	if peerID == "" {
+		return errors.New("peer ID is empty")
+	}
+	if duration <= 0 {
+		return errors.Errorf("invalid ban duration %v", duration)
+	}
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	now := time.Now()
+	ps := keeper.get(peerID, now)
+	ps.BannedUntil = now.Add(duration).Unix()
+	ps.LastUpdate = now.Unix()
+	keeper.dirty = true
+	Logger.Infof("Peer %v is banned until %v", peerID, time.Unix(ps.BannedUntil, 0).Format(time.RFC3339))
+	return keeper.save()
+}
+
+// Unban lifts the ban of a peer and resets its score to neutral
+func (keeper *PeerScoreKeeper) Unban(peerID string) error {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	ps, ok := keeper.scores[peerID]
+	if !ok || !ps.isBanned(time.Now()) {
+		return errors.Errorf("peer %v is not banned", peerID)
+	}
+	ps.BannedUntil = 0
+	if ps.Score < 0 {
+		ps.Score = 0
+	}
+	ps.LastUpdate = time.Now().Unix()
+	keeper.dirty = true
+	Logger.Infof("Peer %v is unbanned", peerID)
+	return keeper.save()
+}
+
+func (keeper *PeerScoreKeeper) IsBanned(peerID string) bool {
+	keeper.lock.RLock()
+	defer keeper.lock.RUnlock()
+	ps, ok := keeper.scores[peerID]
+	return ok && ps.isBanned(time.Now())
+}
+
+// GetPeerScores returns scores of all known peers, highest score first
+func (keeper *PeerScoreKeeper) GetPeerScores() []PeerScore {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	now := time.Now()
+	res := []PeerScore{}
+	for peerID := range keeper.scores {
+		res = append(res, *keeper.get(peerID, now))
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Score == res[j].Score {
+			return res[i].PeerID < res[j].PeerID
+		}
+		return res[i].Score > res[j].Score
+	})
+	return res
+}
+
+// SortPeers filters out banned peers and orders the rest by score, highest first
+func (keeper *PeerScoreKeeper) SortPeers(peerIDs []string) []string {
+	keeper.lock.Lock()
+	defer keeper.lock.Unlock()
+	now := time.Now()
+	res := []string{}
+	scores := map[string]int{}
+	for _, peerID := range peerIDs {
+		if ps, ok := keeper.scores[peerID]; ok {
+			ps.decay(now)
+			if ps.isBanned(now) {
+				continue
+			}
+			scores[peerID] = ps.Score
+		}
+		res = append(res, peerID)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return scores[res[i]] > scores[res[j]]
+	})
+	return res
+}
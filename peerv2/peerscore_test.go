@@ -0,0 +1,84 @@
+package peerv2
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/incdb"
+	_ "github.com/incognitochain/incognito-chain/incdb/lvdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// Makes sure a peer keeps sending invalid messages is banned and then ignored when choosing peers
+func TestPeerScoreAutoBan(t *testing.T) {
+	keeper := NewPeerScoreKeeper()
+	for i := 0; i < -MinPeerScore/-InvalidMessagePenalty; i++ {
+		assert.False(t, keeper.IsBanned("bad"))
+		keeper.ReportInvalidMessage("bad", "garbage")
+	}
+	keeper.ReportInvalidMessage("bad", "garbage")
+	assert.True(t, keeper.IsBanned("bad"))
+
+	keeper.ReportUsefulBlock("good")
+	assert.Equal(t, []string{"good", "unknown"}, keeper.SortPeers([]string{"bad", "unknown", "good"}))
+}
+
+// Checks that peers are ordered by score, and unknown peers are neutral
+func TestPeerScoreSortPeers(t *testing.T) {
+	keeper := NewPeerScoreKeeper()
+	keeper.ReportTimeout("slow")
+	keeper.ReportUsefulBlock("good")
+	keeper.ReportUsefulBlock("good")
+	keeper.ReportUsefulBlock("ok")
+	assert.Equal(t, []string{"good", "ok", "unknown", "slow"}, keeper.SortPeers([]string{"slow", "unknown", "ok", "good"}))
+}
+
+// Reports without peer ID (block chosen by highway) must be ignored
+func TestPeerScoreIgnoreEmptyPeer(t *testing.T) {
+	keeper := NewPeerScoreKeeper()
+	keeper.ReportInvalidMessage("", "garbage")
+	assert.Equal(t, 0, len(keeper.GetPeerScores()))
+	assert.NotNil(t, keeper.Ban("", time.Minute))
+}
+
+func TestPeerScoreBanUnban(t *testing.T) {
+	keeper := NewPeerScoreKeeper()
+	assert.NotNil(t, keeper.Unban("peer"))
+	assert.Nil(t, keeper.Ban("peer", time.Minute))
+	assert.True(t, keeper.IsBanned("peer"))
+	assert.Nil(t, keeper.Unban("peer"))
+	assert.False(t, keeper.IsBanned("peer"))
+}
+
+// Makes sure old misbehaviour is forgotten over time
+func TestPeerScoreDecay(t *testing.T) {
+	now := time.Now()
+	ps := &PeerScore{PeerID: "peer", Score: -5, LastUpdate: now.Add(-3 * PeerScoreDecayTimestep).Unix()}
+	ps.decay(now)
+	assert.Equal(t, -2, ps.Score)
+	ps = &PeerScore{PeerID: "peer", Score: 2, LastUpdate: now.Add(-3 * PeerScoreDecayTimestep).Unix()}
+	ps.decay(now)
+	assert.Equal(t, 0, ps.Score)
+}
+
+// Checks that scores and bans survive a restart
+func TestPeerScorePersist(t *testing.T) {
+	dbPath, err := ioutil.TempDir(os.TempDir(), "test_peerscore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dbPath)
+	db, err := incdb.Open("leveldb", dbPath)
+	assert.Nil(t, err)
+
+	keeper := NewPeerScoreKeeper()
+	assert.Nil(t, keeper.SetDB(db))
+	keeper.ReportUsefulBlock("good")
+	assert.Nil(t, keeper.Ban("bad", time.Hour))
+	assert.Nil(t, keeper.Save())
+
+	restored := NewPeerScoreKeeper()
+	assert.Nil(t, restored.SetDB(db))
+	assert.True(t, restored.IsBanned("bad"))
+	assert.Equal(t, keeper.GetPeerScores(), restored.GetPeerScores())
+}
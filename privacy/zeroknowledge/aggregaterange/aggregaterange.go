@@ -2,6 +2,7 @@ package aggregaterange
 
 import (
 	"fmt"
+	"sort"
 	"github.com/incognitochain/incognito-chain/privacy"
 	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/aggregaterange/bulletproofs"
 	"github.com/pkg/errors"
@@ -696,3 +697,37 @@ func VerifyBatch(proofs []*AggregatedRangeProof) (bool, error, int) {
 	}
 	return bulletproofs.VerifyBatch(proofs2)
 }
+
+// VerifyBatchFindInvalid verifies proofs in one batch and finds all invalid proofs if the batch is invalid,
+// see bulletproofs.VerifyBatchFindInvalid
+func VerifyBatchFindInvalid(proofs []*AggregatedRangeProof) ([]int, error) {
+	invalid := []int{}
+	var firstErr error
+	proofs2 := make([]*bulletproofs.AggregatedRangeProof, 0, len(proofs))
+	indexes := make([]int, 0, len(proofs))
+	for i, proof := range proofs {
+		proof2 := new(bulletproofs.AggregatedRangeProof)
+		err := proof2.SetBytes(proof.Bytes())
+		if err != nil {
+			invalid = append(invalid, i)
+			if firstErr == nil {
+				firstErr = errors.New(fmt.Sprintf("cannot convert proof from v1 to v2. Error %v", err))
+			}
+			continue
+		}
+		proofs2 = append(proofs2, proof2)
+		indexes = append(indexes, i)
+	}
+	invalid2, err := bulletproofs.VerifyBatchFindInvalid(proofs2)
+	if len(invalid2) == 0 {
+		return invalid, firstErr
+	}
+	if firstErr == nil || indexes[invalid2[0]] < invalid[0] {
+		firstErr = err
+	}
+	for _, i := range invalid2 {
+		invalid = append(invalid, indexes[i])
+	}
+	sort.Ints(invalid)
+	return invalid, firstErr
+}
@@ -783,6 +783,47 @@ func VerifyBatch(proofs []*AggregatedRangeProof) (bool, error, int) {
 	return true, nil, -1
 }
 
+// VerifyBatchFindInvalid verifies proofs in one batch, if the batch is invalid it is split in halves
+// which are verified again until all invalid proofs are found.
+// Return indexes of invalid proofs in ascending order and the error of the first one
+func VerifyBatchFindInvalid(proofs []*AggregatedRangeProof) ([]int, error) {
+	invalid := []int{}
+	var firstErr error
+	markInvalid := func(index int, err error) {
+		invalid = append(invalid, index)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	var bisect func(from, to int)
+	bisect = func(from, to int) {
+		if from >= to {
+			return
+		}
+		valid, err, k := VerifyBatch(proofs[from:to])
+		if valid {
+			return
+		}
+		if k >= 0 {
+			// proof k is malformed, proofs around it still need to be verified
+			bisect(from, from+k)
+			markInvalid(from+k, err)
+			bisect(from+k+1, to)
+			return
+		}
+		if to-from == 1 {
+			markInvalid(from, err)
+			return
+		}
+		mid := (from + to) / 2
+		bisect(from, mid)
+		bisect(mid, to)
+	}
+	bisect(0, len(proofs))
+	return invalid, firstErr
+}
+
 // estimateMultiRangeProofSize estimate multi range proof size
 func EstimateMultiRangeProofSize(nOutput int) uint64 {
 	return uint64((nOutput+2*int(math.Log2(float64(privacy_util.MaxExp*roundUpPowTwo(nOutput))))+5)*privacy.Ed25519KeySize + 5*privacy.Ed25519KeySize + 2)
@@ -16,6 +16,7 @@ import (
 var _ = func() (_ struct{}) {
 	fmt.Println("This runs before init()!")
 	Logger.Init(common.NewBackend(nil).Logger("test", true))
+	privacy.Logger.Init(common.NewBackend(nil).Logger("test", true))
 	return
 }()
 
@@ -490,3 +491,68 @@ func BenchmarkAggregatedRangeProof_Verify16(b *testing.B)  { benchmarkAggRangePr
 func BenchmarkAggregatedRangeProof_VerifyFaster16(b *testing.B) {
 	benchmarkAggRangeProof_VerifyFaster(16, b)
 }
+
+func newRangeProofs(count int, numValue int, t testing.TB) []*AggregatedRangeProof {
+	proofs := make([]*AggregatedRangeProof, count)
+	for i := range proofs {
+		wit := new(AggregatedRangeWitness)
+		values := make([]uint64, numValue)
+		rands := make([]*privacy.Scalar, numValue)
+		for j := range values {
+			values[j] = uint64(rand.Uint64())
+			rands[j] = privacy.RandomScalar()
+		}
+		wit.Set(values, rands)
+		proof, err := wit.Prove()
+		if err != nil {
+			t.Fatal(err)
+		}
+		proofs[i] = proof
+	}
+	return proofs
+}
+
+func TestVerifyBatchFindInvalid(t *testing.T) {
+	proofs := newRangeProofs(20, 2, t)
+	invalid, err := VerifyBatchFindInvalid(proofs)
+	assert.Equal(t, 0, len(invalid))
+	assert.Equal(t, nil, err)
+
+	for _, i := range []int{3, 4, 17} {
+		proofs[i].tHat = privacy.RandomScalar()
+	}
+	invalid, err = VerifyBatchFindInvalid(proofs)
+	assert.Equal(t, []int{3, 4, 17}, invalid)
+	assert.NotEqual(t, nil, err)
+
+	// malformed proof with too many outputs
+	proofs[10].cmsValue = make([]*privacy.Point, privacy_util.MaxOutputCoin+1)
+	invalid, err = VerifyBatchFindInvalid(proofs)
+	assert.Equal(t, []int{3, 4, 10, 17}, invalid)
+	assert.NotEqual(t, nil, err)
+
+	invalid, err = VerifyBatchFindInvalid([]*AggregatedRangeProof{})
+	assert.Equal(t, 0, len(invalid))
+	assert.Equal(t, nil, err)
+}
+
+func benchmarkVerifyRangeProofs(count int, batch bool, b *testing.B) {
+	proofs := newRangeProofs(count, 2, b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if batch {
+			if invalid, err := VerifyBatchFindInvalid(proofs); len(invalid) != 0 {
+				b.Fatal(err)
+			}
+			continue
+		}
+		for _, proof := range proofs {
+			if valid, err := proof.VerifyFaster(); !valid {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyRangeProofs100OneByOne(b *testing.B) { benchmarkVerifyRangeProofs(100, false, b) }
+func BenchmarkVerifyRangeProofs100Batch(b *testing.B)    { benchmarkVerifyRangeProofs(100, true, b) }
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// VerifyInParallel runs verify for indexes 0..n-1 on a pool of workers goroutines (number of CPUs if workers <= 0).
+// newVerifier is called once per worker, so that each worker can set up its own state (e.g. a copy of state db).
+// Once an index fails, higher indexes are skipped.
+// Return the lowest failing index and its error, or -1 if all are valid.
+func VerifyInParallel(n int, workers int, newVerifier func() func(i int) error) (int, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+	errs := make([]error, n)
+	failedIndex := int64(n)
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			verify := newVerifier()
+			for i := range jobs {
+				if int64(i) > atomic.LoadInt64(&failedIndex) {
+					continue
+				}
+				if err := verify(i); err != nil {
+					errs[i] = err
+					for {
+						failed := atomic.LoadInt64(&failedIndex)
+						if int64(i) >= failed || atomic.CompareAndSwapInt64(&failedIndex, failed, int64(i)) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if failedIndex < int64(n) {
+		return int(failedIndex), errs[failedIndex]
+	}
+	return -1, nil
+}
@@ -0,0 +1,154 @@
+package utils_test
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/aggregaterange"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/oneoutofmany"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/serialnumberprivacy"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = func() (_ struct{}) {
+	privacy.Logger.Init(common.NewBackend(nil).Logger("test", true))
+	return
+}()
+
+// txProofs are the proofs verified for a privacy transaction with 1 input and 2 outputs
+type txProofs struct {
+	oneOfMany    *oneoutofmany.OneOutOfManyProof
+	serialNumber *serialnumberprivacy.SNPrivacyProof
+	rangeProof   *aggregaterange.AggregatedRangeProof
+}
+
+func (p *txProofs) verify() error {
+	if valid, err := p.oneOfMany.Verify(); !valid {
+		return fmt.Errorf("one out of many: %v", err)
+	}
+	if valid, err := p.serialNumber.Verify(nil); !valid {
+		return fmt.Errorf("serial number: %v", err)
+	}
+	if valid, err := p.rangeProof.Verify(); !valid {
+		return fmt.Errorf("range proof: %v", err)
+	}
+	return nil
+}
+
+func newTxProofs(b testing.TB) *txProofs {
+	// one out of many
+	commitments := make([]*privacy.Point, privacy.CommitmentRingSize)
+	randoms := make([]*privacy.Scalar, privacy.CommitmentRingSize)
+	for i := 0; i < privacy.CommitmentRingSize; i++ {
+		randoms[i] = privacy.RandomScalar()
+		commitments[i] = privacy.PedCom.CommitAtIndex(privacy.RandomScalar(), randoms[i], privacy.PedersenSndIndex)
+	}
+	commitments[0] = privacy.PedCom.CommitAtIndex(new(privacy.Scalar).FromUint64(0), randoms[0], privacy.PedersenSndIndex)
+	oneOfManyWitness := new(oneoutofmany.OneOutOfManyWitness)
+	oneOfManyWitness.Set(commitments, randoms[0], 0)
+	oneOfMany, err := oneOfManyWitness.Prove()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// serial number
+	sk := new(privacy.Scalar).FromBytesS(privacy.GeneratePrivateKey(privacy.RandBytes(31)))
+	snd, rSK, rSND := privacy.RandomScalar(), privacy.RandomScalar(), privacy.RandomScalar()
+	stmt := new(serialnumberprivacy.SerialNumberPrivacyStatement)
+	stmt.Set(
+		new(privacy.Point).Derive(privacy.PedCom.G[privacy.PedersenPrivateKeyIndex], sk, snd),
+		privacy.PedCom.CommitAtIndex(sk, rSK, privacy.PedersenPrivateKeyIndex),
+		privacy.PedCom.CommitAtIndex(snd, rSND, privacy.PedersenSndIndex),
+	)
+	snWitness := new(serialnumberprivacy.SNPrivacyWitness)
+	snWitness.Set(stmt, sk, rSK, snd, rSND)
+	serialNumber, err := snWitness.Prove(nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// range proof of 2 outputs
+	rangeWitness := new(aggregaterange.AggregatedRangeWitness)
+	rangeWitness.Set([]uint64{uint64(common.RandInt64()), uint64(common.RandInt64())}, []*privacy.Scalar{privacy.RandomScalar(), privacy.RandomScalar()})
+	rangeProof, err := rangeWitness.Prove()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return &txProofs{oneOfMany: oneOfMany, serialNumber: serialNumber, rangeProof: rangeProof}
+}
+
+// newBlockProofs builds proofs of a block of numTx transactions, distinct proofs are reused to keep set up fast
+func newBlockProofs(b testing.TB, numTx int) []*txProofs {
+	distinct := make([]*txProofs, 8)
+	for i := range distinct {
+		distinct[i] = newTxProofs(b)
+	}
+	block := make([]*txProofs, numTx)
+	for i := range block {
+		block[i] = distinct[i%len(distinct)]
+	}
+	return block
+}
+
+func TestVerifyInParallelPinpointFirstInvalid(t *testing.T) {
+	invalid := map[int]bool{37: true, 80: true, 99: true}
+	for _, workers := range []int{1, 4, 0} {
+		index, err := utils.VerifyInParallel(100, workers, func() func(i int) error {
+			return func(i int) error {
+				if invalid[i] {
+					return errors.New("invalid")
+				}
+				return nil
+			}
+		})
+		assert.Equal(t, 37, index)
+		assert.NotNil(t, err)
+	}
+
+	index, err := utils.VerifyInParallel(100, 4, func() func(i int) error {
+		return func(i int) error { return nil }
+	})
+	assert.Equal(t, -1, index)
+	assert.Nil(t, err)
+
+	index, err = utils.VerifyInParallel(0, 4, func() func(i int) error {
+		return func(i int) error { return nil }
+	})
+	assert.Equal(t, -1, index)
+	assert.Nil(t, err)
+}
+
+func TestVerifyInParallelProofs(t *testing.T) {
+	block := newBlockProofs(t, 20)
+	wrongProofs := newTxProofs(t)
+	wrongProofs.oneOfMany.Statement.Commitments = block[1].oneOfMany.Statement.Commitments // proof for other commitments
+	block[13] = wrongProofs
+	index, err := utils.VerifyInParallel(len(block), 4, func() func(i int) error {
+		return func(i int) error { return block[i].verify() }
+	})
+	assert.Equal(t, 13, index)
+	assert.NotNil(t, err)
+}
+
+func benchmarkVerifyBlock(b *testing.B, numTx int, workers int) {
+	block := newBlockProofs(b, numTx)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		index, err := utils.VerifyInParallel(len(block), workers, func() func(i int) error {
+			return func(i int) error { return block[i].verify() }
+		})
+		if index != -1 {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyBlock1000Serial(b *testing.B)   { benchmarkVerifyBlock(b, 1000, 1) }
+func BenchmarkVerifyBlock1000Parallel(b *testing.B) { benchmarkVerifyBlock(b, 1000, runtime.NumCPU()) }
+func BenchmarkVerifyBlock2000Serial(b *testing.B)   { benchmarkVerifyBlock(b, 2000, 1) }
+func BenchmarkVerifyBlock2000Parallel(b *testing.B) { benchmarkVerifyBlock(b, 2000, runtime.NumCPU()) }
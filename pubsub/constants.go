@@ -16,6 +16,7 @@ const (
 	RequestShardBlockByHeightTopic  = "requestshardblockbyheighttopic"
 	RequestBeaconBlockByHeightTopic = "requestbeaconblockbyheighttopic"
 	RequestBeaconBlockByHashTopic   = "requestbeaconblockbyhashtopic"
+	NoteScannedTopic                = "notescannedtopic"
 	TestTopic                       = "testtopic"
 )
 
@@ -33,4 +34,5 @@ var Topics = []string{
 	RequestShardBlockByHeightTopic,
 	RequestShardBlockByHashTopic,
 	ShardBeststateTopic,
+	NoteScannedTopic,
 }
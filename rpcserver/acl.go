@@ -0,0 +1,262 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/common"
+)
+
+// Access control of rpc methods:
+//	- a client authenticates by an API key in the X-API-Key header or by HTTP Basic auth (rpcuser, rpclimituser)
+//	- API keys are loaded from the json file of --rpcaclfile, which is reloaded when it is modified
+//	- a key allows the methods of its Allow list (all methods if empty) except the methods of its Deny list,
+//	a list has method names, "*" (all methods) and "@group" (methods of aclMethodGroups or of a feature, eg. "@portal")
+//	- a key is limited per key to its RequestPerDay, or to the DefaultRequestPerDay of the file if it has none
+//	(0 is unlimited), clients without API key are limited by remote address (rpclimitrequestperday)
+//	- calls of denied methods are logged by the audit logger
+//
+// Eg. {"DefaultRequestPerDay": 1000, "Keys": [{"Name": "explorer", "Key": "...", "Allow": ["@portal", "getbalancebypaymentaddress"], "Deny": ["@admin"], "RequestPerDay": 10000}]}
+
+const (
+	apiKeyHeader      = "X-API-Key"
+	aclAllMethods     = "*"
+	aclGroupPrefix    = "@"
+	aclReloadInterval = 10 * time.Second
+)
+
+// groups of methods which are not registered by a feature
+var aclMethodGroups = map[string][]string{
+	"wallet": {
+		listAccounts, getAccount, getAddressesByAccount, getAccountAddress, dumpPrivkey, importAccount, removeAccount,
+		listUnspentOutputCoins, getBalance, getBalanceByPrivatekey, getBalanceByPaymentAddress, getReceivedByAccount,
+		setTxFee, convertNativeTokenToPrivacyToken, convertPrivacyTokenToNativeToken,
+		registerScanKey, unregisterScanKey, getScannedBalance, listScannedOutputCoins, getScannedHistory,
+		getScannedTransactionHistory,
+	},
+	"mining": {
+		getMiningInfo, enableMining, getChainMiningStatus, getPublickeyMining, getPublicKeyRole, getRoleByValidatorKey,
+		getIncognitoPublicKeyRole, getMinerRewardFromMiningKey,
+	},
+	"admin": {
+		startProfiling, stopProfiling, exportMetrics, banPeer, unbanPeer, removeTxInMempool, unlockMempool,
+		getAndSendTxsFromFile, getAndSendTxsFromFileV2, setBackup, downloadBackup,
+	},
+}
+
+// methods the rpcuser is not allowed to call, only the rpclimituser is
+var legacyLimitedMethods = []string{aclGroupPrefix + "wallet", banPeer, unbanPeer}
+
+// RPCAPIKeyConfig is an API key in the rpc acl file
+type RPCAPIKeyConfig struct {
+	Name          string
+	Key           string
+	Allow         []string
+	Deny          []string
+	RequestPerDay int // 0 is the DefaultRequestPerDay of the file
+}
+
+type rpcACLConfig struct {
+	DefaultRequestPerDay int // limit of keys without RequestPerDay, 0 is unlimited
+	Keys                 []RPCAPIKeyConfig
+}
+
+// rpcAccess is the methods an authenticated client is allowed to call
+type rpcAccess struct {
+	name           string
+	allow          map[string]bool // nil allows all methods
+	deny           map[string]bool
+	requestPerDay  int  // limit of an api key, 0 is unlimited
+	limitByAddress bool // clients without api key are limited by remote address instead
+}
+
+// access of all clients if auth is disabled
+var noAuthRPCAccess = &rpcAccess{name: "norpcauth", limitByAddress: true}
+
+func (access *rpcAccess) isAllowed(method string) bool {
+	if access.deny[method] {
+		return false
+	}
+	return access.allow == nil || access.allow[method]
+}
+
+func isKnownMethod(method string) bool {
+	_, ok := HttpHandler[method]
+	return ok || method == downloadBackup
+}
+
+// expandACLMethods returns the methods of a list of methods, groups and "*", nil for "*"
+func expandACLMethods(entries []string) (map[string]bool, error) {
+	methods := make(map[string]bool)
+	for _, entry := range entries {
+		switch {
+		case entry == aclAllMethods:
+			return nil, nil
+		case strings.HasPrefix(entry, aclGroupPrefix):
+			group := strings.TrimPrefix(entry, aclGroupPrefix)
+			found := false
+			for _, method := range aclMethodGroups[group] {
+				methods[method] = true
+				found = true
+			}
+			for method, feature := range httpHandlerFeatures {
+				if feature == group {
+					methods[method] = true
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("rpc method group %+v is unknown", group)
+			}
+		default:
+			if !isKnownMethod(entry) {
+				return nil, fmt.Errorf("rpc method %+v is unknown", entry)
+			}
+			methods[entry] = true
+		}
+	}
+	return methods, nil
+}
+
+func newRPCAccess(name string, allow []string, deny []string, requestPerDay int) (*rpcAccess, error) {
+	access := &rpcAccess{
+		name:          name,
+		requestPerDay: requestPerDay,
+	}
+	var err error
+	if len(allow) > 0 {
+		access.allow, err = expandACLMethods(allow)
+		if err != nil {
+			return nil, err
+		}
+	}
+	access.deny, err = expandACLMethods(deny)
+	if err != nil {
+		return nil, err
+	}
+	if access.deny == nil {
+		return nil, errors.New("deny of all methods is not allowed, remove the key instead")
+	}
+	return access, nil
+}
+
+// rpcACL keeps the API keys of the acl file
+type rpcACL struct {
+	file    string
+	lock    sync.RWMutex
+	keys    map[common.Hash]*rpcAccess // by hash of the key
+	modTime time.Time
+	cQuit   chan struct{}
+}
+
+func newRPCACL(file string) *rpcACL {
+	return &rpcACL{
+		file:  file,
+		keys:  make(map[common.Hash]*rpcAccess),
+		cQuit: make(chan struct{}),
+	}
+}
+
+// load reads the acl file, the keys are unchanged if it is invalid
+func (acl *rpcACL) load() error {
+	info, err := os.Stat(acl.file)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(acl.file)
+	if err != nil {
+		return err
+	}
+	config := rpcACLConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return err
+	}
+	if config.DefaultRequestPerDay < 0 {
+		return errors.New("rpc api key default request per day must not be negative")
+	}
+	keys := make(map[common.Hash]*rpcAccess)
+	names := make(map[string]bool)
+	for _, keyConfig := range config.Keys {
+		if keyConfig.Name == "" || keyConfig.Key == "" {
+			return errors.New("rpc api key must have a name and a key")
+		}
+		if names[keyConfig.Name] {
+			return fmt.Errorf("rpc api key name %+v is duplicated", keyConfig.Name)
+		}
+		names[keyConfig.Name] = true
+		keyHash := common.HashH([]byte(keyConfig.Key))
+		if _, ok := keys[keyHash]; ok {
+			return fmt.Errorf("rpc api key of %+v is duplicated", keyConfig.Name)
+		}
+		requestPerDay := keyConfig.RequestPerDay
+		if requestPerDay < 0 {
+			return fmt.Errorf("rpc api key %+v: request per day must not be negative", keyConfig.Name)
+		}
+		if requestPerDay == 0 {
+			requestPerDay = config.DefaultRequestPerDay
+		}
+		access, err := newRPCAccess(keyConfig.Name, keyConfig.Allow, keyConfig.Deny, requestPerDay)
+		if err != nil {
+			return fmt.Errorf("rpc api key %+v: %+v", keyConfig.Name, err)
+		}
+		keys[keyHash] = access
+	}
+	acl.lock.Lock()
+	acl.keys = keys
+	acl.modTime = info.ModTime()
+	acl.lock.Unlock()
+	Logger.log.Infof("Loaded %d rpc api keys from %s", len(keys), acl.file)
+	return nil
+}
+
+func (acl *rpcACL) reloadIfModified() {
+	info, err := os.Stat(acl.file)
+	if err != nil {
+		Logger.log.Errorf("Can not check rpc acl file %s err:%+v", acl.file, err)
+		return
+	}
+	acl.lock.RLock()
+	modified := !info.ModTime().Equal(acl.modTime)
+	acl.lock.RUnlock()
+	if !modified {
+		return
+	}
+	if err := acl.load(); err != nil {
+		Logger.log.Errorf("Can not reload rpc acl file %s, keep the former keys err:%+v", acl.file, err)
+		// do not retry until the file is modified again
+		acl.lock.Lock()
+		acl.modTime = info.ModTime()
+		acl.lock.Unlock()
+	}
+}
+
+// watch reloads the acl file when it is modified until stop
+func (acl *rpcACL) watch() {
+	ticker := time.NewTicker(aclReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-acl.cQuit:
+			return
+		case <-ticker.C:
+			acl.reloadIfModified()
+		}
+	}
+}
+
+func (acl *rpcACL) stop() {
+	close(acl.cQuit)
+}
+
+// getAccess returns the access of an API key, nil if the key is unknown
+func (acl *rpcACL) getAccess(key string) *rpcAccess {
+	acl.lock.RLock()
+	defer acl.lock.RUnlock()
+	return acl.keys[common.HashH([]byte(key))]
+}
@@ -0,0 +1,111 @@
+package rpcserver
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/memcache"
+)
+
+func TestRPCAccessIsAllowed(t *testing.T) {
+	access, err := newRPCAccess("test", nil, legacyLimitedMethods, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if access.isAllowed(listAccounts) || access.isAllowed(banPeer) || access.isAllowed(registerScanKey) {
+		t.Fatal("Expect wallet, note scanner and peer reputation methods are denied")
+	}
+	if !access.isAllowed(getBlockChainInfo) {
+		t.Fatal("Expect other methods are allowed")
+	}
+	access, err = newRPCAccess("test", []string{aclGroupPrefix + "wallet"}, []string{dumpPrivkey}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !access.isAllowed(listAccounts) || access.isAllowed(dumpPrivkey) || access.isAllowed(getBlockChainInfo) {
+		t.Fatal("Expect only wallet methods except dumpprivkey are allowed")
+	}
+	if _, err := newRPCAccess("test", []string{"unknownmethod"}, nil, 0); err == nil {
+		t.Fatal("Expect error of unknown method")
+	}
+	if _, err := newRPCAccess("test", []string{aclGroupPrefix + "unknowngroup"}, nil, 0); err == nil {
+		t.Fatal("Expect error of unknown group")
+	}
+	if _, err := newRPCAccess("test", nil, []string{aclAllMethods}, 0); err == nil {
+		t.Fatal("Expect error of deny of all methods")
+	}
+}
+
+func TestRPCACLLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpcacl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "acl.json")
+	valid := `{"DefaultRequestPerDay": 5, "Keys": [{"Name": "explorer", "Key": "key1", "Deny": ["@admin"], "RequestPerDay": 10}, {"Name": "wallet", "Key": "key3"}]}`
+	if err := ioutil.WriteFile(file, []byte(valid), 0600); err != nil {
+		t.Fatal(err)
+	}
+	acl := newRPCACL(file)
+	if err := acl.load(); err != nil {
+		t.Fatal(err)
+	}
+	access := acl.getAccess("key1")
+	if access == nil || access.name != "explorer" || access.requestPerDay != 10 {
+		t.Fatalf("Expect access of explorer but get %+v", access)
+	}
+	if access.isAllowed(setBackup) || !access.isAllowed(getBlockChainInfo) {
+		t.Fatal("Expect admin methods are denied")
+	}
+	if access := acl.getAccess("key3"); access == nil || access.requestPerDay != 5 {
+		t.Fatalf("Expect default request per day of key without limit but get %+v", access)
+	}
+	if acl.getAccess("key2") != nil {
+		t.Fatal("Expect no access of unknown key")
+	}
+	// an invalid file keeps the former keys
+	duplicated := `{"Keys": [{"Name": "a", "Key": "key2"}, {"Name": "b", "Key": "key2"}]}`
+	if err := ioutil.WriteFile(file, []byte(duplicated), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := acl.load(); err == nil {
+		t.Fatal("Expect error of duplicated key")
+	}
+	if acl.getAccess("key1") == nil {
+		t.Fatal("Expect former keys are kept")
+	}
+}
+
+func TestRPCAccessLimitRequestPerDay(t *testing.T) {
+	server := &HttpServer{config: RpcServerConfig{MemCache: memcache.New(), RPCLimitRequestPerDay: 1}}
+	newRequest := func(remoteAddr string) *http.Request {
+		r := httptest.NewRequest("POST", "/", nil)
+		r.RemoteAddr = remoteAddr
+		return r
+	}
+	reachLimit := func(access *rpcAccess, remoteAddr string) bool {
+		reach, _ := server.reachLimitRequestPerDay(newRequest(remoteAddr), access)
+		return reach
+	}
+	// clients without api key are limited by remote address
+	if reachLimit(noAuthRPCAccess, "1.1.1.1:1") || !reachLimit(noAuthRPCAccess, "1.1.1.1:2") {
+		t.Fatal("Expect second request of a remote address reaches the limit")
+	}
+	// api keys are limited per key, from any remote address
+	limited := &rpcAccess{name: "limited", requestPerDay: 2}
+	if reachLimit(limited, "2.2.2.2:1") || reachLimit(limited, "2.2.2.2:1") || !reachLimit(limited, "3.3.3.3:1") {
+		t.Fatal("Expect third request of a key reaches the limit")
+	}
+	// an api key without limit is not limited by remote address
+	unlimited := &rpcAccess{name: "unlimited"}
+	for i := 0; i < 3; i++ {
+		if reachLimit(unlimited, "4.4.4.4:1") {
+			t.Fatal("Expect no limit of a key without limit")
+		}
+	}
+}
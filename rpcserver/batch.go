@@ -0,0 +1,138 @@
+package rpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+// JSON-RPC 2.0 batch: the body is an array of requests and the response is the array of
+// their responses, in the same order and without the responses of notifications (no id).
+// The requests are processed concurrently by the RPCBatchWorkers workers of the server, shared by
+// the batches of all clients, each request is counted by the limit request per day and is allowed
+// by the access of the client. Requests which are not processed when the batch is canceled get an error.
+
+// isBatchRequest returns true if the body is a json array
+func isBatchRequest(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+func (httpServer *HttpServer) processBatchRequest(w http.ResponseWriter, r *http.Request, access *rpcAccess, body []byte, closeChan <-chan struct{}, buf io.Writer) {
+	var rawRequests []json.RawMessage
+	var jsonErr error
+	if err := json.Unmarshal(body, &rawRequests); err != nil {
+		jsonErr = rpcservice.NewRPCError(rpcservice.RPCParseError, err)
+	} else if len(rawRequests) == 0 {
+		jsonErr = rpcservice.NewRPCError(rpcservice.RPCInvalidRequestError, errors.New("batch is empty"))
+	} else if maxBatchSize := httpServer.config.RPCMaxBatchSize; maxBatchSize > 0 && len(rawRequests) > maxBatchSize {
+		jsonErr = rpcservice.NewRPCError(rpcservice.RPCInvalidRequestError, fmt.Errorf("batch of %d requests is larger than %d", len(rawRequests), maxBatchSize))
+	}
+	if jsonErr != nil {
+		// the batch itself is invalid, it is answered by a single response
+		Logger.log.Errorf("RPC batch process with err \n %+v", jsonErr)
+		msg, err := createMarshalledResponse(&JsonRequest{}, nil, jsonErr)
+		if err != nil {
+			Logger.log.Errorf("Failed to marshal reply: %s", err.Error())
+			return
+		}
+		httpServer.writeRpcResponse(w, r, buf, msg)
+		return
+	}
+
+	responses := make([][]byte, len(rawRequests))
+	var wg sync.WaitGroup
+	for index := range rawRequests {
+		select {
+		case httpServer.batchWorkers <- struct{}{}:
+		case <-closeChan:
+			responses[index] = httpServer.processBatchElement(r, access, rawRequests[index], index, closeChan)
+			continue
+		}
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-httpServer.batchWorkers
+				wg.Done()
+			}()
+			responses[index] = httpServer.processBatchElement(r, access, rawRequests[index], index, closeChan)
+		}(index)
+	}
+	wg.Wait()
+
+	msg := make([]byte, 0)
+	for _, response := range responses {
+		if response == nil {
+			continue
+		}
+		if len(msg) == 0 {
+			msg = append(msg, '[')
+		} else {
+			msg = append(msg, ',')
+		}
+		msg = append(msg, response...)
+	}
+	if len(msg) == 0 {
+		// a batch of notifications has no response
+		httpServer.writeResponseHeaders(w, r, http.StatusNoContent, buf)
+		return
+	}
+	msg = append(msg, ']')
+	httpServer.writeRpcResponse(w, r, buf, msg)
+}
+
+func isClosed(closeChan <-chan struct{}) bool {
+	select {
+	case <-closeChan:
+		return true
+	default:
+		return false
+	}
+}
+
+// processBatchElement returns the marshalled response of a request of a batch, nil for a notification
+func (httpServer *HttpServer) processBatchElement(r *http.Request, access *rpcAccess, rawRequest []byte, index int, closeChan <-chan struct{}) []byte {
+	var result interface{}
+	request, jsonErr := parseJsonRequest(rawRequest, r.Method)
+	if jsonErr == nil {
+		if request.Id == nil && !(httpServer.config.RPCQuirks && request.Jsonrpc == "") {
+			return nil
+		}
+		// the first request is counted with the http request
+		reachLimit, errMsg := false, ""
+		canceled := isClosed(closeChan)
+		if index > 0 && !canceled {
+			reachLimit, errMsg = httpServer.reachLimitRequestPerDay(r, access)
+		}
+		if canceled {
+			jsonErr = rpcservice.NewRPCError(rpcservice.RPCInternalError, errors.New("batch request is canceled"))
+		} else if reachLimit {
+			jsonErr = rpcservice.NewRPCError(rpcservice.RPCInvalidRequestError, errors.New(errMsg))
+		} else if httpServer.checkBlackListClientRequestErrorPerHour(r, request.Method) {
+			jsonErr = rpcservice.NewRPCError(rpcservice.RPCInvalidRequestError, errors.New("Reach limit request error for method "+request.Method))
+		} else {
+			result, jsonErr = httpServer.processJsonRequest(r, access, request, closeChan)
+		}
+	}
+
+	if rpcErr, ok := jsonErr.(*rpcservice.RPCError); ok && rpcErr != nil {
+		if request.Method != getTransactionByHash {
+			Logger.log.Errorf("RPC function process with err \n %+v", jsonErr)
+		}
+		httpServer.addBlackListClientRequestErrorPerHour(r, request.Method)
+	}
+
+	msg, err := createMarshalledResponse(request, result, jsonErr)
+	if err != nil {
+		Logger.log.Errorf("Failed to marshal reply: %s", err.Error())
+		Logger.log.Error(err)
+		return nil
+	}
+	return msg
+}
@@ -0,0 +1,166 @@
+package rpcserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/memcache"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestIsBatchRequest(t *testing.T) {
+	if !isBatchRequest([]byte(" \n[" + testRpcServerString + "]")) {
+		t.Fatal("Expect array is a batch request")
+	}
+	if isBatchRequest([]byte(testRpcServerString)) || isBatchRequest([]byte{}) {
+		t.Fatal("Expect object or empty body is not a batch request")
+	}
+}
+
+func TestAcceptGzip(t *testing.T) {
+	r := &http.Request{Header: make(http.Header)}
+	if acceptGzip(r) {
+		t.Fatal("Expect no gzip without Accept-Encoding")
+	}
+	r.Header.Set("Accept-Encoding", "deflate, gzip;q=0.8")
+	if !acceptGzip(r) {
+		t.Fatal("Expect gzip is accepted")
+	}
+}
+
+func newTestBatchHttpServer() *HttpServer {
+	httpServer := &HttpServer{}
+	httpServer.Init(&RpcServerConfig{
+		MemCache:        memcache.New(),
+		DisableAuth:     true,
+		RPCMaxClients:   10,
+		RPCBatchWorkers: 2,
+	})
+	return httpServer
+}
+
+func TestHttpServerBatchGzip(t *testing.T) {
+	httpServer := newTestBatchHttpServer()
+	// requests of a batch are answered in order, except notifications, the response is large enough to be compressed
+	requests := []JsonRequest{}
+	for i := 0; i < 20; i++ {
+		requests = append(requests, JsonRequest{Jsonrpc: "2.0", Method: generateTokenID, Params: []interface{}{"network", fmt.Sprintf("token%d", i)}, Id: i})
+	}
+	requests = append(requests, JsonRequest{Jsonrpc: "2.0", Method: generateTokenID, Params: []interface{}{"network", "notification"}})
+	requests = append(requests, JsonRequest{Jsonrpc: "2.0", Method: "unknownmethod", Id: 20})
+	body, err := json.Marshal(requests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h2cClient := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}}
+	for _, tc := range []struct {
+		name   string
+		client *http.Client
+		proto  int
+	}{
+		{"HTTP/1", http.DefaultClient, 1},
+		{"HTTP/2", h2cClient, 2},
+	} {
+		server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(httpServer.handleRequest), &http2.Server{}))
+		req, err := http.NewRequest("POST", server.URL, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Accept-Encoding", "gzip")
+		resp, err := tc.client.Do(req)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.name, err)
+		}
+		if resp.ProtoMajor != tc.proto || resp.Header.Get("Content-Encoding") != "gzip" {
+			t.Fatalf("%v: expect gzip response of HTTP/%d but get %v encoded by %q", tc.name, tc.proto, resp.Proto, resp.Header.Get("Content-Encoding"))
+		}
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.name, err)
+		}
+		data, err := ioutil.ReadAll(gzipReader)
+		resp.Body.Close()
+		server.Close()
+		if err != nil {
+			t.Fatalf("%v: %v", tc.name, err)
+		}
+		responses := []JsonResponse{}
+		if err := json.Unmarshal(data, &responses); err != nil {
+			t.Fatalf("%v: %v", tc.name, err)
+		}
+		if len(responses) != 21 {
+			t.Fatalf("%v: expect 21 responses but get %d", tc.name, len(responses))
+		}
+		for i, response := range responses {
+			if response.Id == nil || fmt.Sprint(*response.Id) != fmt.Sprint(i) {
+				t.Fatalf("%v: expect response %d in order", tc.name, i)
+			}
+			if (response.Error != nil) != (i == 20) {
+				t.Fatalf("%v: unexpected error of response %d: %+v", tc.name, i, response.Error)
+			}
+		}
+	}
+}
+
+func TestProcessBatchElementCanceled(t *testing.T) {
+	httpServer := newTestBatchHttpServer()
+	closeChan := make(chan struct{})
+	close(closeChan)
+	r := httptest.NewRequest("POST", "/", nil)
+	msg := httpServer.processBatchElement(r, noAuthRPCAccess, []byte(`{"Jsonrpc": "2.0", "Method": "generatetokenid", "Params": ["network", "token"], "Id": 1}`), 1, closeChan)
+	response := JsonResponse{}
+	if err := json.Unmarshal(msg, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Error == nil {
+		t.Fatalf("Expect an error of a canceled request but get %s", msg)
+	}
+}
+
+func TestProcessJsonRequestTimeout(t *testing.T) {
+	httpServer := newTestBatchHttpServer()
+	release := make(chan struct{})
+	defer close(release)
+	// the handler ignores closeChan, as most handlers do
+	HttpHandler["testblockinghandler"] = func(*HttpServer, interface{}, <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+		<-release
+		return nil, nil
+	}
+	defer delete(HttpHandler, "testblockinghandler")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	r := httptest.NewRequest("POST", "/", nil).WithContext(ctx)
+	request := &JsonRequest{Jsonrpc: "2.0", Method: "testblockinghandler", Id: 1}
+	result := make(chan error, 1)
+	go func() {
+		_, err := httpServer.processJsonRequest(r, noAuthRPCAccess, request, nil)
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		if rpcErr, ok := err.(*rpcservice.RPCError); !ok || rpcErr == nil {
+			t.Fatalf("Expect an error of a timed out request, have %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect a request is cut off at its timeout")
+	}
+}
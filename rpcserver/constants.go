@@ -15,6 +15,9 @@ const (
 	getNodeRole          = "getnoderole"
 	getInOutMessages     = "getinoutmessages"
 	getInOutMessageCount = "getinoutmessagecount"
+	getPeerScores        = "getpeerscores"
+	banPeer              = "banpeer"
+	unbanPeer            = "unbanpeer"
 
 	estimateFee              = "estimatefee"
 	estimateFeeV2            = "estimatefeev2"
@@ -40,6 +43,7 @@ const (
 	getBeaconPoolStateV2        = "getbeaconpoolstatev2"
 	//getFeeEstimator             = "getfeeestimator"
 	setBackup                   = "setbackup"
+	downloadBackup              = "downloadbackup"
 	getLatestBackup             = "getlatestbackup"
 	getBestBlock                = "getbestblock"
 	getBestBlockHash            = "getbestblockhash"
@@ -77,6 +81,8 @@ const (
 	gettransactionhashbyreceiverv2               = "gettransactionhashbyreceiverv2"
 	gettransactionbyreceiver                     = "gettransactionbyreceiver"
 	gettransactionbyreceiverv2                   = "gettransactionbyreceiverv2"
+	getTransactionHistory                        = "gettransactionhistory"
+	getTransactionReceipt                        = "gettransactionreceipt"
 	listCustomToken                              = "listcustomtoken"
 	listPrivacyCustomToken                       = "listprivacycustomtoken"
 	getPrivacyCustomToken                        = "getprivacycustomtoken"
@@ -118,6 +124,9 @@ const (
 	getBeaconBestState       = "getbeaconbeststate"
 	getBeaconBestStateDetail = "getbeaconbeststatedetail"
 
+	// features
+	listFeatures = "listfeatures"
+
 	// Wallet rpc cmd
 	listAccounts               = "listaccounts"
 	getAccount                 = "getaccount"
@@ -186,8 +195,33 @@ const (
 	getMinerRewardFromMiningKey = "getminerrewardfromminingkey"
 
 	// slash
-	getProducersBlackList       = "getproducersblacklist"
-	getProducersBlackListDetail = "getproducersblacklistdetail"
+	getProducersBlackList                     = "getproducersblacklist"
+	getProducersBlackListDetail               = "getproducersblacklistdetail"
+	getEquivocationEvidences                  = "getequivocationevidences"
+	createAndSendSlashEquivocationTransaction = "createandsendslashequivocationtransaction"
+
+	// staking pool
+	getStakingPool                              = "getstakingpool"
+	createAndSendStakingPoolRegisterTransaction = "createandsendstakingpoolregistertransaction"
+	createAndSendStakingPoolDelegateTransaction = "createandsendstakingpooldelegatetransaction"
+
+	// unstake
+	getUnbondingStatus              = "getunbondingstatus"
+	createAndSendUnstakeTransaction = "createandsendunstaketransaction"
+
+	// auto withdraw reward
+	getAutoWithdrawRewardPolicy                      = "getautowithdrawrewardpolicy"
+	createAndSendAutoWithdrawRewardPolicyTransaction = "createandsendautowithdrawrewardpolicytransaction"
+
+	// validator stats
+	getValidatorStats = "getvalidatorstats"
+
+	// governance
+	getGovernanceParams                         = "getgovernanceparams"
+	getGovernanceProposal                       = "getgovernanceproposal"
+	listGovernanceProposals                     = "listgovernanceproposals"
+	createAndSendParamChangeProposalTransaction = "createandsendparamchangeproposaltransaction"
+	createAndSendParamChangeVoteTransaction     = "createandsendparamchangevotetransaction"
 
 	// pde
 	getPDEState                                = "getpdestate"
@@ -291,6 +325,15 @@ const (
 
 	//validator state
 	getValKeyState = "getvalkeystate"
+
+	// note scanner
+	registerScanKey        = "registerscankey"
+	unregisterScanKey      = "unregisterscankey"
+	getScannedBalance      = "getscannedbalance"
+	listScannedOutputCoins = "listscannedoutputcoins"
+	getScannedHistory      = "getscannedhistory"
+
+	getScannedTransactionHistory = "getscannedtransactionhistory"
 )
 
 const (
@@ -312,4 +355,12 @@ const (
 	subcribeBeaconBestState                     = "subcribebeaconbeststate"
 	subcribeBeaconPoolBeststate                 = "subcribebeaconpoolbeststate"
 	subcribeShardPoolBeststate                  = "subcribeshardpoolbeststate"
+	subcribeTransactionConfirmation             = "subcribetransactionconfirmation"
+	subcribeViewKeyActivity                     = "subcribeviewkeyactivity"
+	subcribePDETradeStatus                      = "subcribepdetradestatus"
+	subcribePDEContributionStatus               = "subcribepdecontributionstatus"
+	subcribePortalPortingStatus                 = "subcribeportalportingstatus"
+	subcribePortalRedeemStatus                  = "subcribeportalredeemstatus"
+	subcribeBridgeBurnConfirmation              = "subcribebridgeburnconfirmation"
+	subcribeTransactionReceipt                  = "subcribetransactionreceipt"
 )
@@ -0,0 +1,150 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC methods and the JSON-RPC method they are served like, a client is allowed to call a gRPC method
+// by its rpcAccess of the JSON-RPC method (see acl.go)
+var grpcMethods = map[string]string{
+	"/rpcserver.RpcService/RetrieveBlock":                retrieveBlock,
+	"/rpcserver.RpcService/RetrieveBlockByHeight":        retrieveBlockByHeight,
+	"/rpcserver.RpcService/RetrieveBeaconBlock":          retrieveBeaconBlock,
+	"/rpcserver.RpcService/RetrieveBeaconBlockByHeight":  retrieveBeaconBlockByHeight,
+	"/rpcserver.RpcService/SubscribeNewShardBlock":       subcribeNewShardBlock,
+	"/rpcserver.RpcService/SubscribeNewBeaconBlock":      subcribeNewBeaconBlock,
+	"/rpcserver.RpcService/GetTransactionByHash":         getTransactionByHash,
+	"/rpcserver.RpcService/GetBalanceByPrivateKey":       getBalanceByPrivatekey,
+	"/rpcserver.RpcService/GetBalancePrivacyCustomToken": getBalancePrivacyCustomToken,
+	"/rpcserver.RpcService/GetPDEState":                  getPDEState,
+	"/rpcserver.RpcService/GetPDEContributionStatus":     getPDEContributionStatusV2,
+	"/rpcserver.RpcService/GetPDETradeStatus":            getPDETradeStatus,
+	"/rpcserver.RpcService/GetPDEWithdrawalStatus":       getPDEWithdrawalStatus,
+	"/rpcserver.RpcService/GetPortingRequestByTxID":      getPortalPortingRequestByKey,
+	"/rpcserver.RpcService/GetPortalReqPTokenStatus":     getPortalReqPTokenStatus,
+	"/rpcserver.RpcService/GetPortalRedeemReqStatus":     getPortalReqRedeemStatus,
+}
+
+// GrpcServer serves the gRPC API of rpcserver/proto. It shares the services, the authentication
+// and the limits of the HttpServer, so a gRPC method returns what its JSON-RPC method returns.
+type GrpcServer struct {
+	started    int32
+	shutdown   int32
+	config     RpcServerConfig
+	server     *grpc.Server
+	httpServer *HttpServer
+}
+
+func (grpcServer *GrpcServer) Init(config *RpcServerConfig, httpServer *HttpServer) {
+	grpcServer.config = *config
+	grpcServer.httpServer = httpServer
+}
+
+// Start is used by rpcserver.go to start the grpc listener.
+func (grpcServer *GrpcServer) Start() error {
+	if atomic.LoadInt32(&grpcServer.started) == 1 {
+		return rpcservice.NewRPCError(rpcservice.AlreadyStartedError, nil)
+	}
+	grpcServer.server = grpc.NewServer(
+		grpc.UnaryInterceptor(grpcServer.unaryInterceptor),
+		grpc.StreamInterceptor(grpcServer.streamInterceptor),
+	)
+	proto.RegisterRpcServiceServer(grpcServer.server, grpcServer)
+	for _, listen := range grpcServer.config.GrpcListenters {
+		go func(listen net.Listener) {
+			Logger.log.Infof("RPC gRPC server listening on %s", listen.Addr())
+			err := grpcServer.server.Serve(listen)
+			if err != nil {
+				Logger.log.Errorf("Close gRPC Listener %+v", err)
+			}
+			Logger.log.Infof("RPC gRPC listener done for %s", listen.Addr())
+		}(listen)
+	}
+	atomic.StoreInt32(&grpcServer.started, 1)
+	return nil
+}
+
+// Stop is used by rpcserver.go to stop the grpc listener.
+func (grpcServer *GrpcServer) Stop() {
+	if atomic.AddInt32(&grpcServer.shutdown, 1) != 1 {
+		Logger.log.Info("RPC gRPC server is already in the process of shutting down")
+	}
+	Logger.log.Info("RPC gRPC server shutting down")
+	if atomic.LoadInt32(&grpcServer.started) != 0 {
+		grpcServer.server.Stop()
+	}
+	Logger.log.Warn("RPC gRPC server shutdown complete")
+	atomic.StoreInt32(&grpcServer.started, 0)
+}
+
+func (grpcServer *GrpcServer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := grpcServer.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (grpcServer *GrpcServer) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := grpcServer.authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authorize checks the API key or the HTTP Basic authentication of the request metadata,
+// whether the client is allowed to call the method and its limit request per day
+func (grpcServer *GrpcServer) authorize(ctx context.Context, fullMethod string) error {
+	method, ok := grpcMethods[fullMethod]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "method %s is not served", fullMethod)
+	}
+	// the checks of the http server are done on the headers of the metadata
+	r := &http.Request{Header: make(http.Header)}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{apiKeyHeader, "Authorization", "X-Forwarded-For"} {
+		if values := md.Get(key); len(values) > 0 {
+			r.Header.Set(key, values[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		r.RemoteAddr = p.Addr.String()
+	}
+	access, err := grpcServer.httpServer.checkAuth(r, true)
+	if err != nil || access == nil {
+		return status.Error(codes.Unauthenticated, "authentication failure")
+	}
+	if !access.isAllowed(method) {
+		ALogger.log.Warnf("RPC gRPC method %s denied to %s from %s", fullMethod, access.name, getIP(r))
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", fullMethod)
+	}
+	if reachLimit, errMsg := grpcServer.httpServer.reachLimitRequestPerDay(r, access); reachLimit {
+		Logger.log.Error(errMsg)
+		return status.Error(codes.ResourceExhausted, errMsg)
+	}
+	return nil
+}
+
+// grpcError returns the gRPC status of an error of the services, the message starts with
+// the code of the JSON-RPC error
+func grpcError(rpcErr *rpcservice.RPCError) error {
+	code := codes.Unknown
+	switch rpcErr.Code {
+	case rpcservice.ErrCodeMessage[rpcservice.RPCInvalidParamsError].Code:
+		code = codes.InvalidArgument
+	case rpcservice.ErrCodeMessage[rpcservice.RPCInternalError].Code:
+		code = codes.Internal
+	}
+	return status.Error(code, fmt.Sprintf("%d: %+v", rpcErr.Code, rpcErr.GetErr()))
+}
@@ -0,0 +1,209 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (grpcServer *GrpcServer) RetrieveBlock(ctx context.Context, req *proto.RetrieveBlockRequest) (*proto.ShardBlock, error) {
+	result, rpcErr := grpcServer.httpServer.blockService.RetrieveShardBlock(req.BlockHash, req.Verbosity)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	return newGrpcShardBlock(result), nil
+}
+
+func (grpcServer *GrpcServer) RetrieveBlockByHeight(ctx context.Context, req *proto.RetrieveBlockByHeightRequest) (*proto.ShardBlocks, error) {
+	if req.ShardID < 0 {
+		return nil, status.Error(codes.InvalidArgument, "shardID is invalid")
+	}
+	results, rpcErr := grpcServer.httpServer.blockService.RetrieveShardBlockByHeight(req.Height, int(req.ShardID), req.Verbosity)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	blocks := &proto.ShardBlocks{}
+	for _, result := range results {
+		blocks.Blocks = append(blocks.Blocks, newGrpcShardBlock(result))
+	}
+	return blocks, nil
+}
+
+func (grpcServer *GrpcServer) RetrieveBeaconBlock(ctx context.Context, req *proto.RetrieveBeaconBlockRequest) (*proto.BeaconBlock, error) {
+	result, rpcErr := grpcServer.httpServer.blockService.RetrieveBeaconBlock(req.BlockHash)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	return newGrpcBeaconBlock(result), nil
+}
+
+func (grpcServer *GrpcServer) RetrieveBeaconBlockByHeight(ctx context.Context, req *proto.RetrieveBeaconBlockByHeightRequest) (*proto.BeaconBlocks, error) {
+	results, rpcErr := grpcServer.httpServer.blockService.RetrieveBeaconBlockByHeight(req.Height)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	blocks := &proto.BeaconBlocks{}
+	for _, result := range results {
+		blocks.Blocks = append(blocks.Blocks, newGrpcBeaconBlock(result))
+	}
+	return blocks, nil
+}
+
+// SubscribeNewShardBlock streams the new blocks of a shard until the client cancels
+func (grpcServer *GrpcServer) SubscribeNewShardBlock(req *proto.SubscribeNewShardBlockRequest, stream proto.RpcService_SubscribeNewShardBlockServer) error {
+	if req.ShardID < 0 || int(req.ShardID) >= common.MaxShardNumber {
+		return status.Error(codes.InvalidArgument, "shardID is invalid")
+	}
+	shardID := byte(req.ShardID)
+	subId, subChan, err := grpcServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewShardblockTopic)
+	if err != nil {
+		return grpcError(rpcservice.NewRPCError(rpcservice.SubcribeError, err))
+	}
+	defer func() {
+		Logger.log.Info("Finish gRPC Subscribe New Shard Block ShardID ", shardID)
+		grpcServer.config.PubSubManager.Unsubscribe(pubsub.NewShardblockTopic, subId)
+	}()
+	for {
+		select {
+		case msg := <-subChan:
+			shardBlock, ok := msg.Value.(*blockchain.ShardBlock)
+			if !ok {
+				Logger.log.Errorf("Wrong Message Type from Pubsub Manager, wanted *blockchain.ShardBlock, have %+v", reflect.TypeOf(msg.Value))
+				continue
+			}
+			if shardBlock.Header.ShardID != shardID {
+				continue
+			}
+			blockBytes, err := json.Marshal(shardBlock)
+			if err != nil {
+				return grpcError(rpcservice.NewRPCError(rpcservice.UnexpectedError, err))
+			}
+			blockResult := jsonresult.NewGetBlockResult(shardBlock, uint64(len(blockBytes)), common.EmptyString)
+			if err := stream.Send(newGrpcShardBlock(blockResult)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// SubscribeNewBeaconBlock streams the new beacon blocks until the client cancels
+func (grpcServer *GrpcServer) SubscribeNewBeaconBlock(req *proto.SubscribeNewBeaconBlockRequest, stream proto.RpcService_SubscribeNewBeaconBlockServer) error {
+	subId, subChan, err := grpcServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewBeaconBlockTopic)
+	if err != nil {
+		return grpcError(rpcservice.NewRPCError(rpcservice.SubcribeError, err))
+	}
+	defer func() {
+		Logger.log.Info("Finish gRPC Subscribe New Beacon Block")
+		grpcServer.config.PubSubManager.Unsubscribe(pubsub.NewBeaconBlockTopic, subId)
+	}()
+	for {
+		select {
+		case msg := <-subChan:
+			beaconBlock, ok := msg.Value.(*blockchain.BeaconBlock)
+			if !ok {
+				Logger.log.Errorf("Wrong Message Type from Pubsub Manager, wanted *blockchain.BeaconBlock, have %+v", reflect.TypeOf(msg.Value))
+				continue
+			}
+			blockBytes, err := json.Marshal(beaconBlock)
+			if err != nil {
+				return grpcError(rpcservice.NewRPCError(rpcservice.UnexpectedError, err))
+			}
+			blockResult := jsonresult.NewGetBlocksBeaconResult(beaconBlock, uint64(len(blockBytes)), common.EmptyString)
+			if err := stream.Send(newGrpcBeaconBlock(blockResult)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newGrpcInstructions(instructions [][]string) []*proto.Instruction {
+	result := make([]*proto.Instruction, 0, len(instructions))
+	for _, instruction := range instructions {
+		result = append(result, &proto.Instruction{Values: instruction})
+	}
+	return result
+}
+
+func newGrpcShardBlock(block *jsonresult.GetShardBlockResult) *proto.ShardBlock {
+	result := &proto.ShardBlock{
+		Hash:              block.Hash,
+		ShardID:           int32(block.ShardID),
+		Height:            block.Height,
+		Confirmations:     block.Confirmations,
+		Version:           int32(block.Version),
+		TxRoot:            block.TxRoot,
+		Time:              block.Time,
+		PreviousBlockHash: block.PreviousBlockHash,
+		NextBlockHash:     block.NextBlockHash,
+		TxHashes:          block.TxHashes,
+		BlockProducer:     block.BlockProducer,
+		ValidationData:    block.ValidationData,
+		ConsensusType:     block.ConsensusType,
+		Data:              block.Data,
+		BeaconHeight:      block.BeaconHeight,
+		BeaconBlockHash:   block.BeaconBlockHash,
+		Round:             int32(block.Round),
+		Epoch:             block.Epoch,
+		Reward:            block.Reward,
+		RewardBeacon:      block.RewardBeacon,
+		Fee:               block.Fee,
+		Size:              block.Size,
+		Instructions:      newGrpcInstructions(block.Instruction),
+	}
+	for _, tx := range block.Txs {
+		result.Txs = append(result.Txs, &proto.BlockTx{
+			Hash:     tx.Hash,
+			Locktime: tx.Locktime,
+			HexData:  tx.HexData,
+		})
+	}
+	for _, shardID := range block.CrossShardBitMap {
+		result.CrossShardBitMap = append(result.CrossShardBitMap, int32(shardID))
+	}
+	return result
+}
+
+func newGrpcBeaconBlock(block *jsonresult.GetBeaconBlockResult) *proto.BeaconBlock {
+	result := &proto.BeaconBlock{
+		Hash:              block.Hash,
+		Height:            block.Height,
+		BlockProducer:     block.BlockProducer,
+		ValidationData:    block.ValidationData,
+		ConsensusType:     block.ConsensusType,
+		Version:           int32(block.Version),
+		Epoch:             block.Epoch,
+		Round:             int32(block.Round),
+		Time:              block.Time,
+		PreviousBlockHash: block.PreviousBlockHash,
+		NextBlockHash:     block.NextBlockHash,
+		Instructions:      newGrpcInstructions(block.Instructions),
+		Size:              block.Size,
+		ShardStates:       make(map[int32]*proto.ShardStates),
+	}
+	shardStates, _ := block.ShardStates.(map[byte][]blockchain.ShardState)
+	for shardID, states := range shardStates {
+		grpcStates := &proto.ShardStates{}
+		for _, state := range states {
+			grpcStates.States = append(grpcStates.States, &proto.ShardState{
+				Height:     state.Height,
+				Hash:       state.Hash.String(),
+				CrossShard: state.CrossShard,
+			})
+		}
+		result.ShardStates[int32(shardID)] = grpcStates
+	}
+	return result
+}
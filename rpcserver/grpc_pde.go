@@ -0,0 +1,86 @@
+package rpcserver
+
+import (
+	"context"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (grpcServer *GrpcServer) GetPDEState(ctx context.Context, req *proto.GetPDEStateRequest) (*proto.PDEState, error) {
+	beaconHeight := req.BeaconHeight
+	if beaconHeight == 0 {
+		beaconHeight = grpcServer.config.BlockChain.GetBeaconBestState().BeaconHeight
+	}
+	pdeState, rpcErr := grpcServer.httpServer.blockService.GetPDEState(beaconHeight)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	result := &proto.PDEState{
+		WaitingPDEContributions: make(map[string]*proto.PDEContribution),
+		PDEPoolPairs:            make(map[string]*proto.PDEPoolForPair),
+		PDEShares:               pdeState.PDEShares,
+		PDETradingFees:          pdeState.PDETradingFees,
+		BeaconTimeStamp:         pdeState.BeaconTimeStamp,
+	}
+	for key, contribution := range pdeState.WaitingPDEContributions {
+		result.WaitingPDEContributions[key] = &proto.PDEContribution{
+			ContributorAddressStr: contribution.ContributorAddressStr,
+			TokenIDStr:            contribution.TokenIDStr,
+			Amount:                contribution.Amount,
+			TxReqID:               contribution.TxReqID.String(),
+		}
+	}
+	for key, poolPair := range pdeState.PDEPoolPairs {
+		result.PDEPoolPairs[key] = &proto.PDEPoolForPair{
+			Token1IDStr:     poolPair.Token1IDStr,
+			Token1PoolValue: poolPair.Token1PoolValue,
+			Token2IDStr:     poolPair.Token2IDStr,
+			Token2PoolValue: poolPair.Token2PoolValue,
+		}
+	}
+	return result, nil
+}
+
+func (grpcServer *GrpcServer) GetPDEContributionStatus(ctx context.Context, req *proto.GetPDEContributionStatusRequest) (*proto.PDEContributionStatus, error) {
+	contributionStatus, err := grpcServer.httpServer.blockService.GetPDEContributionStatus(rawdbv2.PDEContributionStatusPrefix, []byte(req.ContributionPairID))
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetPDEStateError, err))
+	}
+	if contributionStatus == nil {
+		return nil, status.Errorf(codes.NotFound, "contribution status of %s is not found", req.ContributionPairID)
+	}
+	return &proto.PDEContributionStatus{
+		Status:             uint32(contributionStatus.Status),
+		TokenID1Str:        contributionStatus.TokenID1Str,
+		Contributed1Amount: contributionStatus.Contributed1Amount,
+		Returned1Amount:    contributionStatus.Returned1Amount,
+		TokenID2Str:        contributionStatus.TokenID2Str,
+		Contributed2Amount: contributionStatus.Contributed2Amount,
+		Returned2Amount:    contributionStatus.Returned2Amount,
+	}, nil
+}
+
+func (grpcServer *GrpcServer) GetPDETradeStatus(ctx context.Context, req *proto.GetPDEStatusRequest) (*proto.PDEStatus, error) {
+	return grpcServer.getPDEStatus(rawdbv2.PDETradeStatusPrefix, req.TxRequestIDStr)
+}
+
+func (grpcServer *GrpcServer) GetPDEWithdrawalStatus(ctx context.Context, req *proto.GetPDEStatusRequest) (*proto.PDEStatus, error) {
+	return grpcServer.getPDEStatus(rawdbv2.PDEWithdrawalStatusPrefix, req.TxRequestIDStr)
+}
+
+func (grpcServer *GrpcServer) getPDEStatus(pdePrefix []byte, txRequestIDStr string) (*proto.PDEStatus, error) {
+	txIDHash, err := common.Hash{}.NewHashFromStr(txRequestIDStr)
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetPDEStateError, err))
+	}
+	pdeStatus, err := grpcServer.httpServer.blockService.GetPDEStatus(pdePrefix, txIDHash[:])
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetPDEStateError, err))
+	}
+	return &proto.PDEStatus{Status: uint32(pdeStatus)}, nil
+}
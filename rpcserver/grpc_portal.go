@@ -0,0 +1,83 @@
+package rpcserver
+
+import (
+	"context"
+
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+func (grpcServer *GrpcServer) GetPortingRequestByTxID(ctx context.Context, req *proto.GetPortingRequestByTxIDRequest) (*proto.PortingRequestStatus, error) {
+	result, err := grpcServer.httpServer.portal.GetPortingRequestByByTxID(req.TxHash)
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetPortingRequestError, err))
+	}
+	portingRequest := result.PortingRequest
+	portingRequestStatus := &proto.PortingRequestStatus{
+		UniquePortingID: portingRequest.UniquePortingID,
+		TxReqID:         portingRequest.TxReqID.String(),
+		TokenID:         portingRequest.TokenID,
+		PorterAddress:   portingRequest.PorterAddress,
+		Amount:          portingRequest.Amount,
+		PortingFee:      portingRequest.PortingFee,
+		Status:          int32(portingRequest.Status),
+		BeaconHeight:    portingRequest.BeaconHeight,
+		ShardHeight:     portingRequest.ShardHeight,
+		ShardID:         int32(portingRequest.ShardID),
+	}
+	for _, custodian := range portingRequest.Custodians {
+		portingRequestStatus.Custodians = append(portingRequestStatus.Custodians, &proto.MatchingPortingCustodianDetail{
+			IncAddress:             custodian.IncAddress,
+			RemoteAddress:          custodian.RemoteAddress,
+			Amount:                 custodian.Amount,
+			LockedAmountCollateral: custodian.LockedAmountCollateral,
+			LockedTokenCollaterals: custodian.LockedTokenCollaterals,
+		})
+	}
+	return portingRequestStatus, nil
+}
+
+func (grpcServer *GrpcServer) GetPortalReqPTokenStatus(ctx context.Context, req *proto.GetPortalReqPTokenStatusRequest) (*proto.PortalRequestPTokensStatus, error) {
+	reqStatus, err := grpcServer.httpServer.blockService.GetPortalReqPTokenStatus(req.ReqTxID)
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetReqPTokenStatusError, err))
+	}
+	return &proto.PortalRequestPTokensStatus{
+		Status:          uint32(reqStatus.Status),
+		UniquePortingID: reqStatus.UniquePortingID,
+		TokenID:         reqStatus.TokenID,
+		IncogAddressStr: reqStatus.IncogAddressStr,
+		PortingAmount:   reqStatus.PortingAmount,
+		PortingProof:    reqStatus.PortingProof,
+		TxReqID:         reqStatus.TxReqID.String(),
+	}, nil
+}
+
+func (grpcServer *GrpcServer) GetPortalRedeemReqStatus(ctx context.Context, req *proto.GetPortalRedeemReqStatusRequest) (*proto.PortalRedeemRequestStatus, error) {
+	reqStatus, err := grpcServer.httpServer.blockService.GetPortalRedeemReqStatus(req.RedeemID)
+	if err != nil {
+		return nil, grpcError(rpcservice.NewRPCError(rpcservice.GetReqRedeemStatusError, err))
+	}
+	redeemStatus := &proto.PortalRedeemRequestStatus{
+		Status:                  uint32(reqStatus.Status),
+		UniqueRedeemID:          reqStatus.UniqueRedeemID,
+		TokenID:                 reqStatus.TokenID,
+		RedeemAmount:            reqStatus.RedeemAmount,
+		RedeemerIncAddressStr:   reqStatus.RedeemerIncAddressStr,
+		RemoteAddress:           reqStatus.RemoteAddress,
+		RedeemFee:               reqStatus.RedeemFee,
+		TxReqID:                 reqStatus.TxReqID.String(),
+		ShardID:                 int32(reqStatus.ShardID),
+		ShardHeight:             reqStatus.ShardHeight,
+		BeaconHeight:            reqStatus.BeaconHeight,
+		RedeemerExternalAddress: reqStatus.RedeemerExternalAddress,
+	}
+	for _, custodian := range reqStatus.MatchingCustodianDetail {
+		redeemStatus.MatchingCustodianDetail = append(redeemStatus.MatchingCustodianDetail, &proto.MatchingRedeemCustodianDetail{
+			IncAddress:    custodian.GetIncognitoAddress(),
+			RemoteAddress: custodian.GetRemoteAddress(),
+			Amount:        custodian.GetAmount(),
+		})
+	}
+	return redeemStatus, nil
+}
@@ -0,0 +1,144 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/memcache"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGrpcMethods(t *testing.T) {
+	service := proto.File_rpcserver_proto.Services().Get(0)
+	methods := service.Methods()
+	if methods.Len() != len(grpcMethods) {
+		t.Fatalf("Expect %d gRPC methods, have %d", methods.Len(), len(grpcMethods))
+	}
+	for i := 0; i < methods.Len(); i++ {
+		fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(i).Name())
+		method, ok := grpcMethods[fullMethod]
+		if !ok {
+			t.Fatalf("Expect %s is served", fullMethod)
+		}
+		if _, ok := HttpHandler[method]; !ok {
+			if _, ok := WsHandler[method]; !ok {
+				t.Fatalf("Expect %s of %s is a JSON-RPC method", method, fullMethod)
+			}
+		}
+	}
+}
+
+func TestGrpcError(t *testing.T) {
+	err := grpcError(rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, nil))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expect InvalidArgument, have %+v", status.Code(err))
+	}
+	err = grpcError(rpcservice.NewRPCError(rpcservice.GetPDEStateError, nil))
+	if status.Code(err) != codes.Unknown {
+		t.Fatalf("Expect Unknown, have %+v", status.Code(err))
+	}
+}
+
+func TestGrpcServerRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpcgrpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	aclFile := filepath.Join(dir, "acl.json")
+	if err := ioutil.WriteFile(aclFile, []byte(`{"Keys": [{"Name": "pde", "Key": "pdekey", "Allow": ["getpdestate"]}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	pubSubManager := pubsub.NewPubSubManager()
+	go pubSubManager.Start()
+	listener := bufconn.Listen(1 << 20)
+	config := &RpcServerConfig{
+		GrpcListenters: []net.Listener{listener},
+		PubSubManager:  pubSubManager,
+		MemCache:       memcache.New(),
+		RPCUser:        "user",
+		RPCPass:        "pass",
+		RPCACLFile:     aclFile,
+	}
+	httpServer := &HttpServer{}
+	httpServer.Init(config)
+	grpcServer := &GrpcServer{}
+	grpcServer.Init(config, httpServer)
+	if err := grpcServer.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := proto.NewRpcServiceClient(conn)
+	userCtx := metadata.AppendToOutgoingContext(ctx, "Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:pass")))
+	pdeCtx := metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "pdekey")
+
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"no authentication", ctx, codes.Unauthenticated},
+		{"unknown api key", metadata.AppendToOutgoingContext(ctx, apiKeyHeader, "unknownkey"), codes.Unauthenticated},
+		{"method denied to the api key", pdeCtx, codes.PermissionDenied},
+		// the request is served by the service of the JSON-RPC method
+		{"invalid params", userCtx, codes.InvalidArgument},
+	} {
+		_, err := client.GetTransactionByHash(tc.ctx, &proto.GetTransactionByHashRequest{TxHash: "invalidhash"})
+		if status.Code(err) != tc.code {
+			t.Fatalf("%v: expect %v, have %+v", tc.name, tc.code, err)
+		}
+	}
+
+	stream, err := client.SubscribeNewBeaconBlock(userCtx, &proto.SubscribeNewBeaconBlockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &blockchain.BeaconBlock{Header: blockchain.BeaconHeader{Height: 7}}
+	// the block is published until the server has subscribed and streams it
+	received := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-received:
+				return
+			case <-ticker.C:
+				pubSubManager.PublishMessage(pubsub.NewMessage(pubsub.NewBeaconBlockTopic, block))
+			}
+		}
+	}()
+	result, err := stream.Recv()
+	close(received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Height != 7 || result.Hash != block.Hash().String() {
+		t.Fatalf("Expect beacon block 7 %s, have %d %s", block.Hash().String(), result.Height, result.Hash)
+	}
+}
@@ -0,0 +1,98 @@
+package rpcserver
+
+import (
+	"context"
+
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+	"github.com/incognitochain/incognito-chain/rpcserver/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (grpcServer *GrpcServer) GetTransactionByHash(ctx context.Context, req *proto.GetTransactionByHashRequest) (*proto.TransactionDetail, error) {
+	result, rpcErr := grpcServer.httpServer.txService.GetTransactionByHash(req.TxHash)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	return newGrpcTransactionDetail(result), nil
+}
+
+func (grpcServer *GrpcServer) GetBalanceByPrivateKey(ctx context.Context, req *proto.GetBalanceByPrivateKeyRequest) (*proto.Balance, error) {
+	balance, rpcErr := grpcServer.httpServer.walletService.GetBalanceByPrivateKey(req.PrivateKey, req.ShardHeight)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	return &proto.Balance{Balance: balance}, nil
+}
+
+func (grpcServer *GrpcServer) GetBalancePrivacyCustomToken(ctx context.Context, req *proto.GetBalancePrivacyCustomTokenRequest) (*proto.Balance, error) {
+	if len(req.PrivateKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "private key is invalid")
+	}
+	if len(req.TokenID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tokenID is invalid")
+	}
+	balance, rpcErr := grpcServer.httpServer.txService.GetBalancePrivacyCustomToken(req.PrivateKey, req.TokenID)
+	if rpcErr != nil {
+		return nil, grpcError(rpcErr)
+	}
+	return &proto.Balance{Balance: balance}, nil
+}
+
+func newGrpcProofDetail(proofDetail jsonresult.ProofDetail) *proto.ProofDetail {
+	newCoinDetails := func(coins []*jsonresult.CoinDetail) []*proto.CoinDetail {
+		result := make([]*proto.CoinDetail, 0, len(coins))
+		for _, coin := range coins {
+			result = append(result, &proto.CoinDetail{
+				CoinDetails: &proto.Coin{
+					PublicKey:      coin.CoinDetails.PublicKey,
+					CoinCommitment: coin.CoinDetails.CoinCommitment,
+					SNDerivator:    coin.CoinDetails.SNDerivator.ToBytesS(),
+					SerialNumber:   coin.CoinDetails.SerialNumber,
+					Randomness:     coin.CoinDetails.Randomness.ToBytesS(),
+					Value:          coin.CoinDetails.Value,
+					Info:           coin.CoinDetails.Info,
+				},
+				CoinDetailsEncrypted: coin.CoinDetailsEncrypted,
+			})
+		}
+		return result
+	}
+	return &proto.ProofDetail{
+		InputCoins:  newCoinDetails(proofDetail.InputCoins),
+		OutputCoins: newCoinDetails(proofDetail.OutputCoins),
+	}
+}
+
+func newGrpcTransactionDetail(tx *jsonresult.TransactionDetail) *proto.TransactionDetail {
+	return &proto.TransactionDetail{
+		BlockHash:                     tx.BlockHash,
+		BlockHeight:                   tx.BlockHeight,
+		TxSize:                        tx.TxSize,
+		Index:                         tx.Index,
+		ShardID:                       int32(tx.ShardID),
+		Hash:                          tx.Hash,
+		Version:                       int32(tx.Version),
+		Type:                          tx.Type,
+		LockTime:                      tx.LockTime,
+		Fee:                           tx.Fee,
+		Image:                         tx.Image,
+		IsPrivacy:                     tx.IsPrivacy,
+		ProofDetail:                   newGrpcProofDetail(tx.ProofDetail),
+		InputCoinPubKey:               tx.InputCoinPubKey,
+		SigPubKey:                     tx.SigPubKey,
+		Sig:                           tx.Sig,
+		Metadata:                      tx.Metadata,
+		CustomTokenData:               tx.CustomTokenData,
+		PrivacyCustomTokenID:          tx.PrivacyCustomTokenID,
+		PrivacyCustomTokenName:        tx.PrivacyCustomTokenName,
+		PrivacyCustomTokenSymbol:      tx.PrivacyCustomTokenSymbol,
+		PrivacyCustomTokenData:        tx.PrivacyCustomTokenData,
+		PrivacyCustomTokenProofDetail: newGrpcProofDetail(tx.PrivacyCustomTokenProofDetail),
+		PrivacyCustomTokenIsPrivacy:   tx.PrivacyCustomTokenIsPrivacy,
+		PrivacyCustomTokenFee:         tx.PrivacyCustomTokenFee,
+		IsInMempool:                   tx.IsInMempool,
+		IsInBlock:                     tx.IsInBlock,
+		Info:                          tx.Info,
+	}
+}
@@ -1,6 +1,9 @@
 package rpcserver
 
 import (
+	"bufio"
+	"compress/gzip"
+	"context"
 	"crypto/subtle"
 	"encoding/base64"
 	"errors"
@@ -20,6 +23,8 @@ import (
 
 	"github.com/incognitochain/incognito-chain/common"
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 type HttpServer struct {
@@ -33,18 +38,23 @@ type HttpServer struct {
 	statusLines      map[int]string
 	authSHA          []byte
 	limitAuthSHA     []byte
+	userAccess       *rpcAccess
+	limitUserAccess  *rpcAccess
+	acl              *rpcACL
+	batchWorkers     chan struct{} // slots of the workers processing requests of batches, shared by all clients
 	// channel
 	cRequestProcessShutdown chan struct{}
 
 	// service
-	blockService      *rpcservice.BlockService
-	outputCoinService *rpcservice.CoinService
-	txMemPoolService  *rpcservice.TxMemPoolService
-	networkService    *rpcservice.NetworkService
-	txService         *rpcservice.TxService
-	walletService     *rpcservice.WalletService
-	portal            *rpcservice.PortalService
-	synkerService     *rpcservice.SynkerService
+	blockService       *rpcservice.BlockService
+	outputCoinService  *rpcservice.CoinService
+	txMemPoolService   *rpcservice.TxMemPoolService
+	networkService     *rpcservice.NetworkService
+	txService          *rpcservice.TxService
+	walletService      *rpcservice.WalletService
+	portal             *rpcservice.PortalService
+	synkerService      *rpcservice.SynkerService
+	noteScannerService *rpcservice.NoteScannerService
 }
 
 func (httpServer *HttpServer) Init(config *RpcServerConfig) {
@@ -60,6 +70,25 @@ func (httpServer *HttpServer) Init(config *RpcServerConfig) {
 		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 		httpServer.limitAuthSHA = common.HashB([]byte(auth))
 	}
+	// the limited user calls all methods while the user can not call methods of the local wallet and peer reputation
+	httpServer.limitUserAccess = &rpcAccess{name: "rpclimituser", limitByAddress: true}
+	userAccess, err := newRPCAccess("rpcuser", nil, legacyLimitedMethods, 0)
+	if err != nil {
+		panic(err)
+	}
+	userAccess.limitByAddress = true
+	httpServer.userAccess = userAccess
+	batchWorkers := config.RPCBatchWorkers
+	if batchWorkers <= 0 {
+		batchWorkers = defaultRPCBatchWorkers
+	}
+	httpServer.batchWorkers = make(chan struct{}, batchWorkers)
+	if config.RPCACLFile != "" {
+		httpServer.acl = newRPCACL(config.RPCACLFile)
+		if err := httpServer.acl.load(); err != nil {
+			Logger.log.Errorf("Can not load rpc acl file %s, no api key is accepted until it is fixed err:%+v", config.RPCACLFile, err)
+		}
+	}
 
 	// init service
 	httpServer.blockService = &rpcservice.BlockService{
@@ -74,7 +103,8 @@ func (httpServer *HttpServer) Init(config *RpcServerConfig) {
 		TxMemPool: httpServer.config.TxMemPool,
 	}
 	httpServer.networkService = &rpcservice.NetworkService{
-		ConnMgr: httpServer.config.ConnMgr,
+		ConnMgr:    httpServer.config.ConnMgr,
+		PeerScorer: httpServer.config.PeerScorer,
 	}
 	httpServer.txService = &rpcservice.TxService{
 		BlockChain:   httpServer.config.BlockChain,
@@ -93,6 +123,9 @@ func (httpServer *HttpServer) Init(config *RpcServerConfig) {
 	httpServer.portal = &rpcservice.PortalService{
 		BlockChain: httpServer.config.BlockChain,
 	}
+	httpServer.noteScannerService = &rpcservice.NoteScannerService{
+		Scanner: httpServer.config.NoteScanner,
+	}
 }
 
 // Start is used by rpcserver.go to start the rpc listener.
@@ -102,7 +135,8 @@ func (httpServer *HttpServer) Start() error {
 	}
 	httpServeMux := http.NewServeMux()
 	httpServer.server = &http.Server{
-		Handler: httpServeMux,
+		// HTTP/2 without TLS (h2c) is served along with HTTP/1
+		Handler: h2c.NewHandler(httpServeMux, &http2.Server{}),
 		// Timeout connections which don't complete the initial
 		// handshake within the allowed timeframe.
 		ReadTimeout: time.Second * rpcAuthTimeoutSeconds,
@@ -123,6 +157,9 @@ func (httpServer *HttpServer) Start() error {
 			Logger.log.Infof("RPC Http listener done for %s", listen.Addr())
 		}(listen)
 	}
+	if httpServer.acl != nil {
+		go httpServer.acl.watch()
+	}
 	atomic.StoreInt32(&httpServer.started, 1)
 	return nil
 }
@@ -136,6 +173,9 @@ func (httpServer *HttpServer) Stop() {
 	if httpServer.started != 0 {
 		err := httpServer.server.Close()
 		fmt.Println(err)
+		if httpServer.acl != nil {
+			httpServer.acl.stop()
+		}
 	}
 	for _, listen := range httpServer.config.HttpListenters {
 		listen.Close()
@@ -163,7 +203,6 @@ func (httpServer *HttpServer) handleRequest(w http.ResponseWriter, r *http.Reque
 	}
 
 	// Keep track of the number of connected clients.
-	done := make(chan int)
 	//before := httpServer.numClients
 	httpServer.IncrementClients()
 	defer func() {
@@ -171,30 +210,25 @@ func (httpServer *HttpServer) handleRequest(w http.ResponseWriter, r *http.Reque
 		//fmt.Println("RPCCON:", before, httpServer.numClients)
 	}()
 	// Check authentication for rpc user
-	ok, isLimitUser, err := httpServer.checkAuth(r, true)
-	if err != nil || !ok {
+	access, err := httpServer.checkAuth(r, true)
+	if err != nil || access == nil {
 		Logger.log.Error(err)
 		AuthFail(w)
 		return
 	}
 
-	go func() {
-		httpServer.ProcessRpcRequest(w, r, isLimitUser)
-		done <- 1
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(time.Second * rpcProcessTimeoutSeconds):
-	}
-
+	// The request is canceled when its processing times out, the response is only written
+	// by ProcessRpcRequest before the handler returns.
+	ctx, cancel := context.WithTimeout(r.Context(), time.Second*rpcProcessTimeoutSeconds)
+	defer cancel()
+	httpServer.ProcessRpcRequest(w, r.WithContext(ctx), access)
 }
 
 /*
 handles reading and responding to RPC messages.
 */
 
-func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.Request, isLimitedUser bool) {
+func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.Request, access *rpcAccess) {
 	defer func() {
 		if r.Method == getShardBestState {
 			return
@@ -210,15 +244,11 @@ func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if httpServer.config.RPCLimitRequestPerDay > 0 {
-		// check limit request per day
-		if httpServer.checkLimitRequestPerDay(r) {
-			errMsg := "Reach limit request per day"
-			Logger.log.Error(errMsg)
-			errCode := http.StatusTooManyRequests
-			http.Error(w, strconv.Itoa(errCode)+" "+errMsg, errCode)
-			return
-		}
+	if reachLimit, errMsg := httpServer.reachLimitRequestPerDay(r, access); reachLimit {
+		Logger.log.Error(errMsg)
+		errCode := http.StatusTooManyRequests
+		http.Error(w, strconv.Itoa(errCode)+" "+errMsg, errCode)
+		return
 	}
 
 	// Read and close the JSON-RPC request body from the caller.
@@ -229,31 +259,67 @@ func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.R
 		http.Error(w, fmt.Sprintf("%d error reading JSON Message: %+v", errCode, err), errCode)
 		return
 	}
-	// Unfortunately, the http server doesn't provide the ability to
-	// change the read deadline for the new connection and having one breaks
-	// long polling.  However, not having a read deadline on the initial
-	// connection would mean clients can connect and idle forever.  Thus,
-	// hijack the connecton from the HTTP server, clear the read deadline,
-	// and handle writing the response manually.
-	hj, ok := w.(http.Hijacker)
-	if !ok {
-		errMsg := "webserver doesn't support hijacking"
-		Logger.log.Error(errMsg)
-		errCode := http.StatusInternalServerError
-		http.Error(w, strconv.Itoa(errCode)+" "+errMsg, errCode)
-		return
+
+	var conn net.Conn
+	var buf *bufio.ReadWriter
+	var closeChan <-chan struct{}
+	if r.ProtoMajor >= 2 {
+		// A HTTP/2 stream can not be hijacked and has no read deadline of its
+		// connection to clear, the response is written by the response writer.
+		buf = bufio.NewReadWriter(nil, bufio.NewWriter(w))
+		closeChan = r.Context().Done()
+	} else {
+		// Unfortunately, the http server doesn't provide the ability to
+		// change the read deadline for the new connection and having one breaks
+		// long polling.  However, not having a read deadline on the initial
+		// connection would mean clients can connect and idle forever.  Thus,
+		// hijack the connecton from the HTTP server, clear the read deadline,
+		// and handle writing the response manually.
+		hj, ok := w.(http.Hijacker)
+		if !ok {
+			errMsg := "webserver doesn't support hijacking"
+			Logger.log.Error(errMsg)
+			errCode := http.StatusInternalServerError
+			http.Error(w, strconv.Itoa(errCode)+" "+errMsg, errCode)
+			return
+		}
+		conn, buf, err = hj.Hijack()
+		if err != nil {
+			Logger.log.Errorf("Failed to hijack HTTP connection: %s", err.Error())
+			Logger.log.Error(err)
+			errCode := http.StatusInternalServerError
+			http.Error(w, strconv.Itoa(errCode)+" "+err.Error(), errCode)
+			return
+		}
+		defer conn.Close()
+		conn.SetReadDeadline(timeZeroVal)
+
+		// Setup a close notifier.  Since the connection is hijacked,
+		// the CloseNotifer on the ResponseWriter is not available.
+		connClosed := make(chan struct{}, 1)
+		go func() {
+			_, err := conn.Read(make([]byte, 1))
+			if err != nil {
+				close(connClosed)
+			}
+		}()
+		// the request is also closed when it is canceled
+		closeNotifier := make(chan struct{})
+		go func() {
+			select {
+			case <-connClosed:
+			case <-r.Context().Done():
+			}
+			close(closeNotifier)
+		}()
+		closeChan = closeNotifier
 	}
-	conn, buf, err := hj.Hijack()
-	if err != nil {
-		Logger.log.Errorf("Failed to hijack HTTP connection: %s", err.Error())
-		Logger.log.Error(err)
-		errCode := http.StatusInternalServerError
-		http.Error(w, strconv.Itoa(errCode)+" "+err.Error(), errCode)
+	defer buf.Flush()
+
+	if isBatchRequest(body) {
+		httpServer.processBatchRequest(w, r, access, body, closeChan, buf)
 		return
 	}
-	defer conn.Close()
-	defer buf.Flush()
-	conn.SetReadDeadline(timeZeroVal)
 
 	var jsonErr error
 	var result interface{}
@@ -275,52 +341,17 @@ func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.R
 			}
 		}
 
-		// Setup a close notifier.  Since the connection is hijacked,
-		// the CloseNotifer on the ResponseWriter is not available.
-		closeChan := make(chan struct{}, 1)
-		go func() {
-			_, err := conn.Read(make([]byte, 1))
-			if err != nil {
-				close(closeChan)
-			}
-		}()
-
-		// Check if the user is limited and set error if method unauthorized
-		if !isLimitedUser {
-			if function, ok := LimitedHttpHandler[request.Method]; ok {
-				_ = function
-				jsonErr = rpcservice.NewRPCError(rpcservice.RPCInvalidMethodPermissionError, errors.New(""))
-			}
-		}
-		if jsonErr == nil {
-			if request.Method == "downloadbackup" {
-				httpServer.handleDownloadBackup(conn, request.Params)
-				return
-			}
-
-			// Attempt to parse the JSON-RPC request into a known concrete
-			// command.
-			command := HttpHandler[request.Method]
-			if command == nil {
-				if isLimitedUser {
-					command = LimitedHttpHandler[request.Method]
-				} else {
-					result = nil
-					jsonErr = rpcservice.NewRPCError(rpcservice.RPCMethodNotFoundError, errors.New("Method not found: "+request.Method))
-				}
-			}
-			if command != nil {
-				result, jsonErr = command(httpServer, request.Params, closeChan)
-			} else {
-				jsonErr = rpcservice.NewRPCError(rpcservice.RPCMethodNotFoundError, errors.New("Method not found: "+request.Method))
-			}
+		if request.Method == downloadBackup && conn != nil && access.isAllowed(request.Method) {
+			httpServer.handleDownloadBackup(conn, request.Params)
+			return
 		}
+		result, jsonErr = httpServer.processJsonRequest(r, access, request, closeChan)
 	}
 
 	if jsonErr.(*rpcservice.RPCError) != nil && r.Method != "OPTIONS" {
 		if jsonErr.(*rpcservice.RPCError).Code == rpcservice.ErrCodeMessage[rpcservice.RPCParseError].Code {
 			Logger.log.Errorf("RPC function process with err \n %+v", jsonErr)
-			httpServer.writeHTTPResponseHeaders(r, w.Header(), http.StatusBadRequest, buf)
+			httpServer.writeResponseHeaders(w, r, http.StatusBadRequest, buf)
 			httpServer.addBlackListClientRequestErrorPerHour(r, request.Method)
 			return
 		}
@@ -345,23 +376,105 @@ func (httpServer *HttpServer) ProcessRpcRequest(w http.ResponseWriter, r *http.R
 	}
 
 	// Write the response.
+	httpServer.writeRpcResponse(w, r, buf, msg)
+}
+
+// processJsonRequest calls the handler of the method of a request if the client is allowed to call it
+func (httpServer *HttpServer) processJsonRequest(r *http.Request, access *rpcAccess, request *JsonRequest, closeChan <-chan struct{}) (interface{}, error) {
+	// Check if the client is allowed to call the method
+	if !access.isAllowed(request.Method) {
+		ALogger.log.Warnf("RPC method %s denied to %s from %s", request.Method, access.name, getIP(r))
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidMethodPermissionError, errors.New("method "+request.Method+" is not allowed"))
+	}
+	if request.Method == downloadBackup {
+		// the backup is streamed over the hijacked connection
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidRequestError, errors.New("method "+request.Method+" can not be called in a batch or over HTTP/2"))
+	}
+
+	// Attempt to parse the JSON-RPC request into a known concrete
+	// command.
+	command := HttpHandler[request.Method]
+	if command == nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCMethodNotFoundError, errors.New("Method not found: "+request.Method))
+	}
+
+	// The request is answered when it is canceled or times out. A handler ignoring closeChan keeps running
+	// until it returns, its result is dropped, but it does not hold the response nor a batch worker.
+	type commandResult struct {
+		result interface{}
+		err    *rpcservice.RPCError
+	}
+	done := make(chan commandResult, 1)
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				Logger.log.Errorf("RPC method %s panics: %v", request.Method, err)
+				done <- commandResult{err: rpcservice.NewRPCError(rpcservice.RPCInternalError, fmt.Errorf("method %s panics: %v", request.Method, err))}
+			}
+		}()
+		result, err := command(httpServer, request.Params, closeChan)
+		done <- commandResult{result: result, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.result, res.err
+	case <-r.Context().Done():
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInternalError, fmt.Errorf("method %s is not processed: %v", request.Method, r.Context().Err()))
+	}
+}
+
+// writeResponseHeaders writes the response headers to the hijacked connection,
+// or by the response writer for HTTP/2
+func (httpServer *HttpServer) writeResponseHeaders(w http.ResponseWriter, r *http.Request, code int, buf io.Writer) error {
+	if r.ProtoMajor >= 2 {
+		w.WriteHeader(code)
+		return nil
+	}
+	return httpServer.writeHTTPResponseHeaders(r, w.Header(), code, buf)
+}
+
+// writeRpcResponse writes the marshalled response, it is gzip compressed if the client accepts it
+func (httpServer *HttpServer) writeRpcResponse(w http.ResponseWriter, r *http.Request, buf io.Writer, msg []byte) {
+	var out io.Writer = buf
+	var gzipWriter *gzip.Writer
+	if acceptGzip(r) && len(msg) >= rpcGzipMinLength {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+		gzipWriter = gzip.NewWriter(buf)
+		out = gzipWriter
+	}
 	// for testing only
 	// w.WriteHeader(http.StatusOK)
-	err = httpServer.writeHTTPResponseHeaders(r, w.Header(), http.StatusOK, buf)
+	err := httpServer.writeResponseHeaders(w, r, http.StatusOK, buf)
 	if err != nil {
 		Logger.log.Error(err)
 		return
 	}
-	if _, err := buf.Write(msg); err != nil {
+	if _, err := out.Write(msg); err != nil {
 		Logger.log.Errorf("Failed to write marshalled reply: %s", err.Error())
 		Logger.log.Error(err)
 	}
 
 	// Terminate with newline to maintain compatibility with coin Core.
-	if err := buf.WriteByte('\n'); err != nil {
+	if _, err := out.Write([]byte{'\n'}); err != nil {
 		Logger.log.Errorf("Failed to append terminating newline to reply: %s", err.Error())
 		Logger.log.Error(err)
 	}
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			Logger.log.Errorf("Failed to compress reply: %s", err.Error())
+		}
+	}
+}
+
+func acceptGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding = strings.TrimSpace(strings.Split(encoding, ";")[0])
+		if encoding == "gzip" {
+			return true
+		}
+	}
+	return false
 }
 
 func getIP(r *http.Request) string {
@@ -456,60 +569,92 @@ func (httpServer *HttpServer) addBlackListClientRequestErrorPerHour(r *http.Requ
 	}
 }
 
+// reachLimitRequestPerDay counts a request of the client, by its api key or by its remote address
+// if it has no api key, and returns true and the error message if it reaches the limit
+func (httpServer *HttpServer) reachLimitRequestPerDay(r *http.Request, access *rpcAccess) (bool, string) {
+	if access.limitByAddress {
+		// check limit request per day
+		if httpServer.checkLimitRequestPerDay(r) {
+			return true, "Reach limit request per day"
+		}
+	} else if access.requestPerDay > 0 {
+		// check limit request per day of the api key
+		if httpServer.checkAccessLimitRequestPerDay(access) {
+			return true, "Reach limit request per day of " + access.name
+		}
+	}
+	return false, ""
+}
+
 func (httpServer *HttpServer) checkLimitRequestPerDay(r *http.Request) bool {
 	if httpServer.config.RPCLimitRequestPerDay == 0 {
 		return false
 	}
 	remoteAddress := getIP(r)
-	remoteAddressKey := []byte(remoteAddress)
-	requestCountInByte, _ := httpServer.config.MemCache.Get(remoteAddressKey)
+	return httpServer.countRequestPerDay(remoteAddress, []byte(remoteAddress), httpServer.config.RPCLimitRequestPerDay)
+}
+
+// checkAccessLimitRequestPerDay limits the requests of an api key, whatever its remote address
+func (httpServer *HttpServer) checkAccessLimitRequestPerDay(access *rpcAccess) bool {
+	return httpServer.countRequestPerDay(access.name, append([]byte("rpc-acl-"), []byte(access.name)...), access.requestPerDay)
+}
+
+// countRequestPerDay counts a request of a client and returns true if it reaches the limit
+func (httpServer *HttpServer) countRequestPerDay(client string, key []byte, limit int) bool {
+	requestCountInByte, _ := httpServer.config.MemCache.Get(key)
 	//if err != nil {
-	//Logger.log.Info("Can not get limit request per day for %s err:%+v", remoteAddress, err)
+	//Logger.log.Info("Can not get limit request per day for %s err:%+v", client, err)
 	//}
 	reachLimit := false
 	if requestCountInByte != nil {
 		requestCount := common.BytesToInt(requestCountInByte)
 		requestCount += 1
-		if requestCount > httpServer.config.RPCLimitRequestPerDay {
+		if requestCount > limit {
 			reachLimit = true
 		}
 		requestCountInByte = common.IntToBytes(requestCount)
-		httpServer.config.MemCache.Put(remoteAddressKey, requestCountInByte)
+		httpServer.config.MemCache.Put(key, requestCountInByte)
 	} else {
 		requestCount := 1
 		requestCountInByte = common.IntToBytes(requestCount)
-		err := httpServer.config.MemCache.PutExpired(remoteAddressKey, requestCountInByte, 24*60*60*1000) // cache 1 day
+		err := httpServer.config.MemCache.PutExpired(key, requestCountInByte, 24*60*60*1000) // cache 1 day
 		if err != nil {
-			Logger.log.Error("Can not update limit request per day for %s err:%+v", remoteAddress, err)
+			Logger.log.Error("Can not update limit request per day for %s err:%+v", client, err)
 		}
 	}
 	return reachLimit
 }
 
-// checkAuth checks the HTTP Basic authentication supplied by a wallet
+// checkAuth checks the API key or the HTTP Basic authentication supplied by a wallet
 // or RPC client in the HTTP request r.  If the supplied authentication
-// does not match the username and password expected, a non-nil error is
+// does not match an API key or the username and password expected, a non-nil error is
 // returned.
 //
-// This check is time-constant.
+// The check of Basic authentication is time-constant.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false). The second is
-// always false if the first is.
-func (httpServer *HttpServer) checkAuth(r *http.Request, require bool) (bool, bool, error) {
+// The access return value is the methods the client is allowed to call, it is nil
+// if the authentication is not required and not supplied.
+func (httpServer *HttpServer) checkAuth(r *http.Request, require bool) (*rpcAccess, error) {
 	if httpServer.config.DisableAuth {
-		return true, true, nil
+		return noAuthRPCAccess, nil
+	}
+	if apiKey := r.Header.Get(apiKeyHeader); apiKey != "" && httpServer.acl != nil {
+		access := httpServer.acl.getAccess(apiKey)
+		if access == nil {
+			ALogger.log.Warnf("RPC api key authentication failure from %s", getIP(r))
+			return nil, rpcservice.NewRPCError(rpcservice.AuthFailError, nil)
+		}
+		return access, nil
 	}
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) <= 0 {
 		if require {
 			Logger.log.Warnf("RPC authentication failure from %s",
 				r.RemoteAddr)
-			return false, false, rpcservice.NewRPCError(rpcservice.AuthFailError, nil)
+			return nil, rpcservice.NewRPCError(rpcservice.AuthFailError, nil)
 		}
 
-		return false, false, nil
+		return nil, nil
 	}
 
 	authsha := common.HashB([]byte(authhdr[0]))
@@ -518,18 +663,18 @@ func (httpServer *HttpServer) checkAuth(r *http.Request, require bool) (bool, bo
 	// are probably expected to have a higher volume of calls
 	limitcmp := subtle.ConstantTimeCompare(authsha[:], httpServer.limitAuthSHA[:])
 	if limitcmp == 1 {
-		return true, true, nil
+		return httpServer.limitUserAccess, nil
 	}
 
 	// Check for admin-level auth
 	cmp := subtle.ConstantTimeCompare(authsha[:], httpServer.authSHA[:])
 	if cmp == 1 {
-		return true, false, nil
+		return httpServer.userAccess, nil
 	}
 
 	// JsonRequest's auth doesn't match either user
 	Logger.log.Warnf("RPC authentication failure from %s", r.RemoteAddr)
-	return false, false, rpcservice.NewRPCError(rpcservice.AuthFailError, nil)
+	return nil, rpcservice.NewRPCError(rpcservice.AuthFailError, nil)
 }
 
 // AuthFail sends a Message back to the client if the http auth is rejected.
@@ -0,0 +1,62 @@
+package rpcserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+func init() {
+	mustRegisterHttpHandlers(metadata.RewardFeature, map[string]httpHandler{
+		getAutoWithdrawRewardPolicy:                      (*HttpServer).handleGetAutoWithdrawRewardPolicy,
+		createAndSendAutoWithdrawRewardPolicyTransaction: (*HttpServer).handleCreateAndSendAutoWithdrawRewardPolicyTransaction,
+	})
+}
+
+// handleGetAutoWithdrawRewardPolicy - RPC get the auto withdraw policy of a reward receiver
+// param #1: payment address of the reward receiver
+func (httpServer *HttpServer) handleGetAutoWithdrawRewardPolicy(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	paymentAddress, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("payment address is invalid"))
+	}
+	result, err := httpServer.blockService.GetAutoWithdrawRewardPolicy(paymentAddress)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+// handleCreateAndSendAutoWithdrawRewardPolicyTransaction - RPC create and send auto withdraw reward policy tx to network,
+// the tx must be sent by the reward receiver and burn 0 PRV. Interval and Threshold both 0 removes the policy
+// param #5: {"PaymentAddress": "...", "Interval": 2, "Threshold": 1000000000}
+func (httpServer *HttpServer) handleCreateAndSendAutoWithdrawRewardPolicyTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		paymentAddress, ok := data["PaymentAddress"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Payment Address %+v", data["PaymentAddress"])
+		}
+		interval := float64(0)
+		if data["Interval"] != nil {
+			interval, ok = data["Interval"].(float64)
+			if !ok || interval < 0 {
+				return nil, fmt.Errorf("Invalid Interval %+v", data["Interval"])
+			}
+		}
+		threshold := float64(0)
+		if data["Threshold"] != nil {
+			threshold, ok = data["Threshold"].(float64)
+			if !ok || threshold < 0 {
+				return nil, fmt.Errorf("Invalid Threshold %+v", data["Threshold"])
+			}
+		}
+		return metadata.NewAutoWithdrawRewardPolicy(metadata.AutoWithdrawRewardPolicyMeta, paymentAddress, uint64(interval), uint64(threshold))
+	})
+}
@@ -12,6 +12,22 @@ import (
 	"github.com/pkg/errors"
 )
 
+func init() {
+	mustRegisterHttpHandlers(metadata.BridgeFeature, map[string]httpHandler{
+		createIssuingRequest:              (*HttpServer).handleCreateIssuingRequest,
+		sendIssuingRequest:                (*HttpServer).handleSendIssuingRequest,
+		createAndSendIssuingRequest:       (*HttpServer).handleCreateAndSendIssuingRequest,
+		createAndSendIssuingRequestV2:     (*HttpServer).handleCreateAndSendIssuingRequestV2,
+		createAndSendContractingRequest:   (*HttpServer).handleCreateAndSendContractingRequest,
+		createAndSendContractingRequestV2: (*HttpServer).handleCreateAndSendContractingRequestV2,
+		checkETHHashIssued:                (*HttpServer).handleCheckETHHashIssued,
+		getAllBridgeTokens:                (*HttpServer).handleGetAllBridgeTokens,
+		getETHHeaderByHash:                (*HttpServer).handleGetETHHeaderByHash,
+		getBridgeReqWithStatus:            (*HttpServer).handleGetBridgeReqWithStatus,
+		generateTokenID:                   (*HttpServer).handleGenerateTokenID,
+	})
+}
+
 func (httpServer *HttpServer) handleCreateIssuingRequest(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
 	constructor := metaConstructors[createAndSendIssuingRequest]
 	return httpServer.createRawTxWithMetadata(params, closeChan, constructor)
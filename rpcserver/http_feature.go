@@ -0,0 +1,27 @@
+package rpcserver
+
+import (
+	"errors"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+// handleListFeatures - RPC list the features with their activation height and status at the beacon best state
+// param #1: status (active, upcoming), "" for all
+func (httpServer *HttpServer) handleListFeatures(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	status := ""
+	if len(arrayParams) > 0 {
+		tmp, ok := arrayParams[0].(string)
+		if !ok {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("status is invalid"))
+		}
+		status = tmp
+	}
+	result, err := httpServer.blockService.ListFeatures(status)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	return result, nil
+}
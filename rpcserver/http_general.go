@@ -2,7 +2,10 @@ package rpcserver
 
 import (
 	"errors"
+	"time"
+
 	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/peerv2"
 	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
 )
@@ -169,3 +172,58 @@ func (httpServer *HttpServer) handleGenerateTokenID(params interface{}, closeCha
 		return tokenID.String(), nil
 	}
 }
+
+/*
+handleGetPeerScores - return reputation of all known peers, highest score first
+*/
+func (httpServer *HttpServer) handleGetPeerScores(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	result, err := httpServer.networkService.GetPeerScores()
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+/*
+handleBanPeer - ban a peer, param #1: peer ID, param #2 (optional): ban duration in seconds
+*/
+func (httpServer *HttpServer) handleBanPeer(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Expected peer ID"))
+	}
+	peerID, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Expected peer ID string value"))
+	}
+	duration := peerv2.AutoBanDuration
+	if len(arrayParams) > 1 {
+		seconds, ok := arrayParams[1].(float64)
+		if !ok || seconds <= 0 {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Expected positive ban duration in seconds"))
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	if err := httpServer.networkService.BanPeer(peerID, duration); err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return true, nil
+}
+
+/*
+handleUnbanPeer - lift the ban of a peer, param #1: peer ID
+*/
+func (httpServer *HttpServer) handleUnbanPeer(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Expected peer ID"))
+	}
+	peerID, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Expected peer ID string value"))
+	}
+	if err := httpServer.networkService.UnbanPeer(peerID); err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return true, nil
+}
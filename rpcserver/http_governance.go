@@ -0,0 +1,120 @@
+package rpcserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+func init() {
+	mustRegisterHttpHandlers(metadata.GovernanceFeature, map[string]httpHandler{
+		getGovernanceParams:                         (*HttpServer).handleGetGovernanceParams,
+		getGovernanceProposal:                       (*HttpServer).handleGetGovernanceProposal,
+		listGovernanceProposals:                     (*HttpServer).handleListGovernanceProposals,
+		createAndSendParamChangeProposalTransaction: (*HttpServer).handleCreateAndSendParamChangeProposalTransaction,
+		createAndSendParamChangeVoteTransaction:     (*HttpServer).handleCreateAndSendParamChangeVoteTransaction,
+	})
+}
+
+// handleGetGovernanceParams - RPC get the governable chain params with their default and current values
+func (httpServer *HttpServer) handleGetGovernanceParams(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	result, err := httpServer.blockService.GetGovernanceParams()
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+// handleGetGovernanceProposal - RPC get a param change proposal with its votes
+// param #1: proposal id, the hash of the proposal tx
+func (httpServer *HttpServer) handleGetGovernanceProposal(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	proposalID, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("proposal id is invalid"))
+	}
+	result, err := httpServer.blockService.GetGovernanceProposal(proposalID)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+// handleListGovernanceProposals - RPC list the param change proposals
+// param #1: status (voting, approved, declined, activated), "" for all
+func (httpServer *HttpServer) handleListGovernanceProposals(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	status := ""
+	if len(arrayParams) > 0 {
+		tmp, ok := arrayParams[0].(string)
+		if !ok {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("status is invalid"))
+		}
+		status = tmp
+	}
+	result, err := httpServer.blockService.ListGovernanceProposals(status)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+// handleCreateAndSendParamChangeProposalTransaction - RPC create and send param change proposal tx to network,
+// the tx must be sent by the funder of the staking tx of the proposer and burn 0 PRV
+// param #5: {"CommitteePublicKey": "...", "Params": {"UnbondingPeriod": 10}, "ActivationHeight": 1000}
+func (httpServer *HttpServer) handleCreateAndSendParamChangeProposalTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		committeePublicKey, ok := data["CommitteePublicKey"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Committee Public Key %+v", data["CommitteePublicKey"])
+		}
+		tmpParams, ok := data["Params"].(map[string]interface{})
+		if !ok || len(tmpParams) == 0 {
+			return nil, fmt.Errorf("Invalid Params %+v", data["Params"])
+		}
+		proposalParams := make(map[string]uint64)
+		for name, tmpValue := range tmpParams {
+			value, ok := tmpValue.(float64)
+			if !ok || value < 0 {
+				return nil, fmt.Errorf("Invalid Value %+v of Param %+v", tmpValue, name)
+			}
+			proposalParams[name] = uint64(value)
+		}
+		activationHeight, ok := data["ActivationHeight"].(float64)
+		if !ok || activationHeight <= 0 {
+			return nil, fmt.Errorf("Invalid Activation Height %+v", data["ActivationHeight"])
+		}
+		return metadata.NewParamChangeProposal(metadata.ParamChangeProposalMeta, committeePublicKey, proposalParams, uint64(activationHeight))
+	})
+}
+
+// handleCreateAndSendParamChangeVoteTransaction - RPC create and send param change vote tx to network,
+// the tx must be sent by the funder of the staking tx of the voter and burn 0 PRV
+// param #5: {"CommitteePublicKey": "...", "ProposalID": "...", "Approve": true}
+func (httpServer *HttpServer) handleCreateAndSendParamChangeVoteTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		committeePublicKey, ok := data["CommitteePublicKey"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Committee Public Key %+v", data["CommitteePublicKey"])
+		}
+		tmpProposalID, ok := data["ProposalID"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Proposal ID %+v", data["ProposalID"])
+		}
+		proposalID, err := common.Hash{}.NewHashFromStr(tmpProposalID)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid Proposal ID %+v", data["ProposalID"])
+		}
+		approve, ok := data["Approve"].(bool)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Approve %+v", data["Approve"])
+		}
+		return metadata.NewParamChangeVote(metadata.ParamChangeVoteMeta, committeePublicKey, *proposalID, approve)
+	})
+}
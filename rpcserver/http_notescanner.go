@@ -0,0 +1,118 @@
+package rpcserver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/notescanner"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+// getScanKeyParam parses the payload of note scanner RPCs, the first param is an object with "Key"
+// (read-only key or private key registered to the note scanner)
+func getScanKeyParam(params interface{}) (map[string]interface{}, string, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if len(arrayParams) < 1 {
+		return nil, "", rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	data, ok := arrayParams[0].(map[string]interface{})
+	if !ok {
+		return nil, "", rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("payload data is invalid"))
+	}
+	key, ok := data["Key"].(string)
+	if !ok || key == "" {
+		return nil, "", rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Key is invalid"))
+	}
+	return data, key, nil
+}
+
+// getPageParam parses "Offset" (default 0) and "Limit" (default and max notescanner.MaxPageSize) of a payload
+func getPageParam(data map[string]interface{}) (int, int, *rpcservice.RPCError) {
+	offset, err := getHeightParam(data["Offset"])
+	if err != nil {
+		return 0, 0, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Offset is invalid, error %+v", err))
+	}
+	limit, err := getHeightParam(data["Limit"])
+	if err != nil || limit > notescanner.MaxPageSize {
+		return 0, 0, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Limit must be in [1, %+v]", notescanner.MaxPageSize))
+	}
+	if limit == 0 {
+		limit = notescanner.MaxPageSize
+	}
+	if offset > math.MaxInt32 {
+		return 0, 0, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Offset is invalid"))
+	}
+	return int(offset), int(limit), nil
+}
+
+/*
+handleRegisterScanKey registers a read-only key or private key to the note scanner,
+its shard is scanned from "StartHeight" (default 1). Spending of coins is tracked only when the private key is registered
+*/
+func (httpServer *HttpServer) handleRegisterScanKey(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	data, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	startHeight, err := getHeightParam(data["StartHeight"])
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	return httpServer.noteScannerService.RegisterScanKey(key, startHeight)
+}
+
+// handleUnregisterScanKey removes a key and its scanned data from the note scanner
+func (httpServer *HttpServer) handleUnregisterScanKey(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	_, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if rpcErr := httpServer.noteScannerService.UnregisterScanKey(key); rpcErr != nil {
+		return nil, rpcErr
+	}
+	return true, nil
+}
+
+func (httpServer *HttpServer) handleGetScannedBalance(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	_, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return httpServer.noteScannerService.GetScannedBalance(key)
+}
+
+// handleListScannedOutputCoins lists a page of scanned coins, filtered by "TokenID" (all tokens if empty) and "UnspentOnly"
+func (httpServer *HttpServer) handleListScannedOutputCoins(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	data, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	offset, limit, rpcErr := getPageParam(data)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	var tokenID *common.Hash
+	if tokenIDStr, ok := data["TokenID"].(string); ok && tokenIDStr != "" {
+		var err error
+		tokenID, err = common.Hash{}.NewHashFromStr(tokenIDStr)
+		if err != nil {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+		}
+	}
+	unspentOnly, _ := data["UnspentOnly"].(bool)
+	return httpServer.noteScannerService.ListScannedOutputCoins(key, tokenID, unspentOnly, offset, limit)
+}
+
+func (httpServer *HttpServer) handleGetScannedHistory(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	data, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	offset, limit, rpcErr := getPageParam(data)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return httpServer.noteScannerService.GetScannedHistory(key, offset, limit)
+}
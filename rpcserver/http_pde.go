@@ -22,6 +22,30 @@ import (
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
 )
 
+func init() {
+	mustRegisterHttpHandlers(metadata.PDEFeature, map[string]httpHandler{
+		getPDEState:                                (*HttpServer).handleGetPDEState,
+		createAndSendTxWithWithdrawalReq:           (*HttpServer).handleCreateAndSendTxWithWithdrawalReq,
+		createAndSendTxWithWithdrawalReqV2:         (*HttpServer).handleCreateAndSendTxWithWithdrawalReqV2,
+		createAndSendTxWithPDEFeeWithdrawalReq:     (*HttpServer).handleCreateAndSendTxWithPDEFeeWithdrawalReq,
+		createAndSendTxWithPTokenTradeReq:          (*HttpServer).handleCreateAndSendTxWithPTokenTradeReq,
+		createAndSendTxWithPTokenCrossPoolTradeReq: (*HttpServer).handleCreateAndSendTxWithPTokenCrossPoolTradeReq,
+		createAndSendTxWithPRVTradeReq:             (*HttpServer).handleCreateAndSendTxWithPRVTradeReq,
+		createAndSendTxWithPRVCrossPoolTradeReq:    (*HttpServer).handleCreateAndSendTxWithPRVCrossPoolTradeReq,
+		createAndSendTxWithPTokenContribution:      (*HttpServer).handleCreateAndSendTxWithPTokenContribution,
+		createAndSendTxWithPRVContribution:         (*HttpServer).handleCreateAndSendTxWithPRVContribution,
+		createAndSendTxWithPTokenContributionV2:    (*HttpServer).handleCreateAndSendTxWithPTokenContributionV2,
+		createAndSendTxWithPRVContributionV2:       (*HttpServer).handleCreateAndSendTxWithPRVContributionV2,
+		getPDEContributionStatus:                   (*HttpServer).handleGetPDEContributionStatus,
+		getPDEContributionStatusV2:                 (*HttpServer).handleGetPDEContributionStatusV2,
+		getPDETradeStatus:                          (*HttpServer).handleGetPDETradeStatus,
+		getPDEWithdrawalStatus:                     (*HttpServer).handleGetPDEWithdrawalStatus,
+		getPDEFeeWithdrawalStatus:                  (*HttpServer).handleGetPDEFeeWithdrawalStatus,
+		convertPDEPrices:                           (*HttpServer).handleConvertPDEPrices,
+		extractPDEInstsFromBeaconBlock:             (*HttpServer).handleExtractPDEInstsFromBeaconBlock,
+	})
+}
+
 type PDEWithdrawal struct {
 	WithdrawalTokenIDStr string
 	WithdrawerAddressStr string
@@ -509,35 +533,15 @@ func (httpServer *HttpServer) handleGetPDEState(params interface{}, closeChan <-
 	if !ok {
 		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Payload data is invalid"))
 	}
-	beaconHeight, ok := data["BeaconHeight"].(float64)
-	if !ok {
-		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Beacon height is invalid"))
-	}
-	beaconFeatureStateRootHash, err := httpServer.config.BlockChain.GetBeaconFeatureRootHash(httpServer.config.BlockChain.GetBeaconBestState(), uint64(beaconHeight))
+	beaconHeight, err := httpServer.getBeaconHeightParam(data)
 	if err != nil {
-		return nil, rpcservice.NewRPCError(rpcservice.GetPDEStateError, fmt.Errorf("Can't found ConsensusStateRootHash of beacon height %+v, error %+v", beaconHeight, err))
-	}
-	beaconFeatureStateDB, err := statedb.NewWithPrefixTrie(beaconFeatureStateRootHash, statedb.NewDatabaseAccessWarper(httpServer.GetBeaconChainDatabase()))
-	if err != nil {
-		return nil, rpcservice.NewRPCError(rpcservice.GetPDEStateError, err)
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Beacon height is invalid, error %+v", err))
 	}
-	pdeState, err := blockchain.InitCurrentPDEStateFromDB(beaconFeatureStateDB, uint64(beaconHeight))
-	if err != nil {
-		return nil, rpcservice.NewRPCError(rpcservice.GetPDEStateError, err)
-	}
-	beaconBlocks, err := httpServer.config.BlockChain.GetBeaconBlockByHeight(uint64(beaconHeight))
-	if err != nil {
-		return nil, rpcservice.NewRPCError(rpcservice.GetPDEStateError, err)
-	}
-	beaconBlock := beaconBlocks[0]
-	result := jsonresult.CurrentPDEState{
-		BeaconTimeStamp:         beaconBlock.Header.Timestamp,
-		PDEPoolPairs:            pdeState.PDEPoolPairs,
-		PDEShares:               pdeState.PDEShares,
-		WaitingPDEContributions: pdeState.WaitingPDEContributions,
-		PDETradingFees:          pdeState.PDETradingFees,
+	result, rpcErr := httpServer.blockService.GetPDEState(beaconHeight)
+	if rpcErr != nil {
+		return nil, rpcErr
 	}
-	return result, nil
+	return *result, nil
 }
 
 func (httpServer *HttpServer) handleConvertNativeTokenToPrivacyToken(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
@@ -14,6 +14,18 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+func init() {
+	mustRegisterHttpHandlers(metadata.RelayingFeature, map[string]httpHandler{
+		createAndSendTxWithRelayingBNBHeader: (*HttpServer).handleCreateAndSendTxWithRelayingBNBHeader,
+		createAndSendTxWithRelayingBTCHeader: (*HttpServer).handleCreateAndSendTxWithRelayingBTCHeader,
+		getRelayingBNBHeaderState:            (*HttpServer).handleGetRelayingBNBHeaderState,
+		getRelayingBNBHeaderByBlockHeight:    (*HttpServer).handleGetRelayingBNBHeaderByBlockHeight,
+		getBTCRelayingBestState:              (*HttpServer).handleGetBTCRelayingBestState,
+		getBTCBlockByHash:                    (*HttpServer).handleGetBTCBlockByHash,
+		getLatestBNBHeaderBlockHeight:        (*HttpServer).handleGetLatestBNBHeaderBlockHeight,
+	})
+}
+
 func (httpServer *HttpServer) handleCreateRawTxWithRelayingBTCHeader(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
 	return httpServer.handleCreateRawTxWithRelayingHeader(
 		metadata.RelayingBTCHeaderMeta,
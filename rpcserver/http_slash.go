@@ -1,6 +1,14 @@
 package rpcserver
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/rpcserver/bean"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
 )
 
@@ -62,3 +70,88 @@ func (httpServer *HttpServer) handleGetProducersBlackListDetail(params interface
 
 	return nil, nil
 }
+
+func (httpServer *HttpServer) handleGetEquivocationEvidences(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	result := []jsonresult.EquivocationEvidenceResult{}
+	for _, evidence := range httpServer.config.ConsensusEngine.GetEquivocationEvidences() {
+		result = append(result, jsonresult.EquivocationEvidenceResult{
+			Hash:     evidence.Hash().String(),
+			Evidence: evidence,
+		})
+	}
+	return result, nil
+}
+
+// handleCreateRawSlashEquivocationTransaction - RPC create slash equivocation tx from an evidence collected by this node
+// param #5: {"EvidenceHash": "..."}
+func (httpServer *HttpServer) handleCreateRawSlashEquivocationTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	paramsArray := common.InterfaceSlice(params)
+	if paramsArray == nil || len(paramsArray) < 5 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 5 element"))
+	}
+
+	createRawTxParam, errNewParam := bean.NewCreateRawTxParam(params)
+	if errNewParam != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errNewParam)
+	}
+
+	data, ok := paramsArray[4].(map[string]interface{})
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Invalid Data For Slash Equivocation Transaction %+v", paramsArray[4]))
+	}
+	evidenceHash, ok := data["EvidenceHash"].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Invalid Evidence Hash %+v", data["EvidenceHash"]))
+	}
+	var evidence *metadata.EquivocationEvidence
+	for _, e := range httpServer.config.ConsensusEngine.GetEquivocationEvidences() {
+		if e.Hash().String() == evidenceHash {
+			temp := e
+			evidence = &temp
+			break
+		}
+	}
+	if evidence == nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Evidence %+v not found", evidenceHash))
+	}
+
+	slashMetadata, err := metadata.NewSlashEquivocationRequest(metadata.SlashEquivocationRequestMeta, *evidence)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	txID, txBytes, txShardID, err := httpServer.txService.CreateRawTransaction(createRawTxParam, slashMetadata)
+	if err.(*rpcservice.RPCError) != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.CreateTxDataError, err)
+	}
+
+	result := jsonresult.CreateTransactionResult{
+		TxID:            txID.String(),
+		Base58CheckData: base58.Base58Check{}.Encode(txBytes, common.ZeroByte),
+		ShardID:         txShardID,
+	}
+	return result, nil
+}
+
+// handleCreateAndSendSlashEquivocationTransaction - RPC create and send slash equivocation tx to network,
+// the evidence is removed from the local pool once the tx is sent
+func (httpServer *HttpServer) handleCreateAndSendSlashEquivocationTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	var err error
+	data, err := httpServer.handleCreateRawSlashEquivocationTransaction(params, closeChan)
+	if err.(*rpcservice.RPCError) != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.CreateTxDataError, err)
+	}
+	tx := data.(jsonresult.CreateTransactionResult)
+	base58CheckData := tx.Base58CheckData
+
+	newParam := make([]interface{}, 0)
+	newParam = append(newParam, base58CheckData)
+	sendResult, err := httpServer.handleSendRawTransaction(newParam, closeChan)
+	if err.(*rpcservice.RPCError) != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.SendTxDataError, err)
+	}
+	paramsArray := common.InterfaceSlice(params)
+	evidenceHash := paramsArray[4].(map[string]interface{})["EvidenceHash"].(string)
+	httpServer.config.ConsensusEngine.RemoveEquivocationEvidence(evidenceHash)
+	result := jsonresult.NewCreateTransactionResult(nil, sendResult.(jsonresult.CreateTransactionResult).TxID, nil, tx.ShardID)
+	return result, nil
+}
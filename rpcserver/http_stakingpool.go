@@ -0,0 +1,107 @@
+package rpcserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/common/base58"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/rpcserver/bean"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+func init() {
+	mustRegisterHttpHandlers(metadata.StakingFeature, map[string]httpHandler{
+		getStakingPool: (*HttpServer).handleGetStakingPool,
+		createAndSendStakingPoolRegisterTransaction: (*HttpServer).handleCreateAndSendStakingPoolRegisterTransaction,
+		createAndSendStakingPoolDelegateTransaction: (*HttpServer).handleCreateAndSendStakingPoolDelegateTransaction,
+	})
+}
+
+// handleGetStakingPool - RPC get the staking pool of a validator and its delegations
+// param #1: committee public key
+func (httpServer *HttpServer) handleGetStakingPool(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	committeePublicKey, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("committee public key is invalid"))
+	}
+	result, err := httpServer.blockService.GetStakingPool(committeePublicKey)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+func (httpServer *HttpServer) createAndSendStakingPoolTransaction(params interface{}, closeChan <-chan struct{}, buildMetadata func(data map[string]interface{}) (metadata.Metadata, error)) (interface{}, *rpcservice.RPCError) {
+	paramsArray := common.InterfaceSlice(params)
+	if paramsArray == nil || len(paramsArray) < 5 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 5 element"))
+	}
+
+	createRawTxParam, errNewParam := bean.NewCreateRawTxParam(params)
+	if errNewParam != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errNewParam)
+	}
+
+	data, ok := paramsArray[4].(map[string]interface{})
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Invalid Data For Staking Pool Transaction %+v", paramsArray[4]))
+	}
+	meta, err := buildMetadata(data)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	txID, txBytes, txShardID, err1 := httpServer.txService.CreateRawTransaction(createRawTxParam, meta)
+	if err1 != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.CreateTxDataError, err1)
+	}
+
+	newParam := make([]interface{}, 0)
+	newParam = append(newParam, base58.Base58Check{}.Encode(txBytes, common.ZeroByte))
+	_, err1 = httpServer.handleSendRawTransaction(newParam, closeChan)
+	if err1 != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.SendTxDataError, err1)
+	}
+	result := jsonresult.NewCreateTransactionResult(nil, txID.String(), nil, txShardID)
+	return result, nil
+}
+
+// handleCreateAndSendStakingPoolRegisterTransaction - RPC create and send staking pool register tx to network,
+// the tx must be sent by the funder of the staking tx of the validator and burn 0 PRV
+// param #5: {"CommitteePublicKey": "...", "Commission": 500}
+func (httpServer *HttpServer) handleCreateAndSendStakingPoolRegisterTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		committeePublicKey, ok := data["CommitteePublicKey"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Committee Public Key %+v", data["CommitteePublicKey"])
+		}
+		commission, ok := data["Commission"].(float64)
+		if !ok || commission < 0 {
+			return nil, fmt.Errorf("Invalid Commission %+v", data["Commission"])
+		}
+		return metadata.NewStakingPoolRegister(metadata.StakingPoolRegisterMeta, committeePublicKey, uint64(commission))
+	})
+}
+
+// handleCreateAndSendStakingPoolDelegateTransaction - RPC create and send staking pool delegate tx to network,
+// the delegated amount is the PRV amount burnt by the tx
+// param #5: {"CommitteePublicKey": "...", "DelegatorPaymentAddress": "..."}
+func (httpServer *HttpServer) handleCreateAndSendStakingPoolDelegateTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		committeePublicKey, ok := data["CommitteePublicKey"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Committee Public Key %+v", data["CommitteePublicKey"])
+		}
+		delegatorPaymentAddress, ok := data["DelegatorPaymentAddress"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Delegator Payment Address %+v", data["DelegatorPaymentAddress"])
+		}
+		return metadata.NewStakingPoolDelegate(metadata.StakingPoolDelegateMeta, committeePublicKey, delegatorPaymentAddress)
+	})
+}
@@ -104,6 +104,7 @@ var _ = func() (_ struct{}) {
 	rpcConfig.DisableAuth = true
 	httpServer.config = *rpcConfig
 	Logger.Init(common.NewBackend(nil).Logger("test", true))
+	ALogger.Init(common.NewBackend(nil).Logger("test", true))
 	return
 }()
 
@@ -216,7 +217,7 @@ func TestHttpServerCheckAuth(t *testing.T) {
 	}
 	ResetHttpServer()
 	// disable auth
-	if ok, isLimitUser, err := httpServer.checkAuth(r, true); !(err == nil && ok && isLimitUser) {
+	if access, err := httpServer.checkAuth(r, true); !(err == nil && access == noAuthRPCAccess) {
 		t.Fatal("Expect no error because diable auth")
 	}
 	httpServer.Init(rpcConfig)
@@ -224,27 +225,27 @@ func TestHttpServerCheckAuth(t *testing.T) {
 	limitLogin := limitUser + ":" + limitPass
 	login := user + ":" + pass
 	r.Header["Authorization"] = []string{"Basic " + base64.StdEncoding.EncodeToString([]byte(limitLogin))}
-	if ok, isLimitUser, err := httpServer.checkAuth(r, true); !(err == nil && ok && isLimitUser) {
-		t.Fatal("Expect no error, pass auth and limited user", err, ok, isLimitUser)
+	if access, err := httpServer.checkAuth(r, true); !(err == nil && access == httpServer.limitUserAccess) {
+		t.Fatal("Expect no error, pass auth and limited user", err, access)
 	}
 	r.Header["Authorization"] = []string{"Basic " + base64.StdEncoding.EncodeToString([]byte(login))}
-	if ok, isLimitUser, err := httpServer.checkAuth(r, true); !(err == nil && ok && !isLimitUser) {
-		t.Fatal("Expect no error, pass auth and limited user", err, ok, isLimitUser)
+	if access, err := httpServer.checkAuth(r, true); !(err == nil && access == httpServer.userAccess) {
+		t.Fatal("Expect no error, pass auth and user", err, access)
 	}
 	r.Header["Authorization"] = []string{}
-	if ok, isLimitUser, err := httpServer.checkAuth(r, true); !(err != nil && !ok && !isLimitUser) {
-		t.Fatal("Expect no error, pass auth and limited user", err, ok, isLimitUser)
+	if access, err := httpServer.checkAuth(r, true); !(err != nil && access == nil) {
+		t.Fatal("Expect error and no access", err, access)
 	} else {
 		if err.(*rpcservice.RPCError).Code != rpcservice.ErrCodeMessage[rpcservice.AuthFailError].Code {
 			t.Fatalf("Expect %+v but get %+v", rpcservice.AuthFailError, err)
 		}
 	}
-	if ok, isLimitUser, err := httpServer.checkAuth(r, false); !(err == nil && !ok && !isLimitUser) {
-		t.Fatal("Expect no error, pass auth and limited user", err, ok, isLimitUser)
+	if access, err := httpServer.checkAuth(r, false); !(err == nil && access == nil) {
+		t.Fatal("Expect no error and no access", err, access)
 	}
 	r.Header["Authorization"] = []string{wrongUser + ":" + wrongPass}
-	if ok, isLimitUser, err := httpServer.checkAuth(r, true); !(err != nil && !ok && !isLimitUser) {
-		t.Fatal("Expect no error, pass auth and limited user", err, ok, isLimitUser)
+	if access, err := httpServer.checkAuth(r, true); !(err != nil && access == nil) {
+		t.Fatal("Expect error and no access", err, access)
 	} else {
 		if err.(*rpcservice.RPCError).Code != rpcservice.ErrCodeMessage[rpcservice.AuthFailError].Code {
 			t.Fatalf("Expect %+v but get %+v", rpcservice.AuthFailError, err)
@@ -268,21 +269,21 @@ func TestHttpServerProcessRpcRequest(t *testing.T) {
 		ContentLength: int64(len(testRPCBodyBytes)),
 	}
 	r.Header.Set("content-type", "json")
-	httpServer.ProcessRpcRequest(w, r, false)
+	httpServer.ProcessRpcRequest(w, r, httpServer.userAccess)
 	if w.Code != http.StatusBadRequest {
 		t.Fatalf("Expect code %+v but get %+v", http.StatusBadRequest, w.Code)
 	}
 	w = httptest.NewRecorder()
 	r.Body = ioutil.NopCloser(strings.NewReader(testRpcServerString))
 	// not hijack connection
-	httpServer.ProcessRpcRequest(w, r, false)
+	httpServer.ProcessRpcRequest(w, r, httpServer.userAccess)
 	if w.Code != http.StatusInternalServerError {
 		t.Fatalf("Expect code %+v but get %+v", http.StatusInternalServerError, w.Code)
 	}
 	hijackW := NewHijackerResponse()
 	//server start => can dial connection => return connection and no error
 	r.Body = ioutil.NopCloser(strings.NewReader(testRpcServerString))
-	httpServer.ProcessRpcRequest(hijackW, r, false)
+	httpServer.ProcessRpcRequest(hijackW, r, httpServer.userAccess)
 	if hijackW.Code == http.StatusBadRequest || hijackW.Code == http.StatusInternalServerError {
 		t.Fatalf("Expect no bad status")
 	}
@@ -290,7 +291,7 @@ func TestHttpServerProcessRpcRequest(t *testing.T) {
 	httpServer.shutdown = 0
 	httpServer.started = 0
 	hijackW = NewHijackerResponse()
-	httpServer.ProcessRpcRequest(hijackW, r, false)
+	httpServer.ProcessRpcRequest(hijackW, r, httpServer.userAccess)
 	// no server => can not dial => no connection
 	if hijackW.Code != http.StatusInternalServerError {
 		t.Fatalf("Expect code %+v but get %+v", http.StatusInternalServerError, w.Code)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/incognitochain/incognito-chain/blockchain"
 	"github.com/incognitochain/incognito-chain/common"
 	"github.com/incognitochain/incognito-chain/common/base58"
 	"github.com/incognitochain/incognito-chain/incognitokey"
@@ -287,6 +288,109 @@ func (httpServer *HttpServer) handleGetTransactionByReceiverV2(params interface{
 	return result, nil
 }
 
+// handleGetTransactionHistory returns a page of transactions of a payment address, newest first in each shard.
+// Payload: PaymentAddress, Direction ("in", "out" or empty for both), TokenID, MetadataType,
+// FromHeight and ToHeight (heights of the shard of transactions), ShardID (all shards if not set), Cursor (NextCursor of previous page), Limit
+func (httpServer *HttpServer) handleGetTransactionHistory(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	data, ok := arrayParams[0].(map[string]interface{})
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("payload data is invalid"))
+	}
+
+	paymentAddressStr, ok := data["PaymentAddress"].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("PaymentAddress is invalid"))
+	}
+	paymentAddress, err := wallet.Base58CheckDeserialize(paymentAddressStr)
+	if err != nil || len(paymentAddress.KeySet.PaymentAddress.Pk) == 0 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("PaymentAddress is invalid, error %+v", err))
+	}
+	return httpServer.getTransactionHistory(data, paymentAddress.KeySet.PaymentAddress.Pk, nil)
+}
+
+// handleGetScannedTransactionHistory returns a page of transactions of a private key registered to the note scanner,
+// like handleGetTransactionHistory with "Key" instead of PaymentAddress (read-only key of the account also works).
+// Transactions the key sends with privacy are listed as out from its scanned blocks
+func (httpServer *HttpServer) handleGetScannedTransactionHistory(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	data, key, rpcErr := getScanKeyParam(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	publicKey, shardID, source, rpcErr := httpServer.noteScannerService.GetSpentTxHistorySource(key)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return httpServer.getTransactionHistory(data, publicKey, map[byte]blockchain.TxHistorySource{shardID: source})
+}
+
+// getTransactionHistory parses filters and page of a tx history payload and returns the page of a public key
+func (httpServer *HttpServer) getTransactionHistory(data map[string]interface{}, publicKey []byte, sources map[byte]blockchain.TxHistorySource) (interface{}, *rpcservice.RPCError) {
+	var err error
+	direction, _ := data["Direction"].(string)
+	var tokenID *common.Hash
+	if tokenIDStr, ok := data["TokenID"].(string); ok && tokenIDStr != "" {
+		tokenID, err = common.Hash{}.NewHashFromStr(tokenIDStr)
+		if err != nil {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("TokenID is invalid"))
+		}
+	}
+	var metadataType *int
+	if metadataTypeParam, ok := data["MetadataType"].(float64); ok {
+		value := int(metadataTypeParam)
+		metadataType = &value
+	}
+	fromHeight, err := getHeightParam(data["FromHeight"])
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	toHeight, err := getHeightParam(data["ToHeight"])
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+	}
+	if toHeight > 0 && fromHeight > toHeight {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("FromHeight is greater than ToHeight"))
+	}
+
+	shardIDs := []byte{}
+	if shardIDParam, ok := data["ShardID"].(float64); ok {
+		if shardIDParam < 0 || int(shardIDParam) >= common.MaxShardNumber {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("ShardID is invalid"))
+		}
+		shardIDs = append(shardIDs, byte(shardIDParam))
+	} else {
+		for shardID := 0; shardID < httpServer.config.BlockChain.GetBeaconBestState().ActiveShards; shardID++ {
+			shardIDs = append(shardIDs, byte(shardID))
+		}
+	}
+
+	cursor, _ := data["Cursor"].(string)
+	limit, err := getHeightParam(data["Limit"])
+	if err != nil || limit > maxTransactionHistoryLimit {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Limit must be in [1, %+v]", maxTransactionHistoryLimit))
+	}
+	if limit == 0 {
+		limit = maxTransactionHistoryLimit
+	}
+	return httpServer.txService.GetTransactionHistory(publicKey, shardIDs, direction, tokenID, metadataType, fromHeight, toHeight, cursor, int(limit), sources)
+}
+
+// handleGetTransactionReceipt returns the receipt of a metadata transaction: status, amounts, refunded amounts and response transactions
+func (httpServer *HttpServer) handleGetTransactionReceipt(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if arrayParams == nil || len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	}
+	txHashStr, ok := arrayParams[0].(string)
+	if !ok {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Tx hash is invalid"))
+	}
+	return httpServer.txService.GetTransactionReceipt(txHashStr)
+}
+
 // Get transaction by Hash
 func (httpServer *HttpServer) handleGetTransactionByHash(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
 	arrayParams := common.InterfaceSlice(params)
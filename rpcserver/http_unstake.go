@@ -0,0 +1,102 @@
+package rpcserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+func init() {
+	mustRegisterHttpHandlers(metadata.StakingFeature, map[string]httpHandler{
+		getUnbondingStatus:              (*HttpServer).handleGetUnbondingStatus,
+		createAndSendUnstakeTransaction: (*HttpServer).handleCreateAndSendUnstakeTransaction,
+	})
+}
+
+// handleGetUnbondingStatus - RPC get the unbonding stakes waiting to be released
+// param #1: committee public key, "" for all
+// param #2: receiver payment address, "" for all
+func (httpServer *HttpServer) handleGetUnbondingStatus(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	committeePublicKey := ""
+	paymentAddress := ""
+	if len(arrayParams) > 0 {
+		tmp, ok := arrayParams[0].(string)
+		if !ok {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("committee public key is invalid"))
+		}
+		committeePublicKey = tmp
+	}
+	if len(arrayParams) > 1 {
+		tmp, ok := arrayParams[1].(string)
+		if !ok {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("payment address is invalid"))
+		}
+		paymentAddress = tmp
+	}
+	result, err := httpServer.blockService.GetUnbondingStatus(committeePublicKey, paymentAddress)
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
+
+// handleCreateAndSendUnstakeTransaction - RPC create and send unstake tx to network, the tx burns 0 PRV.
+// Amount 0 unstakes the validator (sent by the funder of its staking tx), StakingAmount is the amount of its staking tx,
+// found in the chain if it is not set
+// Amount > 0 unstakes a part of the delegation of PaymentAddress (sent by the delegator)
+// param #5: {"CommitteePublicKey": "...", "PaymentAddress": "...", "Amount": 0, "StakingAmount": 0}
+func (httpServer *HttpServer) handleCreateAndSendUnstakeTransaction(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	return httpServer.createAndSendStakingPoolTransaction(params, closeChan, func(data map[string]interface{}) (metadata.Metadata, error) {
+		committeePublicKey, ok := data["CommitteePublicKey"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Committee Public Key %+v", data["CommitteePublicKey"])
+		}
+		paymentAddress, ok := data["PaymentAddress"].(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid Payment Address %+v", data["PaymentAddress"])
+		}
+		amount := float64(0)
+		if data["Amount"] != nil {
+			amount, ok = data["Amount"].(float64)
+			if !ok || amount < 0 {
+				return nil, fmt.Errorf("Invalid Amount %+v", data["Amount"])
+			}
+		}
+		stakingAmount := float64(0)
+		if data["StakingAmount"] != nil {
+			stakingAmount, ok = data["StakingAmount"].(float64)
+			if !ok || stakingAmount < 0 {
+				return nil, fmt.Errorf("Invalid Staking Amount %+v", data["StakingAmount"])
+			}
+		}
+		if amount == 0 && stakingAmount == 0 {
+			tmp, err := httpServer.getStakingAmount(committeePublicKey)
+			if err != nil {
+				return nil, err
+			}
+			stakingAmount = float64(tmp)
+		}
+		return metadata.NewUnstakeRequest(metadata.UnstakeRequestMeta, committeePublicKey, paymentAddress, uint64(amount), uint64(stakingAmount))
+	})
+}
+
+// getStakingAmount returns the amount of the staking tx of a validator
+func (httpServer *HttpServer) getStakingAmount(committeePublicKey string) (uint64, error) {
+	stakingTxHash, ok := httpServer.config.BlockChain.GetBeaconBestState().StakingTx[committeePublicKey]
+	if !ok {
+		return 0, fmt.Errorf("Staking tx of %+v not found", committeePublicKey)
+	}
+	_, _, _, _, stakingTx, err := httpServer.config.BlockChain.GetTransactionByHash(stakingTxHash)
+	if err != nil {
+		return 0, fmt.Errorf("Staking tx %+v not found, set StakingAmount: %+v", stakingTxHash.String(), err)
+	}
+	stakingMeta, ok := stakingTx.GetMetadata().(*metadata.StakingMetadata)
+	if !ok {
+		return 0, fmt.Errorf("Tx %+v is not a staking tx", stakingTxHash.String())
+	}
+	return stakingMeta.StakingAmountShard, nil
+}
@@ -2,6 +2,9 @@ package rpcserver
 
 import (
 	"encoding/json"
+	"math"
+	"strconv"
+
 	"github.com/incognitochain/incognito-chain/rpcserver/bean"
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
 	"github.com/pkg/errors"
@@ -127,3 +130,37 @@ func (httpServer *HttpServer) createAndSendTxWithMetadata(params interface{}, cl
 	newParam = append(newParam, base58CheckData)
 	return sendHandler(httpServer, newParam, closeChan)
 }
+
+// getHeightParam parses the optional height param of read RPCs answering state as of a block height,
+// it is a number or a numeric string, no param is 0 (best view)
+func getHeightParam(param interface{}) (uint64, error) {
+	switch height := param.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		if height < 0 || height != math.Trunc(height) {
+			return 0, errors.Errorf("height %+v is invalid", height)
+		}
+		return uint64(height), nil
+	case string:
+		return strconv.ParseUint(height, 10, 64)
+	}
+	return 0, errors.Errorf("height %+v is invalid", param)
+}
+
+// getBeaconHeightParam parses beacon height of read RPCs taking a payload, from "Height" or legacy "BeaconHeight",
+// no param is the best beacon height
+func (httpServer *HttpServer) getBeaconHeightParam(data map[string]interface{}) (uint64, error) {
+	param, ok := data["Height"]
+	if !ok {
+		param = data["BeaconHeight"]
+	}
+	beaconHeight, err := getHeightParam(param)
+	if err != nil {
+		return 0, err
+	}
+	if beaconHeight == 0 {
+		beaconHeight = httpServer.config.BlockChain.GetBeaconBestState().BeaconHeight
+	}
+	return beaconHeight, nil
+}
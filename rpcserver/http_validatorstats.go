@@ -0,0 +1,42 @@
+package rpcserver
+
+import (
+	"errors"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+// handleGetValidatorStats - RPC get blocks proposed, slots missed, votes, rewards and punishments of a validator by epoch
+// param #1: committee public key
+// param #2: from epoch, 0 by default
+// param #3: to epoch, current epoch by default
+func (httpServer *HttpServer) handleGetValidatorStats(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
+	arrayParams := common.InterfaceSlice(params)
+	if len(arrayParams) < 1 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("committee public key is required"))
+	}
+	committeePublicKey, ok := arrayParams[0].(string)
+	if !ok || committeePublicKey == "" {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("committee public key is invalid"))
+	}
+	fromEpoch := float64(0)
+	if len(arrayParams) > 1 {
+		fromEpoch, ok = arrayParams[1].(float64)
+		if !ok || fromEpoch < 0 {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("from epoch is invalid"))
+		}
+	}
+	toEpoch := float64(0)
+	if len(arrayParams) > 2 {
+		toEpoch, ok = arrayParams[2].(float64)
+		if !ok || toEpoch < 0 {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("to epoch is invalid"))
+		}
+	}
+	result, err := httpServer.blockService.GetValidatorStats(committeePublicKey, uint64(fromEpoch), uint64(toEpoch))
+	if err != nil {
+		return nil, rpcservice.NewRPCError(rpcservice.UnexpectedError, err)
+	}
+	return result, nil
+}
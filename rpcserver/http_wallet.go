@@ -160,16 +160,25 @@ func (httpServer *HttpServer) handleRemoveAccount(params interface{}, closeChan
 func (httpServer *HttpServer) handleGetBalanceByPrivatekey(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
 	// all component
 	arrayParams := common.InterfaceSlice(params)
-	if arrayParams == nil || len(arrayParams) != 1 {
-		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	if arrayParams == nil || len(arrayParams) < 1 || len(arrayParams) > 2 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array of private key and optional shard height"))
 	}
 	// param #1: private key of sender
 	senderKeyParam, ok := arrayParams[0].(string)
 	if !ok {
 		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("invalid private key"))
 	}
+	// param #2: optional shard height, balance of best view if not set
+	var height uint64
+	if len(arrayParams) > 1 {
+		var err error
+		height, err = getHeightParam(arrayParams[1])
+		if err != nil {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+		}
+	}
 
-	return httpServer.walletService.GetBalanceByPrivateKey(senderKeyParam)
+	return httpServer.walletService.GetBalanceByPrivateKey(senderKeyParam, height)
 }
 
 // handleGetBalanceByPaymentAddress -  return balance of paymentaddress
@@ -62,15 +62,24 @@ func (httpServer *HttpServer) handleCreateAndSendWithDrawTransaction(params inte
 // handleGetRewardAmount - Get the reward amount of a payment address with all existed token
 func (httpServer *HttpServer) handleGetRewardAmount(params interface{}, closeChan <-chan struct{}) (interface{}, *rpcservice.RPCError) {
 	arrayParams := common.InterfaceSlice(params)
-	if arrayParams == nil || len(arrayParams) != 1 {
-		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array at least 1 element"))
+	if arrayParams == nil || len(arrayParams) < 1 || len(arrayParams) > 2 {
+		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("param must be an array of payment address and optional shard height"))
 	}
 
 	paymentAddress, ok := arrayParams[0].(string)
 	if !ok {
 		return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("payment address is invalid"))
 	}
-	rewardAmount, err := httpServer.blockService.GetRewardAmount(paymentAddress)
+	// optional shard height, reward of best view if not set
+	var height uint64
+	if len(arrayParams) > 1 {
+		var err error
+		height, err = getHeightParam(arrayParams[1])
+		if err != nil {
+			return nil, rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)
+		}
+	}
+	rewardAmount, err := httpServer.blockService.GetRewardAmount(paymentAddress, height)
 	if err != nil {
 		return nil, rpcservice.NewRPCError(rpcservice.GetRewardAmountError, err)
 	}
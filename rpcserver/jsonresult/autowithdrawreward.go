@@ -0,0 +1,21 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+)
+
+type AutoWithdrawRewardPolicyResult struct {
+	PaymentAddress string `json:"PaymentAddress"`
+	Interval       uint64 `json:"Interval"`
+	Threshold      uint64 `json:"Threshold"`
+	Fee            uint64 `json:"Fee"`
+}
+
+func NewAutoWithdrawRewardPolicyResult(policy *statedb.AutoWithdrawPolicyState, fee uint64) *AutoWithdrawRewardPolicyResult {
+	return &AutoWithdrawRewardPolicyResult{
+		PaymentAddress: paymentAddressToString(policy.Receiver()),
+		Interval:       policy.Interval(),
+		Threshold:      policy.Threshold(),
+		Fee:            fee,
+	}
+}
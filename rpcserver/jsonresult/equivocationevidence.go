@@ -0,0 +1,8 @@
+package jsonresult
+
+import "github.com/incognitochain/incognito-chain/metadata"
+
+type EquivocationEvidenceResult struct {
+	Hash     string                        `json:"Hash"`
+	Evidence metadata.EquivocationEvidence `json:"Evidence"`
+}
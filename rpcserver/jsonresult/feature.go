@@ -0,0 +1,30 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/blockchain"
+)
+
+const (
+	FeatureActiveStatus   = "active"
+	FeatureUpcomingStatus = "upcoming"
+)
+
+type FeatureResult struct {
+	Name             string `json:"Name"`
+	Description      string `json:"Description"`
+	ActivationHeight uint64 `json:"ActivationHeight"`
+	Status           string `json:"Status"`
+}
+
+func NewFeatureResult(feature blockchain.FeatureInfo) *FeatureResult {
+	result := &FeatureResult{
+		Name:             string(feature.Name),
+		Description:      feature.Description,
+		ActivationHeight: feature.ActivationHeight,
+		Status:           FeatureUpcomingStatus,
+	}
+	if feature.IsActive {
+		result.Status = FeatureActiveStatus
+	}
+	return result
+}
@@ -35,3 +35,18 @@ type ReceivedTransactionV2 struct {
 type ListReceivedTransactionV2 struct {
 	ReceivedTransactions []ReceivedTransactionV2 `json:"ReceivedTransactions"`
 }
+
+type TransactionHistoryItem struct {
+	ShardID      byte     `json:"ShardID"`
+	BlockHeight  uint64   `json:"BlockHeight"`
+	TxIndex      uint32   `json:"TxIndex"`
+	TxHash       string   `json:"TxHash"`
+	Direction    string   `json:"Direction"`
+	TokenIDs     []string `json:"TokenIDs"`
+	MetadataType int      `json:"MetadataType"`
+}
+
+type TransactionHistory struct {
+	Transactions []TransactionHistoryItem `json:"Transactions"`
+	NextCursor   string                   `json:"NextCursor"`
+}
@@ -0,0 +1,98 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+)
+
+// TransactionReceiptAmount is an amount of a token in a receipt
+type TransactionReceiptAmount struct {
+	TokenID string `json:"TokenID"`
+	Amount  uint64 `json:"Amount"`
+}
+
+// TransactionReceiptEvent is a result of processing the request by beacon
+type TransactionReceiptEvent struct {
+	BeaconHeight    uint64                     `json:"BeaconHeight"`
+	BeaconBlockHash string                     `json:"BeaconBlockHash"`
+	InstIndex       uint32                     `json:"InstIndex"`
+	MetadataType    int                        `json:"MetadataType"`
+	Status          string                     `json:"Status"`
+	Amounts         []TransactionReceiptAmount `json:"Amounts"`
+	RefundedAmounts []TransactionReceiptAmount `json:"RefundedAmounts"`
+}
+
+// TransactionReceiptResponse is a response transaction of the request
+type TransactionReceiptResponse struct {
+	TxHash       string                     `json:"TxHash"`
+	MetadataType int                        `json:"MetadataType"`
+	ShardID      byte                       `json:"ShardID"`
+	BlockHash    string                     `json:"BlockHash"`
+	BlockHeight  uint64                     `json:"BlockHeight"`
+	Amounts      []TransactionReceiptAmount `json:"Amounts"`
+}
+
+type TransactionReceipt struct {
+	TxHash           string                       `json:"TxHash"`
+	MetadataType     int                          `json:"MetadataType"`
+	ShardID          byte                         `json:"ShardID"`
+	BlockHash        string                       `json:"BlockHash"`
+	BlockHeight      uint64                       `json:"BlockHeight"`
+	Status           string                       `json:"Status"`
+	Amounts          []TransactionReceiptAmount   `json:"Amounts"`
+	RefundedAmounts  []TransactionReceiptAmount   `json:"RefundedAmounts"`
+	ResponseTxHashes []string                     `json:"ResponseTxHashes"`
+	Events           []TransactionReceiptEvent    `json:"Events"`
+	Responses        []TransactionReceiptResponse `json:"Responses"`
+	Finalized        bool                         `json:"Finalized"`
+}
+
+func newTransactionReceiptAmounts(amounts []rawdbv2.TxReceiptAmount) []TransactionReceiptAmount {
+	result := []TransactionReceiptAmount{}
+	for _, amount := range amounts {
+		result = append(result, TransactionReceiptAmount{TokenID: amount.TokenID, Amount: amount.Amount})
+	}
+	return result
+}
+
+func NewTransactionReceipt(receipt *blockchain.TxReceipt) *TransactionReceipt {
+	result := &TransactionReceipt{
+		TxHash:           receipt.TxHash.String(),
+		MetadataType:     receipt.MetadataType,
+		ShardID:          receipt.ShardID,
+		BlockHash:        receipt.BlockHash.String(),
+		BlockHeight:      receipt.BlockHeight,
+		Status:           receipt.Status,
+		Amounts:          newTransactionReceiptAmounts(receipt.Amounts),
+		RefundedAmounts:  newTransactionReceiptAmounts(receipt.RefundedAmounts),
+		ResponseTxHashes: []string{},
+		Events:           []TransactionReceiptEvent{},
+		Responses:        []TransactionReceiptResponse{},
+		Finalized:        receipt.Finalized,
+	}
+	for _, txHash := range receipt.ResponseTxHashes {
+		result.ResponseTxHashes = append(result.ResponseTxHashes, txHash.String())
+	}
+	for _, event := range receipt.Events {
+		result.Events = append(result.Events, TransactionReceiptEvent{
+			BeaconHeight:    event.BeaconHeight,
+			BeaconBlockHash: event.BeaconBlockHash.String(),
+			InstIndex:       event.InstIndex,
+			MetadataType:    event.MetadataType,
+			Status:          event.Status,
+			Amounts:         newTransactionReceiptAmounts(event.Amounts),
+			RefundedAmounts: newTransactionReceiptAmounts(event.RefundedAmounts),
+		})
+	}
+	for _, response := range receipt.Responses {
+		result.Responses = append(result.Responses, TransactionReceiptResponse{
+			TxHash:       response.ResponseTxHash.String(),
+			MetadataType: response.MetadataType,
+			ShardID:      response.ShardID,
+			BlockHash:    response.BlockHash.String(),
+			BlockHeight:  response.BlockHeight,
+			Amounts:      newTransactionReceiptAmounts(response.Amounts),
+		})
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+)
+
+type GovernanceParamActivationResult struct {
+	Height     uint64 `json:"Height"`
+	Value      uint64 `json:"Value"`
+	ProposalID string `json:"ProposalID"`
+}
+
+type GovernanceParamResult struct {
+	Name         string                            `json:"Name"`
+	DefaultValue uint64                            `json:"DefaultValue"`
+	Value        uint64                            `json:"Value"`
+	Activations  []GovernanceParamActivationResult `json:"Activations"`
+}
+
+func NewGovernanceParamResult(param blockchain.GovernanceParamInfo) *GovernanceParamResult {
+	result := &GovernanceParamResult{
+		Name:         param.Name,
+		DefaultValue: param.DefaultValue,
+		Value:        param.Value,
+		Activations:  []GovernanceParamActivationResult{},
+	}
+	for _, activation := range param.Activations {
+		result.Activations = append(result.Activations, GovernanceParamActivationResult{
+			Height:     activation.Height,
+			Value:      activation.Value,
+			ProposalID: activation.ProposalID.String(),
+		})
+	}
+	return result
+}
+
+type GovernanceProposalResult struct {
+	ProposalID       string            `json:"ProposalID"`
+	Proposer         string            `json:"Proposer"`
+	Params           map[string]uint64 `json:"Params"`
+	ActivationHeight uint64            `json:"ActivationHeight"`
+	VotingEndHeight  uint64            `json:"VotingEndHeight"`
+	Votes            map[string]bool   `json:"Votes"`
+	Status           string            `json:"Status"`
+	ApprovedStake    uint64            `json:"ApprovedStake"`
+	TotalStake       uint64            `json:"TotalStake"`
+}
+
+// NewGovernanceProposalResult ApprovedStake and TotalStake are 0 while the proposal is in voting
+func NewGovernanceProposalResult(proposal *statedb.GovernanceProposalState) *GovernanceProposalResult {
+	return &GovernanceProposalResult{
+		ProposalID:       proposal.ProposalID().String(),
+		Proposer:         proposal.Proposer(),
+		Params:           proposal.Params(),
+		ActivationHeight: proposal.ActivationHeight(),
+		VotingEndHeight:  proposal.VotingEndHeight(),
+		Votes:            proposal.Votes(),
+		Status:           proposal.Status(),
+		ApprovedStake:    proposal.ApprovedStake(),
+		TotalStake:       proposal.TotalStake(),
+	}
+}
@@ -0,0 +1,23 @@
+package jsonresult
+
+import "github.com/incognitochain/incognito-chain/notescanner"
+
+// ScannedBalanceResult has Balances of a key tracking spending (SpentTracked), otherwise balances are
+// unavailable (null) and Received has the totals of received coins
+type ScannedBalanceResult struct {
+	*notescanner.ScanKeyInfo
+	Balances map[string]uint64 `json:"Balances"`
+	Received map[string]uint64 `json:"Received"`
+}
+
+type ListScannedOutputCoinsResult struct {
+	*notescanner.ScanKeyInfo
+	Total int                        `json:"Total"`
+	Coins []*notescanner.ScannedCoin `json:"Coins"`
+}
+
+type ScannedHistoryResult struct {
+	*notescanner.ScanKeyInfo
+	Total   int                      `json:"Total"`
+	History []*notescanner.ScannedTx `json:"History"`
+}
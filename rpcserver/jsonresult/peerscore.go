@@ -0,0 +1,31 @@
+package jsonresult
+
+import (
+	"time"
+
+	"github.com/incognitochain/incognito-chain/peerv2"
+)
+
+type PeerScoreResult struct {
+	PeerID          string `json:"PeerID"`
+	Score           int    `json:"Score"`
+	InvalidMessages uint64 `json:"InvalidMessages"`
+	Timeouts        uint64 `json:"Timeouts"`
+	UsefulBlocks    uint64 `json:"UsefulBlocks"`
+	Banned          bool   `json:"Banned"`
+	BannedUntil     int64  `json:"BannedUntil"`
+	LastUpdate      int64  `json:"LastUpdate"`
+}
+
+func NewPeerScoreResult(ps peerv2.PeerScore) PeerScoreResult {
+	return PeerScoreResult{
+		PeerID:          ps.PeerID,
+		Score:           ps.Score,
+		InvalidMessages: ps.InvalidMessages,
+		Timeouts:        ps.Timeouts,
+		UsefulBlocks:    ps.UsefulBlocks,
+		Banned:          ps.BannedUntil > time.Now().Unix(),
+		BannedUntil:     ps.BannedUntil,
+		LastUpdate:      ps.LastUpdate,
+	}
+}
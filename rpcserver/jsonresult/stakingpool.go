@@ -0,0 +1,48 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+type DelegationResult struct {
+	DelegatorAddress string `json:"DelegatorAddress"`
+	Amount           uint64 `json:"Amount"`
+}
+
+type StakingPoolResult struct {
+	CommitteePublicKey     string             `json:"CommitteePublicKey"`
+	OperatorAddress        string             `json:"OperatorAddress"`
+	Commission             uint64             `json:"Commission"`
+	PendingCommission      uint64             `json:"PendingCommission"`
+	PendingCommissionEpoch uint64             `json:"PendingCommissionEpoch"`
+	TotalDelegated         uint64             `json:"TotalDelegated"`
+	Delegations            []DelegationResult `json:"Delegations"`
+}
+
+func paymentAddressToString(paymentAddress privacy.PaymentAddress) string {
+	keyWallet := wallet.KeyWallet{}
+	keyWallet.KeySet.PaymentAddress = paymentAddress
+	return keyWallet.Base58CheckSerialize(wallet.PaymentAddressType)
+}
+
+func NewStakingPoolResult(pool *statedb.StakingPoolState, delegations []*statedb.DelegationState) *StakingPoolResult {
+	pendingCommission, pendingCommissionEpoch := pool.PendingCommission()
+	result := &StakingPoolResult{
+		CommitteePublicKey:     pool.CommitteePublicKey(),
+		OperatorAddress:        paymentAddressToString(pool.Operator()),
+		Commission:             pool.Commission(),
+		PendingCommission:      pendingCommission,
+		PendingCommissionEpoch: pendingCommissionEpoch,
+		TotalDelegated:         pool.TotalDelegated(),
+		Delegations:            []DelegationResult{},
+	}
+	for _, delegation := range delegations {
+		result.Delegations = append(result.Delegations, DelegationResult{
+			DelegatorAddress: paymentAddressToString(delegation.Delegator()),
+			Amount:           delegation.Amount(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+)
+
+type UnbondingResult struct {
+	RequestTxID        string `json:"RequestTxID"`
+	CommitteePublicKey string `json:"CommitteePublicKey"`
+	PaymentAddress     string `json:"PaymentAddress"`
+	Amount             uint64 `json:"Amount"`
+	IsDelegation       bool   `json:"IsDelegation"`
+	ReleaseEpoch       uint64 `json:"ReleaseEpoch"`
+	ReleaseHeight      uint64 `json:"ReleaseHeight"`
+}
+
+// NewUnbondingResult ReleaseEpoch and ReleaseHeight are 0 while an unstaked validator waits to be swapped out,
+// ReleaseHeight is the first beacon height of the release epoch
+func NewUnbondingResult(unbonding *statedb.UnbondingState, epoch uint64) *UnbondingResult {
+	result := &UnbondingResult{
+		RequestTxID:        unbonding.TxReqID().String(),
+		CommitteePublicKey: unbonding.CommitteePublicKey(),
+		PaymentAddress:     paymentAddressToString(unbonding.Receiver()),
+		Amount:             unbonding.Amount(),
+		IsDelegation:       unbonding.IsDelegation(),
+		ReleaseEpoch:       unbonding.ReleaseEpoch(),
+	}
+	if unbonding.ReleaseEpoch() != 0 {
+		result.ReleaseHeight = (unbonding.ReleaseEpoch()-1)*epoch + 1
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package jsonresult
+
+import (
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+)
+
+type ValidatorEpochStatsResult struct {
+	Epoch          uint64                    `json:"Epoch"`
+	ChainID        int                       `json:"ChainID"`
+	BlocksProposed uint64                    `json:"BlocksProposed"`
+	SlotsMissed    uint64                    `json:"SlotsMissed"`
+	VotesCast      uint64                    `json:"VotesCast"`
+	VotesExpected  uint64                    `json:"VotesExpected"`
+	Rewards        []rawdbv2.TxReceiptAmount `json:"Rewards"`
+	Events         []rawdbv2.ValidatorEvent  `json:"Events"`
+}
+
+type ValidatorStatsResult struct {
+	CommitteePublicKey string                       `json:"CommitteePublicKey"`
+	FromEpoch          uint64                       `json:"FromEpoch"`
+	ToEpoch            uint64                       `json:"ToEpoch"`
+	Epochs             []*ValidatorEpochStatsResult `json:"Epochs"`
+}
+
+// NewValidatorStatsResult ChainID of beacon committee is -1
+func NewValidatorStatsResult(committeePublicKey string, fromEpoch uint64, toEpoch uint64, stats []*blockchain.ValidatorEpochStats) *ValidatorStatsResult {
+	result := &ValidatorStatsResult{
+		CommitteePublicKey: committeePublicKey,
+		FromEpoch:          fromEpoch,
+		ToEpoch:            toEpoch,
+		Epochs:             []*ValidatorEpochStatsResult{},
+	}
+	for _, epochStats := range stats {
+		result.Epochs = append(result.Epochs, &ValidatorEpochStatsResult{
+			Epoch:          epochStats.Epoch,
+			ChainID:        epochStats.ChainID,
+			BlocksProposed: epochStats.BlocksProposed,
+			SlotsMissed:    epochStats.SlotsMissed,
+			VotesCast:      epochStats.VotesCast,
+			VotesExpected:  epochStats.VotesExpected,
+			Rewards:        epochStats.Rewards,
+			Events:         epochStats.Events,
+		})
+	}
+	return result
+}
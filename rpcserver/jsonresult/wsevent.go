@@ -0,0 +1,42 @@
+package jsonresult
+
+import "github.com/incognitochain/incognito-chain/notescanner"
+
+const (
+	TxConfirmedEvent          = "confirmed"
+	TxCrossShardReceivedEvent = "crossshardreceived"
+)
+
+// TxConfirmationEvent is sent when the block of a transaction is finalized (confirmed), then when each receiver shard
+// finalizes the block receiving output coins of the transaction (crossshardreceived)
+type TxConfirmationEvent struct {
+	Event                   string `json:"Event"`
+	TxHash                  string `json:"TxHash"`
+	ShardID                 byte   `json:"ShardID"` // shard of the block of the event
+	BlockHash               string `json:"BlockHash"`
+	BlockHeight             uint64 `json:"BlockHeight"`
+	TxShardID               byte   `json:"TxShardID"`
+	TxBlockHash             string `json:"TxBlockHash"`
+	TxIndex                 int    `json:"TxIndex"`
+	PendingReceiverShardIDs []int  `json:"PendingReceiverShardIDs"`
+	ResumeToken             string `json:"ResumeToken"`
+}
+
+// ViewKeyActivityEvent is a balance change of a key registered to the note scanner
+type ViewKeyActivityEvent struct {
+	ScanKeyID string `json:"ScanKeyID"`
+	*notescanner.ScannedTx
+	ResumeToken string `json:"ResumeToken"`
+}
+
+// BeaconInstructionEvent is a status of a request processed by beacon, from an instruction of a finalized beacon block
+type BeaconInstructionEvent struct {
+	MetadataType    int      `json:"MetadataType"`
+	Status          string   `json:"Status"`
+	RequestTxHash   string   `json:"RequestTxHash"`
+	ShardID         string   `json:"ShardID"`
+	BeaconHeight    uint64   `json:"BeaconHeight"`
+	BeaconBlockHash string   `json:"BeaconBlockHash"`
+	Instruction     []string `json:"Instruction"`
+	ResumeToken     string   `json:"ResumeToken"`
+}
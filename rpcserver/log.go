@@ -21,3 +21,14 @@ func (self *DeBridgeLogger) Init(inst common.Logger) {
 // Global instant to use
 var Logger = RpcLogger{}
 var BLogger = DeBridgeLogger{}
+
+type AuditLogger struct {
+	log common.Logger
+}
+
+func (self *AuditLogger) Init(inst common.Logger) {
+	self.log = inst
+}
+
+// ALogger logs denied rpc calls
+var ALogger = AuditLogger{}
@@ -0,0 +1,4706 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        (unknown)
+// source: rpcserver.proto
+
+package proto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type RetrieveBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash string `protobuf:"bytes,1,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Verbosity string `protobuf:"bytes,2,opt,name=Verbosity,proto3" json:"Verbosity,omitempty"`
+}
+
+func (x *RetrieveBlockRequest) Reset() {
+	*x = RetrieveBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveBlockRequest) ProtoMessage() {}
+
+func (x *RetrieveBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveBlockRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetrieveBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *RetrieveBlockRequest) GetVerbosity() string {
+	if x != nil {
+		return x.Verbosity
+	}
+	return ""
+}
+
+type RetrieveBlockByHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	ShardID   int32  `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Verbosity string `protobuf:"bytes,3,opt,name=Verbosity,proto3" json:"Verbosity,omitempty"`
+}
+
+func (x *RetrieveBlockByHeightRequest) Reset() {
+	*x = RetrieveBlockByHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveBlockByHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveBlockByHeightRequest) ProtoMessage() {}
+
+func (x *RetrieveBlockByHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveBlockByHeightRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveBlockByHeightRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetrieveBlockByHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RetrieveBlockByHeightRequest) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+func (x *RetrieveBlockByHeightRequest) GetVerbosity() string {
+	if x != nil {
+		return x.Verbosity
+	}
+	return ""
+}
+
+type RetrieveBeaconBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash string `protobuf:"bytes,1,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+}
+
+func (x *RetrieveBeaconBlockRequest) Reset() {
+	*x = RetrieveBeaconBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveBeaconBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveBeaconBlockRequest) ProtoMessage() {}
+
+func (x *RetrieveBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetrieveBeaconBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type RetrieveBeaconBlockByHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+}
+
+func (x *RetrieveBeaconBlockByHeightRequest) Reset() {
+	*x = RetrieveBeaconBlockByHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveBeaconBlockByHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveBeaconBlockByHeightRequest) ProtoMessage() {}
+
+func (x *RetrieveBeaconBlockByHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveBeaconBlockByHeightRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveBeaconBlockByHeightRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RetrieveBeaconBlockByHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SubscribeNewShardBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardID int32 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+}
+
+func (x *SubscribeNewShardBlockRequest) Reset() {
+	*x = SubscribeNewShardBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeNewShardBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeNewShardBlockRequest) ProtoMessage() {}
+
+func (x *SubscribeNewShardBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeNewShardBlockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeNewShardBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeNewShardBlockRequest) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+type SubscribeNewBeaconBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeNewBeaconBlockRequest) Reset() {
+	*x = SubscribeNewBeaconBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeNewBeaconBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeNewBeaconBlockRequest) ProtoMessage() {}
+
+func (x *SubscribeNewBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeNewBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeNewBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{5}
+}
+
+type Instruction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=Values,proto3" json:"Values,omitempty"`
+}
+
+func (x *Instruction) Reset() {
+	*x = Instruction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Instruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instruction) ProtoMessage() {}
+
+func (x *Instruction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
+func (*Instruction) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Instruction) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BlockTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash     string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Locktime int64  `protobuf:"varint,2,opt,name=Locktime,proto3" json:"Locktime,omitempty"`
+	HexData  string `protobuf:"bytes,3,opt,name=HexData,proto3" json:"HexData,omitempty"`
+}
+
+func (x *BlockTx) Reset() {
+	*x = BlockTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTx) ProtoMessage() {}
+
+func (x *BlockTx) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTx.ProtoReflect.Descriptor instead.
+func (*BlockTx) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlockTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockTx) GetLocktime() int64 {
+	if x != nil {
+		return x.Locktime
+	}
+	return 0
+}
+
+func (x *BlockTx) GetHexData() string {
+	if x != nil {
+		return x.HexData
+	}
+	return ""
+}
+
+type ShardBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash              string         `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	ShardID           int32          `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Height            uint64         `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	Confirmations     int64          `protobuf:"varint,4,opt,name=Confirmations,proto3" json:"Confirmations,omitempty"`
+	Version           int32          `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	TxRoot            string         `protobuf:"bytes,6,opt,name=TxRoot,proto3" json:"TxRoot,omitempty"`
+	Time              int64          `protobuf:"varint,7,opt,name=Time,proto3" json:"Time,omitempty"`
+	PreviousBlockHash string         `protobuf:"bytes,8,opt,name=PreviousBlockHash,proto3" json:"PreviousBlockHash,omitempty"`
+	NextBlockHash     string         `protobuf:"bytes,9,opt,name=NextBlockHash,proto3" json:"NextBlockHash,omitempty"`
+	TxHashes          []string       `protobuf:"bytes,10,rep,name=TxHashes,proto3" json:"TxHashes,omitempty"`
+	Txs               []*BlockTx     `protobuf:"bytes,11,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	BlockProducer     string         `protobuf:"bytes,12,opt,name=BlockProducer,proto3" json:"BlockProducer,omitempty"`
+	ValidationData    string         `protobuf:"bytes,13,opt,name=ValidationData,proto3" json:"ValidationData,omitempty"`
+	ConsensusType     string         `protobuf:"bytes,14,opt,name=ConsensusType,proto3" json:"ConsensusType,omitempty"`
+	Data              string         `protobuf:"bytes,15,opt,name=Data,proto3" json:"Data,omitempty"`
+	BeaconHeight      uint64         `protobuf:"varint,16,opt,name=BeaconHeight,proto3" json:"BeaconHeight,omitempty"`
+	BeaconBlockHash   string         `protobuf:"bytes,17,opt,name=BeaconBlockHash,proto3" json:"BeaconBlockHash,omitempty"`
+	Round             int32          `protobuf:"varint,18,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch             uint64         `protobuf:"varint,19,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Reward            uint64         `protobuf:"varint,20,opt,name=Reward,proto3" json:"Reward,omitempty"`
+	RewardBeacon      uint64         `protobuf:"varint,21,opt,name=RewardBeacon,proto3" json:"RewardBeacon,omitempty"`
+	Fee               uint64         `protobuf:"varint,22,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	Size              uint64         `protobuf:"varint,23,opt,name=Size,proto3" json:"Size,omitempty"`
+	Instructions      []*Instruction `protobuf:"bytes,24,rep,name=Instructions,proto3" json:"Instructions,omitempty"`
+	CrossShardBitMap  []int32        `protobuf:"varint,25,rep,packed,name=CrossShardBitMap,proto3" json:"CrossShardBitMap,omitempty"`
+}
+
+func (x *ShardBlock) Reset() {
+	*x = ShardBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardBlock) ProtoMessage() {}
+
+func (x *ShardBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardBlock.ProtoReflect.Descriptor instead.
+func (*ShardBlock) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShardBlock) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetTxRoot() string {
+	if x != nil {
+		return x.TxRoot
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetPreviousBlockHash() string {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetNextBlockHash() string {
+	if x != nil {
+		return x.NextBlockHash
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+func (x *ShardBlock) GetTxs() []*BlockTx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *ShardBlock) GetBlockProducer() string {
+	if x != nil {
+		return x.BlockProducer
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetValidationData() string {
+	if x != nil {
+		return x.ValidationData
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetConsensusType() string {
+	if x != nil {
+		return x.ConsensusType
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetBeaconHeight() uint64 {
+	if x != nil {
+		return x.BeaconHeight
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetBeaconBlockHash() string {
+	if x != nil {
+		return x.BeaconBlockHash
+	}
+	return ""
+}
+
+func (x *ShardBlock) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetReward() uint64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetRewardBeacon() uint64 {
+	if x != nil {
+		return x.RewardBeacon
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ShardBlock) GetInstructions() []*Instruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *ShardBlock) GetCrossShardBitMap() []int32 {
+	if x != nil {
+		return x.CrossShardBitMap
+	}
+	return nil
+}
+
+type ShardBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*ShardBlock `protobuf:"bytes,1,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+}
+
+func (x *ShardBlocks) Reset() {
+	*x = ShardBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardBlocks) ProtoMessage() {}
+
+func (x *ShardBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardBlocks.ProtoReflect.Descriptor instead.
+func (*ShardBlocks) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ShardBlocks) GetBlocks() []*ShardBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type ShardState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height     uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Hash       string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	CrossShard []byte `protobuf:"bytes,3,opt,name=CrossShard,proto3" json:"CrossShard,omitempty"`
+}
+
+func (x *ShardState) Reset() {
+	*x = ShardState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardState) ProtoMessage() {}
+
+func (x *ShardState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardState.ProtoReflect.Descriptor instead.
+func (*ShardState) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ShardState) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ShardState) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ShardState) GetCrossShard() []byte {
+	if x != nil {
+		return x.CrossShard
+	}
+	return nil
+}
+
+type ShardStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []*ShardState `protobuf:"bytes,1,rep,name=States,proto3" json:"States,omitempty"`
+}
+
+func (x *ShardStates) Reset() {
+	*x = ShardStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardStates) ProtoMessage() {}
+
+func (x *ShardStates) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardStates.ProtoReflect.Descriptor instead.
+func (*ShardStates) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ShardStates) GetStates() []*ShardState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type BeaconBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash              string                 `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Height            uint64                 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	BlockProducer     string                 `protobuf:"bytes,3,opt,name=BlockProducer,proto3" json:"BlockProducer,omitempty"`
+	ValidationData    string                 `protobuf:"bytes,4,opt,name=ValidationData,proto3" json:"ValidationData,omitempty"`
+	ConsensusType     string                 `protobuf:"bytes,5,opt,name=ConsensusType,proto3" json:"ConsensusType,omitempty"`
+	Version           int32                  `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Epoch             uint64                 `protobuf:"varint,7,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round             int32                  `protobuf:"varint,8,opt,name=Round,proto3" json:"Round,omitempty"`
+	Time              int64                  `protobuf:"varint,9,opt,name=Time,proto3" json:"Time,omitempty"`
+	PreviousBlockHash string                 `protobuf:"bytes,10,opt,name=PreviousBlockHash,proto3" json:"PreviousBlockHash,omitempty"`
+	NextBlockHash     string                 `protobuf:"bytes,11,opt,name=NextBlockHash,proto3" json:"NextBlockHash,omitempty"`
+	Instructions      []*Instruction         `protobuf:"bytes,12,rep,name=Instructions,proto3" json:"Instructions,omitempty"`
+	Size              uint64                 `protobuf:"varint,13,opt,name=Size,proto3" json:"Size,omitempty"`
+	ShardStates       map[int32]*ShardStates `protobuf:"bytes,14,rep,name=ShardStates,proto3" json:"ShardStates,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BeaconBlock) Reset() {
+	*x = BeaconBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconBlock) ProtoMessage() {}
+
+func (x *BeaconBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconBlock.ProtoReflect.Descriptor instead.
+func (*BeaconBlock) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BeaconBlock) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetBlockProducer() string {
+	if x != nil {
+		return x.BlockProducer
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetValidationData() string {
+	if x != nil {
+		return x.ValidationData
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetConsensusType() string {
+	if x != nil {
+		return x.ConsensusType
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetPreviousBlockHash() string {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetNextBlockHash() string {
+	if x != nil {
+		return x.NextBlockHash
+	}
+	return ""
+}
+
+func (x *BeaconBlock) GetInstructions() []*Instruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *BeaconBlock) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BeaconBlock) GetShardStates() map[int32]*ShardStates {
+	if x != nil {
+		return x.ShardStates
+	}
+	return nil
+}
+
+type BeaconBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BeaconBlock `protobuf:"bytes,1,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+}
+
+func (x *BeaconBlocks) Reset() {
+	*x = BeaconBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconBlocks) ProtoMessage() {}
+
+func (x *BeaconBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconBlocks.ProtoReflect.Descriptor instead.
+func (*BeaconBlocks) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BeaconBlocks) GetBlocks() []*BeaconBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetTransactionByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+}
+
+func (x *GetTransactionByHashRequest) Reset() {
+	*x = GetTransactionByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionByHashRequest) ProtoMessage() {}
+
+func (x *GetTransactionByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTransactionByHashRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type Coin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      string `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	CoinCommitment string `protobuf:"bytes,2,opt,name=CoinCommitment,proto3" json:"CoinCommitment,omitempty"`
+	SNDerivator    []byte `protobuf:"bytes,3,opt,name=SNDerivator,proto3" json:"SNDerivator,omitempty"`
+	SerialNumber   string `protobuf:"bytes,4,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	Randomness     []byte `protobuf:"bytes,5,opt,name=Randomness,proto3" json:"Randomness,omitempty"`
+	Value          uint64 `protobuf:"varint,6,opt,name=Value,proto3" json:"Value,omitempty"`
+	Info           string `protobuf:"bytes,7,opt,name=Info,proto3" json:"Info,omitempty"`
+}
+
+func (x *Coin) Reset() {
+	*x = Coin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coin) ProtoMessage() {}
+
+func (x *Coin) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coin.ProtoReflect.Descriptor instead.
+func (*Coin) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Coin) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *Coin) GetCoinCommitment() string {
+	if x != nil {
+		return x.CoinCommitment
+	}
+	return ""
+}
+
+func (x *Coin) GetSNDerivator() []byte {
+	if x != nil {
+		return x.SNDerivator
+	}
+	return nil
+}
+
+func (x *Coin) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Coin) GetRandomness() []byte {
+	if x != nil {
+		return x.Randomness
+	}
+	return nil
+}
+
+func (x *Coin) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Coin) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+type CoinDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinDetails          *Coin  `protobuf:"bytes,1,opt,name=CoinDetails,proto3" json:"CoinDetails,omitempty"`
+	CoinDetailsEncrypted string `protobuf:"bytes,2,opt,name=CoinDetailsEncrypted,proto3" json:"CoinDetailsEncrypted,omitempty"`
+}
+
+func (x *CoinDetail) Reset() {
+	*x = CoinDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinDetail) ProtoMessage() {}
+
+func (x *CoinDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinDetail.ProtoReflect.Descriptor instead.
+func (*CoinDetail) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CoinDetail) GetCoinDetails() *Coin {
+	if x != nil {
+		return x.CoinDetails
+	}
+	return nil
+}
+
+func (x *CoinDetail) GetCoinDetailsEncrypted() string {
+	if x != nil {
+		return x.CoinDetailsEncrypted
+	}
+	return ""
+}
+
+type ProofDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputCoins  []*CoinDetail `protobuf:"bytes,1,rep,name=InputCoins,proto3" json:"InputCoins,omitempty"`
+	OutputCoins []*CoinDetail `protobuf:"bytes,2,rep,name=OutputCoins,proto3" json:"OutputCoins,omitempty"`
+}
+
+func (x *ProofDetail) Reset() {
+	*x = ProofDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofDetail) ProtoMessage() {}
+
+func (x *ProofDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofDetail.ProtoReflect.Descriptor instead.
+func (*ProofDetail) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProofDetail) GetInputCoins() []*CoinDetail {
+	if x != nil {
+		return x.InputCoins
+	}
+	return nil
+}
+
+func (x *ProofDetail) GetOutputCoins() []*CoinDetail {
+	if x != nil {
+		return x.OutputCoins
+	}
+	return nil
+}
+
+type TransactionDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash                     string       `protobuf:"bytes,1,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	BlockHeight                   uint64       `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	TxSize                        uint64       `protobuf:"varint,3,opt,name=TxSize,proto3" json:"TxSize,omitempty"`
+	Index                         uint64       `protobuf:"varint,4,opt,name=Index,proto3" json:"Index,omitempty"`
+	ShardID                       int32        `protobuf:"varint,5,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Hash                          string       `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Version                       int32        `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
+	Type                          string       `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
+	LockTime                      string       `protobuf:"bytes,9,opt,name=LockTime,proto3" json:"LockTime,omitempty"`
+	Fee                           uint64       `protobuf:"varint,10,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	Image                         string       `protobuf:"bytes,11,opt,name=Image,proto3" json:"Image,omitempty"`
+	IsPrivacy                     bool         `protobuf:"varint,12,opt,name=IsPrivacy,proto3" json:"IsPrivacy,omitempty"`
+	ProofDetail                   *ProofDetail `protobuf:"bytes,13,opt,name=ProofDetail,proto3" json:"ProofDetail,omitempty"`
+	InputCoinPubKey               string       `protobuf:"bytes,14,opt,name=InputCoinPubKey,proto3" json:"InputCoinPubKey,omitempty"`
+	SigPubKey                     string       `protobuf:"bytes,15,opt,name=SigPubKey,proto3" json:"SigPubKey,omitempty"`
+	Sig                           string       `protobuf:"bytes,16,opt,name=Sig,proto3" json:"Sig,omitempty"`
+	Metadata                      string       `protobuf:"bytes,17,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+	CustomTokenData               string       `protobuf:"bytes,18,opt,name=CustomTokenData,proto3" json:"CustomTokenData,omitempty"`
+	PrivacyCustomTokenID          string       `protobuf:"bytes,19,opt,name=PrivacyCustomTokenID,proto3" json:"PrivacyCustomTokenID,omitempty"`
+	PrivacyCustomTokenName        string       `protobuf:"bytes,20,opt,name=PrivacyCustomTokenName,proto3" json:"PrivacyCustomTokenName,omitempty"`
+	PrivacyCustomTokenSymbol      string       `protobuf:"bytes,21,opt,name=PrivacyCustomTokenSymbol,proto3" json:"PrivacyCustomTokenSymbol,omitempty"`
+	PrivacyCustomTokenData        string       `protobuf:"bytes,22,opt,name=PrivacyCustomTokenData,proto3" json:"PrivacyCustomTokenData,omitempty"`
+	PrivacyCustomTokenProofDetail *ProofDetail `protobuf:"bytes,23,opt,name=PrivacyCustomTokenProofDetail,proto3" json:"PrivacyCustomTokenProofDetail,omitempty"`
+	PrivacyCustomTokenIsPrivacy   bool         `protobuf:"varint,24,opt,name=PrivacyCustomTokenIsPrivacy,proto3" json:"PrivacyCustomTokenIsPrivacy,omitempty"`
+	PrivacyCustomTokenFee         uint64       `protobuf:"varint,25,opt,name=PrivacyCustomTokenFee,proto3" json:"PrivacyCustomTokenFee,omitempty"`
+	IsInMempool                   bool         `protobuf:"varint,26,opt,name=IsInMempool,proto3" json:"IsInMempool,omitempty"`
+	IsInBlock                     bool         `protobuf:"varint,27,opt,name=IsInBlock,proto3" json:"IsInBlock,omitempty"`
+	Info                          string       `protobuf:"bytes,28,opt,name=Info,proto3" json:"Info,omitempty"`
+}
+
+func (x *TransactionDetail) Reset() {
+	*x = TransactionDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDetail) ProtoMessage() {}
+
+func (x *TransactionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDetail.ProtoReflect.Descriptor instead.
+func (*TransactionDetail) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransactionDetail) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetTxSize() uint64 {
+	if x != nil {
+		return x.TxSize
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetLockTime() string {
+	if x != nil {
+		return x.LockTime
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetIsPrivacy() bool {
+	if x != nil {
+		return x.IsPrivacy
+	}
+	return false
+}
+
+func (x *TransactionDetail) GetProofDetail() *ProofDetail {
+	if x != nil {
+		return x.ProofDetail
+	}
+	return nil
+}
+
+func (x *TransactionDetail) GetInputCoinPubKey() string {
+	if x != nil {
+		return x.InputCoinPubKey
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetSigPubKey() string {
+	if x != nil {
+		return x.SigPubKey
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetSig() string {
+	if x != nil {
+		return x.Sig
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetCustomTokenData() string {
+	if x != nil {
+		return x.CustomTokenData
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenID() string {
+	if x != nil {
+		return x.PrivacyCustomTokenID
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenName() string {
+	if x != nil {
+		return x.PrivacyCustomTokenName
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenSymbol() string {
+	if x != nil {
+		return x.PrivacyCustomTokenSymbol
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenData() string {
+	if x != nil {
+		return x.PrivacyCustomTokenData
+	}
+	return ""
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenProofDetail() *ProofDetail {
+	if x != nil {
+		return x.PrivacyCustomTokenProofDetail
+	}
+	return nil
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenIsPrivacy() bool {
+	if x != nil {
+		return x.PrivacyCustomTokenIsPrivacy
+	}
+	return false
+}
+
+func (x *TransactionDetail) GetPrivacyCustomTokenFee() uint64 {
+	if x != nil {
+		return x.PrivacyCustomTokenFee
+	}
+	return 0
+}
+
+func (x *TransactionDetail) GetIsInMempool() bool {
+	if x != nil {
+		return x.IsInMempool
+	}
+	return false
+}
+
+func (x *TransactionDetail) GetIsInBlock() bool {
+	if x != nil {
+		return x.IsInBlock
+	}
+	return false
+}
+
+func (x *TransactionDetail) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+type GetBalanceByPrivateKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey  string `protobuf:"bytes,1,opt,name=PrivateKey,proto3" json:"PrivateKey,omitempty"`
+	ShardHeight uint64 `protobuf:"varint,2,opt,name=ShardHeight,proto3" json:"ShardHeight,omitempty"`
+}
+
+func (x *GetBalanceByPrivateKeyRequest) Reset() {
+	*x = GetBalanceByPrivateKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceByPrivateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceByPrivateKeyRequest) ProtoMessage() {}
+
+func (x *GetBalanceByPrivateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceByPrivateKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceByPrivateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBalanceByPrivateKeyRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *GetBalanceByPrivateKeyRequest) GetShardHeight() uint64 {
+	if x != nil {
+		return x.ShardHeight
+	}
+	return 0
+}
+
+type GetBalancePrivacyCustomTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey string `protobuf:"bytes,1,opt,name=PrivateKey,proto3" json:"PrivateKey,omitempty"`
+	TokenID    string `protobuf:"bytes,2,opt,name=TokenID,proto3" json:"TokenID,omitempty"`
+}
+
+func (x *GetBalancePrivacyCustomTokenRequest) Reset() {
+	*x = GetBalancePrivacyCustomTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalancePrivacyCustomTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancePrivacyCustomTokenRequest) ProtoMessage() {}
+
+func (x *GetBalancePrivacyCustomTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancePrivacyCustomTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancePrivacyCustomTokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBalancePrivacyCustomTokenRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *GetBalancePrivacyCustomTokenRequest) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
+}
+
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance uint64 `protobuf:"varint,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Balance) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GetPDEStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconHeight uint64 `protobuf:"varint,1,opt,name=BeaconHeight,proto3" json:"BeaconHeight,omitempty"`
+}
+
+func (x *GetPDEStateRequest) Reset() {
+	*x = GetPDEStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPDEStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPDEStateRequest) ProtoMessage() {}
+
+func (x *GetPDEStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPDEStateRequest.ProtoReflect.Descriptor instead.
+func (*GetPDEStateRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPDEStateRequest) GetBeaconHeight() uint64 {
+	if x != nil {
+		return x.BeaconHeight
+	}
+	return 0
+}
+
+type PDEContribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContributorAddressStr string `protobuf:"bytes,1,opt,name=ContributorAddressStr,proto3" json:"ContributorAddressStr,omitempty"`
+	TokenIDStr            string `protobuf:"bytes,2,opt,name=TokenIDStr,proto3" json:"TokenIDStr,omitempty"`
+	Amount                uint64 `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	TxReqID               string `protobuf:"bytes,4,opt,name=TxReqID,proto3" json:"TxReqID,omitempty"`
+}
+
+func (x *PDEContribution) Reset() {
+	*x = PDEContribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDEContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDEContribution) ProtoMessage() {}
+
+func (x *PDEContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDEContribution.ProtoReflect.Descriptor instead.
+func (*PDEContribution) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PDEContribution) GetContributorAddressStr() string {
+	if x != nil {
+		return x.ContributorAddressStr
+	}
+	return ""
+}
+
+func (x *PDEContribution) GetTokenIDStr() string {
+	if x != nil {
+		return x.TokenIDStr
+	}
+	return ""
+}
+
+func (x *PDEContribution) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PDEContribution) GetTxReqID() string {
+	if x != nil {
+		return x.TxReqID
+	}
+	return ""
+}
+
+type PDEPoolForPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token1IDStr     string `protobuf:"bytes,1,opt,name=Token1IDStr,proto3" json:"Token1IDStr,omitempty"`
+	Token1PoolValue uint64 `protobuf:"varint,2,opt,name=Token1PoolValue,proto3" json:"Token1PoolValue,omitempty"`
+	Token2IDStr     string `protobuf:"bytes,3,opt,name=Token2IDStr,proto3" json:"Token2IDStr,omitempty"`
+	Token2PoolValue uint64 `protobuf:"varint,4,opt,name=Token2PoolValue,proto3" json:"Token2PoolValue,omitempty"`
+}
+
+func (x *PDEPoolForPair) Reset() {
+	*x = PDEPoolForPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDEPoolForPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDEPoolForPair) ProtoMessage() {}
+
+func (x *PDEPoolForPair) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDEPoolForPair.ProtoReflect.Descriptor instead.
+func (*PDEPoolForPair) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PDEPoolForPair) GetToken1IDStr() string {
+	if x != nil {
+		return x.Token1IDStr
+	}
+	return ""
+}
+
+func (x *PDEPoolForPair) GetToken1PoolValue() uint64 {
+	if x != nil {
+		return x.Token1PoolValue
+	}
+	return 0
+}
+
+func (x *PDEPoolForPair) GetToken2IDStr() string {
+	if x != nil {
+		return x.Token2IDStr
+	}
+	return ""
+}
+
+func (x *PDEPoolForPair) GetToken2PoolValue() uint64 {
+	if x != nil {
+		return x.Token2PoolValue
+	}
+	return 0
+}
+
+type PDEState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitingPDEContributions map[string]*PDEContribution `protobuf:"bytes,1,rep,name=WaitingPDEContributions,proto3" json:"WaitingPDEContributions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PDEPoolPairs            map[string]*PDEPoolForPair  `protobuf:"bytes,2,rep,name=PDEPoolPairs,proto3" json:"PDEPoolPairs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PDEShares               map[string]uint64           `protobuf:"bytes,3,rep,name=PDEShares,proto3" json:"PDEShares,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PDETradingFees          map[string]uint64           `protobuf:"bytes,4,rep,name=PDETradingFees,proto3" json:"PDETradingFees,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BeaconTimeStamp         int64                       `protobuf:"varint,5,opt,name=BeaconTimeStamp,proto3" json:"BeaconTimeStamp,omitempty"`
+}
+
+func (x *PDEState) Reset() {
+	*x = PDEState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDEState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDEState) ProtoMessage() {}
+
+func (x *PDEState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDEState.ProtoReflect.Descriptor instead.
+func (*PDEState) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PDEState) GetWaitingPDEContributions() map[string]*PDEContribution {
+	if x != nil {
+		return x.WaitingPDEContributions
+	}
+	return nil
+}
+
+func (x *PDEState) GetPDEPoolPairs() map[string]*PDEPoolForPair {
+	if x != nil {
+		return x.PDEPoolPairs
+	}
+	return nil
+}
+
+func (x *PDEState) GetPDEShares() map[string]uint64 {
+	if x != nil {
+		return x.PDEShares
+	}
+	return nil
+}
+
+func (x *PDEState) GetPDETradingFees() map[string]uint64 {
+	if x != nil {
+		return x.PDETradingFees
+	}
+	return nil
+}
+
+func (x *PDEState) GetBeaconTimeStamp() int64 {
+	if x != nil {
+		return x.BeaconTimeStamp
+	}
+	return 0
+}
+
+type GetPDEContributionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContributionPairID string `protobuf:"bytes,1,opt,name=ContributionPairID,proto3" json:"ContributionPairID,omitempty"`
+}
+
+func (x *GetPDEContributionStatusRequest) Reset() {
+	*x = GetPDEContributionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPDEContributionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPDEContributionStatusRequest) ProtoMessage() {}
+
+func (x *GetPDEContributionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPDEContributionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPDEContributionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPDEContributionStatusRequest) GetContributionPairID() string {
+	if x != nil {
+		return x.ContributionPairID
+	}
+	return ""
+}
+
+type PDEContributionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status             uint32 `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	TokenID1Str        string `protobuf:"bytes,2,opt,name=TokenID1Str,proto3" json:"TokenID1Str,omitempty"`
+	Contributed1Amount uint64 `protobuf:"varint,3,opt,name=Contributed1Amount,proto3" json:"Contributed1Amount,omitempty"`
+	Returned1Amount    uint64 `protobuf:"varint,4,opt,name=Returned1Amount,proto3" json:"Returned1Amount,omitempty"`
+	TokenID2Str        string `protobuf:"bytes,5,opt,name=TokenID2Str,proto3" json:"TokenID2Str,omitempty"`
+	Contributed2Amount uint64 `protobuf:"varint,6,opt,name=Contributed2Amount,proto3" json:"Contributed2Amount,omitempty"`
+	Returned2Amount    uint64 `protobuf:"varint,7,opt,name=Returned2Amount,proto3" json:"Returned2Amount,omitempty"`
+}
+
+func (x *PDEContributionStatus) Reset() {
+	*x = PDEContributionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDEContributionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDEContributionStatus) ProtoMessage() {}
+
+func (x *PDEContributionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDEContributionStatus.ProtoReflect.Descriptor instead.
+func (*PDEContributionStatus) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PDEContributionStatus) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PDEContributionStatus) GetTokenID1Str() string {
+	if x != nil {
+		return x.TokenID1Str
+	}
+	return ""
+}
+
+func (x *PDEContributionStatus) GetContributed1Amount() uint64 {
+	if x != nil {
+		return x.Contributed1Amount
+	}
+	return 0
+}
+
+func (x *PDEContributionStatus) GetReturned1Amount() uint64 {
+	if x != nil {
+		return x.Returned1Amount
+	}
+	return 0
+}
+
+func (x *PDEContributionStatus) GetTokenID2Str() string {
+	if x != nil {
+		return x.TokenID2Str
+	}
+	return ""
+}
+
+func (x *PDEContributionStatus) GetContributed2Amount() uint64 {
+	if x != nil {
+		return x.Contributed2Amount
+	}
+	return 0
+}
+
+func (x *PDEContributionStatus) GetReturned2Amount() uint64 {
+	if x != nil {
+		return x.Returned2Amount
+	}
+	return 0
+}
+
+type GetPDEStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxRequestIDStr string `protobuf:"bytes,1,opt,name=TxRequestIDStr,proto3" json:"TxRequestIDStr,omitempty"`
+}
+
+func (x *GetPDEStatusRequest) Reset() {
+	*x = GetPDEStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPDEStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPDEStatusRequest) ProtoMessage() {}
+
+func (x *GetPDEStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPDEStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPDEStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPDEStatusRequest) GetTxRequestIDStr() string {
+	if x != nil {
+		return x.TxRequestIDStr
+	}
+	return ""
+}
+
+type PDEStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status uint32 `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *PDEStatus) Reset() {
+	*x = PDEStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PDEStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDEStatus) ProtoMessage() {}
+
+func (x *PDEStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDEStatus.ProtoReflect.Descriptor instead.
+func (*PDEStatus) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PDEStatus) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetPortingRequestByTxIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+}
+
+func (x *GetPortingRequestByTxIDRequest) Reset() {
+	*x = GetPortingRequestByTxIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortingRequestByTxIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortingRequestByTxIDRequest) ProtoMessage() {}
+
+func (x *GetPortingRequestByTxIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortingRequestByTxIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPortingRequestByTxIDRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPortingRequestByTxIDRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type MatchingPortingCustodianDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncAddress             string            `protobuf:"bytes,1,opt,name=IncAddress,proto3" json:"IncAddress,omitempty"`
+	RemoteAddress          string            `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Amount                 uint64            `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	LockedAmountCollateral uint64            `protobuf:"varint,4,opt,name=LockedAmountCollateral,proto3" json:"LockedAmountCollateral,omitempty"`
+	LockedTokenCollaterals map[string]uint64 `protobuf:"bytes,5,rep,name=LockedTokenCollaterals,proto3" json:"LockedTokenCollaterals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *MatchingPortingCustodianDetail) Reset() {
+	*x = MatchingPortingCustodianDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchingPortingCustodianDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingPortingCustodianDetail) ProtoMessage() {}
+
+func (x *MatchingPortingCustodianDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingPortingCustodianDetail.ProtoReflect.Descriptor instead.
+func (*MatchingPortingCustodianDetail) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MatchingPortingCustodianDetail) GetIncAddress() string {
+	if x != nil {
+		return x.IncAddress
+	}
+	return ""
+}
+
+func (x *MatchingPortingCustodianDetail) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *MatchingPortingCustodianDetail) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MatchingPortingCustodianDetail) GetLockedAmountCollateral() uint64 {
+	if x != nil {
+		return x.LockedAmountCollateral
+	}
+	return 0
+}
+
+func (x *MatchingPortingCustodianDetail) GetLockedTokenCollaterals() map[string]uint64 {
+	if x != nil {
+		return x.LockedTokenCollaterals
+	}
+	return nil
+}
+
+type PortingRequestStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UniquePortingID string                            `protobuf:"bytes,1,opt,name=UniquePortingID,proto3" json:"UniquePortingID,omitempty"`
+	TxReqID         string                            `protobuf:"bytes,2,opt,name=TxReqID,proto3" json:"TxReqID,omitempty"`
+	TokenID         string                            `protobuf:"bytes,3,opt,name=TokenID,proto3" json:"TokenID,omitempty"`
+	PorterAddress   string                            `protobuf:"bytes,4,opt,name=PorterAddress,proto3" json:"PorterAddress,omitempty"`
+	Amount          uint64                            `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Custodians      []*MatchingPortingCustodianDetail `protobuf:"bytes,6,rep,name=Custodians,proto3" json:"Custodians,omitempty"`
+	PortingFee      uint64                            `protobuf:"varint,7,opt,name=PortingFee,proto3" json:"PortingFee,omitempty"`
+	Status          int32                             `protobuf:"varint,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	BeaconHeight    uint64                            `protobuf:"varint,9,opt,name=BeaconHeight,proto3" json:"BeaconHeight,omitempty"`
+	ShardHeight     uint64                            `protobuf:"varint,10,opt,name=ShardHeight,proto3" json:"ShardHeight,omitempty"`
+	ShardID         int32                             `protobuf:"varint,11,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+}
+
+func (x *PortingRequestStatus) Reset() {
+	*x = PortingRequestStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortingRequestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortingRequestStatus) ProtoMessage() {}
+
+func (x *PortingRequestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortingRequestStatus.ProtoReflect.Descriptor instead.
+func (*PortingRequestStatus) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PortingRequestStatus) GetUniquePortingID() string {
+	if x != nil {
+		return x.UniquePortingID
+	}
+	return ""
+}
+
+func (x *PortingRequestStatus) GetTxReqID() string {
+	if x != nil {
+		return x.TxReqID
+	}
+	return ""
+}
+
+func (x *PortingRequestStatus) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
+}
+
+func (x *PortingRequestStatus) GetPorterAddress() string {
+	if x != nil {
+		return x.PorterAddress
+	}
+	return ""
+}
+
+func (x *PortingRequestStatus) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PortingRequestStatus) GetCustodians() []*MatchingPortingCustodianDetail {
+	if x != nil {
+		return x.Custodians
+	}
+	return nil
+}
+
+func (x *PortingRequestStatus) GetPortingFee() uint64 {
+	if x != nil {
+		return x.PortingFee
+	}
+	return 0
+}
+
+func (x *PortingRequestStatus) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PortingRequestStatus) GetBeaconHeight() uint64 {
+	if x != nil {
+		return x.BeaconHeight
+	}
+	return 0
+}
+
+func (x *PortingRequestStatus) GetShardHeight() uint64 {
+	if x != nil {
+		return x.ShardHeight
+	}
+	return 0
+}
+
+func (x *PortingRequestStatus) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+type GetPortalReqPTokenStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqTxID string `protobuf:"bytes,1,opt,name=ReqTxID,proto3" json:"ReqTxID,omitempty"`
+}
+
+func (x *GetPortalReqPTokenStatusRequest) Reset() {
+	*x = GetPortalReqPTokenStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortalReqPTokenStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalReqPTokenStatusRequest) ProtoMessage() {}
+
+func (x *GetPortalReqPTokenStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalReqPTokenStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPortalReqPTokenStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPortalReqPTokenStatusRequest) GetReqTxID() string {
+	if x != nil {
+		return x.ReqTxID
+	}
+	return ""
+}
+
+type PortalRequestPTokensStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          uint32 `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	UniquePortingID string `protobuf:"bytes,2,opt,name=UniquePortingID,proto3" json:"UniquePortingID,omitempty"`
+	TokenID         string `protobuf:"bytes,3,opt,name=TokenID,proto3" json:"TokenID,omitempty"`
+	IncogAddressStr string `protobuf:"bytes,4,opt,name=IncogAddressStr,proto3" json:"IncogAddressStr,omitempty"`
+	PortingAmount   uint64 `protobuf:"varint,5,opt,name=PortingAmount,proto3" json:"PortingAmount,omitempty"`
+	PortingProof    string `protobuf:"bytes,6,opt,name=PortingProof,proto3" json:"PortingProof,omitempty"`
+	TxReqID         string `protobuf:"bytes,7,opt,name=TxReqID,proto3" json:"TxReqID,omitempty"`
+}
+
+func (x *PortalRequestPTokensStatus) Reset() {
+	*x = PortalRequestPTokensStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortalRequestPTokensStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortalRequestPTokensStatus) ProtoMessage() {}
+
+func (x *PortalRequestPTokensStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortalRequestPTokensStatus.ProtoReflect.Descriptor instead.
+func (*PortalRequestPTokensStatus) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PortalRequestPTokensStatus) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PortalRequestPTokensStatus) GetUniquePortingID() string {
+	if x != nil {
+		return x.UniquePortingID
+	}
+	return ""
+}
+
+func (x *PortalRequestPTokensStatus) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
+}
+
+func (x *PortalRequestPTokensStatus) GetIncogAddressStr() string {
+	if x != nil {
+		return x.IncogAddressStr
+	}
+	return ""
+}
+
+func (x *PortalRequestPTokensStatus) GetPortingAmount() uint64 {
+	if x != nil {
+		return x.PortingAmount
+	}
+	return 0
+}
+
+func (x *PortalRequestPTokensStatus) GetPortingProof() string {
+	if x != nil {
+		return x.PortingProof
+	}
+	return ""
+}
+
+func (x *PortalRequestPTokensStatus) GetTxReqID() string {
+	if x != nil {
+		return x.TxReqID
+	}
+	return ""
+}
+
+type GetPortalRedeemReqStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedeemID string `protobuf:"bytes,1,opt,name=RedeemID,proto3" json:"RedeemID,omitempty"`
+}
+
+func (x *GetPortalRedeemReqStatusRequest) Reset() {
+	*x = GetPortalRedeemReqStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortalRedeemReqStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalRedeemReqStatusRequest) ProtoMessage() {}
+
+func (x *GetPortalRedeemReqStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalRedeemReqStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPortalRedeemReqStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPortalRedeemReqStatusRequest) GetRedeemID() string {
+	if x != nil {
+		return x.RedeemID
+	}
+	return ""
+}
+
+type MatchingRedeemCustodianDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncAddress    string `protobuf:"bytes,1,opt,name=IncAddress,proto3" json:"IncAddress,omitempty"`
+	RemoteAddress string `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Amount        uint64 `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *MatchingRedeemCustodianDetail) Reset() {
+	*x = MatchingRedeemCustodianDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchingRedeemCustodianDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingRedeemCustodianDetail) ProtoMessage() {}
+
+func (x *MatchingRedeemCustodianDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingRedeemCustodianDetail.ProtoReflect.Descriptor instead.
+func (*MatchingRedeemCustodianDetail) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MatchingRedeemCustodianDetail) GetIncAddress() string {
+	if x != nil {
+		return x.IncAddress
+	}
+	return ""
+}
+
+func (x *MatchingRedeemCustodianDetail) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *MatchingRedeemCustodianDetail) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PortalRedeemRequestStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status                  uint32                           `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	UniqueRedeemID          string                           `protobuf:"bytes,2,opt,name=UniqueRedeemID,proto3" json:"UniqueRedeemID,omitempty"`
+	TokenID                 string                           `protobuf:"bytes,3,opt,name=TokenID,proto3" json:"TokenID,omitempty"`
+	RedeemAmount            uint64                           `protobuf:"varint,4,opt,name=RedeemAmount,proto3" json:"RedeemAmount,omitempty"`
+	RedeemerIncAddressStr   string                           `protobuf:"bytes,5,opt,name=RedeemerIncAddressStr,proto3" json:"RedeemerIncAddressStr,omitempty"`
+	RemoteAddress           string                           `protobuf:"bytes,6,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	RedeemFee               uint64                           `protobuf:"varint,7,opt,name=RedeemFee,proto3" json:"RedeemFee,omitempty"`
+	MatchingCustodianDetail []*MatchingRedeemCustodianDetail `protobuf:"bytes,8,rep,name=MatchingCustodianDetail,proto3" json:"MatchingCustodianDetail,omitempty"`
+	TxReqID                 string                           `protobuf:"bytes,9,opt,name=TxReqID,proto3" json:"TxReqID,omitempty"`
+	ShardID                 int32                            `protobuf:"varint,10,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	ShardHeight             uint64                           `protobuf:"varint,11,opt,name=ShardHeight,proto3" json:"ShardHeight,omitempty"`
+	BeaconHeight            uint64                           `protobuf:"varint,12,opt,name=BeaconHeight,proto3" json:"BeaconHeight,omitempty"`
+	RedeemerExternalAddress string                           `protobuf:"bytes,13,opt,name=RedeemerExternalAddress,proto3" json:"RedeemerExternalAddress,omitempty"`
+}
+
+func (x *PortalRedeemRequestStatus) Reset() {
+	*x = PortalRedeemRequestStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcserver_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortalRedeemRequestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortalRedeemRequestStatus) ProtoMessage() {}
+
+func (x *PortalRedeemRequestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcserver_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortalRedeemRequestStatus.ProtoReflect.Descriptor instead.
+func (*PortalRedeemRequestStatus) Descriptor() ([]byte, []int) {
+	return file_rpcserver_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PortalRedeemRequestStatus) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetUniqueRedeemID() string {
+	if x != nil {
+		return x.UniqueRedeemID
+	}
+	return ""
+}
+
+func (x *PortalRedeemRequestStatus) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
+}
+
+func (x *PortalRedeemRequestStatus) GetRedeemAmount() uint64 {
+	if x != nil {
+		return x.RedeemAmount
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetRedeemerIncAddressStr() string {
+	if x != nil {
+		return x.RedeemerIncAddressStr
+	}
+	return ""
+}
+
+func (x *PortalRedeemRequestStatus) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *PortalRedeemRequestStatus) GetRedeemFee() uint64 {
+	if x != nil {
+		return x.RedeemFee
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetMatchingCustodianDetail() []*MatchingRedeemCustodianDetail {
+	if x != nil {
+		return x.MatchingCustodianDetail
+	}
+	return nil
+}
+
+func (x *PortalRedeemRequestStatus) GetTxReqID() string {
+	if x != nil {
+		return x.TxReqID
+	}
+	return ""
+}
+
+func (x *PortalRedeemRequestStatus) GetShardID() int32 {
+	if x != nil {
+		return x.ShardID
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetShardHeight() uint64 {
+	if x != nil {
+		return x.ShardHeight
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetBeaconHeight() uint64 {
+	if x != nil {
+		return x.BeaconHeight
+	}
+	return 0
+}
+
+func (x *PortalRedeemRequestStatus) GetRedeemerExternalAddress() string {
+	if x != nil {
+		return x.RedeemerExternalAddress
+	}
+	return ""
+}
+
+var File_rpcserver_proto protoreflect.FileDescriptor
+
+var file_rpcserver_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x14,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79,
+	0x22, 0x6e, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79,
+	0x22, 0x3a, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x22,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x39, 0x0a, 0x1d, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4e, 0x65, 0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x53,
+	0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x65, 0x78,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x65, 0x78, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0xa0, 0x06, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x52, 0x6f,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a,
+	0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x69, 0x74, 0x4d, 0x61, 0x70, 0x18, 0x19,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x42, 0x69, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x3c, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x58, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x68, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x68, 0x61, 0x72, 0x64, 0x22, 0x3c,
+	0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xce, 0x04, 0x0a,
+	0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x0c, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x56, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a,
+	0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a,
+	0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x35, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0xdc, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x43,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x4e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x53, 0x4e, 0x44, 0x65, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x73, 0x0a, 0x0a, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0b, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70,
+	0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x0a, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x37,
+	0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xa3, 0x08, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x54,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49,
+	0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x49, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x69, 0x6e,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x69, 0x67, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x53, 0x69, 0x67, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x53,
+	0x69, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x69, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x18, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x18, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x36, 0x0a, 0x16, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x5c, 0x0a, 0x1d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x1d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x40, 0x0a, 0x1b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x73, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x15, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x49,
+	0x6e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x49, 0x73, 0x49, 0x6e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x49,
+	0x73, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x49, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x61, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x5f, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x44, 0x22, 0x23, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x99, 0x01, 0x0a, 0x0f, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x44, 0x53, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x53, 0x74, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x22, 0xa8, 0x01, 0x0a,
+	0x0e, 0x50, 0x44, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x49, 0x44, 0x53, 0x74, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x49, 0x44, 0x53, 0x74,
+	0x72, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x50, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x31, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x49, 0x44, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x49, 0x44, 0x53, 0x74, 0x72, 0x12, 0x28, 0x0a,
+	0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x50, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc3, 0x05, 0x0a, 0x08, 0x50, 0x44, 0x45, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x17, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x49, 0x0a, 0x0c, 0x50, 0x44, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x44, 0x45, 0x50,
+	0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x69, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x50,
+	0x44, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x50,
+	0x44, 0x45, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x50, 0x44, 0x45, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x4f, 0x0a,
+	0x0e, 0x50, 0x44, 0x45, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x44, 0x45, 0x54, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x50, 0x44, 0x45, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x66, 0x0a, 0x1c, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x5a, 0x0a, 0x11, 0x50, 0x44, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x50, 0x44, 0x45, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x44,
+	0x45, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x69, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x44,
+	0x22, 0xa7, 0x02, 0x0a, 0x15, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x31, 0x53, 0x74,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44,
+	0x31, 0x53, 0x74, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x64, 0x31, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x31, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x31, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x31, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x32, 0x53, 0x74, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x32, 0x53, 0x74, 0x72,
+	0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x32,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x32, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x32, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x32, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x53, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x53, 0x74, 0x72, 0x22, 0x23, 0x0a, 0x09, 0x50, 0x44, 0x45,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x79, 0x54, 0x78, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x80, 0x03, 0x0a, 0x1e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x49,
+	0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x49, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x12, 0x7d, 0x0a, 0x16, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x45, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73,
+	0x1a, 0x49, 0x0a, 0x1b, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x03, 0x0a, 0x14,
+	0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x50, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x54, 0x78, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x71, 0x54, 0x78, 0x49, 0x44,
+	0x22, 0x86, 0x02, 0x0a, 0x1a, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x49,
+	0x6e, 0x63, 0x6f, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x50, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x50,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x18, 0x0a, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x44, 0x22, 0x7d, 0x0a, 0x1d, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64,
+	0x69, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x49,
+	0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x04, 0x0a, 0x19, 0x50, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x72, 0x49,
+	0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x62, 0x0a,
+	0x17, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69,
+	0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69,
+	0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x17, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x54, 0x78, 0x52, 0x65, 0x71, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0xcf, 0x0b, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x58, 0x0a,
+	0x15, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x65, 0x0a,
+	0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x2e, 0x72,
+	0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x5b, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4e, 0x65, 0x77, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30,
+	0x01, 0x12, 0x5e, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4e, 0x65,
+	0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x72,
+	0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4e, 0x65, 0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30,
+	0x01, 0x12, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x68,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x70, 0x63,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x44, 0x45, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x44, 0x45,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x44, 0x45, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x65, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x54, 0x78, 0x49, 0x44, 0x12, 0x29,
+	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x54, 0x78,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6d, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x50, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x50,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6c, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcserver_proto_rawDescOnce sync.Once
+	file_rpcserver_proto_rawDescData = file_rpcserver_proto_rawDesc
+)
+
+func file_rpcserver_proto_rawDescGZIP() []byte {
+	file_rpcserver_proto_rawDescOnce.Do(func() {
+		file_rpcserver_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcserver_proto_rawDescData)
+	})
+	return file_rpcserver_proto_rawDescData
+}
+
+var file_rpcserver_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_rpcserver_proto_goTypes = []interface{}{
+	(*RetrieveBlockRequest)(nil),                // 0: rpcserver.RetrieveBlockRequest
+	(*RetrieveBlockByHeightRequest)(nil),        // 1: rpcserver.RetrieveBlockByHeightRequest
+	(*RetrieveBeaconBlockRequest)(nil),          // 2: rpcserver.RetrieveBeaconBlockRequest
+	(*RetrieveBeaconBlockByHeightRequest)(nil),  // 3: rpcserver.RetrieveBeaconBlockByHeightRequest
+	(*SubscribeNewShardBlockRequest)(nil),       // 4: rpcserver.SubscribeNewShardBlockRequest
+	(*SubscribeNewBeaconBlockRequest)(nil),      // 5: rpcserver.SubscribeNewBeaconBlockRequest
+	(*Instruction)(nil),                         // 6: rpcserver.Instruction
+	(*BlockTx)(nil),                             // 7: rpcserver.BlockTx
+	(*ShardBlock)(nil),                          // 8: rpcserver.ShardBlock
+	(*ShardBlocks)(nil),                         // 9: rpcserver.ShardBlocks
+	(*ShardState)(nil),                          // 10: rpcserver.ShardState
+	(*ShardStates)(nil),                         // 11: rpcserver.ShardStates
+	(*BeaconBlock)(nil),                         // 12: rpcserver.BeaconBlock
+	(*BeaconBlocks)(nil),                        // 13: rpcserver.BeaconBlocks
+	(*GetTransactionByHashRequest)(nil),         // 14: rpcserver.GetTransactionByHashRequest
+	(*Coin)(nil),                                // 15: rpcserver.Coin
+	(*CoinDetail)(nil),                          // 16: rpcserver.CoinDetail
+	(*ProofDetail)(nil),                         // 17: rpcserver.ProofDetail
+	(*TransactionDetail)(nil),                   // 18: rpcserver.TransactionDetail
+	(*GetBalanceByPrivateKeyRequest)(nil),       // 19: rpcserver.GetBalanceByPrivateKeyRequest
+	(*GetBalancePrivacyCustomTokenRequest)(nil), // 20: rpcserver.GetBalancePrivacyCustomTokenRequest
+	(*Balance)(nil),                             // 21: rpcserver.Balance
+	(*GetPDEStateRequest)(nil),                  // 22: rpcserver.GetPDEStateRequest
+	(*PDEContribution)(nil),                     // 23: rpcserver.PDEContribution
+	(*PDEPoolForPair)(nil),                      // 24: rpcserver.PDEPoolForPair
+	(*PDEState)(nil),                            // 25: rpcserver.PDEState
+	(*GetPDEContributionStatusRequest)(nil),     // 26: rpcserver.GetPDEContributionStatusRequest
+	(*PDEContributionStatus)(nil),               // 27: rpcserver.PDEContributionStatus
+	(*GetPDEStatusRequest)(nil),                 // 28: rpcserver.GetPDEStatusRequest
+	(*PDEStatus)(nil),                           // 29: rpcserver.PDEStatus
+	(*GetPortingRequestByTxIDRequest)(nil),      // 30: rpcserver.GetPortingRequestByTxIDRequest
+	(*MatchingPortingCustodianDetail)(nil),      // 31: rpcserver.MatchingPortingCustodianDetail
+	(*PortingRequestStatus)(nil),                // 32: rpcserver.PortingRequestStatus
+	(*GetPortalReqPTokenStatusRequest)(nil),     // 33: rpcserver.GetPortalReqPTokenStatusRequest
+	(*PortalRequestPTokensStatus)(nil),          // 34: rpcserver.PortalRequestPTokensStatus
+	(*GetPortalRedeemReqStatusRequest)(nil),     // 35: rpcserver.GetPortalRedeemReqStatusRequest
+	(*MatchingRedeemCustodianDetail)(nil),       // 36: rpcserver.MatchingRedeemCustodianDetail
+	(*PortalRedeemRequestStatus)(nil),           // 37: rpcserver.PortalRedeemRequestStatus
+	nil,                                         // 38: rpcserver.BeaconBlock.ShardStatesEntry
+	nil,                                         // 39: rpcserver.PDEState.WaitingPDEContributionsEntry
+	nil,                                         // 40: rpcserver.PDEState.PDEPoolPairsEntry
+	nil,                                         // 41: rpcserver.PDEState.PDESharesEntry
+	nil,                                         // 42: rpcserver.PDEState.PDETradingFeesEntry
+	nil,                                         // 43: rpcserver.MatchingPortingCustodianDetail.LockedTokenCollateralsEntry
+}
+var file_rpcserver_proto_depIdxs = []int32{
+	7,  // 0: rpcserver.ShardBlock.Txs:type_name -> rpcserver.BlockTx
+	6,  // 1: rpcserver.ShardBlock.Instructions:type_name -> rpcserver.Instruction
+	8,  // 2: rpcserver.ShardBlocks.Blocks:type_name -> rpcserver.ShardBlock
+	10, // 3: rpcserver.ShardStates.States:type_name -> rpcserver.ShardState
+	6,  // 4: rpcserver.BeaconBlock.Instructions:type_name -> rpcserver.Instruction
+	38, // 5: rpcserver.BeaconBlock.ShardStates:type_name -> rpcserver.BeaconBlock.ShardStatesEntry
+	12, // 6: rpcserver.BeaconBlocks.Blocks:type_name -> rpcserver.BeaconBlock
+	15, // 7: rpcserver.CoinDetail.CoinDetails:type_name -> rpcserver.Coin
+	16, // 8: rpcserver.ProofDetail.InputCoins:type_name -> rpcserver.CoinDetail
+	16, // 9: rpcserver.ProofDetail.OutputCoins:type_name -> rpcserver.CoinDetail
+	17, // 10: rpcserver.TransactionDetail.ProofDetail:type_name -> rpcserver.ProofDetail
+	17, // 11: rpcserver.TransactionDetail.PrivacyCustomTokenProofDetail:type_name -> rpcserver.ProofDetail
+	39, // 12: rpcserver.PDEState.WaitingPDEContributions:type_name -> rpcserver.PDEState.WaitingPDEContributionsEntry
+	40, // 13: rpcserver.PDEState.PDEPoolPairs:type_name -> rpcserver.PDEState.PDEPoolPairsEntry
+	41, // 14: rpcserver.PDEState.PDEShares:type_name -> rpcserver.PDEState.PDESharesEntry
+	42, // 15: rpcserver.PDEState.PDETradingFees:type_name -> rpcserver.PDEState.PDETradingFeesEntry
+	43, // 16: rpcserver.MatchingPortingCustodianDetail.LockedTokenCollaterals:type_name -> rpcserver.MatchingPortingCustodianDetail.LockedTokenCollateralsEntry
+	31, // 17: rpcserver.PortingRequestStatus.Custodians:type_name -> rpcserver.MatchingPortingCustodianDetail
+	36, // 18: rpcserver.PortalRedeemRequestStatus.MatchingCustodianDetail:type_name -> rpcserver.MatchingRedeemCustodianDetail
+	11, // 19: rpcserver.BeaconBlock.ShardStatesEntry.value:type_name -> rpcserver.ShardStates
+	23, // 20: rpcserver.PDEState.WaitingPDEContributionsEntry.value:type_name -> rpcserver.PDEContribution
+	24, // 21: rpcserver.PDEState.PDEPoolPairsEntry.value:type_name -> rpcserver.PDEPoolForPair
+	0,  // 22: rpcserver.RpcService.RetrieveBlock:input_type -> rpcserver.RetrieveBlockRequest
+	1,  // 23: rpcserver.RpcService.RetrieveBlockByHeight:input_type -> rpcserver.RetrieveBlockByHeightRequest
+	2,  // 24: rpcserver.RpcService.RetrieveBeaconBlock:input_type -> rpcserver.RetrieveBeaconBlockRequest
+	3,  // 25: rpcserver.RpcService.RetrieveBeaconBlockByHeight:input_type -> rpcserver.RetrieveBeaconBlockByHeightRequest
+	4,  // 26: rpcserver.RpcService.SubscribeNewShardBlock:input_type -> rpcserver.SubscribeNewShardBlockRequest
+	5,  // 27: rpcserver.RpcService.SubscribeNewBeaconBlock:input_type -> rpcserver.SubscribeNewBeaconBlockRequest
+	14, // 28: rpcserver.RpcService.GetTransactionByHash:input_type -> rpcserver.GetTransactionByHashRequest
+	19, // 29: rpcserver.RpcService.GetBalanceByPrivateKey:input_type -> rpcserver.GetBalanceByPrivateKeyRequest
+	20, // 30: rpcserver.RpcService.GetBalancePrivacyCustomToken:input_type -> rpcserver.GetBalancePrivacyCustomTokenRequest
+	22, // 31: rpcserver.RpcService.GetPDEState:input_type -> rpcserver.GetPDEStateRequest
+	26, // 32: rpcserver.RpcService.GetPDEContributionStatus:input_type -> rpcserver.GetPDEContributionStatusRequest
+	28, // 33: rpcserver.RpcService.GetPDETradeStatus:input_type -> rpcserver.GetPDEStatusRequest
+	28, // 34: rpcserver.RpcService.GetPDEWithdrawalStatus:input_type -> rpcserver.GetPDEStatusRequest
+	30, // 35: rpcserver.RpcService.GetPortingRequestByTxID:input_type -> rpcserver.GetPortingRequestByTxIDRequest
+	33, // 36: rpcserver.RpcService.GetPortalReqPTokenStatus:input_type -> rpcserver.GetPortalReqPTokenStatusRequest
+	35, // 37: rpcserver.RpcService.GetPortalRedeemReqStatus:input_type -> rpcserver.GetPortalRedeemReqStatusRequest
+	8,  // 38: rpcserver.RpcService.RetrieveBlock:output_type -> rpcserver.ShardBlock
+	9,  // 39: rpcserver.RpcService.RetrieveBlockByHeight:output_type -> rpcserver.ShardBlocks
+	12, // 40: rpcserver.RpcService.RetrieveBeaconBlock:output_type -> rpcserver.BeaconBlock
+	13, // 41: rpcserver.RpcService.RetrieveBeaconBlockByHeight:output_type -> rpcserver.BeaconBlocks
+	8,  // 42: rpcserver.RpcService.SubscribeNewShardBlock:output_type -> rpcserver.ShardBlock
+	12, // 43: rpcserver.RpcService.SubscribeNewBeaconBlock:output_type -> rpcserver.BeaconBlock
+	18, // 44: rpcserver.RpcService.GetTransactionByHash:output_type -> rpcserver.TransactionDetail
+	21, // 45: rpcserver.RpcService.GetBalanceByPrivateKey:output_type -> rpcserver.Balance
+	21, // 46: rpcserver.RpcService.GetBalancePrivacyCustomToken:output_type -> rpcserver.Balance
+	25, // 47: rpcserver.RpcService.GetPDEState:output_type -> rpcserver.PDEState
+	27, // 48: rpcserver.RpcService.GetPDEContributionStatus:output_type -> rpcserver.PDEContributionStatus
+	29, // 49: rpcserver.RpcService.GetPDETradeStatus:output_type -> rpcserver.PDEStatus
+	29, // 50: rpcserver.RpcService.GetPDEWithdrawalStatus:output_type -> rpcserver.PDEStatus
+	32, // 51: rpcserver.RpcService.GetPortingRequestByTxID:output_type -> rpcserver.PortingRequestStatus
+	34, // 52: rpcserver.RpcService.GetPortalReqPTokenStatus:output_type -> rpcserver.PortalRequestPTokensStatus
+	37, // 53: rpcserver.RpcService.GetPortalRedeemReqStatus:output_type -> rpcserver.PortalRedeemRequestStatus
+	38, // [38:54] is the sub-list for method output_type
+	22, // [22:38] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_rpcserver_proto_init() }
+func file_rpcserver_proto_init() {
+	if File_rpcserver_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcserver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveBlockByHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveBeaconBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveBeaconBlockByHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeNewShardBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeNewBeaconBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardBlocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceByPrivateKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalancePrivacyCustomTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPDEStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDEContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDEPoolForPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDEState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPDEContributionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDEContributionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPDEStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PDEStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortingRequestByTxIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchingPortingCustodianDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortingRequestStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortalReqPTokenStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortalRequestPTokensStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortalRedeemReqStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchingRedeemCustodianDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcserver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortalRedeemRequestStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcserver_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcserver_proto_goTypes,
+		DependencyIndexes: file_rpcserver_proto_depIdxs,
+		MessageInfos:      file_rpcserver_proto_msgTypes,
+	}.Build()
+	File_rpcserver_proto = out.File
+	file_rpcserver_proto_rawDesc = nil
+	file_rpcserver_proto_goTypes = nil
+	file_rpcserver_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RpcServiceClient is the client API for RpcService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcServiceClient interface {
+	RetrieveBlock(ctx context.Context, in *RetrieveBlockRequest, opts ...grpc.CallOption) (*ShardBlock, error)
+	RetrieveBlockByHeight(ctx context.Context, in *RetrieveBlockByHeightRequest, opts ...grpc.CallOption) (*ShardBlocks, error)
+	RetrieveBeaconBlock(ctx context.Context, in *RetrieveBeaconBlockRequest, opts ...grpc.CallOption) (*BeaconBlock, error)
+	RetrieveBeaconBlockByHeight(ctx context.Context, in *RetrieveBeaconBlockByHeightRequest, opts ...grpc.CallOption) (*BeaconBlocks, error)
+	SubscribeNewShardBlock(ctx context.Context, in *SubscribeNewShardBlockRequest, opts ...grpc.CallOption) (RpcService_SubscribeNewShardBlockClient, error)
+	SubscribeNewBeaconBlock(ctx context.Context, in *SubscribeNewBeaconBlockRequest, opts ...grpc.CallOption) (RpcService_SubscribeNewBeaconBlockClient, error)
+	GetTransactionByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionDetail, error)
+	GetBalanceByPrivateKey(ctx context.Context, in *GetBalanceByPrivateKeyRequest, opts ...grpc.CallOption) (*Balance, error)
+	GetBalancePrivacyCustomToken(ctx context.Context, in *GetBalancePrivacyCustomTokenRequest, opts ...grpc.CallOption) (*Balance, error)
+	GetPDEState(ctx context.Context, in *GetPDEStateRequest, opts ...grpc.CallOption) (*PDEState, error)
+	GetPDEContributionStatus(ctx context.Context, in *GetPDEContributionStatusRequest, opts ...grpc.CallOption) (*PDEContributionStatus, error)
+	GetPDETradeStatus(ctx context.Context, in *GetPDEStatusRequest, opts ...grpc.CallOption) (*PDEStatus, error)
+	GetPDEWithdrawalStatus(ctx context.Context, in *GetPDEStatusRequest, opts ...grpc.CallOption) (*PDEStatus, error)
+	GetPortingRequestByTxID(ctx context.Context, in *GetPortingRequestByTxIDRequest, opts ...grpc.CallOption) (*PortingRequestStatus, error)
+	GetPortalReqPTokenStatus(ctx context.Context, in *GetPortalReqPTokenStatusRequest, opts ...grpc.CallOption) (*PortalRequestPTokensStatus, error)
+	GetPortalRedeemReqStatus(ctx context.Context, in *GetPortalRedeemReqStatusRequest, opts ...grpc.CallOption) (*PortalRedeemRequestStatus, error)
+}
+
+type rpcServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRpcServiceClient(cc grpc.ClientConnInterface) RpcServiceClient {
+	return &rpcServiceClient{cc}
+}
+
+func (c *rpcServiceClient) RetrieveBlock(ctx context.Context, in *RetrieveBlockRequest, opts ...grpc.CallOption) (*ShardBlock, error) {
+	out := new(ShardBlock)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/RetrieveBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RetrieveBlockByHeight(ctx context.Context, in *RetrieveBlockByHeightRequest, opts ...grpc.CallOption) (*ShardBlocks, error) {
+	out := new(ShardBlocks)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/RetrieveBlockByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RetrieveBeaconBlock(ctx context.Context, in *RetrieveBeaconBlockRequest, opts ...grpc.CallOption) (*BeaconBlock, error) {
+	out := new(BeaconBlock)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/RetrieveBeaconBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RetrieveBeaconBlockByHeight(ctx context.Context, in *RetrieveBeaconBlockByHeightRequest, opts ...grpc.CallOption) (*BeaconBlocks, error) {
+	out := new(BeaconBlocks)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/RetrieveBeaconBlockByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) SubscribeNewShardBlock(ctx context.Context, in *SubscribeNewShardBlockRequest, opts ...grpc.CallOption) (RpcService_SubscribeNewShardBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[0], "/rpcserver.RpcService/SubscribeNewShardBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcServiceSubscribeNewShardBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RpcService_SubscribeNewShardBlockClient interface {
+	Recv() (*ShardBlock, error)
+	grpc.ClientStream
+}
+
+type rpcServiceSubscribeNewShardBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcServiceSubscribeNewShardBlockClient) Recv() (*ShardBlock, error) {
+	m := new(ShardBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcServiceClient) SubscribeNewBeaconBlock(ctx context.Context, in *SubscribeNewBeaconBlockRequest, opts ...grpc.CallOption) (RpcService_SubscribeNewBeaconBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[1], "/rpcserver.RpcService/SubscribeNewBeaconBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcServiceSubscribeNewBeaconBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RpcService_SubscribeNewBeaconBlockClient interface {
+	Recv() (*BeaconBlock, error)
+	grpc.ClientStream
+}
+
+type rpcServiceSubscribeNewBeaconBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcServiceSubscribeNewBeaconBlockClient) Recv() (*BeaconBlock, error) {
+	m := new(BeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcServiceClient) GetTransactionByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionDetail, error) {
+	out := new(TransactionDetail)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetTransactionByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetBalanceByPrivateKey(ctx context.Context, in *GetBalanceByPrivateKeyRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetBalanceByPrivateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetBalancePrivacyCustomToken(ctx context.Context, in *GetBalancePrivacyCustomTokenRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetBalancePrivacyCustomToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPDEState(ctx context.Context, in *GetPDEStateRequest, opts ...grpc.CallOption) (*PDEState, error) {
+	out := new(PDEState)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPDEState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPDEContributionStatus(ctx context.Context, in *GetPDEContributionStatusRequest, opts ...grpc.CallOption) (*PDEContributionStatus, error) {
+	out := new(PDEContributionStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPDEContributionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPDETradeStatus(ctx context.Context, in *GetPDEStatusRequest, opts ...grpc.CallOption) (*PDEStatus, error) {
+	out := new(PDEStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPDETradeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPDEWithdrawalStatus(ctx context.Context, in *GetPDEStatusRequest, opts ...grpc.CallOption) (*PDEStatus, error) {
+	out := new(PDEStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPDEWithdrawalStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPortingRequestByTxID(ctx context.Context, in *GetPortingRequestByTxIDRequest, opts ...grpc.CallOption) (*PortingRequestStatus, error) {
+	out := new(PortingRequestStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPortingRequestByTxID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPortalReqPTokenStatus(ctx context.Context, in *GetPortalReqPTokenStatusRequest, opts ...grpc.CallOption) (*PortalRequestPTokensStatus, error) {
+	out := new(PortalRequestPTokensStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPortalReqPTokenStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) GetPortalRedeemReqStatus(ctx context.Context, in *GetPortalRedeemReqStatusRequest, opts ...grpc.CallOption) (*PortalRedeemRequestStatus, error) {
+	out := new(PortalRedeemRequestStatus)
+	err := c.cc.Invoke(ctx, "/rpcserver.RpcService/GetPortalRedeemReqStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RpcServiceServer is the server API for RpcService service.
+type RpcServiceServer interface {
+	RetrieveBlock(context.Context, *RetrieveBlockRequest) (*ShardBlock, error)
+	RetrieveBlockByHeight(context.Context, *RetrieveBlockByHeightRequest) (*ShardBlocks, error)
+	RetrieveBeaconBlock(context.Context, *RetrieveBeaconBlockRequest) (*BeaconBlock, error)
+	RetrieveBeaconBlockByHeight(context.Context, *RetrieveBeaconBlockByHeightRequest) (*BeaconBlocks, error)
+	SubscribeNewShardBlock(*SubscribeNewShardBlockRequest, RpcService_SubscribeNewShardBlockServer) error
+	SubscribeNewBeaconBlock(*SubscribeNewBeaconBlockRequest, RpcService_SubscribeNewBeaconBlockServer) error
+	GetTransactionByHash(context.Context, *GetTransactionByHashRequest) (*TransactionDetail, error)
+	GetBalanceByPrivateKey(context.Context, *GetBalanceByPrivateKeyRequest) (*Balance, error)
+	GetBalancePrivacyCustomToken(context.Context, *GetBalancePrivacyCustomTokenRequest) (*Balance, error)
+	GetPDEState(context.Context, *GetPDEStateRequest) (*PDEState, error)
+	GetPDEContributionStatus(context.Context, *GetPDEContributionStatusRequest) (*PDEContributionStatus, error)
+	GetPDETradeStatus(context.Context, *GetPDEStatusRequest) (*PDEStatus, error)
+	GetPDEWithdrawalStatus(context.Context, *GetPDEStatusRequest) (*PDEStatus, error)
+	GetPortingRequestByTxID(context.Context, *GetPortingRequestByTxIDRequest) (*PortingRequestStatus, error)
+	GetPortalReqPTokenStatus(context.Context, *GetPortalReqPTokenStatusRequest) (*PortalRequestPTokensStatus, error)
+	GetPortalRedeemReqStatus(context.Context, *GetPortalRedeemReqStatusRequest) (*PortalRedeemRequestStatus, error)
+}
+
+// UnimplementedRpcServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcServiceServer struct {
+}
+
+func (*UnimplementedRpcServiceServer) RetrieveBlock(context.Context, *RetrieveBlockRequest) (*ShardBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBlock not implemented")
+}
+func (*UnimplementedRpcServiceServer) RetrieveBlockByHeight(context.Context, *RetrieveBlockByHeightRequest) (*ShardBlocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBlockByHeight not implemented")
+}
+func (*UnimplementedRpcServiceServer) RetrieveBeaconBlock(context.Context, *RetrieveBeaconBlockRequest) (*BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBeaconBlock not implemented")
+}
+func (*UnimplementedRpcServiceServer) RetrieveBeaconBlockByHeight(context.Context, *RetrieveBeaconBlockByHeightRequest) (*BeaconBlocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBeaconBlockByHeight not implemented")
+}
+func (*UnimplementedRpcServiceServer) SubscribeNewShardBlock(*SubscribeNewShardBlockRequest, RpcService_SubscribeNewShardBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNewShardBlock not implemented")
+}
+func (*UnimplementedRpcServiceServer) SubscribeNewBeaconBlock(*SubscribeNewBeaconBlockRequest, RpcService_SubscribeNewBeaconBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNewBeaconBlock not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetTransactionByHash(context.Context, *GetTransactionByHashRequest) (*TransactionDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionByHash not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetBalanceByPrivateKey(context.Context, *GetBalanceByPrivateKeyRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceByPrivateKey not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetBalancePrivacyCustomToken(context.Context, *GetBalancePrivacyCustomTokenRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalancePrivacyCustomToken not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPDEState(context.Context, *GetPDEStateRequest) (*PDEState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPDEState not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPDEContributionStatus(context.Context, *GetPDEContributionStatusRequest) (*PDEContributionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPDEContributionStatus not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPDETradeStatus(context.Context, *GetPDEStatusRequest) (*PDEStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPDETradeStatus not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPDEWithdrawalStatus(context.Context, *GetPDEStatusRequest) (*PDEStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPDEWithdrawalStatus not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPortingRequestByTxID(context.Context, *GetPortingRequestByTxIDRequest) (*PortingRequestStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortingRequestByTxID not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPortalReqPTokenStatus(context.Context, *GetPortalReqPTokenStatusRequest) (*PortalRequestPTokensStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortalReqPTokenStatus not implemented")
+}
+func (*UnimplementedRpcServiceServer) GetPortalRedeemReqStatus(context.Context, *GetPortalRedeemReqStatusRequest) (*PortalRedeemRequestStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortalRedeemReqStatus not implemented")
+}
+
+func RegisterRpcServiceServer(s *grpc.Server, srv RpcServiceServer) {
+	s.RegisterService(&_RpcService_serviceDesc, srv)
+}
+
+func _RpcService_RetrieveBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RetrieveBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/RetrieveBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RetrieveBlock(ctx, req.(*RetrieveBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RetrieveBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RetrieveBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/RetrieveBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RetrieveBlockByHeight(ctx, req.(*RetrieveBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RetrieveBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveBeaconBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RetrieveBeaconBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/RetrieveBeaconBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RetrieveBeaconBlock(ctx, req.(*RetrieveBeaconBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RetrieveBeaconBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveBeaconBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RetrieveBeaconBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/RetrieveBeaconBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RetrieveBeaconBlockByHeight(ctx, req.(*RetrieveBeaconBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_SubscribeNewShardBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNewShardBlockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).SubscribeNewShardBlock(m, &rpcServiceSubscribeNewShardBlockServer{stream})
+}
+
+type RpcService_SubscribeNewShardBlockServer interface {
+	Send(*ShardBlock) error
+	grpc.ServerStream
+}
+
+type rpcServiceSubscribeNewShardBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceSubscribeNewShardBlockServer) Send(m *ShardBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_SubscribeNewBeaconBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNewBeaconBlockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).SubscribeNewBeaconBlock(m, &rpcServiceSubscribeNewBeaconBlockServer{stream})
+}
+
+type RpcService_SubscribeNewBeaconBlockServer interface {
+	Send(*BeaconBlock) error
+	grpc.ServerStream
+}
+
+type rpcServiceSubscribeNewBeaconBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceSubscribeNewBeaconBlockServer) Send(m *BeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_GetTransactionByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetTransactionByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetTransactionByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetTransactionByHash(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetBalanceByPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceByPrivateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetBalanceByPrivateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetBalanceByPrivateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetBalanceByPrivateKey(ctx, req.(*GetBalanceByPrivateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetBalancePrivacyCustomToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancePrivacyCustomTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetBalancePrivacyCustomToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetBalancePrivacyCustomToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetBalancePrivacyCustomToken(ctx, req.(*GetBalancePrivacyCustomTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPDEState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPDEStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPDEState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPDEState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPDEState(ctx, req.(*GetPDEStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPDEContributionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPDEContributionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPDEContributionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPDEContributionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPDEContributionStatus(ctx, req.(*GetPDEContributionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPDETradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPDEStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPDETradeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPDETradeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPDETradeStatus(ctx, req.(*GetPDEStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPDEWithdrawalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPDEStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPDEWithdrawalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPDEWithdrawalStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPDEWithdrawalStatus(ctx, req.(*GetPDEStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPortingRequestByTxID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortingRequestByTxIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPortingRequestByTxID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPortingRequestByTxID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPortingRequestByTxID(ctx, req.(*GetPortingRequestByTxIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPortalReqPTokenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortalReqPTokenStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPortalReqPTokenStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPortalReqPTokenStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPortalReqPTokenStatus(ctx, req.(*GetPortalReqPTokenStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_GetPortalRedeemReqStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortalRedeemReqStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).GetPortalRedeemReqStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcserver.RpcService/GetPortalRedeemReqStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).GetPortalRedeemReqStatus(ctx, req.(*GetPortalRedeemReqStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RpcService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcserver.RpcService",
+	HandlerType: (*RpcServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetrieveBlock",
+			Handler:    _RpcService_RetrieveBlock_Handler,
+		},
+		{
+			MethodName: "RetrieveBlockByHeight",
+			Handler:    _RpcService_RetrieveBlockByHeight_Handler,
+		},
+		{
+			MethodName: "RetrieveBeaconBlock",
+			Handler:    _RpcService_RetrieveBeaconBlock_Handler,
+		},
+		{
+			MethodName: "RetrieveBeaconBlockByHeight",
+			Handler:    _RpcService_RetrieveBeaconBlockByHeight_Handler,
+		},
+		{
+			MethodName: "GetTransactionByHash",
+			Handler:    _RpcService_GetTransactionByHash_Handler,
+		},
+		{
+			MethodName: "GetBalanceByPrivateKey",
+			Handler:    _RpcService_GetBalanceByPrivateKey_Handler,
+		},
+		{
+			MethodName: "GetBalancePrivacyCustomToken",
+			Handler:    _RpcService_GetBalancePrivacyCustomToken_Handler,
+		},
+		{
+			MethodName: "GetPDEState",
+			Handler:    _RpcService_GetPDEState_Handler,
+		},
+		{
+			MethodName: "GetPDEContributionStatus",
+			Handler:    _RpcService_GetPDEContributionStatus_Handler,
+		},
+		{
+			MethodName: "GetPDETradeStatus",
+			Handler:    _RpcService_GetPDETradeStatus_Handler,
+		},
+		{
+			MethodName: "GetPDEWithdrawalStatus",
+			Handler:    _RpcService_GetPDEWithdrawalStatus_Handler,
+		},
+		{
+			MethodName: "GetPortingRequestByTxID",
+			Handler:    _RpcService_GetPortingRequestByTxID_Handler,
+		},
+		{
+			MethodName: "GetPortalReqPTokenStatus",
+			Handler:    _RpcService_GetPortalReqPTokenStatus_Handler,
+		},
+		{
+			MethodName: "GetPortalRedeemReqStatus",
+			Handler:    _RpcService_GetPortalRedeemReqStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeNewShardBlock",
+			Handler:       _RpcService_SubscribeNewShardBlock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNewBeaconBlock",
+			Handler:       _RpcService_SubscribeNewBeaconBlock_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcserver.proto",
+}
@@ -1,11 +1,15 @@
 package rpcserver
 
-import "github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+import (
+	"fmt"
+
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
 
 type httpHandler func(*HttpServer, interface{}, <-chan struct{}) (interface{}, *rpcservice.RPCError)
 type wsHandler func(*WsServer, interface{}, string, chan RpcSubResult, <-chan struct{})
 
-// Commands valid for normal user
+// Commands of the rpc server, clients are allowed to call them by their rpcAccess (see acl.go)
 var HttpHandler = map[string]httpHandler{
 	//Test Rpc Server
 	testHttpServer: (*HttpServer).handleTestHttpServer,
@@ -23,6 +27,7 @@ var HttpHandler = map[string]httpHandler{
 	getInOutMessages:         (*HttpServer).handleGetInOutMessages,
 	getInOutMessageCount:     (*HttpServer).handleGetInOutMessageCount,
 	getAllPeers:              (*HttpServer).handleGetAllPeers,
+	getPeerScores:            (*HttpServer).handleGetPeerScores,
 	estimateFee:              (*HttpServer).handleEstimateFee,
 	estimateFeeV2:            (*HttpServer).handleEstimateFeeV2,
 	estimateFeeWithEstimator: (*HttpServer).handleEstimateFeeWithEstimator,
@@ -74,6 +79,8 @@ var HttpHandler = map[string]httpHandler{
 	gettransactionhashbyreceiverv2:            (*HttpServer).handleGetTransactionHashByReceiverV2,
 	gettransactionbyreceiver:                  (*HttpServer).handleGetTransactionByReceiver,
 	gettransactionbyreceiverv2:                (*HttpServer).handleGetTransactionByReceiverV2,
+	getTransactionHistory:                     (*HttpServer).handleGetTransactionHistory,
+	getTransactionReceipt:                     (*HttpServer).handleGetTransactionReceipt,
 	createAndSendStakingTransaction:           (*HttpServer).handleCreateAndSendStakingTx,
 	createAndSendStakingTransactionV2:         (*HttpServer).handleCreateAndSendStakingTxV2,
 	createAndSendStopAutoStakingTransaction:   (*HttpServer).handleCreateAndSendStopAutoStakingTransaction,
@@ -110,6 +117,9 @@ var HttpHandler = map[string]httpHandler{
 	canPubkeyStake:      (*HttpServer).handleCanPubkeyStake,
 	getTotalTransaction: (*HttpServer).handleGetTotalTransaction,
 
+	// features
+	listFeatures: (*HttpServer).handleListFeatures,
+
 	// custom token which support privacy
 	createRawPrivacyCustomTokenTransaction:       (*HttpServer).handleCreateRawPrivacyCustomTokenTransaction,
 	sendRawPrivacyCustomTokenTransaction:         (*HttpServer).handleSendRawPrivacyCustomTokenTransaction,
@@ -122,19 +132,6 @@ var HttpHandler = map[string]httpHandler{
 	getListPrivacyCustomTokenBalance:             (*HttpServer).handleGetListPrivacyCustomTokenBalance,
 	getBalancePrivacyCustomToken:                 (*HttpServer).handleGetBalancePrivacyCustomToken,
 
-	// Bridge
-	createIssuingRequest:              (*HttpServer).handleCreateIssuingRequest,
-	sendIssuingRequest:                (*HttpServer).handleSendIssuingRequest,
-	createAndSendIssuingRequest:       (*HttpServer).handleCreateAndSendIssuingRequest,
-	createAndSendIssuingRequestV2:     (*HttpServer).handleCreateAndSendIssuingRequestV2,
-	createAndSendContractingRequest:   (*HttpServer).handleCreateAndSendContractingRequest,
-	createAndSendContractingRequestV2: (*HttpServer).handleCreateAndSendContractingRequestV2,
-	checkETHHashIssued:                (*HttpServer).handleCheckETHHashIssued,
-	getAllBridgeTokens:                (*HttpServer).handleGetAllBridgeTokens,
-	getETHHeaderByHash:                (*HttpServer).handleGetETHHeaderByHash,
-	getBridgeReqWithStatus:            (*HttpServer).handleGetBridgeReqWithStatus,
-	generateTokenID:                   (*HttpServer).handleGenerateTokenID,
-
 	// wallet
 	getPublicKeyFromPaymentAddress:     (*HttpServer).handleGetPublicKeyFromPaymentAddress,
 	defragmentAccount:                  (*HttpServer).handleDefragmentAccount,
@@ -173,86 +170,14 @@ var HttpHandler = map[string]httpHandler{
 	getProducersBlackList:       (*HttpServer).handleGetProducersBlackList,
 	getProducersBlackListDetail: (*HttpServer).handleGetProducersBlackListDetail,
 
-	// pde
-	getPDEState:                                (*HttpServer).handleGetPDEState,
-	createAndSendTxWithWithdrawalReq:           (*HttpServer).handleCreateAndSendTxWithWithdrawalReq,
-	createAndSendTxWithWithdrawalReqV2:         (*HttpServer).handleCreateAndSendTxWithWithdrawalReqV2,
-	createAndSendTxWithPDEFeeWithdrawalReq:     (*HttpServer).handleCreateAndSendTxWithPDEFeeWithdrawalReq,
-	createAndSendTxWithPTokenTradeReq:          (*HttpServer).handleCreateAndSendTxWithPTokenTradeReq,
-	createAndSendTxWithPTokenCrossPoolTradeReq: (*HttpServer).handleCreateAndSendTxWithPTokenCrossPoolTradeReq,
-	createAndSendTxWithPRVTradeReq:             (*HttpServer).handleCreateAndSendTxWithPRVTradeReq,
-	createAndSendTxWithPRVCrossPoolTradeReq:    (*HttpServer).handleCreateAndSendTxWithPRVCrossPoolTradeReq,
-	createAndSendTxWithPTokenContribution:      (*HttpServer).handleCreateAndSendTxWithPTokenContribution,
-	createAndSendTxWithPRVContribution:         (*HttpServer).handleCreateAndSendTxWithPRVContribution,
-	createAndSendTxWithPTokenContributionV2:    (*HttpServer).handleCreateAndSendTxWithPTokenContributionV2,
-	createAndSendTxWithPRVContributionV2:       (*HttpServer).handleCreateAndSendTxWithPRVContributionV2,
-	getPDEContributionStatus:                   (*HttpServer).handleGetPDEContributionStatus,
-	getPDEContributionStatusV2:                 (*HttpServer).handleGetPDEContributionStatusV2,
-	getPDETradeStatus:                          (*HttpServer).handleGetPDETradeStatus,
-	getPDEWithdrawalStatus:                     (*HttpServer).handleGetPDEWithdrawalStatus,
-	getPDEFeeWithdrawalStatus:                  (*HttpServer).handleGetPDEFeeWithdrawalStatus,
-	convertPDEPrices:                           (*HttpServer).handleConvertPDEPrices,
-	extractPDEInstsFromBeaconBlock:             (*HttpServer).handleExtractPDEInstsFromBeaconBlock,
+	// equivocation slashing
+	getEquivocationEvidences:                  (*HttpServer).handleGetEquivocationEvidences,
+	createAndSendSlashEquivocationTransaction: (*HttpServer).handleCreateAndSendSlashEquivocationTransaction,
 
-	getBurningAddress: (*HttpServer).handleGetBurningAddress,
+	// validator stats
+	getValidatorStats: (*HttpServer).handleGetValidatorStats,
 
-	// portal
-	getPortalState:                                (*HttpServer).handleGetPortalState,
-	createAndSendTxWithCustodianDeposit:           (*HttpServer).handleCreateAndSendTxWithCustodianDeposit,
-	getPortalCustodianDepositStatus:               (*HttpServer).handleGetPortalCustodianDepositStatus,
-	createAndSendRegisterPortingPublicTokens:      (*HttpServer).handleCreateAndSendTxPortingRequest,
-	createAndSendTxWithReqPToken:                  (*HttpServer).handleCreateAndSendTxWithReqPToken,
-	createAndSendPortalExchangeRates:              (*HttpServer).handleCreateAndSendTxWithPortalExchangeRate,
-	getPortalFinalExchangeRates:                   (*HttpServer).handleGetPortalFinalExchangeRates,
-	getPortalPortingRequestByKey:                  (*HttpServer).handleGetPortingRequestStatusByTxID,
-	getPortalPortingRequestByPortingId:            (*HttpServer).handleGetPortingRequestStatusByPortingId,
-	convertExchangeRates:                          (*HttpServer).handleConvertExchangeRates,
-	getPortalReqPTokenStatus:                      (*HttpServer).handleGetPortalReqPTokenStatus,
-	getPortingRequestFees:                         (*HttpServer).handleGetPortingRequestFees,
-	createAndSendTxWithRedeemReq:                  (*HttpServer).handleCreateAndSendTxWithRedeemReq,
-	createAndSendTxWithReqUnlockCollateral:        (*HttpServer).handleCreateAndSendTxWithReqUnlockCollateral,
-	getPortalReqUnlockCollateralStatus:            (*HttpServer).handleGetPortalReqUnlockCollateralStatus,
-	getPortalReqRedeemStatus:                      (*HttpServer).handleGetReqRedeemStatusByRedeemID,
-	createAndSendCustodianWithdrawRequest:         (*HttpServer).handleCreateAndSendTxWithCustodianWithdrawRequest,
-	getCustodianWithdrawByTxId:                    (*HttpServer).handleGetCustodianWithdrawRequestStatusByTxId,
-	getCustodianLiquidationStatus:                 (*HttpServer).handleGetCustodianLiquidationStatus,
-	createAndSendTxWithReqWithdrawRewardPortal:    (*HttpServer).handleCreateAndSendTxWithReqWithdrawRewardPortal,
-	getLiquidationExchangeRatesPool:               (*HttpServer).handleGetLiquidationExchangeRatesPool,
-	createAndSendTxRedeemFromLiquidationPoolV3:    (*HttpServer).handleCreateAndSendTxRedeemFromLiquidationPoolV3,
-	createAndSendCustodianTopup:                   (*HttpServer).handleCreateAndSendCustodianTopup,
-	createAndSendTopUpWaitingPorting:              (*HttpServer).handleCreateAndSendTopUpWaitingPorting,
-	createAndSendCustodianTopupV3:                 (*HttpServer).handleCreateAndSendCustodianTopupV3,
-	createAndSendTopUpWaitingPortingV3:            (*HttpServer).handleCreateAndSendTopUpWaitingPortingV3,
-	getTopupAmountForCustodian:                    (*HttpServer).handleGetTopupAmountForCustodianState,
-	getPortalReward:                               (*HttpServer).handleGetPortalReward,
-	getRequestWithdrawPortalRewardStatus:          (*HttpServer).handleGetRequestWithdrawPortalRewardStatus,
-	createAndSendTxWithReqMatchingRedeem:          (*HttpServer).handleCreateAndSendTxWithReqMatchingRedeem,
-	getReqMatchingRedeemStatus:                    (*HttpServer).handleGetReqMatchingRedeemStatusByTxID,
-	getPortalCustodianTopupStatus:                 (*HttpServer).handleGetPortalCustodianTopupStatus,
-	getPortalCustodianTopupStatusV3:               (*HttpServer).handleGetPortalCustodianTopupStatusV3,
-	getPortalCustodianTopupWaitingPortingStatus:   (*HttpServer).handleGetPortalCustodianTopupWaitingPortingStatus,
-	getPortalCustodianTopupWaitingPortingStatusV3: (*HttpServer).handleGetPortalCustodianTopupWaitingPortingStatusV3,
-	getAmountTopUpWaitingPorting:                  (*HttpServer).handleGetAmountTopUpWaitingPorting,
-	getPortalReqRedeemByTxIDStatus:                (*HttpServer).handleGetReqRedeemStatusByTxID,
-	getReqRedeemFromLiquidationPoolByTxIDStatus:   (*HttpServer).handleGetReqRedeemFromLiquidationPoolByTxIDStatus,
-	getReqRedeemFromLiquidationPoolByTxIDStatusV3: (*HttpServer).handleGetReqRedeemFromLiquidationPoolByTxIDStatusV3,
-	createAndSendTxWithCustodianDepositV3:         (*HttpServer).handleCreateAndSendTxWithCustodianDepositV3,
-	getPortalCustodianDepositStatusV3:             (*HttpServer).handleGetPortalCustodianDepositStatusV3,
-	checkPortalExternalHashSubmitted:              (*HttpServer).handleCheckPortalExternalHashSubmitted,
-	createAndSendTxWithCustodianWithdrawRequestV3: (*HttpServer).handleCreateAndSendTxWithCustodianWithdrawRequestV3,
-	getCustodianWithdrawRequestStatusV3ByTxId:     (*HttpServer).handleGetCustodianWithdrawRequestStatusV3ByTxId,
-	getPortalWithdrawCollateralProof:              (*HttpServer).handleGetPortalWithdrawCollateralProof,
-	createAndSendUnlockOverRateCollaterals:        (*HttpServer).handleCreateAndSendTxWithPortalCusUnlockOverRateCollaterals,
-	getPortalUnlockOverRateCollateralsStatus:      (*HttpServer).handleGetPortalReqUnlockOverRateCollateralStatus,
-
-	// relaying
-	createAndSendTxWithRelayingBNBHeader: (*HttpServer).handleCreateAndSendTxWithRelayingBNBHeader,
-	createAndSendTxWithRelayingBTCHeader: (*HttpServer).handleCreateAndSendTxWithRelayingBTCHeader,
-	getRelayingBNBHeaderState:            (*HttpServer).handleGetRelayingBNBHeaderState,
-	getRelayingBNBHeaderByBlockHeight:    (*HttpServer).handleGetRelayingBNBHeaderByBlockHeight,
-	getBTCRelayingBestState:              (*HttpServer).handleGetBTCRelayingBestState,
-	getBTCBlockByHash:                    (*HttpServer).handleGetBTCBlockByHash,
-	getLatestBNBHeaderBlockHeight:        (*HttpServer).handleGetLatestBNBHeaderBlockHeight,
+	getBurningAddress: (*HttpServer).handleGetBurningAddress,
 
 	// incognnito mode for sc
 	getBurnProofForDepositToSC:                  (*HttpServer).handleGetBurnProofForDepositToSC,
@@ -275,10 +200,16 @@ var HttpHandler = map[string]httpHandler{
 
 	//validators state
 	getValKeyState: (*HttpServer).handleGetValKeyState,
-}
 
-// Commands that are available to a limited user
-var LimitedHttpHandler = map[string]httpHandler{
+	// note scanner
+	registerScanKey:        (*HttpServer).handleRegisterScanKey,
+	unregisterScanKey:      (*HttpServer).handleUnregisterScanKey,
+	getScannedBalance:      (*HttpServer).handleGetScannedBalance,
+	listScannedOutputCoins: (*HttpServer).handleListScannedOutputCoins,
+	getScannedHistory:      (*HttpServer).handleGetScannedHistory,
+
+	getScannedTransactionHistory: (*HttpServer).handleGetScannedTransactionHistory,
+
 	// local WALLET
 	listAccounts:                     (*HttpServer).handleListAccounts,
 	getAccount:                       (*HttpServer).handleGetAccount,
@@ -295,6 +226,10 @@ var LimitedHttpHandler = map[string]httpHandler{
 	setTxFee:                         (*HttpServer).handleSetTxFee,
 	convertNativeTokenToPrivacyToken: (*HttpServer).handleConvertNativeTokenToPrivacyToken,
 	convertPrivacyTokenToNativeToken: (*HttpServer).handleConvertPrivacyTokenToNativeToken,
+
+	// peer reputation
+	banPeer:   (*HttpServer).handleBanPeer,
+	unbanPeer: (*HttpServer).handleUnbanPeer,
 }
 
 var WsHandler = map[string]wsHandler{
@@ -315,4 +250,39 @@ var WsHandler = map[string]wsHandler{
 	subcribeBeaconBestState:                     (*WsServer).handleSubscribeBeaconBestState,
 	subcribeBeaconPoolBeststate:                 (*WsServer).handleSubscribeBeaconPoolBestState,
 	subcribeShardPoolBeststate:                  (*WsServer).handleSubscribeShardPoolBeststate,
+	subcribeTransactionConfirmation:             (*WsServer).handleSubscribeTransactionConfirmation,
+	subcribeViewKeyActivity:                     (*WsServer).handleSubscribeViewKeyActivity,
+	subcribePDETradeStatus:                      (*WsServer).handleSubscribePDETradeStatus,
+	subcribePDEContributionStatus:               (*WsServer).handleSubscribePDEContributionStatus,
+	subcribePortalPortingStatus:                 (*WsServer).handleSubscribePortalPortingStatus,
+	subcribePortalRedeemStatus:                  (*WsServer).handleSubscribePortalRedeemStatus,
+	subcribeBridgeBurnConfirmation:              (*WsServer).handleSubscribeBridgeBurnConfirmation,
+	subcribeTransactionReceipt:                  (*WsServer).handleSubscribeTransactionReceipt,
+}
+
+// httpHandlerFeatures keeps the feature of handlers registered by feature modules
+var httpHandlerFeatures = map[string]string{}
+
+// registerHttpHandlers adds handlers of a feature to HttpHandler, none of them is added
+// if a method is already handled
+func registerHttpHandlers(feature string, handlers map[string]httpHandler) error {
+	for method := range handlers {
+		if registered, ok := httpHandlerFeatures[method]; ok {
+			return fmt.Errorf("rpc method %+v of feature %+v is already registered by feature %+v", method, feature, registered)
+		}
+		if _, ok := HttpHandler[method]; ok {
+			return fmt.Errorf("rpc method %+v of feature %+v is already handled", method, feature)
+		}
+	}
+	for method, handler := range handlers {
+		HttpHandler[method] = handler
+		httpHandlerFeatures[method] = feature
+	}
+	return nil
+}
+
+func mustRegisterHttpHandlers(feature string, handlers map[string]httpHandler) {
+	if err := registerHttpHandlers(feature, handlers); err != nil {
+		panic(err)
+	}
 }
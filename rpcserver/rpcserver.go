@@ -14,7 +14,10 @@ import (
 	"github.com/incognitochain/incognito-chain/incdb"
 	"github.com/incognitochain/incognito-chain/memcache"
 	"github.com/incognitochain/incognito-chain/mempool"
+	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/netsync"
+	"github.com/incognitochain/incognito-chain/notescanner"
+	"github.com/incognitochain/incognito-chain/peerv2"
 	"github.com/incognitochain/incognito-chain/pubsub"
 	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
 	"github.com/incognitochain/incognito-chain/syncker"
@@ -24,9 +27,12 @@ import (
 )
 
 const (
-	rpcAuthTimeoutSeconds    = 60
-	rpcProcessTimeoutSeconds = 90
-	RpcServerVersion         = "1.0"
+	rpcAuthTimeoutSeconds      = 60
+	rpcProcessTimeoutSeconds   = 90
+	RpcServerVersion           = "1.0"
+	maxTransactionHistoryLimit = 100
+	defaultRPCBatchWorkers     = 8
+	rpcGzipMinLength           = 1024 // smaller responses are not compressed
 )
 
 // timeZeroVal is simply the zero value for a time.Time and is used to avoid
@@ -41,6 +47,7 @@ type UsageFlag uint32
 type RpcServer struct {
 	HttpServer *HttpServer
 	WsServer   *WsServer
+	GrpcServer *GrpcServer
 
 	started          int32
 	shutdown         int32
@@ -62,6 +69,7 @@ type RpcServer struct {
 type RpcServerConfig struct {
 	HttpListenters  []net.Listener
 	WsListenters    []net.Listener
+	GrpcListenters  []net.Listener
 	ProtocolVersion string
 	ChainParams     *blockchain.Params
 	BlockChain      *blockchain.BlockChain
@@ -72,9 +80,12 @@ type RpcServerConfig struct {
 	ConnMgr         *connmanager.ConnManager
 	AddrMgr         *addrmanager.AddrManager
 	// NodeMode        string
-	NetSync *netsync.NetSync
-	Syncker *syncker.SynckerManager
-	Server  interface {
+	NetSync    *netsync.NetSync
+	Syncker    *syncker.SynckerManager
+	PeerScorer *peerv2.PeerScoreKeeper
+	// NoteScanner is nil when note scanning is disabled
+	NoteScanner *notescanner.Scanner
+	Server      interface {
 		// Push TxNormal Message
 		PushMessageToAll(message wire.Message) error
 		PushMessageToPeer(message wire.Message, id peer2.ID) error
@@ -93,6 +104,8 @@ type RpcServerConfig struct {
 		GetAllMiningPublicKeys() []string
 		ExtractBridgeValidationData(block common.BlockInterface) ([][]byte, []int, error)
 		GetAllValidatorKeyState() map[string]consensus.MiningState
+		GetEquivocationEvidences() []metadata.EquivocationEvidence
+		RemoveEquivocationEvidence(evidenceHash string)
 	}
 	TxMemPool                   rpcservice.MempoolInterface
 	RPCMaxClients               int
@@ -100,11 +113,14 @@ type RpcServerConfig struct {
 	RPCLimitRequestPerDay       int
 	RPCLimitRequestErrorPerHour int
 	RPCQuirks                   bool
+	RPCBatchWorkers             int // number of requests of batches processed concurrently, by all clients
+	RPCMaxBatchSize             int // 0: unlimited
 	// Authentication
 	RPCUser      string
 	RPCPass      string
 	RPCLimitUser string
 	RPCLimitPass string
+	RPCACLFile   string // file of API keys, see acl.go
 	DisableAuth  bool
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
@@ -115,7 +131,8 @@ type RpcServerConfig struct {
 }
 
 func (rpcServer *RpcServer) Init(config *RpcServerConfig) {
-	if len(config.HttpListenters) > 0 {
+	// the gRPC server shares the services of the http server
+	if len(config.HttpListenters) > 0 || len(config.GrpcListenters) > 0 {
 		rpcServer.HttpServer = &HttpServer{}
 		rpcServer.HttpServer.Init(config)
 	}
@@ -123,6 +140,10 @@ func (rpcServer *RpcServer) Init(config *RpcServerConfig) {
 		rpcServer.WsServer = &WsServer{}
 		rpcServer.WsServer.Init(config)
 	}
+	if len(config.GrpcListenters) > 0 {
+		rpcServer.GrpcServer = &GrpcServer{}
+		rpcServer.GrpcServer.Init(config, rpcServer.HttpServer)
+	}
 }
 func (rpcServer *RpcServer) Start() {
 	if rpcServer.WsServer != nil {
@@ -137,11 +158,20 @@ func (rpcServer *RpcServer) Start() {
 			Logger.log.Error(err)
 		}
 	}
+	if rpcServer.GrpcServer != nil {
+		err := rpcServer.GrpcServer.Start()
+		if err != nil {
+			Logger.log.Error(err)
+		}
+	}
 }
 func (rpcServer *RpcServer) Stop() {
 	if rpcServer.WsServer != nil {
 		rpcServer.WsServer.Stop()
 	}
+	if rpcServer.GrpcServer != nil {
+		rpcServer.GrpcServer.Stop()
+	}
 	if rpcServer.HttpServer != nil {
 		rpcServer.HttpServer.Stop()
 	}
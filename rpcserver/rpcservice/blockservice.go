@@ -1,6 +1,7 @@
 package rpcservice
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -19,6 +20,7 @@ import (
 	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
 	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
 )
 
 type BlockService struct {
@@ -660,7 +662,8 @@ func (blockService BlockService) ListRewardAmount() (map[string]map[common.Hash]
 	return m, nil
 }
 
-func (blockService BlockService) GetRewardAmount(paymentAddress string) (map[string]uint64, error) {
+// GetRewardAmount returns committee reward of a payment address as of a height of its shard, 0 for the best view
+func (blockService BlockService) GetRewardAmount(paymentAddress string, height uint64) (map[string]uint64, error) {
 	rewardAmountResult := make(map[string]uint64)
 	keySet, _, err := GetKeySetFromPaymentAddressParam(paymentAddress)
 	if err != nil {
@@ -675,8 +678,14 @@ func (blockService BlockService) GetRewardAmount(paymentAddress string) (map[str
 	if err != nil {
 		return nil, err
 	}
+	committeeRewardStateDB := blockService.BlockChain.GetBestStateShard(shardID).GetShardRewardStateDB()
+	if height > 0 {
+		committeeRewardStateDB, err = blockService.BlockChain.GetShardRewardStateDBByHeight(shardID, height)
+		if err != nil {
+			return nil, err
+		}
+	}
 	for _, coinID := range allCoinIDs {
-		committeeRewardStateDB := blockService.BlockChain.GetBestStateShard(shardID).GetShardRewardStateDB()
 		tempPK := base58.Base58Check{}.Encode(publicKey, common.Base58Version)
 		amount, err := statedb.GetCommitteeReward(committeeRewardStateDB, tempPK, coinID)
 		if err != nil {
@@ -917,6 +926,30 @@ func (blockService BlockService) GetPDEStatus(pdePrefix []byte, pdeSuffix []byte
 	return statedb.GetPDEStatus(pdexStateDB, pdePrefix, pdeSuffix)
 }
 
+func (blockService BlockService) GetPDEState(beaconHeight uint64) (*jsonresult.CurrentPDEState, *RPCError) {
+	beaconFeatureStateDB, err := blockService.BlockChain.GetBeaconFeatureStateDBByHeight(beaconHeight)
+	if err != nil {
+		return nil, NewRPCError(GetPDEStateError, err)
+	}
+	pdeState, err := blockchain.InitCurrentPDEStateFromDB(beaconFeatureStateDB, beaconHeight)
+	if err != nil {
+		return nil, NewRPCError(GetPDEStateError, err)
+	}
+	beaconBlocks, err := blockService.BlockChain.GetBeaconBlockByHeight(beaconHeight)
+	if err != nil {
+		return nil, NewRPCError(GetPDEStateError, err)
+	}
+	beaconBlock := beaconBlocks[0]
+	result := &jsonresult.CurrentPDEState{
+		BeaconTimeStamp:         beaconBlock.Header.Timestamp,
+		PDEPoolPairs:            pdeState.PDEPoolPairs,
+		PDEShares:               pdeState.PDEShares,
+		WaitingPDEContributions: pdeState.WaitingPDEContributions,
+		PDETradingFees:          pdeState.PDETradingFees,
+	}
+	return result, nil
+}
+
 ////============================= Slash ===============================
 //func (blockService BlockService) GetProducersBlackList(beaconHeight uint64) (map[string]uint8, error) {
 //	slashRootHash, err := blockService.BlockChain.GetBeaconSlashRootHash(blockService.BlockChain.GetBeaconBestState().GetBeaconConsensusStateDB(), beaconHeight)
@@ -1254,3 +1287,142 @@ func (blockService BlockService) CheckPortalExternalTxSubmitted(data map[string]
 	submitted, err := statedb.IsPortalExternalTxHashSubmitted(featureStateDB, uniqExternalTx)
 	return submitted, err
 }
+
+// GetStakingPool returns the staking pool of a validator and its delegations from the beacon best state
+func (blockService BlockService) GetStakingPool(committeePublicKey string) (*jsonresult.StakingPoolResult, error) {
+	consensusStateDB := blockService.BlockChain.GetBeaconBestState().GetBeaconConsensusStateDB()
+	pool, has, err := statedb.GetStakingPool(consensusStateDB, committeePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("staking pool of %+v not found", committeePublicKey)
+	}
+	delegations, err := statedb.GetDelegations(consensusStateDB, committeePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return jsonresult.NewStakingPoolResult(pool, delegations), nil
+}
+
+// GetUnbondingStatus returns the unbonding stakes from the beacon best state, filtered by committee public key
+// and receiver payment address when they are not empty
+func (blockService BlockService) GetUnbondingStatus(committeePublicKey string, paymentAddress string) ([]*jsonresult.UnbondingResult, error) {
+	var receiverPk []byte
+	if paymentAddress != "" {
+		keyWallet, err := wallet.Base58CheckDeserialize(paymentAddress)
+		if err != nil {
+			return nil, err
+		}
+		receiverPk = keyWallet.KeySet.PaymentAddress.Pk
+	}
+	beaconBestState := blockService.BlockChain.GetBeaconBestState()
+	unbondings, err := statedb.GetAllUnbondings(beaconBestState.GetBeaconConsensusStateDB())
+	if err != nil {
+		return nil, err
+	}
+	result := []*jsonresult.UnbondingResult{}
+	for _, unbonding := range unbondings {
+		if committeePublicKey != "" && unbonding.CommitteePublicKey() != committeePublicKey {
+			continue
+		}
+		if receiverPk != nil && !bytes.Equal(unbonding.Receiver().Pk, receiverPk) {
+			continue
+		}
+		result = append(result, jsonresult.NewUnbondingResult(unbonding, blockService.BlockChain.GetConfig().ChainParams.Epoch))
+	}
+	return result, nil
+}
+
+// GetAutoWithdrawRewardPolicy returns the auto withdraw policy of a reward receiver from the beacon best state
+func (blockService BlockService) GetAutoWithdrawRewardPolicy(paymentAddress string) (*jsonresult.AutoWithdrawRewardPolicyResult, error) {
+	keyWallet, err := wallet.Base58CheckDeserialize(paymentAddress)
+	if err != nil {
+		return nil, err
+	}
+	consensusStateDB := blockService.BlockChain.GetBeaconBestState().GetBeaconConsensusStateDB()
+	policy, has, err := statedb.GetAutoWithdrawPolicy(consensusStateDB, keyWallet.KeySet.PaymentAddress.Pk)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("auto withdraw reward policy of %+v not found", paymentAddress)
+	}
+	return jsonresult.NewAutoWithdrawRewardPolicyResult(policy, blockService.BlockChain.GetCurrentGovernanceParam(blockchain.AutoWithdrawRewardFeeParam)), nil
+}
+
+// ListFeatures returns the features with their activation height, filtered by status (active or upcoming)
+// when it is not empty
+func (blockService BlockService) ListFeatures(status string) ([]*jsonresult.FeatureResult, error) {
+	if status != "" && status != jsonresult.FeatureActiveStatus && status != jsonresult.FeatureUpcomingStatus {
+		return nil, fmt.Errorf("feature status %+v is invalid", status)
+	}
+	result := []*jsonresult.FeatureResult{}
+	for _, feature := range blockService.BlockChain.GetFeatures() {
+		featureResult := jsonresult.NewFeatureResult(feature)
+		if status != "" && featureResult.Status != status {
+			continue
+		}
+		result = append(result, featureResult)
+	}
+	return result, nil
+}
+
+// GetGovernanceParams returns the governable chain params at the height of the beacon best state
+func (blockService BlockService) GetGovernanceParams() ([]*jsonresult.GovernanceParamResult, error) {
+	params, err := blockService.BlockChain.GetGovernanceParams()
+	if err != nil {
+		return nil, err
+	}
+	result := []*jsonresult.GovernanceParamResult{}
+	for _, param := range params {
+		result = append(result, jsonresult.NewGovernanceParamResult(param))
+	}
+	return result, nil
+}
+
+// GetGovernanceProposal returns a param change proposal from the beacon best state by the hash of its tx
+func (blockService BlockService) GetGovernanceProposal(proposalID string) (*jsonresult.GovernanceProposalResult, error) {
+	proposalHash, err := common.Hash{}.NewHashFromStr(proposalID)
+	if err != nil {
+		return nil, err
+	}
+	consensusStateDB := blockService.BlockChain.GetBeaconBestState().GetBeaconConsensusStateDB()
+	proposal, has, err := statedb.GetGovernanceProposal(consensusStateDB, *proposalHash)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("governance proposal %+v not found", proposalID)
+	}
+	return jsonresult.NewGovernanceProposalResult(proposal), nil
+}
+
+// ListGovernanceProposals returns the param change proposals from the beacon best state, filtered by status when it is not empty
+func (blockService BlockService) ListGovernanceProposals(status string) ([]*jsonresult.GovernanceProposalResult, error) {
+	consensusStateDB := blockService.BlockChain.GetBeaconBestState().GetBeaconConsensusStateDB()
+	proposals, err := statedb.GetAllGovernanceProposals(consensusStateDB)
+	if err != nil {
+		return nil, err
+	}
+	result := []*jsonresult.GovernanceProposalResult{}
+	for _, proposal := range proposals {
+		if status != "" && proposal.Status() != status {
+			continue
+		}
+		result = append(result, jsonresult.NewGovernanceProposalResult(proposal))
+	}
+	return result, nil
+}
+
+// GetValidatorStats returns the stats of a validator by epoch, toEpoch 0 is the current epoch of beacon
+func (blockService BlockService) GetValidatorStats(committeePublicKey string, fromEpoch uint64, toEpoch uint64) (*jsonresult.ValidatorStatsResult, error) {
+	if toEpoch == 0 {
+		toEpoch = blockService.BlockChain.GetBeaconBestState().Epoch
+	}
+	stats, err := blockService.BlockChain.GetValidatorStats(committeePublicKey, fromEpoch, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return jsonresult.NewValidatorStatsResult(committeePublicKey, fromEpoch, toEpoch, stats), nil
+}
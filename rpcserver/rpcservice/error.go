@@ -57,6 +57,8 @@ const (
 	SendRawTransactionError
 	BuildTokenParamError
 	BuildPrivacyTokenParamError
+	GetTransactionHistoryError
+	GetTransactionReceiptError
 	GetListPrivacyCustomTokenBalanceError
 	GetPrivacyTokenError
 	// reject tx
@@ -128,6 +130,10 @@ const (
 	RestoreCandidateShardWaitingForNextRandom
 
 	GetTotalStakerError
+
+	// note scanner
+	NoteScannerNotEnabledError
+	NoteScannerError
 )
 
 // Standard JSON-RPC 2.0 errors.
@@ -187,6 +193,8 @@ var ErrCodeMessage = map[int]struct {
 	SendRawTransactionError:          {-4007, "Send Raw Transaction Error"},
 	BuildTokenParamError:             {-4008, "Build Token Param Error"},
 	BuildPrivacyTokenParamError:      {-4009, "Build Privacy Token Param Error"},
+	GetTransactionHistoryError:       {-4010, "Get Transaction History Error"},
+	GetTransactionReceiptError:       {-4011, "Get Transaction Receipt Error"},
 	// socket/subcribe -5xxx
 	SubcribeError:   {-5000, "Failed to subcribe"},
 	UnsubcribeError: {-5001, "Failed to unsubcribe"},
@@ -256,6 +264,10 @@ var ErrCodeMessage = map[int]struct {
 	RestoreCandidateShardWaitingForNextRandom:     {-12008, "Restore candidate shard waiting for next random"},
 	GetAllBeaconViews:                             {-12009, "Get all beacon views"},
 	GetTotalStakerError:                           {-12010, "Get total staker return error"},
+
+	// note scanner -13xxx
+	NoteScannerNotEnabledError: {-13000, "Note scanner is not enabled"},
+	NoteScannerError:           {-13001, "Note scanner error"},
 }
 
 // RPCError represents an error that is used as a part of a JSON-RPC JsonResponse
@@ -1,12 +1,20 @@
 package rpcservice
 
-import "github.com/incognitochain/incognito-chain/connmanager"
+import (
+	"errors"
+	"time"
 
-type NetworkService struct{
-	ConnMgr *connmanager.ConnManager
+	"github.com/incognitochain/incognito-chain/connmanager"
+	"github.com/incognitochain/incognito-chain/peerv2"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+)
+
+type NetworkService struct {
+	ConnMgr    *connmanager.ConnManager
+	PeerScorer *peerv2.PeerScoreKeeper
 }
 
-func (networkService  NetworkService) GetConnectionCount() int {
+func (networkService NetworkService) GetConnectionCount() int {
 	if networkService.ConnMgr == nil || networkService.ConnMgr.GetListeningPeer() == nil {
 		return 0
 	}
@@ -14,3 +22,28 @@ func (networkService  NetworkService) GetConnectionCount() int {
 	listeningPeer := networkService.ConnMgr.GetListeningPeer()
 	return len(listeningPeer.GetPeerConns())
 }
+
+func (networkService NetworkService) GetPeerScores() ([]jsonresult.PeerScoreResult, error) {
+	if networkService.PeerScorer == nil {
+		return nil, errors.New("peer scoring is not available")
+	}
+	result := []jsonresult.PeerScoreResult{}
+	for _, ps := range networkService.PeerScorer.GetPeerScores() {
+		result = append(result, jsonresult.NewPeerScoreResult(ps))
+	}
+	return result, nil
+}
+
+func (networkService NetworkService) BanPeer(peerID string, duration time.Duration) error {
+	if networkService.PeerScorer == nil {
+		return errors.New("peer scoring is not available")
+	}
+	return networkService.PeerScorer.Ban(peerID, duration)
+}
+
+func (networkService NetworkService) UnbanPeer(peerID string) error {
+	if networkService.PeerScorer == nil {
+		return errors.New("peer scoring is not available")
+	}
+	return networkService.PeerScorer.Unban(peerID)
+}
@@ -0,0 +1,145 @@
+package rpcservice
+
+import (
+	"errors"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/notescanner"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+)
+
+type NoteScannerService struct {
+	Scanner *notescanner.Scanner
+}
+
+func (s *NoteScannerService) getScanner() (*notescanner.Scanner, *RPCError) {
+	if s.Scanner == nil {
+		return nil, NewRPCError(NoteScannerNotEnabledError, errors.New("run node with notescannerpassphrase to enable note scanner"))
+	}
+	return s.Scanner, nil
+}
+
+func newNoteScannerRPCError(err error) *RPCError {
+	if scannerErr, ok := err.(*notescanner.NoteScannerError); ok && scannerErr.Code == notescanner.ErrCodeMessage[notescanner.InvalidScanKeyError].Code {
+		return NewRPCError(RPCInvalidParamsError, err)
+	}
+	return NewRPCError(NoteScannerError, err)
+}
+
+func (s *NoteScannerService) RegisterScanKey(key string, startHeight uint64) (*notescanner.ScanKeyInfo, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	info, err := scanner.RegisterKey(key, startHeight)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return info, nil
+}
+
+func (s *NoteScannerService) UnregisterScanKey(key string) *RPCError {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return rpcErr
+	}
+	if err := scanner.UnregisterKey(key); err != nil {
+		return newNoteScannerRPCError(err)
+	}
+	return nil
+}
+
+func (s *NoteScannerService) GetScannedBalance(key string) (*jsonresult.ScannedBalanceResult, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	info, balances, received, err := scanner.GetBalances(key)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return &jsonresult.ScannedBalanceResult{ScanKeyInfo: info, Balances: balances, Received: received}, nil
+}
+
+func (s *NoteScannerService) ListScannedOutputCoins(key string, tokenID *common.Hash, unspentOnly bool, offset int, limit int) (*jsonresult.ListScannedOutputCoinsResult, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	info, err := scanner.GetKeyInfo(key)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	coins, total, err := scanner.ListCoins(key, tokenID, unspentOnly, offset, limit)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return &jsonresult.ListScannedOutputCoinsResult{ScanKeyInfo: info, Total: total, Coins: coins}, nil
+}
+
+func (s *NoteScannerService) GetScannedHistory(key string, offset int, limit int) (*jsonresult.ScannedHistoryResult, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	info, err := scanner.GetKeyInfo(key)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	history, total, err := scanner.ListHistory(key, offset, limit)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return &jsonresult.ScannedHistoryResult{ScanKeyInfo: info, Total: total, History: history}, nil
+}
+
+func (s *NoteScannerService) GetScanKeyInfo(key string) (*notescanner.ScanKeyInfo, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	info, err := scanner.GetKeyInfo(key)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return info, nil
+}
+
+// ListScannedHistoryFrom returns a page of balance changes of a key from a position (height and index in the block)
+func (s *NoteScannerService) ListScannedHistoryFrom(key string, height uint64, index uint32) ([]*notescanner.ScannedTx, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	history, err := scanner.ListHistoryFrom(key, height, index, notescanner.MaxPageSize)
+	if err != nil {
+		return nil, newNoteScannerRPCError(err)
+	}
+	return history, nil
+}
+
+// GetSpentTxHistorySource returns the public key of a registered private key, its shard and the source of its out
+// tx history records to merge with the tx history index
+func (s *NoteScannerService) GetSpentTxHistorySource(key string) ([]byte, byte, blockchain.TxHistorySource, *RPCError) {
+	scanner, rpcErr := s.getScanner()
+	if rpcErr != nil {
+		return nil, 0, nil, rpcErr
+	}
+	info, err := scanner.GetKeyInfo(key)
+	if err != nil {
+		return nil, 0, nil, newNoteScannerRPCError(err)
+	}
+	if !info.SpentTracked {
+		return nil, 0, nil, NewRPCError(RPCInvalidParamsError, errors.New("Key is registered without private key, its spending is not tracked"))
+	}
+	publicKey, err := scanner.GetPublicKey(key)
+	if err != nil {
+		return nil, 0, nil, newNoteScannerRPCError(err)
+	}
+	source := func(cursor []byte, fromHeight uint64, toHeight uint64, limit int, filter func(record *rawdbv2.TxHistoryRecord) bool) ([]*rawdbv2.TxHistoryRecord, []byte, error) {
+		return scanner.GetSpentTxHistory(key, cursor, fromHeight, toHeight, limit, filter)
+	}
+	return publicKey, info.ShardID, source, nil
+}
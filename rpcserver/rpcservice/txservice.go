@@ -1174,6 +1174,21 @@ func (txService TxService) PrivacyCustomTokenDetail(tokenIDStr string) ([]common
 	tokenData.PropertySymbol = tokenStates.PropertySymbol()
 	txs = append(txs, tokenStates.InitTx())
 	txs = append(txs, tokenStates.Txs()...)
+	// transactions missing in transaction state of a shard are completed by the token tx index
+	indexedTxs, err := txService.BlockChain.GetIndexedPrivacyTokenTxs(*tokenID)
+	if err != nil {
+		return nil, nil, err
+	}
+	existed := make(map[common.Hash]bool)
+	for _, txHash := range txs {
+		existed[txHash] = true
+	}
+	for _, txHash := range indexedTxs {
+		if !existed[txHash] {
+			existed[txHash] = true
+			txs = append(txs, txHash)
+		}
+	}
 	return txs, tokenData, nil
 }
 
@@ -2541,3 +2556,84 @@ func (txService TxService) BuildDefragmentPrivacyCustomTokenParamV2(tokenParamsR
 	}
 	return tokenParams, nil, nil, nil
 }
+
+const (
+	TxHistoryDirectionIn  = "in"
+	TxHistoryDirectionOut = "out"
+)
+
+// GetTransactionHistory returns a page of transactions of a public key from tx history index merged with records of
+// sources (nil for none), direction ("in", "out" or empty for both), tokenID and metadataType (nil for any) filter the transactions
+func (txService TxService) GetTransactionHistory(
+	publicKey []byte,
+	shardIDs []byte,
+	direction string,
+	tokenID *common.Hash,
+	metadataType *int,
+	fromHeight uint64,
+	toHeight uint64,
+	cursor string,
+	limit int,
+	sources map[byte]blockchain.TxHistorySource,
+) (*jsonresult.TransactionHistory, *RPCError) {
+	if direction != "" && direction != TxHistoryDirectionIn && direction != TxHistoryDirectionOut {
+		return nil, NewRPCError(RPCInvalidParamsError, fmt.Errorf("Direction %+v is invalid, it must be %+v, %+v or empty", direction, TxHistoryDirectionIn, TxHistoryDirectionOut))
+	}
+	filter := func(record *rawdbv2.TxHistoryRecord) bool {
+		if (direction == TxHistoryDirectionIn && record.Direction != rawdbv2.TxHistoryIn) || (direction == TxHistoryDirectionOut && record.Direction != rawdbv2.TxHistoryOut) {
+			return false
+		}
+		if metadataType != nil && record.MetadataType != *metadataType {
+			return false
+		}
+		if tokenID != nil {
+			for _, recordTokenID := range record.TokenIDs {
+				if recordTokenID == *tokenID {
+					return true
+				}
+			}
+			return false
+		}
+		return true
+	}
+	items, nextCursor, err := txService.BlockChain.GetTxHistory(publicKey, shardIDs, cursor, fromHeight, toHeight, limit, filter, sources)
+	if err != nil {
+		return nil, NewRPCError(GetTransactionHistoryError, err)
+	}
+	result := &jsonresult.TransactionHistory{
+		Transactions: []jsonresult.TransactionHistoryItem{},
+		NextCursor:   nextCursor,
+	}
+	for _, item := range items {
+		historyItem := jsonresult.TransactionHistoryItem{
+			ShardID:      item.ShardID,
+			BlockHeight:  item.Height,
+			TxIndex:      item.TxIndex,
+			TxHash:       item.TxHash.String(),
+			Direction:    TxHistoryDirectionIn,
+			TokenIDs:     []string{},
+			MetadataType: item.MetadataType,
+		}
+		if item.Direction == rawdbv2.TxHistoryOut {
+			historyItem.Direction = TxHistoryDirectionOut
+		}
+		for _, recordTokenID := range item.TokenIDs {
+			historyItem.TokenIDs = append(historyItem.TokenIDs, recordTokenID.String())
+		}
+		result.Transactions = append(result.Transactions, historyItem)
+	}
+	return result, nil
+}
+
+// GetTransactionReceipt returns the receipt of a metadata transaction, built from beacon events and response transactions
+func (txService TxService) GetTransactionReceipt(txHashStr string) (*jsonresult.TransactionReceipt, *RPCError) {
+	txHash, err := common.Hash{}.NewHashFromStr(txHashStr)
+	if err != nil {
+		return nil, NewRPCError(RPCInvalidParamsError, errors.New("Tx hash is invalid"))
+	}
+	receipt, err := txService.BlockChain.GetTxReceipt(*txHash)
+	if err != nil {
+		return nil, NewRPCError(GetTransactionReceiptError, err)
+	}
+	return jsonresult.NewTransactionReceipt(receipt), nil
+}
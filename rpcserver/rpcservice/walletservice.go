@@ -7,6 +7,7 @@ import (
 
 	"github.com/incognitochain/incognito-chain/blockchain"
 	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/privacy"
 	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
 	"github.com/incognitochain/incognito-chain/wallet"
 )
@@ -106,7 +107,8 @@ func (walletService *WalletService) RemoveAccount(privateKey string, passPhrase
 	return true, nil
 }
 
-func (walletService WalletService) GetBalanceByPrivateKey(privateKey string) (uint64, *RPCError) {
+// GetBalanceByPrivateKey returns PRV balance of a private key as of a shard height, 0 for the best view
+func (walletService WalletService) GetBalanceByPrivateKey(privateKey string, height uint64) (uint64, *RPCError) {
 	keySet, shardIDSender, err := GetKeySetFromPrivateKeyParams(privateKey)
 	if err != nil {
 		return uint64(0), NewRPCError(RPCInvalidParamsError, err)
@@ -119,7 +121,12 @@ func (walletService WalletService) GetBalanceByPrivateKey(privateKey string) (ui
 	if err != nil {
 		return uint64(0), NewRPCError(TokenIsInvalidError, err)
 	}
-	outcoints, err := walletService.BlockChain.GetListOutputCoinsByKeyset(keySet, shardIDSender, prvCoinID)
+	var outcoints []*privacy.OutputCoin
+	if height == 0 {
+		outcoints, err = walletService.BlockChain.GetListOutputCoinsByKeyset(keySet, shardIDSender, prvCoinID)
+	} else {
+		outcoints, err = walletService.BlockChain.GetListOutputCoinsByKeysetAtHeight(keySet, shardIDSender, prvCoinID, height)
+	}
 	// log.Println(err)
 	if err != nil {
 		return uint64(0), NewRPCError(UnexpectedError, err)
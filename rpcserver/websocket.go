@@ -25,7 +25,9 @@ type WsServer struct {
 	// channel
 	cRequestProcessShutdown chan struct{}
 
-	blockService *rpcservice.BlockService
+	blockService       *rpcservice.BlockService
+	noteScannerService *rpcservice.NoteScannerService
+	txService          *rpcservice.TxService
 }
 type RpcSubResult struct {
 	Result interface{}
@@ -54,6 +56,12 @@ func (wsServer *WsServer) Init(config *RpcServerConfig) {
 		DB:         wsServer.config.Database,
 		MemCache:   wsServer.config.MemCache,
 	}
+	wsServer.noteScannerService = &rpcservice.NoteScannerService{
+		Scanner: wsServer.config.NoteScanner,
+	}
+	wsServer.txService = &rpcservice.TxService{
+		BlockChain: wsServer.config.BlockChain,
+	}
 }
 
 func NewSubscriptionManager(ws *websocket.Conn) *SubcriptionManager {
@@ -0,0 +1,572 @@
+package rpcserver
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/notescanner"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+)
+
+// Event subscriptions send events from finalized blocks only, so an event is never reverted.
+// Each event has a resume token, a client subscribing again with the token of the last event it received
+// gets the events after it, including those of blocks finalized while it was disconnected
+
+const (
+	wsResumeTokenSize    = 13
+	wsBeaconChainID      = byte(255)
+	wsCrossShardLookback = 1000
+)
+
+var (
+	wsPDETradeMetaTypes        = []int{metadata.PDETradeRequestMeta, metadata.PDECrossPoolTradeRequestMeta}
+	wsPDEContributionMetaTypes = []int{metadata.PDEContributionMeta, metadata.PDEPRVRequiredContributionRequestMeta}
+	wsPortalPortingMetaTypes   = []int{
+		metadata.PortalRequestPortingMeta,
+		metadata.PortalRequestPortingMetaV3,
+		metadata.PortalUserRequestPTokenMeta,
+		metadata.PortalExpiredWaitingPortingReqMeta,
+	}
+	wsPortalRedeemMetaTypes = []int{
+		metadata.PortalRedeemRequestMeta,
+		metadata.PortalRedeemRequestMetaV3,
+		metadata.PortalReqMatchingRedeemMeta,
+		metadata.PortalPickMoreCustodianForRedeemMeta,
+		metadata.PortalRequestUnlockCollateralMeta,
+		metadata.PortalRequestUnlockCollateralMetaV3,
+	}
+	wsBridgeBurnMetaTypes = []int{
+		metadata.BurningConfirmMeta,
+		metadata.BurningConfirmMetaV2,
+		metadata.BurningConfirmForDepositToSCMeta,
+		metadata.BurningConfirmForDepositToSCMetaV2,
+	}
+)
+
+// wsResumeToken is the position of the next event of a stream
+//	- chainID: shard of the stream, or wsBeaconChainID for streams of beacon instructions
+//	- height, index: block height and index of the next event in the block,
+//	for a tx confirmation stream index is the bit set of delivered events instead
+type wsResumeToken struct {
+	chainID byte
+	height  uint64
+	index   uint32
+}
+
+func (token wsResumeToken) String() string {
+	data := make([]byte, wsResumeTokenSize)
+	data[0] = token.chainID
+	binary.BigEndian.PutUint64(data[1:], token.height)
+	binary.BigEndian.PutUint32(data[9:], token.index)
+	return hex.EncodeToString(data)
+}
+
+func parseWsResumeToken(tokenStr string) (*wsResumeToken, error) {
+	data, err := hex.DecodeString(tokenStr)
+	if err != nil || len(data) != wsResumeTokenSize {
+		return nil, fmt.Errorf("Resume token %+v is invalid", tokenStr)
+	}
+	return &wsResumeToken{
+		chainID: data[0],
+		height:  binary.BigEndian.Uint64(data[1:]),
+		index:   binary.BigEndian.Uint32(data[9:]),
+	}, nil
+}
+
+// getWsEventParams returns the subject and the resume token (empty if not set) of params of an event subscription
+func getWsEventParams(params interface{}) (string, string, error) {
+	arrayParams := common.InterfaceSlice(params)
+	if len(arrayParams) < 1 || len(arrayParams) > 2 {
+		return "", "", errors.New("Methods should contain 1 or 2 params")
+	}
+	subject, ok := arrayParams[0].(string)
+	if !ok {
+		return "", "", errors.New("First param is invalid")
+	}
+	tokenStr := ""
+	if len(arrayParams) == 2 {
+		if tokenStr, ok = arrayParams[1].(string); !ok {
+			return "", "", errors.New("Resume token is invalid")
+		}
+	}
+	return subject, tokenStr, nil
+}
+
+// sendWsEvent sends a result to the client, it returns false if the subscription is closed
+func sendWsEvent(cResult chan RpcSubResult, closeChan <-chan struct{}, result RpcSubResult) bool {
+	select {
+	case cResult <- result:
+		return true
+	case <-closeChan:
+		return false
+	}
+}
+
+// handleSubscribeTransactionConfirmation sends event confirmed when the block of a transaction is finalized,
+// then event crossshardreceived for each shard receiving output coins of the transaction.
+// Params: tx hash, resume token (optional)
+func (wsServer *WsServer) handleSubscribeTransactionConfirmation(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	defer close(cResult)
+	txHashStr, tokenStr, err := getWsEventParams(params)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+		return
+	}
+	txHash, err := common.Hash{}.NewHashFromStr(txHashStr)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+		return
+	}
+	var token *wsResumeToken
+	if tokenStr != "" {
+		if token, err = parseWsResumeToken(tokenStr); err != nil {
+			cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+			return
+		}
+	}
+	subId, subChan, err := wsServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewShardblockTopic)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.SubcribeError, err)}
+		return
+	}
+	defer func() {
+		Logger.log.Info("Finish Subscribe Transaction Confirmation ", txHashStr)
+		wsServer.config.PubSubManager.Unsubscribe(pubsub.NewShardblockTopic, subId)
+	}()
+
+	bc := wsServer.config.BlockChain
+	// delivered events: bit 0 for confirmed, bit 1+shardID for the receipt of a receiver shard
+	delivered := uint32(0)
+	txIndex := -1
+	var txShardID byte
+	var txBlockHash common.Hash
+	var txHeight uint64
+	receiverShardIDs := []byte{}
+	checkedHeights := make(map[byte]uint64)
+	send := func(event string, shardID byte, blockHash string, height uint64) bool {
+		pending := []int{}
+		for _, receiverShardID := range receiverShardIDs {
+			if delivered&(1<<(1+uint32(receiverShardID))) == 0 {
+				pending = append(pending, int(receiverShardID))
+			}
+		}
+		return sendWsEvent(cResult, closeChan, RpcSubResult{Result: jsonresult.TxConfirmationEvent{
+			Event:                   event,
+			TxHash:                  txHashStr,
+			ShardID:                 shardID,
+			BlockHash:               blockHash,
+			BlockHeight:             height,
+			TxShardID:               txShardID,
+			TxBlockHash:             txBlockHash.String(),
+			TxIndex:                 txIndex,
+			PendingReceiverShardIDs: pending,
+			ResumeToken:             wsResumeToken{chainID: txShardID, height: txHeight, index: delivered}.String(),
+		}})
+	}
+	// process sends the events of finalized blocks, it returns true when the stream is finished
+	process := func() bool {
+		if txIndex < 0 {
+			shardID, blockHash, height, index, tx, err := bc.GetTransactionByHash(*txHash)
+			if err != nil {
+				return false
+			}
+			finalizedHash, err := rawdbv2.GetFinalizedShardBlockHashByIndex(bc.GetShardChainDatabase(shardID), shardID, height)
+			if err != nil || !finalizedHash.IsEqual(&blockHash) {
+				return false
+			}
+			if token != nil {
+				if token.chainID != shardID || token.height != height {
+					cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Resume token is not of the transaction"))}
+					return true
+				}
+				delivered = token.index
+			}
+			txShardID, txBlockHash, txHeight, txIndex = shardID, blockHash, height, index
+			receiverShardIDs = blockchain.GetTxOutputShardIDs(tx, shardID)
+			if delivered&1 == 0 {
+				delivered |= 1
+				if !send(jsonresult.TxConfirmedEvent, txShardID, txBlockHash.String(), txHeight) {
+					return true
+				}
+			}
+		}
+		finished := true
+		for _, shardID := range receiverShardIDs {
+			bit := uint32(1) << (1 + uint32(shardID))
+			if delivered&bit != 0 {
+				continue
+			}
+			shardBlock, err := wsServer.findCrossShardReceipt(txShardID, txBlockHash, txHeight, shardID, checkedHeights)
+			if err != nil {
+				Logger.log.Error(err)
+			}
+			if shardBlock == nil {
+				finished = false
+				continue
+			}
+			delivered |= bit
+			if !send(jsonresult.TxCrossShardReceivedEvent, shardID, shardBlock.Hash().String(), shardBlock.Header.Height) {
+				return true
+			}
+		}
+		return finished
+	}
+	if process() {
+		return
+	}
+	for {
+		select {
+		case <-subChan:
+			if process() {
+				return
+			}
+		case <-closeChan:
+			cResult <- RpcSubResult{Result: jsonresult.UnsubcribeResult{Message: "Unsubscribe Transaction Confirmation " + txHashStr}}
+			return
+		}
+	}
+}
+
+// findCrossShardReceipt returns the finalized block of a receiver shard receiving output coins of a sender block, or nil
+// if it is not finalized yet. On the first call, blocks are searched backward from the final height until a block
+// receiving from older sender blocks, then next calls search forward from the last checked height
+func (wsServer *WsServer) findCrossShardReceipt(senderShardID byte, senderBlockHash common.Hash, senderHeight uint64, shardID byte, checkedHeights map[byte]uint64) (*blockchain.ShardBlock, error) {
+	bc := wsServer.config.BlockChain
+	if int(shardID) >= len(bc.ShardChain) {
+		return nil, fmt.Errorf("Shard %+v not found", shardID)
+	}
+	db := bc.GetShardChainDatabase(shardID)
+	getBlock := func(height uint64) (*blockchain.ShardBlock, error) {
+		hash, err := rawdbv2.GetFinalizedShardBlockHashByIndex(db, shardID, height)
+		if err != nil {
+			return nil, err
+		}
+		shardBlock, _, err := bc.GetShardBlockByHashWithShardID(*hash, shardID)
+		return shardBlock, err
+	}
+	// receives returns whether a block receives the sender block, and whether it only receives older sender blocks
+	receives := func(shardBlock *blockchain.ShardBlock) (bool, bool) {
+		crossTransactions := shardBlock.Body.CrossTransactions[senderShardID]
+		for _, crossTransaction := range crossTransactions {
+			if crossTransaction.BlockHash.IsEqual(&senderBlockHash) {
+				return true, false
+			}
+			if crossTransaction.BlockHeight >= senderHeight {
+				return false, false
+			}
+		}
+		return false, len(crossTransactions) > 0
+	}
+	finalHeight := bc.ShardChain[shardID].GetFinalView().GetHeight()
+	checkedHeight, ok := checkedHeights[shardID]
+	if !ok {
+		checkedHeights[shardID] = finalHeight
+		for height := finalHeight; height > 0 && finalHeight-height < wsCrossShardLookback; height-- {
+			shardBlock, err := getBlock(height)
+			if err != nil {
+				return nil, err
+			}
+			found, older := receives(shardBlock)
+			if found {
+				return shardBlock, nil
+			}
+			if older {
+				break
+			}
+		}
+		return nil, nil
+	}
+	for height := checkedHeight + 1; height <= finalHeight; height++ {
+		shardBlock, err := getBlock(height)
+		if err != nil {
+			return nil, err
+		}
+		checkedHeights[shardID] = height
+		if found, _ := receives(shardBlock); found {
+			return shardBlock, nil
+		}
+	}
+	return nil, nil
+}
+
+// handleSubscribeViewKeyActivity sends balance changes of a key registered to the note scanner once their blocks are scanned.
+// Params: scan key, resume token (optional)
+func (wsServer *WsServer) handleSubscribeViewKeyActivity(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	defer close(cResult)
+	keyStr, tokenStr, err := getWsEventParams(params)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+		return
+	}
+	info, rpcErr := wsServer.noteScannerService.GetScanKeyInfo(keyStr)
+	if rpcErr != nil {
+		cResult <- RpcSubResult{Error: rpcErr}
+		return
+	}
+	next := wsResumeToken{chainID: info.ShardID, height: info.ScannedHeight + 1}
+	if tokenStr != "" {
+		token, err := parseWsResumeToken(tokenStr)
+		if err != nil || token.chainID != info.ShardID {
+			cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Resume token %+v is invalid", tokenStr))}
+			return
+		}
+		next = *token
+	}
+	subId, subChan, err := wsServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NoteScannedTopic)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.SubcribeError, err)}
+		return
+	}
+	defer func() {
+		Logger.log.Info("Finish Subscribe View Key Activity ", info.ScanKeyID)
+		wsServer.config.PubSubManager.Unsubscribe(pubsub.NoteScannedTopic, subId)
+	}()
+	// process sends the changes after the last sent one, it returns false when the stream is finished
+	process := func() bool {
+		for {
+			history, rpcErr := wsServer.noteScannerService.ListScannedHistoryFrom(keyStr, next.height, next.index)
+			if rpcErr != nil {
+				cResult <- RpcSubResult{Error: rpcErr}
+				return false
+			}
+			for _, tx := range history {
+				next = wsResumeToken{chainID: info.ShardID, height: tx.Height, index: tx.Index + 1}
+				event := jsonresult.ViewKeyActivityEvent{ScanKeyID: info.ScanKeyID, ScannedTx: tx, ResumeToken: next.String()}
+				if !sendWsEvent(cResult, closeChan, RpcSubResult{Result: event}) {
+					return false
+				}
+			}
+			if len(history) < notescanner.MaxPageSize {
+				return true
+			}
+		}
+	}
+	if !process() {
+		return
+	}
+	for {
+		select {
+		case msg := <-subChan:
+			if shardID, ok := msg.Value.(byte); ok && shardID == info.ShardID && !process() {
+				return
+			}
+		case <-closeChan:
+			cResult <- RpcSubResult{Result: jsonresult.UnsubcribeResult{Message: "Unsubscribe View Key Activity " + info.ScanKeyID}}
+			return
+		}
+	}
+}
+
+// handleSubscribeTransactionReceipt sends the receipt of a metadata transaction, then sends it again each time it changes
+// with a new beacon event, a new response transaction or finality of their blocks. Params: tx hash
+func (wsServer *WsServer) handleSubscribeTransactionReceipt(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	defer close(cResult)
+	arrayParams := common.InterfaceSlice(params)
+	if len(arrayParams) < 1 {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Methods should only contain at least 1 param"))}
+		return
+	}
+	txHashStr, ok := arrayParams[0].(string)
+	if !ok {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, errors.New("Tx hash is invalid"))}
+		return
+	}
+	beaconSubId, beaconSubChan, err := wsServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewBeaconBlockTopic)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.SubcribeError, err)}
+		return
+	}
+	defer wsServer.config.PubSubManager.Unsubscribe(pubsub.NewBeaconBlockTopic, beaconSubId)
+	shardSubId, shardSubChan, err := wsServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewShardblockTopic)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.SubcribeError, err)}
+		return
+	}
+	defer func() {
+		Logger.log.Info("Finish Subscribe Transaction Receipt ", txHashStr)
+		wsServer.config.PubSubManager.Unsubscribe(pubsub.NewShardblockTopic, shardSubId)
+	}()
+	lastReceipt := []byte{}
+	// process sends the receipt if it changed, the tx may be not in a block yet, then nothing is sent
+	process := func() bool {
+		receipt, rpcErr := wsServer.txService.GetTransactionReceipt(txHashStr)
+		if rpcErr != nil {
+			return true
+		}
+		receiptBytes, err := json.Marshal(receipt)
+		if err != nil || string(receiptBytes) == string(lastReceipt) {
+			return true
+		}
+		lastReceipt = receiptBytes
+		return sendWsEvent(cResult, closeChan, RpcSubResult{Result: receipt})
+	}
+	if !process() {
+		return
+	}
+	for {
+		select {
+		case <-beaconSubChan:
+			if !process() {
+				return
+			}
+		case <-shardSubChan:
+			if !process() {
+				return
+			}
+		case <-closeChan:
+			cResult <- RpcSubResult{Result: jsonresult.UnsubcribeResult{Message: "Unsubscribe Transaction Receipt " + txHashStr}}
+			return
+		}
+	}
+}
+
+// Params of PDE, portal and bridge events: request tx hash (empty for requests of all txs), resume token (optional)
+
+func (wsServer *WsServer) handleSubscribePDETradeStatus(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	wsServer.subscribeBeaconInstructions(params, wsPDETradeMetaTypes, "PDE Trade Status", cResult, closeChan)
+}
+
+func (wsServer *WsServer) handleSubscribePDEContributionStatus(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	wsServer.subscribeBeaconInstructions(params, wsPDEContributionMetaTypes, "PDE Contribution Status", cResult, closeChan)
+}
+
+func (wsServer *WsServer) handleSubscribePortalPortingStatus(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	wsServer.subscribeBeaconInstructions(params, wsPortalPortingMetaTypes, "Portal Porting Status", cResult, closeChan)
+}
+
+func (wsServer *WsServer) handleSubscribePortalRedeemStatus(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	wsServer.subscribeBeaconInstructions(params, wsPortalRedeemMetaTypes, "Portal Redeem Status", cResult, closeChan)
+}
+
+func (wsServer *WsServer) handleSubscribeBridgeBurnConfirmation(params interface{}, subcription string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	wsServer.subscribeBeaconInstructions(params, wsBridgeBurnMetaTypes, "Bridge Burn Confirmation", cResult, closeChan)
+}
+
+// subscribeBeaconInstructions sends instructions of metadata types in finalized beacon blocks
+func (wsServer *WsServer) subscribeBeaconInstructions(params interface{}, metaTypes []int, name string, cResult chan RpcSubResult, closeChan <-chan struct{}) {
+	defer close(cResult)
+	reqTxHashStr, tokenStr, err := getWsEventParams(params)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+		return
+	}
+	if reqTxHashStr != "" {
+		reqTxHash, err := common.Hash{}.NewHashFromStr(reqTxHashStr)
+		if err != nil {
+			cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, err)}
+			return
+		}
+		reqTxHashStr = reqTxHash.String()
+	}
+	bc := wsServer.config.BlockChain
+	next := wsResumeToken{chainID: wsBeaconChainID, height: bc.BeaconChain.GetFinalView().GetHeight() + 1}
+	if tokenStr != "" {
+		token, err := parseWsResumeToken(tokenStr)
+		if err != nil || token.chainID != wsBeaconChainID {
+			cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.RPCInvalidParamsError, fmt.Errorf("Resume token %+v is invalid", tokenStr))}
+			return
+		}
+		next = *token
+	}
+	subId, subChan, err := wsServer.config.PubSubManager.RegisterNewSubscriber(pubsub.NewBeaconBlockTopic)
+	if err != nil {
+		cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.SubcribeError, err)}
+		return
+	}
+	defer func() {
+		Logger.log.Info("Finish Subscribe ", name)
+		wsServer.config.PubSubManager.Unsubscribe(pubsub.NewBeaconBlockTopic, subId)
+	}()
+	// process sends the instructions of blocks finalized after the last sent one, it returns false when the stream is finished
+	process := func() bool {
+		finalHeight := bc.BeaconChain.GetFinalView().GetHeight()
+		for ; next.height <= finalHeight; next.height, next.index = next.height+1, 0 {
+			select {
+			case <-closeChan:
+				return false
+			default:
+			}
+			hash, err := rawdbv2.GetFinalizedBeaconBlockHashByIndex(bc.GetBeaconChainDatabase(), next.height)
+			if err != nil {
+				cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.GetBeaconBlockByHeightError, err)}
+				return false
+			}
+			beaconBlock, _, err := bc.GetBeaconBlockByHash(*hash)
+			if err != nil {
+				cResult <- RpcSubResult{Error: rpcservice.NewRPCError(rpcservice.GetBeaconBlockByHashError, err)}
+				return false
+			}
+			instructions := beaconBlock.Body.Instructions
+			for ; int(next.index) < len(instructions); next.index++ {
+				event := getBeaconInstructionEvent(instructions[next.index], metaTypes)
+				if event == nil || (reqTxHashStr != "" && event.RequestTxHash != reqTxHashStr) {
+					continue
+				}
+				event.BeaconHeight = next.height
+				event.BeaconBlockHash = hash.String()
+				event.ResumeToken = wsResumeToken{chainID: wsBeaconChainID, height: next.height, index: next.index + 1}.String()
+				if !sendWsEvent(cResult, closeChan, RpcSubResult{Result: event}) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if !process() {
+		return
+	}
+	for {
+		select {
+		case <-subChan:
+			if !process() {
+				return
+			}
+		case <-closeChan:
+			cResult <- RpcSubResult{Result: jsonresult.UnsubcribeResult{Message: "Unsubscribe " + name}}
+			return
+		}
+	}
+}
+
+// getBeaconInstructionEvent returns the event of an instruction if it is of one of metadata types.
+// Instructions are [metaType, shardID, status, content], except burning confirm instructions having request tx hash at index 5
+func getBeaconInstructionEvent(inst []string, metaTypes []int) *jsonresult.BeaconInstructionEvent {
+	if len(inst) < 2 {
+		return nil
+	}
+	metaType, err := strconv.Atoi(inst[0])
+	if err != nil {
+		return nil
+	}
+	matched := false
+	for _, t := range metaTypes {
+		if t == metaType {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return nil
+	}
+	reqTxHash, receiptEvent := blockchain.GetInstructionReceiptEvent(inst)
+	if receiptEvent == nil {
+		return nil
+	}
+	return &jsonresult.BeaconInstructionEvent{
+		MetadataType:  metaType,
+		Status:        receiptEvent.Status,
+		RequestTxHash: reqTxHash.String(),
+		ShardID:       inst[1],
+		Instruction:   inst,
+	}
+}
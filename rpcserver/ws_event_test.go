@@ -0,0 +1,450 @@
+package rpcserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/rawdbv2"
+	"github.com/incognitochain/incognito-chain/incdb"
+	_ "github.com/incognitochain/incognito-chain/incdb/lvdb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/multiview"
+	"github.com/incognitochain/incognito-chain/notescanner"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge"
+	"github.com/incognitochain/incognito-chain/pubsub"
+	"github.com/incognitochain/incognito-chain/rpcserver/jsonresult"
+	"github.com/incognitochain/incognito-chain/rpcserver/rpcservice"
+	"github.com/incognitochain/incognito-chain/transaction"
+	"github.com/incognitochain/incognito-chain/wallet"
+)
+
+var _ = func() (_ struct{}) {
+	notescanner.Logger.Init(common.NewBackend(nil).Logger("test", true))
+	privacy.Logger.Init(common.NewBackend(nil).Logger("test", true))
+	return
+}()
+
+// wsTestChain is a chain of finalized blocks stored in leveldb, views are kept to move the final view of each chain
+type wsTestChain struct {
+	t          *testing.T
+	bc         *blockchain.BlockChain
+	multiViews map[int]*multiview.MultiView
+}
+
+func newTestWsServer(t *testing.T, activeShards int) (*WsServer, *wsTestChain) {
+	dir, err := ioutil.TempDir(os.TempDir(), "test_ws_event")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	dbs := make(map[int]incdb.Database)
+	chainIDs := []int{common.BeaconChainDataBaseID}
+	for shardID := 0; shardID < activeShards; shardID++ {
+		chainIDs = append(chainIDs, shardID)
+	}
+	for _, chainID := range chainIDs {
+		db, err := incdb.Open("leveldb", filepath.Join(dir, strconv.Itoa(chainID)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		dbs[chainID] = db
+	}
+	bc := &blockchain.BlockChain{}
+	bc.GetConfig().DataBase = dbs
+	chain := &wsTestChain{t: t, bc: bc, multiViews: make(map[int]*multiview.MultiView)}
+	chain.multiViews[common.BeaconChainDataBaseID] = multiview.NewMultiView()
+	bc.BeaconChain = blockchain.NewBeaconChain(chain.multiViews[common.BeaconChainDataBaseID], nil, bc, common.BeaconChainKey)
+	for shardID := 0; shardID < activeShards; shardID++ {
+		chain.multiViews[shardID] = multiview.NewMultiView()
+		bc.ShardChain = append(bc.ShardChain, blockchain.NewShardChain(shardID, chain.multiViews[shardID], nil, bc, common.GetShardChainKey(byte(shardID))))
+	}
+
+	pubSubManager := pubsub.NewPubSubManager()
+	go pubSubManager.Start()
+	wsServer := &WsServer{config: RpcServerConfig{BlockChain: bc, PubSubManager: pubSubManager}}
+	return wsServer, chain
+}
+
+// addBeaconBlocks stores finalized beacon blocks, the last one is the final view
+func (chain *wsTestChain) addBeaconBlocks(activeShards int, instructions ...[][]string) {
+	db := chain.bc.GetBeaconChainDatabase()
+	view := blockchain.NewBeaconBestState()
+	for i, blockInstructions := range instructions {
+		beaconBlock := blockchain.NewBeaconBlock()
+		beaconBlock.Header.Version = 2
+		beaconBlock.Header.Height = uint64(i + 1)
+		beaconBlock.Body.Instructions = blockInstructions
+		if err := rawdbv2.StoreBeaconBlockByHash(db, *beaconBlock.Hash(), beaconBlock); err != nil {
+			chain.t.Fatal(err)
+		}
+		if err := rawdbv2.StoreFinalizedBeaconBlockHashByIndex(db, beaconBlock.Header.Height, *beaconBlock.Hash()); err != nil {
+			chain.t.Fatal(err)
+		}
+		view.BestBlock = *beaconBlock
+	}
+	view.ActiveShards = activeShards
+	view.BeaconHeight = view.BestBlock.Header.Height
+	chain.multiViews[common.BeaconChainDataBaseID].AddView(view)
+}
+
+// newTestWsShardBlock creates a block proposed at a timeslot, a block finalizes its previous one if their timeslots are sequential
+func newTestWsShardBlock(shardID byte, height uint64, prevHash common.Hash, timeSlot int64) *blockchain.ShardBlock {
+	shardBlock := blockchain.NewShardBlock()
+	shardBlock.Header.Version = 2
+	shardBlock.Header.ShardID = shardID
+	shardBlock.Header.Height = height
+	shardBlock.Header.PreviousBlockHash = prevHash
+	shardBlock.Header.ProposeTime = timeSlot * int64(common.TIMESLOT)
+	shardBlock.Header.Timestamp = int64(height)
+	shardBlock.Header.Round = 1
+	shardBlock.Header.Epoch = 1
+	shardBlock.Header.BeaconHeight = 1
+	shardBlock.Header.TotalTxsFee = make(map[common.Hash]uint64)
+	shardBlock.Header.TxRoot = common.HashH([]byte("txs"))
+	shardBlock.Header.CrossTransactionRoot = common.HashH([]byte("cross txs"))
+	if height > 1 {
+		shardBlock.Header.CommitteeRoot = common.HashH([]byte("committee"))
+		shardBlock.ValidationData = "{}"
+	}
+	shardBlock.Body.Instructions = [][]string{}
+	shardBlock.Body.Transactions = []metadata.Transaction{}
+	shardBlock.Body.CrossTransactions = make(map[byte][]blockchain.CrossTransaction)
+	return shardBlock
+}
+
+// addShardBlock stores a block as finalized at its height and adds its view, the first view added is the final view
+func (chain *wsTestChain) addShardBlock(shardBlock *blockchain.ShardBlock) {
+	shardID := shardBlock.Header.ShardID
+	db := chain.bc.GetShardChainDatabase(shardID)
+	if err := rawdbv2.StoreShardBlock(db, *shardBlock.Hash(), shardBlock); err != nil {
+		chain.t.Fatal(err)
+	}
+	if err := rawdbv2.StoreFinalizedShardBlockHashByIndex(db, shardID, shardBlock.Header.Height, *shardBlock.Hash()); err != nil {
+		chain.t.Fatal(err)
+	}
+	for i, tx := range shardBlock.Body.Transactions {
+		if err := rawdbv2.StoreTransactionIndex(db, *tx.Hash(), *shardBlock.Hash(), i); err != nil {
+			chain.t.Fatal(err)
+		}
+	}
+	view := blockchain.NewShardBestState()
+	view.ShardID = shardID
+	view.BestBlock = shardBlock
+	view.ShardHeight = shardBlock.Header.Height
+	view.BestBlockHash = *shardBlock.Hash()
+	chain.multiViews[int(shardID)].AddView(view)
+}
+
+// newTestWsTx creates a tx sending an output coin to a public key of a shard
+func newTestWsTx(t *testing.T, receiverShardID byte) *transaction.Tx {
+	publicKey := privacy.RandomPoint()
+	for common.GetShardIDFromLastByte(publicKey.ToBytesS()[privacy.Ed25519KeySize-1]) != receiverShardID {
+		publicKey = privacy.RandomPoint()
+	}
+	outputCoin := new(privacy.OutputCoin).Init()
+	outputCoin.CoinDetails.SetPublicKey(publicKey)
+	outputCoin.CoinDetails.SetSNDerivator(privacy.RandomScalar())
+	outputCoin.CoinDetails.SetRandomness(privacy.RandomScalar())
+	outputCoin.CoinDetails.SetValue(10)
+	if err := outputCoin.CoinDetails.CommitAll(); err != nil {
+		t.Fatal(err)
+	}
+	proof := new(zkp.PaymentProof)
+	proof.Init()
+	proof.SetOutputCoins([]*privacy.OutputCoin{outputCoin})
+	return &transaction.Tx{Version: 1, Type: common.TxNormalType, Proof: proof}
+}
+
+// subscribeTestWs runs a subscription handler, results are read from the returned channel
+func subscribeTestWs(handler func(interface{}, string, chan RpcSubResult, <-chan struct{}), params ...interface{}) (chan RpcSubResult, chan struct{}) {
+	cResult := make(chan RpcSubResult)
+	closeChan := make(chan struct{})
+	go handler(params, "", cResult, closeChan)
+	return cResult, closeChan
+}
+
+// nextTestWsResult returns the next result, ok is false when the subscription is finished
+func nextTestWsResult(t *testing.T, cResult chan RpcSubResult) (RpcSubResult, bool) {
+	t.Helper()
+	select {
+	case result, ok := <-cResult:
+		return result, ok
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for websocket result")
+	}
+	return RpcSubResult{}, false
+}
+
+func TestWsResumeToken(t *testing.T) {
+	token := wsResumeToken{chainID: 3, height: 1000, index: 7}
+	parsed, err := parseWsResumeToken(token.String())
+	if err != nil || *parsed != token {
+		t.Fatalf("Expect token %+v is parsed back, have %+v error %v", token, parsed, err)
+	}
+	for _, tokenStr := range []string{"", "xyz", token.String()[2:]} {
+		if _, err := parseWsResumeToken(tokenStr); err == nil {
+			t.Errorf("Expect token %+v is rejected", tokenStr)
+		}
+	}
+}
+
+func TestSubscribeTransactionConfirmation(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	wsServer, chain := newTestWsServer(t, 2)
+	chain.addBeaconBlocks(2, [][]string{})
+
+	// the tx in block 2 of shard 0 sends an output coin to shard 1
+	tx := newTestWsTx(t, 1)
+	shard0Block1 := newTestWsShardBlock(0, 1, common.Hash{}, 1)
+	txBlock := newTestWsShardBlock(0, 2, *shard0Block1.Hash(), 2)
+	txBlock.Body.Transactions = []metadata.Transaction{tx}
+	chain.addShardBlock(txBlock)
+	if err := rawdbv2.StoreShardBlock(chain.bc.GetShardChainDatabase(0), *shard0Block1.Hash(), shard0Block1); err != nil {
+		t.Fatal(err)
+	}
+
+	// shard 1 is finalized at block 3 receiving block 1 of shard 0, block 4 receives the tx block once finalized
+	shard1Blocks := []*blockchain.ShardBlock{}
+	prevHash := common.Hash{}
+	for height := uint64(1); height <= 5; height++ {
+		shardBlock := newTestWsShardBlock(1, height, prevHash, int64(10*height))
+		if height == 5 {
+			shardBlock.Header.ProposeTime = 41 * int64(common.TIMESLOT)
+		}
+		switch height {
+		case 3:
+			shardBlock.Body.CrossTransactions[0] = []blockchain.CrossTransaction{{BlockHash: *shard0Block1.Hash(), BlockHeight: 1}}
+		case 4:
+			shardBlock.Body.CrossTransactions[0] = []blockchain.CrossTransaction{{BlockHash: *txBlock.Hash(), BlockHeight: 2}}
+		}
+		shard1Blocks = append(shard1Blocks, shardBlock)
+		prevHash = *shardBlock.Hash()
+	}
+	for _, shardBlock := range shard1Blocks[:2] {
+		if err := rawdbv2.StoreShardBlock(chain.bc.GetShardChainDatabase(1), *shardBlock.Hash(), shardBlock); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chain.addShardBlock(shard1Blocks[2])
+
+	cResult, _ := subscribeTestWs(wsServer.handleSubscribeTransactionConfirmation, tx.Hash().String())
+	result, ok := nextTestWsResult(t, cResult)
+	confirmed, isEvent := result.Result.(jsonresult.TxConfirmationEvent)
+	if !ok || !isEvent || confirmed.Event != jsonresult.TxConfirmedEvent || confirmed.BlockHeight != 2 || len(confirmed.PendingReceiverShardIDs) != 1 {
+		t.Fatalf("Expect confirmed event of block 2 pending on shard 1, have %+v", result)
+	}
+
+	// the cross shard receipt is sent once block 4 of shard 1 is finalized by block 5
+	chain.addShardBlock(shard1Blocks[3])
+	chain.addShardBlock(shard1Blocks[4])
+	if finalHeight := chain.bc.ShardChain[1].GetFinalView().GetHeight(); finalHeight != 4 {
+		t.Fatalf("Expect block 4 of shard 1 is finalized, have final height %v", finalHeight)
+	}
+	wsServer.config.PubSubManager.PublishMessage(pubsub.NewMessage(pubsub.NewShardblockTopic, shard1Blocks[4]))
+	result, ok = nextTestWsResult(t, cResult)
+	received, isEvent := result.Result.(jsonresult.TxConfirmationEvent)
+	if !ok || !isEvent || received.Event != jsonresult.TxCrossShardReceivedEvent || received.ShardID != 1 || received.BlockHeight != 4 || len(received.PendingReceiverShardIDs) != 0 {
+		t.Fatalf("Expect cross shard received event of block 4 of shard 1, have %+v", result)
+	}
+	if _, ok := nextTestWsResult(t, cResult); ok {
+		t.Fatal("Expect subscription is finished when all events are sent")
+	}
+
+	// resuming after the confirmed event sends the cross shard receipt only
+	cResult, _ = subscribeTestWs(wsServer.handleSubscribeTransactionConfirmation, tx.Hash().String(), confirmed.ResumeToken)
+	result, ok = nextTestWsResult(t, cResult)
+	if resumed, isEvent := result.Result.(jsonresult.TxConfirmationEvent); !ok || !isEvent || resumed.Event != jsonresult.TxCrossShardReceivedEvent || resumed.BlockHeight != 4 {
+		t.Fatalf("Expect resumed subscription sends the cross shard received event, have %+v", result)
+	}
+	if _, ok := nextTestWsResult(t, cResult); ok {
+		t.Fatal("Expect resumed subscription is finished")
+	}
+
+	// resuming after the last event sends nothing
+	cResult, _ = subscribeTestWs(wsServer.handleSubscribeTransactionConfirmation, tx.Hash().String(), received.ResumeToken)
+	if result, ok := nextTestWsResult(t, cResult); ok {
+		t.Fatalf("Expect nothing is sent after the last event, have %+v", result)
+	}
+
+	// tokens of another shard, of another block (the tx was in a fork when the token was sent) or malformed are rejected
+	for _, tokenStr := range []string{
+		wsResumeToken{chainID: 1, height: 2, index: 1}.String(),
+		wsResumeToken{chainID: 0, height: 3, index: 1}.String(),
+		"invalid",
+	} {
+		cResult, _ = subscribeTestWs(wsServer.handleSubscribeTransactionConfirmation, tx.Hash().String(), tokenStr)
+		result, ok = nextTestWsResult(t, cResult)
+		if !ok || result.Error == nil || result.Error.Code != rpcservice.ErrCodeMessage[rpcservice.RPCInvalidParamsError].Code {
+			t.Errorf("Expect token %+v is rejected, have %+v", tokenStr, result)
+		}
+		if _, ok := nextTestWsResult(t, cResult); ok {
+			t.Errorf("Expect subscription is finished after token %+v is rejected", tokenStr)
+		}
+	}
+}
+
+func TestFindCrossShardReceipt(t *testing.T) {
+	timeSlot := common.TIMESLOT
+	common.TIMESLOT = 10
+	defer func() { common.TIMESLOT = timeSlot }()
+	wsServer, chain := newTestWsServer(t, 2)
+	chain.addBeaconBlocks(2, [][]string{})
+	senderHash := common.HashH([]byte("sender block"))
+
+	// blocks 1 to 3 of shard 1 receive sender blocks 5, 9 and 12, the final view is at block 3
+	prevHash := common.Hash{}
+	for height, senderHeight := range []uint64{5, 9, 12} {
+		shardBlock := newTestWsShardBlock(1, uint64(height+1), prevHash, int64(height+1))
+		shardBlock.Body.CrossTransactions[0] = []blockchain.CrossTransaction{{BlockHash: common.HashH(common.Uint64ToBytes(senderHeight)), BlockHeight: senderHeight}}
+		if senderHeight == 9 {
+			shardBlock.Body.CrossTransactions[0] = append(shardBlock.Body.CrossTransactions[0], blockchain.CrossTransaction{BlockHash: senderHash, BlockHeight: 10})
+		}
+		prevHash = *shardBlock.Hash()
+		if height < 2 {
+			if err := rawdbv2.StoreShardBlock(chain.bc.GetShardChainDatabase(1), *shardBlock.Hash(), shardBlock); err != nil {
+				t.Fatal(err)
+			}
+			if err := rawdbv2.StoreFinalizedShardBlockHashByIndex(chain.bc.GetShardChainDatabase(1), 1, shardBlock.Header.Height, *shardBlock.Hash()); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		chain.addShardBlock(shardBlock)
+	}
+
+	// the backward search finds the block receiving the sender block below the final height
+	checkedHeights := make(map[byte]uint64)
+	shardBlock, err := wsServer.findCrossShardReceipt(0, senderHash, 10, 1, checkedHeights)
+	if err != nil || shardBlock == nil || shardBlock.Header.Height != 2 {
+		t.Fatalf("Expect block 2 receives the sender block, have %+v error %v", shardBlock, err)
+	}
+
+	// the backward search stops at a block receiving older sender blocks, next calls search forward
+	checkedHeights = make(map[byte]uint64)
+	laterHash := common.HashH([]byte("later sender block"))
+	if shardBlock, err := wsServer.findCrossShardReceipt(0, laterHash, 13, 1, checkedHeights); err != nil || shardBlock != nil {
+		t.Fatalf("Expect the later sender block is not received yet, have %+v error %v", shardBlock, err)
+	}
+	if checkedHeights[1] != 3 {
+		t.Fatalf("Expect search continues after final height 3, have %v", checkedHeights[1])
+	}
+	block4 := newTestWsShardBlock(1, 4, prevHash, 10)
+	block4.Body.CrossTransactions[0] = []blockchain.CrossTransaction{{BlockHash: laterHash, BlockHeight: 13}}
+	block5 := newTestWsShardBlock(1, 5, *block4.Hash(), 11)
+	chain.addShardBlock(block4)
+	chain.addShardBlock(block5)
+	shardBlock, err = wsServer.findCrossShardReceipt(0, laterHash, 13, 1, checkedHeights)
+	if err != nil || shardBlock == nil || shardBlock.Header.Height != 4 {
+		t.Fatalf("Expect block 4 receives the later sender block, have %+v error %v", shardBlock, err)
+	}
+
+	if _, err := wsServer.findCrossShardReceipt(0, laterHash, 13, 5, checkedHeights); err == nil {
+		t.Fatal("Expect unknown shard is rejected")
+	}
+}
+
+func TestSubscribeBeaconInstructions(t *testing.T) {
+	wsServer, chain := newTestWsServer(t, 1)
+	burnInst := func(reqTxHash common.Hash) []string {
+		return []string{strconv.Itoa(metadata.BurningConfirmMeta), "0", "", "", "", reqTxHash.String()}
+	}
+	reqTxHash1, reqTxHash2 := common.HashH([]byte("request 1")), common.HashH([]byte("request 2"))
+	chain.addBeaconBlocks(1,
+		[][]string{},
+		[][]string{burnInst(reqTxHash1), {strconv.Itoa(metadata.PDETradeRequestMeta), "0", "accepted", "{}"}},
+		[][]string{burnInst(reqTxHash2)},
+	)
+
+	collect := func(cResult chan RpcSubResult, closeChan chan struct{}, count int) []*jsonresult.BeaconInstructionEvent {
+		t.Helper()
+		events := []*jsonresult.BeaconInstructionEvent{}
+		for len(events) < count {
+			result, ok := nextTestWsResult(t, cResult)
+			event, isEvent := result.Result.(*jsonresult.BeaconInstructionEvent)
+			if !ok || !isEvent {
+				t.Fatalf("Expect %v events, have %+v after %v events", count, result, len(events))
+			}
+			events = append(events, event)
+		}
+		close(closeChan)
+		result, _ := nextTestWsResult(t, cResult)
+		if _, ok := result.Result.(jsonresult.UnsubcribeResult); !ok {
+			t.Fatalf("Expect no more event before unsubscribe, have %+v", result)
+		}
+		return events
+	}
+
+	// a token resumes from its position, including blocks finalized before subscribing
+	cResult, closeChan := subscribeTestWs(wsServer.handleSubscribeBridgeBurnConfirmation, "", wsResumeToken{chainID: wsBeaconChainID, height: 1}.String())
+	events := collect(cResult, closeChan, 2)
+	if events[0].RequestTxHash != reqTxHash1.String() || events[0].BeaconHeight != 2 || events[1].RequestTxHash != reqTxHash2.String() || events[1].BeaconHeight != 3 {
+		t.Fatalf("Expect burn confirmations of blocks 2 and 3, have %+v %+v", events[0], events[1])
+	}
+
+	// the token of an event resumes after it
+	cResult, closeChan = subscribeTestWs(wsServer.handleSubscribeBridgeBurnConfirmation, "", events[0].ResumeToken)
+	if resumed := collect(cResult, closeChan, 1); resumed[0].RequestTxHash != reqTxHash2.String() {
+		t.Fatalf("Expect resumed subscription sends the burn confirmation of block 3, have %+v", resumed[0])
+	}
+
+	// events are filtered by request tx hash
+	cResult, closeChan = subscribeTestWs(wsServer.handleSubscribeBridgeBurnConfirmation, reqTxHash2.String(), wsResumeToken{chainID: wsBeaconChainID, height: 1}.String())
+	if filtered := collect(cResult, closeChan, 1); filtered[0].RequestTxHash != reqTxHash2.String() {
+		t.Fatalf("Expect only the burn confirmation of request 2, have %+v", filtered[0])
+	}
+
+	// without token, events start after the final view
+	cResult, closeChan = subscribeTestWs(wsServer.handleSubscribeBridgeBurnConfirmation, "")
+	collect(cResult, closeChan, 0)
+
+	// a token of a shard stream is rejected
+	cResult, _ = subscribeTestWs(wsServer.handleSubscribeBridgeBurnConfirmation, "", wsResumeToken{chainID: 0, height: 1}.String())
+	if result, ok := nextTestWsResult(t, cResult); !ok || result.Error == nil {
+		t.Fatalf("Expect token of shard 0 is rejected, have %+v", result)
+	}
+}
+
+func TestSubscribeViewKeyActivityToken(t *testing.T) {
+	wsServer, chain := newTestWsServer(t, 1)
+	chain.addBeaconBlocks(1, [][]string{})
+	scanner, err := notescanner.NewScanner(&notescanner.Config{DataBase: chain.bc.GetShardChainDatabase(0), Passphrase: "passphrase"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wsServer.noteScannerService = &rpcservice.NoteScannerService{Scanner: scanner}
+	keyWallet, err := wallet.NewMasterKey([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := keyWallet.KeySet.InitFromPrivateKey(&keyWallet.KeySet.PrivateKey); err != nil {
+		t.Fatal(err)
+	}
+	readonlyKey := keyWallet.Base58CheckSerialize(wallet.ReadonlyKeyType)
+	info, err := scanner.RegisterKey(readonlyKey, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a token of the shard of the key is accepted, nothing is scanned yet
+	cResult, closeChan := subscribeTestWs(wsServer.handleSubscribeViewKeyActivity, readonlyKey, wsResumeToken{chainID: info.ShardID, height: 1}.String())
+	close(closeChan)
+	if result, _ := nextTestWsResult(t, cResult); result.Error != nil {
+		t.Fatalf("Expect token of the shard of the key is accepted, have %+v", result.Error)
+	}
+
+	// a token of another shard is rejected
+	cResult, _ = subscribeTestWs(wsServer.handleSubscribeViewKeyActivity, readonlyKey, wsResumeToken{chainID: info.ShardID + 1, height: 1}.String())
+	if result, ok := nextTestWsResult(t, cResult); !ok || result.Error == nil {
+		t.Fatalf("Expect token of another shard is rejected, have %+v", result)
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/incognitochain/incognito-chain/mempool"
 	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/netsync"
+	"github.com/incognitochain/incognito-chain/notescanner"
 	"github.com/incognitochain/incognito-chain/peer"
 	"github.com/incognitochain/incognito-chain/pubsub"
 	btcrelaying "github.com/incognitochain/incognito-chain/relaying/btc"
@@ -79,6 +80,7 @@ type Server struct {
 	consensusEngine *consensus.Engine
 	blockgen        *blockchain.BlockGenerator
 	pusubManager    *pubsub.PubSubManager
+	noteScanner     *notescanner.Scanner
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
 	feeEstimator map[byte]*mempool.FeeEstimator
@@ -186,6 +188,54 @@ func (serverObj *Server) setupRPCWsListeners() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+func (serverObj *Server) setupRPCGrpcListeners() ([]net.Listener, error) {
+	// Setup TLS if not disabled.
+	listenFunc := net.Listen
+	if !cfg.DisableTLS {
+		Logger.log.Debug("Disable TLS for RPC is false")
+		// Generate the TLS cert and key file if both don't already
+		// exist.
+		if !fileExists(cfg.RPCKey) && !fileExists(cfg.RPCCert) {
+			err := rpcserver.GenCertPair(cfg.RPCCert, cfg.RPCKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		keyPair, err := tls.LoadX509KeyPair(cfg.RPCCert, cfg.RPCKey)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig := tls.Config{
+			Certificates: []tls.Certificate{keyPair},
+			MinVersion:   tls.VersionTLS12,
+		}
+
+		// Change the standard net.Listen function to the tls one.
+		listenFunc = func(net string, laddr string) (net.Listener, error) {
+			return tls.Listen(net, laddr, &tlsConfig)
+		}
+	} else {
+		Logger.log.Debug("Disable TLS for RPC is true")
+	}
+
+	netAddrs, err := common.ParseListeners(cfg.RPCGrpcListeners, "tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	listeners := make([]net.Listener, 0, len(netAddrs))
+	for _, addr := range netAddrs {
+		listener, err := listenFunc(addr.Network(), addr.String())
+		if err != nil {
+			log.Printf("Can't listen on %s: %v", addr, err)
+			continue
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
 func (serverObj *Server) GetChainParam() *blockchain.Params {
 	return serverObj.chainParams
 }
@@ -310,6 +360,13 @@ func (serverObj *Server) NewServer(
 		"",
 		relayShards,
 	)
+	if err := serverObj.highway.Scorer.SetDB(serverObj.dataBase[common.BeaconChainDataBaseID]); err != nil {
+		Logger.log.Error("Failed restoring peer scores", err)
+	}
+	serverObj.highway.DirectPeers.AddStaticPeers(cfg.DirectPeers)
+	if err := serverObj.highway.DirectPeers.SetDB(serverObj.dataBase[common.BeaconChainDataBaseID]); err != nil {
+		Logger.log.Error("Failed restoring direct peers", err)
+	}
 
 	err = serverObj.blockChain.Init(&blockchain.Config{
 		BTCChain:      btcChain,
@@ -331,6 +388,7 @@ func (serverObj *Server) NewServer(
 		ConsensusEngine: serverObj.consensusEngine,
 		Highway:         serverObj.highway,
 		GenesisParams:   blockchain.GenesisParam,
+		ArchiveMode:     cfg.Archive,
 	})
 	if err != nil {
 		return err
@@ -393,17 +451,18 @@ func (serverObj *Server) NewServer(
 	}
 
 	serverObj.memPool.Init(&mempool.Config{
-		ConsensusEngine:   serverObj.consensusEngine,
-		BlockChain:        serverObj.blockChain,
-		DataBase:          serverObj.dataBase,
-		ChainParams:       chainParams,
-		FeeEstimator:      serverObj.feeEstimator,
-		TxLifeTime:        cfg.TxPoolTTL,
-		MaxTx:             cfg.TxPoolMaxTx,
-		DataBaseMempool:   dbmp,
-		IsLoadFromMempool: cfg.LoadMempool,
-		PersistMempool:    cfg.PersistMempool,
-		RelayShards:       relayShards,
+		ConsensusEngine:    serverObj.consensusEngine,
+		BlockChain:         serverObj.blockChain,
+		DataBase:           serverObj.dataBase,
+		ChainParams:        chainParams,
+		FeeEstimator:       serverObj.feeEstimator,
+		TxLifeTime:         cfg.TxPoolTTL,
+		MaxTx:              cfg.TxPoolMaxTx,
+		DataBaseMempool:    dbmp,
+		IsLoadFromMempool:  cfg.LoadMempool,
+		PersistMempool:     cfg.PersistMempool,
+		RelayShards:        relayShards,
+		BatchVerifyWorkers: cfg.BatchVerifyWorkers,
 		// UserKeyset:        serverObj.userKeySet,
 		PubSubManager: serverObj.pusubManager,
 	})
@@ -414,18 +473,20 @@ func (serverObj *Server) NewServer(
 	//==============Temp mem pool only used for validation
 	serverObj.tempMemPool = &mempool.TxPool{}
 	serverObj.tempMemPool.Init(&mempool.Config{
-		BlockChain:    serverObj.blockChain,
-		DataBase:      serverObj.dataBase,
-		ChainParams:   chainParams,
-		FeeEstimator:  serverObj.feeEstimator,
-		MaxTx:         cfg.TxPoolMaxTx,
-		PubSubManager: pubsubManager,
+		BlockChain:         serverObj.blockChain,
+		DataBase:           serverObj.dataBase,
+		ChainParams:        chainParams,
+		FeeEstimator:       serverObj.feeEstimator,
+		MaxTx:              cfg.TxPoolMaxTx,
+		PubSubManager:      pubsubManager,
+		BatchVerifyWorkers: cfg.BatchVerifyWorkers,
 	})
 	go serverObj.tempMemPool.Start(serverObj.cQuit)
 	serverObj.blockChain.AddTempTxPool(serverObj.tempMemPool)
 	//===============
 
 	serverObj.addrManager = addrmanager.NewAddrManager(cfg.DataDir, common.HashH(common.Uint32ToBytes(activeNetParams.Params.Net))) // use network param Net as key for storage
+	serverObj.highway.DirectPeers.SetSeeder(serverObj.knownPeerAddresses)
 
 	// Init Net Sync manager to process messages
 	serverObj.netSync = &netsync.NetSync{}
@@ -480,7 +541,7 @@ func (serverObj *Server) NewServer(
 
 	serverObj.connManager = connManager
 	serverObj.consensusEngine.Init(&consensus.EngineConfig{Node: serverObj, Blockchain: serverObj.blockChain, PubSubManager: serverObj.pusubManager})
-	serverObj.syncker.Init(&syncker.SynckerManagerConfig{Network: serverObj.highway, Blockchain: serverObj.blockChain, Consensus: serverObj.consensusEngine})
+	serverObj.syncker.Init(&syncker.SynckerManagerConfig{Network: serverObj.highway, Blockchain: serverObj.blockChain, Consensus: serverObj.consensusEngine, PeerScorer: serverObj.highway.Scorer})
 
 	// Start up persistent peers.
 	permanentPeers := cfg.ConnectPeers
@@ -492,6 +553,24 @@ func (serverObj *Server) NewServer(
 		go serverObj.connManager.Connect(addr, "", "", nil)
 	}
 
+	// note scanner indexes coins of registered keys, it is enabled by its passphrase to encrypt the keys
+	if cfg.NoteScannerPassphrase != "" {
+		noteScannerDB, err := incdb.Open("leveldb", filepath.Join(cfg.DataDir, cfg.DatabaseDir, "notescanner"))
+		if err != nil {
+			return err
+		}
+		serverObj.noteScanner, err = notescanner.NewScanner(&notescanner.Config{
+			BlockChain:    serverObj.blockChain,
+			PubSubManager: pubsubManager,
+			DataBase:      noteScannerDB,
+			Passphrase:    cfg.NoteScannerPassphrase,
+			MaxScanKeys:   cfg.NoteScannerMaxKeys,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if !cfg.DisableRPC {
 		// Setup listeners for the configured RPC listen addresses and
 		// TLS settings.
@@ -501,15 +580,22 @@ func (serverObj *Server) NewServer(
 		if err != nil {
 			return err
 		}
-		if len(httpListeners) == 0 && len(wsListeners) == 0 {
+		grpcListeners, err := serverObj.setupRPCGrpcListeners()
+		if err != nil {
+			return err
+		}
+		if len(httpListeners) == 0 && len(wsListeners) == 0 && len(grpcListeners) == 0 {
 			return errors.New("RPCS: No valid listen address")
 		}
 
 		rpcConfig := rpcserver.RpcServerConfig{
 			HttpListenters:              httpListeners,
 			WsListenters:                wsListeners,
+			GrpcListenters:              grpcListeners,
 			RPCQuirks:                   cfg.RPCQuirks,
 			RPCMaxClients:               cfg.RPCMaxClients,
+			RPCBatchWorkers:             cfg.RPCBatchWorkers,
+			RPCMaxBatchSize:             cfg.RPCMaxBatchSize,
 			RPCMaxWSClients:             cfg.RPCMaxWSClients,
 			RPCLimitRequestPerDay:       cfg.RPCLimitRequestPerDay,
 			RPCLimitRequestErrorPerHour: cfg.RPCLimitRequestErrorPerHour,
@@ -525,6 +611,7 @@ func (serverObj *Server) NewServer(
 			RPCPass:                     cfg.RPCPass,
 			RPCLimitUser:                cfg.RPCLimitUser,
 			RPCLimitPass:                cfg.RPCLimitPass,
+			RPCACLFile:                  cfg.RPCACLFile,
 			DisableAuth:                 cfg.RPCDisableAuth,
 			// NodeMode:                    cfg.NodeMode,
 			FeeEstimator:    serverObj.feeEstimator,
@@ -536,6 +623,8 @@ func (serverObj *Server) NewServer(
 			ConsensusEngine: serverObj.consensusEngine,
 			MemCache:        serverObj.memCache,
 			Syncker:         serverObj.syncker,
+			PeerScorer:      serverObj.highway.Scorer,
+			NoteScanner:     serverObj.noteScanner,
 		}
 		serverObj.rpcServer = &rpcserver.RpcServer{}
 		serverObj.rpcServer.Init(&rpcConfig)
@@ -626,6 +715,10 @@ func (serverObj *Server) Stop() error {
 		}
 	}
 
+	if serverObj.noteScanner != nil {
+		serverObj.noteScanner.Stop()
+	}
+
 	err := serverObj.consensusEngine.Stop()
 	if err != nil {
 		Logger.log.Error(err)
@@ -711,6 +804,12 @@ func (serverObj Server) Start() {
 
 	go serverObj.highway.Start(serverObj.netSync)
 
+	if serverObj.noteScanner != nil {
+		if err := serverObj.noteScanner.Start(); err != nil {
+			Logger.log.Error(err)
+		}
+	}
+
 	if !cfg.DisableRPC && serverObj.rpcServer != nil {
 		serverObj.waitGroup.Add(1)
 
@@ -729,6 +828,7 @@ func (serverObj Server) Start() {
 	//go serverObj.blockChain.Synker.Start()
 	go serverObj.syncker.Start()
 	go serverObj.blockgen.Start(serverObj.cQuit)
+	go serverObj.blockChain.MigrateTxHistory(serverObj.cQuit)
 
 	if serverObj.memPool != nil {
 		err := serverObj.memPool.LoadOrResetDatabaseMempool()
@@ -882,6 +982,16 @@ func (serverObject Server) CheckForceUpdateSourceCode() {
 // addresses to the address manager. Returns the listeners and a NAT interface,
 // which is non-nil if UPnP is in use.
 */
+// knownPeerAddresses return the addresses of nodes saved in address manager,
+// they seed the direct peers used for syncing when highway is unavailable
+func (serverObj *Server) knownPeerAddresses() []string {
+	addrs := []string{}
+	for _, addr := range serverObj.addrManager.AddressCache() {
+		addrs = append(addrs, addr.GetRawAddress())
+	}
+	return addrs
+}
+
 func (serverObj *Server) InitListenerPeer(amgr *addrmanager.AddrManager, listenAddrs string) (*peer.Peer, error) {
 	netAddr, err := common.ParseListener(listenAddrs, "ip")
 	if err != nil {
@@ -1242,6 +1352,7 @@ func (serverObj *Server) OnPeerState(_ *peer.PeerConn, msg *wire.MessagePeerStat
 	//var txProcessed chan struct{}
 	//serverObj.netSync.QueueMessage(nil, msg, txProcessed)
 	go serverObj.syncker.ReceivePeerState(msg)
+	serverObj.highway.DirectPeers.AddPeer(msg.SenderID, msg.SenderAddrs)
 	Logger.log.Debug("Receive a peerstate END")
 }
 
@@ -1630,6 +1741,7 @@ func (serverObj *Server) PublishNodeState() error {
 	for chainID, validator := range chainValidator {
 		currentMiningKey := validator.MiningKey.GetPublicKey().GetMiningKeyBase58(common.BlsConsensus)
 		msg.(*wire.MessagePeerState).SenderMiningPublicKey = currentMiningKey
+		msg.(*wire.MessagePeerState).SenderAddrs = serverObj.highway.LocalHost.P2PAddrs()
 		msg.SetSenderID(serverObj.highway.LocalHost.Host.ID())
 		if chainID != -1 {
 			sBestState := serverObj.blockChain.GetBestStateShard(byte(chainID))
@@ -30,13 +30,15 @@ type BeaconSyncProcess struct {
 	beaconPeerStateCh   chan *wire.MessagePeerState
 	blockchain          *blockchain.BlockChain
 	network             Network
+	peerScorer          PeerScorer
+	blockSenders        *blockSenderScorer
 	chain               Chain
 	beaconPool          *BlkPool
 	actionCh            chan func()
 	lastCrossShardState map[byte]map[byte]uint64
 }
 
-func NewBeaconSyncProcess(network Network, bc *blockchain.BlockChain, chain BeaconChainInterface) *BeaconSyncProcess {
+func NewBeaconSyncProcess(network Network, peerScorer PeerScorer, bc *blockchain.BlockChain, chain BeaconChainInterface) *BeaconSyncProcess {
 
 	var isOutdatedBlock = func(blk interface{}) bool {
 		if blk.(*blockchain.BeaconBlock).GetHeight() < chain.GetFinalViewHeight() {
@@ -49,6 +51,8 @@ func NewBeaconSyncProcess(network Network, bc *blockchain.BlockChain, chain Beac
 		status:              STOP_SYNC,
 		blockchain:          bc,
 		network:             network,
+		peerScorer:          peerScorer,
+		blockSenders:        newBlockSenderScorer(peerScorer),
 		chain:               chain,
 		beaconPool:          NewBlkPool("BeaconPool", isOutdatedBlock),
 		beaconPeerStates:    make(map[string]BeaconPeerState),
@@ -245,6 +249,7 @@ func (s *BeaconSyncProcess) insertBeaconBlockFromPool() {
 				Logger.Error("Insert beacon block from pool fail", blk.GetHeight(), blk.Hash(), err)
 				continue
 			}
+			s.blockSenders.rewardSender(blk.Hash())
 			s.beaconPool.RemoveBlock(blk.Hash())
 		}
 	}
@@ -261,8 +266,9 @@ func (s *BeaconSyncProcess) syncBeacon() {
 			continue
 		}
 
-		for peerID, pState := range s.getBeaconPeerStates() {
-			requestCnt += s.streamFromPeer(peerID, pState)
+		peerStates := s.getBeaconPeerStates()
+		for _, peerID := range s.peerScorer.SortPeers(beaconPeerIDs(peerStates)) {
+			requestCnt += s.streamFromPeer(peerID, peerStates[peerID])
 		}
 
 		//last check, if we still need to sync more
@@ -283,7 +289,6 @@ func (s *BeaconSyncProcess) streamFromPeer(peerID string, pState BeaconPeerState
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-	blockBuffer := []common.BlockInterface{}
 	defer func() {
 		if requestCnt == 0 {
 			pState.processed = true
@@ -318,7 +323,6 @@ func (s *BeaconSyncProcess) streamFromPeer(peerID string, pState BeaconPeerState
 
 	if pState.BestViewHeight > s.chain.GetBestViewHeight() {
 		requestCnt++
-		peerID = ""
 	}
 
 	//incase, we have long multiview chain, just sync last 100 block (very low probability that we have fork more than 100 blocks)
@@ -327,46 +331,66 @@ func (s *BeaconSyncProcess) streamFromPeer(peerID string, pState BeaconPeerState
 		fromHeight = s.chain.GetBestViewHeight()
 	}
 
-	//stream
-	ch, err := s.network.RequestBeaconBlocksViaStream(ctx, peerID, fromHeight, toHeight)
+	//stream from this peer first, so that the streamed blocks are scored for it,
+	//and fall back to any peer if it cannot serve the blocks
+	ok, err := s.streamBlocks(ctx, peerID, fromHeight, toHeight)
+	if !ok && peerID != "" {
+		Logger.Infof("Syncker stream beacon block from peer %v failed, request from any peer", peerID)
+		ok, err = s.streamBlocks(ctx, "", fromHeight, toHeight)
+	}
 	if err != nil {
 		fmt.Println("Syncker: create channel fail")
 		requestCnt = 0
-		return
+	}
+	return
+}
+
+//streamBlocks stream blocks from peerID (any peer if empty) and insert them.
+//It returns false if the stream cannot be created, the peer sends an invalid block or none of its blocks can be inserted
+func (s *BeaconSyncProcess) streamBlocks(ctx context.Context, peerID string, fromHeight, toHeight uint64) (bool, error) {
+	//stream
+	ch, err := s.network.RequestBeaconBlocksViaStream(ctx, peerID, fromHeight, toHeight)
+	if err != nil {
+		return false, err
 	}
 
 	//receive
+	blockBuffer := []common.BlockInterface{}
+	insertBlkCnt := 0
 	insertTime := time.Now()
 	for {
 		select {
 		case blk := <-ch:
 			if !isNil(blk) {
+				if !s.blockSenders.checkBlock(s.chain, blk, peerID) {
+					return false, nil
+				}
 				blockBuffer = append(blockBuffer, blk)
 			}
 
 			if uint64(len(blockBuffer)) >= blockchain.DefaultMaxBlkReqPerPeer || (len(blockBuffer) > 0 && (isNil(blk) || time.Since(insertTime) > time.Millisecond*2000)) {
-				insertBlkCnt := 0
 				for {
 					time1 := time.Now()
 					if successBlk, err := InsertBatchBlock(s.chain, blockBuffer); err != nil {
 						if successBlk == 0 {
 							fmt.Println(err)
 						}
-						return
+						return insertBlkCnt+successBlk > 0, nil
 					} else {
 						insertBlkCnt += successBlk
+						for _, insertedBlk := range blockBuffer[:successBlk] {
+							s.blockSenders.rewardSender(insertedBlk.Hash())
+						}
 						Logger.Infof("Syncker Insert %d beacon block (from %d to %d) elaspse %f \n", successBlk, blockBuffer[0].GetHeight(), blockBuffer[len(blockBuffer)-1].GetHeight(), time.Since(time1).Seconds())
 						if successBlk >= len(blockBuffer) || successBlk == 0 {
-							return
+							return true, nil
 						}
 						blockBuffer = blockBuffer[successBlk:]
 					}
 				}
-				insertTime = time.Now()
-				blockBuffer = []common.BlockInterface{}
 			}
 			if isNil(blk) && len(blockBuffer) == 0 {
-				return
+				return true, nil
 			}
 		}
 	}
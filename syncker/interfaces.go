@@ -19,6 +19,13 @@ type Network interface {
 	RequestShardBlocksByHashViaStream(ctx context.Context, peerID string, fromSID int, hashes [][]byte) (blockCh chan common.BlockInterface, err error)
 }
 
+type PeerScorer interface {
+	ReportInvalidMessage(peerID string, reason string)
+	ReportUsefulBlock(peerID string)
+	IsBanned(peerID string) bool
+	SortPeers(peerIDs []string) []string
+}
+
 type BeaconChainInterface interface {
 	Chain
 	GetShardBestViewHash() map[byte]common.Hash
@@ -28,6 +35,7 @@ type BeaconChainInterface interface {
 type ShardChainInterface interface {
 	Chain
 	GetCrossShardState() map[byte]uint64
+	InsertBatchBlock(blocks []common.BlockInterface) (int, error)
 }
 
 type Chain interface {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,6 +32,8 @@ type ShardSyncProcess struct {
 	crossShardSyncProcess *CrossShardSyncProcess
 	blockchain            *blockchain.BlockChain
 	Network               Network
+	peerScorer            PeerScorer
+	blockSenders          *blockSenderScorer
 	Chain                 ShardChainInterface
 	beaconChain           Chain
 	shardPool             *BlkPool
@@ -38,7 +41,7 @@ type ShardSyncProcess struct {
 	lock                  *sync.RWMutex
 }
 
-func NewShardSyncProcess(shardID int, network Network, bc *blockchain.BlockChain, beaconChain BeaconChainInterface, chain ShardChainInterface) *ShardSyncProcess {
+func NewShardSyncProcess(shardID int, network Network, peerScorer PeerScorer, bc *blockchain.BlockChain, beaconChain BeaconChainInterface, chain ShardChainInterface) *ShardSyncProcess {
 	var isOutdatedBlock = func(blk interface{}) bool {
 		if blk.(*blockchain.ShardBlock).GetHeight() < chain.GetFinalViewHeight() {
 			return true
@@ -51,9 +54,11 @@ func NewShardSyncProcess(shardID int, network Network, bc *blockchain.BlockChain
 		status:           STOP_SYNC,
 		blockchain:       bc,
 		Network:          network,
+		peerScorer:       peerScorer,
+		blockSenders:     newBlockSenderScorer(peerScorer),
 		Chain:            chain,
 		beaconChain:      beaconChain,
-		shardPool:        NewBlkPool("ShardPool-"+string(shardID), isOutdatedBlock),
+		shardPool:        NewBlkPool("ShardPool-"+strconv.Itoa(shardID), isOutdatedBlock),
 		shardPeerState:   make(map[string]ShardPeerState),
 		shardPeerStateCh: make(chan *wire.MessagePeerState),
 
@@ -167,6 +172,7 @@ func (s *ShardSyncProcess) insertShardBlockFromPool() {
 				Logger.Error("Insert shard block from pool fail", blk.GetHeight(), blk.Hash(), err)
 				continue
 			}
+			s.blockSenders.rewardSender(blk.Hash())
 			s.shardPool.RemoveBlock(blk.Hash())
 		}
 	}
@@ -181,8 +187,9 @@ func (s *ShardSyncProcess) syncShardProcess() {
 			continue
 		}
 
-		for peerID, pState := range s.getShardPeerStates() {
-			requestCnt += s.streamFromPeer(peerID, pState)
+		peerStates := s.getShardPeerStates()
+		for _, peerID := range s.peerScorer.SortPeers(shardPeerIDs(peerStates)) {
+			requestCnt += s.streamFromPeer(peerID, peerStates[peerID])
 		}
 
 		if requestCnt > 0 {
@@ -203,7 +210,6 @@ func (s *ShardSyncProcess) streamFromPeer(peerID string, pState ShardPeerState)
 		return
 	}
 
-	blockBuffer := []common.BlockInterface{}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer func() {
 		if requestCnt == 0 {
@@ -241,7 +247,6 @@ func (s *ShardSyncProcess) streamFromPeer(peerID string, pState ShardPeerState)
 
 	if pState.BestViewHeight > s.Chain.GetBestViewHeight() {
 		requestCnt++
-		peerID = ""
 	}
 
 	//incase, we have long multiview chain, just sync last 100 block (very low probability that we have fork more than 100 blocks
@@ -250,19 +255,39 @@ func (s *ShardSyncProcess) streamFromPeer(peerID string, pState ShardPeerState)
 		fromHeight = s.Chain.GetBestViewHeight()
 	}
 
-	//stream
-	ch, err := s.Network.RequestShardBlocksViaStream(ctx, peerID, s.shardID, fromHeight, toHeight)
+	//stream from this peer first, so that the streamed blocks are scored for it,
+	//and fall back to any peer if it cannot serve the blocks
+	ok, err := s.streamBlocks(ctx, peerID, fromHeight, toHeight, pState.BestViewHeight)
+	if !ok && peerID != "" {
+		Logger.Infof("Syncker stream shard %d block from peer %v failed, request from any peer", s.shardID, peerID)
+		ok, err = s.streamBlocks(ctx, "", fromHeight, toHeight, pState.BestViewHeight)
+	}
 	if err != nil {
 		fmt.Println("Syncker: create channel fail")
 		requestCnt = 0
-		return
 	}
+	return
+}
 
+//streamBlocks stream blocks from peerID (any peer if empty) and insert them.
+//It returns false if the stream cannot be created, the peer sends an invalid block or none of its blocks can be inserted
+func (s *ShardSyncProcess) streamBlocks(ctx context.Context, peerID string, fromHeight, toHeight, peerBestHeight uint64) (bool, error) {
+	//stream
+	ch, err := s.Network.RequestShardBlocksViaStream(ctx, peerID, s.shardID, fromHeight, toHeight)
+	if err != nil {
+		return false, err
+	}
+
+	blockBuffer := []common.BlockInterface{}
+	insertBlkCnt := 0
 	insertTime := time.Now()
 	for {
 		select {
 		case blk := <-ch:
 			if !isNil(blk) {
+				if !s.blockSenders.checkBlock(s.Chain, blk, peerID) {
+					return false, nil
+				}
 				blockBuffer = append(blockBuffer, blk)
 
 				if blk.(*blockchain.ShardBlock).Header.BeaconHeight > s.beaconChain.GetBestViewHeight() {
@@ -275,13 +300,15 @@ func (s *ShardSyncProcess) streamFromPeer(peerID string, pState ShardPeerState)
 			}
 
 			if uint64(len(blockBuffer)) >= 500 || (len(blockBuffer) > 0 && (isNil(blk) || time.Since(insertTime) > time.Millisecond*2000)) {
-				insertBlkCnt := 0
 				for {
 					time1 := time.Now()
-					if successBlk, err := InsertBatchBlock(s.Chain, blockBuffer); err != nil {
-						return
+					if successBlk, err := s.insertBlocks(blockBuffer, peerBestHeight); err != nil {
+						return insertBlkCnt+successBlk > 0, nil
 					} else {
 						insertBlkCnt += successBlk
+						for _, insertedBlk := range blockBuffer[:successBlk] {
+							s.blockSenders.rewardSender(insertedBlk.Hash())
+						}
 						fmt.Printf("Syncker Insert %d shard %d block(from %d to %d) elaspse %f \n", successBlk, s.shardID, blockBuffer[0].GetHeight(), blockBuffer[len(blockBuffer)-1].GetHeight(), time.Since(time1).Seconds())
 						if successBlk >= len(blockBuffer) || successBlk == 0 {
 							break
@@ -295,9 +322,22 @@ func (s *ShardSyncProcess) streamFromPeer(peerID string, pState ShardPeerState)
 			}
 
 			if isNil(blk) && len(blockBuffer) == 0 {
-				return
+				return true, nil
 			}
 		}
 	}
+}
 
+//insertBlocks inserts streamed blocks, when far behind the peer, its finalized blocks are inserted with batch insertion
+func (s *ShardSyncProcess) insertBlocks(blocks []common.BlockInterface, peerBestHeight uint64) (int, error) {
+	if peerBestHeight > s.Chain.GetBestViewHeight()+BATCH_INSERT_DISTANCE {
+		finalBlk := 0
+		for finalBlk < len(blocks) && blocks[finalBlk].GetHeight()+FINAL_BLOCK_DISTANCE <= peerBestHeight {
+			finalBlk++
+		}
+		if finalBlk > 0 {
+			return s.Chain.InsertBatchBlock(blocks[:finalBlk])
+		}
+	}
+	return InsertBatchBlock(s.Chain, blocks)
 }
@@ -26,6 +26,7 @@ type SynckerManagerConfig struct {
 	Network    Network
 	Blockchain *blockchain.BlockChain
 	Consensus  peerv2.ConsensusData
+	PeerScorer PeerScorer
 }
 
 type SynckerManager struct {
@@ -51,6 +52,9 @@ func NewSynckerManager() *SynckerManager {
 
 func (synckerManager *SynckerManager) Init(config *SynckerManagerConfig) {
 	synckerManager.config = config
+	if config.PeerScorer == nil {
+		config.PeerScorer = peerv2.NewPeerScoreKeeper()
+	}
 
 	//check preload beacon
 	preloadAddr := synckerManager.config.Blockchain.GetConfig().ChainParams.PreloadAddress
@@ -64,13 +68,13 @@ func (synckerManager *SynckerManager) Init(config *SynckerManagerConfig) {
 	}
 
 	//init beacon sync process
-	synckerManager.BeaconSyncProcess = NewBeaconSyncProcess(synckerManager.config.Network, synckerManager.config.Blockchain, synckerManager.config.Blockchain.BeaconChain)
+	synckerManager.BeaconSyncProcess = NewBeaconSyncProcess(synckerManager.config.Network, synckerManager.config.PeerScorer, synckerManager.config.Blockchain, synckerManager.config.Blockchain.BeaconChain)
 	synckerManager.beaconPool = synckerManager.BeaconSyncProcess.beaconPool
 
 	//init shard sync process
 	for _, chain := range synckerManager.config.Blockchain.ShardChain {
 		sid := chain.GetShardID()
-		synckerManager.ShardSyncProcess[sid] = NewShardSyncProcess(sid, synckerManager.config.Network, synckerManager.config.Blockchain, synckerManager.config.Blockchain.BeaconChain, chain)
+		synckerManager.ShardSyncProcess[sid] = NewShardSyncProcess(sid, synckerManager.config.Network, synckerManager.config.PeerScorer, synckerManager.config.Blockchain, synckerManager.config.Blockchain.BeaconChain, chain)
 		synckerManager.shardPool[sid] = synckerManager.ShardSyncProcess[sid].shardPool
 		synckerManager.CrossShardSyncProcess[sid] = synckerManager.ShardSyncProcess[sid].crossShardSyncProcess
 		synckerManager.crossShardPool[sid] = synckerManager.CrossShardSyncProcess[sid].crossShardPool
@@ -152,12 +156,18 @@ func (synckerManager *SynckerManager) manageSyncProcess() {
 
 //Process incomming broadcast block
 func (synckerManager *SynckerManager) ReceiveBlock(blk interface{}, peerID string) {
+	if synckerManager.config == nil || synckerManager.config.PeerScorer.IsBanned(peerID) {
+		return
+	}
 	switch blk.(type) {
 	case *blockchain.BeaconBlock:
 		beaconBlk := blk.(*blockchain.BeaconBlock)
 		//fmt.Printf("syncker: receive beacon block %d \n", beaconBlk.GetHeight())
 		//create fake s2b pool peerstate
 		if synckerManager.BeaconSyncProcess != nil {
+			if !synckerManager.BeaconSyncProcess.blockSenders.checkBlock(synckerManager.BeaconSyncProcess.chain, beaconBlk, peerID) {
+				return
+			}
 			synckerManager.beaconPool.AddBlock(beaconBlk)
 			synckerManager.BeaconSyncProcess.beaconPeerStateCh <- &wire.MessagePeerState{
 				Beacon: wire.ChainState{
@@ -175,6 +185,9 @@ func (synckerManager *SynckerManager) ReceiveBlock(blk interface{}, peerID strin
 		shardBlk := blk.(*blockchain.ShardBlock)
 		//fmt.Printf("syncker: receive shard block %d \n", shardBlk.GetHeight())
 		if synckerManager.shardPool[shardBlk.GetShardID()] != nil {
+			if !synckerManager.ShardSyncProcess[shardBlk.GetShardID()].blockSenders.checkBlock(synckerManager.ShardSyncProcess[shardBlk.GetShardID()].Chain, shardBlk, peerID) {
+				return
+			}
 			synckerManager.shardPool[shardBlk.GetShardID()].AddBlock(shardBlk)
 			if synckerManager.ShardSyncProcess[shardBlk.GetShardID()] != nil {
 				synckerManager.ShardSyncProcess[shardBlk.GetShardID()].shardPeerStateCh <- &wire.MessagePeerState{
@@ -1,9 +1,13 @@
 package syncker
 
 import (
-	"github.com/incognitochain/incognito-chain/blockchain"
+	"fmt"
 	"reflect"
 
+	"github.com/incognitochain/incognito-chain/blockchain"
+
+	lru "github.com/hashicorp/golang-lru"
+
 	"github.com/incognitochain/incognito-chain/common"
 	"github.com/incognitochain/incognito-chain/incognitokey"
 )
@@ -11,10 +15,71 @@ import (
 const RUNNING_SYNC = "running_sync"
 const STOP_SYNC = "stop_sync"
 
+//when shard chain is more than this number of blocks behind a peer, finalized blocks are inserted in batch
+const BATCH_INSERT_DISTANCE = 1000
+
+//blocks lower than peer best height by at least this number are considered final
+const FINAL_BLOCK_DISTANCE = 2
+
 func isNil(v interface{}) bool {
 	return v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil())
 }
 
+//blockSenderScorer remember the peer sending us each block of a chain,
+//so that the peer is rewarded once the block is inserted, or penalized if the block is invalid
+type blockSenderScorer struct {
+	peerScorer PeerScorer
+	senders    *lru.Cache //block hash -> peer id
+}
+
+func newBlockSenderScorer(peerScorer PeerScorer) *blockSenderScorer {
+	senders, _ := lru.New(10000)
+	return &blockSenderScorer{
+		peerScorer: peerScorer,
+		senders:    senders,
+	}
+}
+
+//checkBlock penalize sender of block with invalid signature (only checkable when block is in current epoch)
+//and remember sender of valid block
+func (s *blockSenderScorer) checkBlock(chain Chain, blk common.BlockInterface, peerID string) bool {
+	if peerID == "" {
+		return true
+	}
+	if blk.GetHeight() > chain.GetBestViewHeight() && blk.GetCurrentEpoch() == chain.GetEpoch() {
+		if err := chain.ValidateBlockSignatures(blk, chain.GetCommittee()); err != nil {
+			s.peerScorer.ReportInvalidMessage(peerID, fmt.Sprintf("block %v %v: %v", blk.GetHeight(), blk.Hash().String(), err))
+			return false
+		}
+	}
+	s.senders.Add(blk.Hash().String(), peerID)
+	return true
+}
+
+//rewardSender increase score of the peer sending us this block, once it is inserted to chain
+func (s *blockSenderScorer) rewardSender(blkHash *common.Hash) {
+	if sender, ok := s.senders.Get(blkHash.String()); ok {
+		s.peerScorer.ReportUsefulBlock(sender.(string))
+		s.senders.Remove(blkHash.String())
+	}
+}
+
+func beaconPeerIDs(peerStates map[string]BeaconPeerState) []string {
+	peerIDs := []string{}
+	for peerID := range peerStates {
+		peerIDs = append(peerIDs, peerID)
+	}
+	return peerIDs
+}
+
+func shardPeerIDs(peerStates map[string]ShardPeerState) []string {
+	peerIDs := []string{}
+	for peerID := range peerStates {
+		peerIDs = append(peerIDs, peerID)
+	}
+	return peerIDs
+}
+
 func InsertBatchBlock(chain Chain, blocks []common.BlockInterface) (int, error) {
 	sameCommitteeBlock := blocks
 
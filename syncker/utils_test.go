@@ -0,0 +1,166 @@
+package syncker
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/blockchain"
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/incognitokey"
+)
+
+func init() {
+	Logger.Init(common.NewBackend(ioutil.Discard).Logger("Syncker", true))
+}
+
+type testPeerScorer struct {
+	PeerScorer
+	useful  map[string]int
+	invalid map[string]int
+}
+
+func newTestPeerScorer() *testPeerScorer {
+	return &testPeerScorer{useful: make(map[string]int), invalid: make(map[string]int)}
+}
+
+func (s *testPeerScorer) ReportInvalidMessage(peerID string, reason string) {
+	s.invalid[peerID]++
+}
+
+func (s *testPeerScorer) ReportUsefulBlock(peerID string) {
+	s.useful[peerID]++
+}
+
+type testChain struct {
+	Chain
+	bestHeight    uint64
+	epoch         uint64
+	invalidBlocks map[common.Hash]bool
+}
+
+func (c *testChain) GetBestViewHeight() uint64 {
+	return c.bestHeight
+}
+
+func (c *testChain) GetFinalViewHeight() uint64 {
+	return c.bestHeight
+}
+
+func (c *testChain) GetBestViewHash() string {
+	return ""
+}
+
+func (c *testChain) GetAllViewHash() []common.Hash {
+	return nil
+}
+
+func (c *testChain) GetEpoch() uint64 {
+	return c.epoch
+}
+
+func (c *testChain) GetCommittee() []incognitokey.CommitteePublicKey {
+	return nil
+}
+
+func (c *testChain) ValidateBlockSignatures(block common.BlockInterface, committee []incognitokey.CommitteePublicKey) error {
+	if c.invalidBlocks[*block.Hash()] {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func (c *testChain) CheckExistedBlk(block common.BlockInterface) bool {
+	return block.GetHeight() <= c.bestHeight
+}
+
+func (c *testChain) InsertBlk(block common.BlockInterface, shouldValidate bool) error {
+	c.bestHeight = block.GetHeight()
+	return nil
+}
+
+//testNetwork stream blocks of a peer, or the default blocks if the peer has none
+type testNetwork struct {
+	Network
+	blocks           []common.BlockInterface
+	peerBlocks       map[string][]common.BlockInterface
+	requestedPeerIDs []string
+}
+
+func (n *testNetwork) RequestBeaconBlocksViaStream(ctx context.Context, peerID string, from uint64, to uint64) (chan common.BlockInterface, error) {
+	n.requestedPeerIDs = append(n.requestedPeerIDs, peerID)
+	blocks, ok := n.peerBlocks[peerID]
+	if !ok {
+		blocks = n.blocks
+	}
+	ch := make(chan common.BlockInterface, len(blocks))
+	for _, blk := range blocks {
+		ch <- blk
+	}
+	close(ch)
+	return ch, nil
+}
+
+func newTestBeaconBlock(height uint64, epoch uint64) *blockchain.BeaconBlock {
+	return &blockchain.BeaconBlock{Header: blockchain.BeaconHeader{Height: height, Epoch: epoch}}
+}
+
+func TestBlockSenderScorer(t *testing.T) {
+	peerScorer := newTestPeerScorer()
+	invalidBlk := newTestBeaconBlock(3, 1)
+	chain := &testChain{bestHeight: 1, epoch: 1, invalidBlocks: map[common.Hash]bool{*invalidBlk.Hash(): true}}
+	beaconSenders := newBlockSenderScorer(peerScorer)
+	shardSenders := newBlockSenderScorer(peerScorer)
+
+	validBlk := newTestBeaconBlock(2, 1)
+	if !beaconSenders.checkBlock(chain, validBlk, "peer1") {
+		t.Fatal("Expect valid block is accepted")
+	}
+	shardSenders.rewardSender(validBlk.Hash())
+	if peerScorer.useful["peer1"] != 0 {
+		t.Fatal("Expect sender of a chain is not rewarded by another chain")
+	}
+	beaconSenders.rewardSender(validBlk.Hash())
+	beaconSenders.rewardSender(validBlk.Hash())
+	if peerScorer.useful["peer1"] != 1 {
+		t.Fatalf("Expect sender is rewarded once, have %v", peerScorer.useful["peer1"])
+	}
+
+	if beaconSenders.checkBlock(chain, invalidBlk, "peer2") || peerScorer.invalid["peer2"] != 1 {
+		t.Fatal("Expect sender of block with invalid signature is penalized")
+	}
+	oldEpochBlk := newTestBeaconBlock(3, 0)
+	chain.invalidBlocks[*oldEpochBlk.Hash()] = true
+	if !beaconSenders.checkBlock(chain, oldEpochBlk, "peer3") || peerScorer.invalid["peer3"] != 0 {
+		t.Fatal("Expect block of another epoch is not checked")
+	}
+}
+
+func TestBeaconSyncProcess_streamFromPeerScoresSender(t *testing.T) {
+	peerScorer := newTestPeerScorer()
+	chain := &testChain{bestHeight: 1, epoch: 1, invalidBlocks: make(map[common.Hash]bool)}
+	network := &testNetwork{blocks: []common.BlockInterface{newTestBeaconBlock(2, 1), newTestBeaconBlock(3, 1), newTestBeaconBlock(4, 1)}}
+	s := &BeaconSyncProcess{network: network, chain: chain, peerScorer: peerScorer, blockSenders: newBlockSenderScorer(peerScorer)}
+
+	s.streamFromPeer("peer1", BeaconPeerState{BestViewHeight: 4})
+	if len(network.requestedPeerIDs) != 1 || network.requestedPeerIDs[0] != "peer1" {
+		t.Fatalf("Expect blocks are requested from the peer, have %v", network.requestedPeerIDs)
+	}
+	if chain.bestHeight != 4 || peerScorer.useful["peer1"] != 3 {
+		t.Fatalf("Expect 3 blocks are inserted and rewarded, have height %v and %v rewards", chain.bestHeight, peerScorer.useful["peer1"])
+	}
+
+	invalidBlk := newTestBeaconBlock(6, 1)
+	chain.invalidBlocks[*invalidBlk.Hash()] = true
+	network.blocks = []common.BlockInterface{newTestBeaconBlock(5, 1), newTestBeaconBlock(6, 1)}
+	network.peerBlocks = map[string][]common.BlockInterface{"peer2": {invalidBlk}}
+	network.requestedPeerIDs = nil
+	s.streamFromPeer("peer2", BeaconPeerState{BestViewHeight: 6})
+	if peerScorer.invalid["peer2"] != 1 || peerScorer.useful["peer2"] != 0 {
+		t.Fatalf("Expect streaming from the peer stop and sender is penalized, have %v penalties, %v rewards", peerScorer.invalid["peer2"], peerScorer.useful["peer2"])
+	}
+	if len(network.requestedPeerIDs) != 2 || network.requestedPeerIDs[1] != "" || chain.bestHeight != 6 {
+		t.Fatalf("Expect blocks are requested from any peer after the peer failed, have requests %v, height %v", network.requestedPeerIDs, chain.bestHeight)
+	}
+}
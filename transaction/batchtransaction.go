@@ -3,87 +3,173 @@ package transaction
 import (
 	"errors"
 	"fmt"
+	"runtime"
 
 	"github.com/incognitochain/incognito-chain/common"
 	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
 	"github.com/incognitochain/incognito-chain/metadata"
 	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/aggregaterange"
+	"github.com/incognitochain/incognito-chain/privacy/zeroknowledge/utils"
 )
 
 type batchTransaction struct {
-	txs []metadata.Transaction
+	txs     []metadata.Transaction
+	workers int
+}
+
+// BatchTxError tells which transaction of a batch makes the batch invalid
+type BatchTxError struct {
+	TxIndex int
+	Err     error
+}
+
+func (e BatchTxError) Error() string {
+	return fmt.Sprintf("transaction at index %+v of batch is invalid: %+v", e.TxIndex, e.Err)
 }
 
 func NewBatchTransaction(txs []metadata.Transaction) *batchTransaction {
-	return &batchTransaction{txs: txs}
+	return &batchTransaction{txs: txs, workers: runtime.NumCPU()}
 }
 
 func (b *batchTransaction) AddTxs(txs []metadata.Transaction) {
 	b.txs = append(b.txs, txs...)
 }
 
+// SetWorkers sets the number of goroutines verifying transactions of the batch, default is number of CPUs
+func (b *batchTransaction) SetWorkers(workers int) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	b.workers = workers
+}
+
+// Validate verifies the batch, return index of the first invalid transaction if any (-1 if it can not be pinpointed)
 func (b *batchTransaction) Validate(transactionStateDB *statedb.StateDB, bridgeStateDB *statedb.StateDB, boolParams map[string]bool) (bool, error, int) {
 	return b.validateBatchTxsByItself(b.txs, transactionStateDB, bridgeStateDB, boolParams)
 }
 
+// validateBatchTxsByItself verifies signature, proofs (except range proofs) and metadata of transactions concurrently,
+// each worker reads its own copy of state dbs. Range proofs of all transactions (PRV and privacy token) are then
+// combined in one batch verification, which finds the invalid ones when the batch fails.
 func (b *batchTransaction) validateBatchTxsByItself(txList []metadata.Transaction, transactionStateDB *statedb.StateDB, bridgeStateDB *statedb.StateDB, boolParams map[string]bool) (bool, error, int) {
 	prvCoinID := &common.Hash{}
 	err := prvCoinID.SetBytes(common.PRVCoinID[:])
 	if err != nil {
 		return false, err, -1
 	}
-	bulletProofList := make([]*aggregaterange.AggregatedRangeProof, 0)
-	for i, tx := range txList {
-		shardID := common.GetShardIDFromLastByte(tx.GetSenderAddrLastByte())
-		hasPrivacy := tx.IsPrivacy()
-
-		boolParams["hasPrivacy"] = tx.IsPrivacy()
-		ok, err := tx.ValidateTransaction(boolParams, transactionStateDB, bridgeStateDB, shardID, prvCoinID)
-		if !ok {
-			return false, err, i
+	txsRangeProofs := make([][]*aggregaterange.AggregatedRangeProof, len(txList))
+	index, err := utils.VerifyInParallel(len(txList), b.workers, func() func(i int) error {
+		txStateDB := transactionStateDB
+		txBridgeStateDB := bridgeStateDB
+		if b.workers > 1 {
+			txStateDB = copyStateDB(transactionStateDB)
+			txBridgeStateDB = copyStateDB(bridgeStateDB)
 		}
-		if tx.GetMetadata() != nil {
-			if hasPrivacy {
-				return false, errors.New("Metadata can not exist in privacy tx"), i
-			}
-			validateMetadata := tx.GetMetadata().ValidateMetadataByItself()
-			if !validateMetadata {
-				return validateMetadata, NewTransactionErr(UnexpectedError, errors.New("Metadata is invalid")), i
-			}
+		return func(i int) error {
+			rangeProofs, err := validateTxInBatch(txList[i], txStateDB, txBridgeStateDB, boolParams, prvCoinID)
+			txsRangeProofs[i] = rangeProofs
+			return err
 		}
+	})
+	if err != nil {
+		return false, err, index
+	}
 
-		if hasPrivacy {
-			if bulletProof := tx.GetProof().GetAggregatedRangeProof(); bulletProof != nil {
-				bulletProofList = append(bulletProofList, bulletProof)
-			}
+	rangeProofs := make([]*aggregaterange.AggregatedRangeProof, 0)
+	rangeProofTxIndex := make([]int, 0)
+	for i, txRangeProofs := range txsRangeProofs {
+		for _, rangeProof := range txRangeProofs {
+			rangeProofs = append(rangeProofs, rangeProof)
+			rangeProofTxIndex = append(rangeProofTxIndex, i)
 		}
 	}
-	//TODO: add go routine
 	isNewZKP, ok := boolParams["isNewZKP"]
 	if !ok {
 		isNewZKP = true
 	}
 
-	if isNewZKP {
-		ok, err, index := aggregaterange.VerifyBatch(bulletProofList)
-		if err != nil {
-			return false, NewTransactionErr(TxProofVerifyFailError, err), -1
+	invalid, err := verifyRangeProofs(rangeProofs, isNewZKP)
+	if len(invalid) == 0 {
+		return true, nil, -1
+	}
+	invalidTxs := make([]int, 0)
+	for _, i := range invalid {
+		if len(invalidTxs) == 0 || invalidTxs[len(invalidTxs)-1] != rangeProofTxIndex[i] {
+			invalidTxs = append(invalidTxs, rangeProofTxIndex[i])
 		}
-		if !ok {
-			Logger.log.Errorf("FAILED VERIFICATION BATCH PAYMENT PROOF VER 2 %d", index)
-			return false, NewTransactionErr(TxProofVerifyFailError, fmt.Errorf("FAILED VERIFICATION BATCH PAYMENT PROOF %d", index)), index
+	}
+	Logger.log.Errorf("FAILED VERIFICATION BATCH PAYMENT PROOF isNewZKP %v, invalid tx indexes %v", isNewZKP, invalidTxs)
+	return false, NewTransactionErr(TxProofVerifyFailError, fmt.Errorf("invalid range proofs of txs at indexes %v: %v", invalidTxs, err)), invalidTxs[0]
+}
+
+// validateTxInBatch verifies a transaction except its range proofs, which are returned to be verified in batch
+func validateTxInBatch(tx metadata.Transaction, transactionStateDB *statedb.StateDB, bridgeStateDB *statedb.StateDB, boolParams map[string]bool, prvCoinID *common.Hash) ([]*aggregaterange.AggregatedRangeProof, error) {
+	shardID := common.GetShardIDFromLastByte(tx.GetSenderAddrLastByte())
+	hasPrivacy := tx.IsPrivacy()
+
+	txBoolParams := make(map[string]bool, len(boolParams)+1)
+	for k, v := range boolParams {
+		txBoolParams[k] = v
+	}
+	txBoolParams["hasPrivacy"] = hasPrivacy
+	ok, err := tx.ValidateTransaction(txBoolParams, transactionStateDB, bridgeStateDB, shardID, prvCoinID)
+	if !ok {
+		if err == nil {
+			err = NewTransactionErr(UnexpectedError, fmt.Errorf("transaction %+v is invalid", tx.Hash().String()))
 		}
-		return true, nil, -1
-	} else {
-		ok, err, index := aggregaterange.VerifyBatchOld(bulletProofList)
-		if err != nil {
-			return false, NewTransactionErr(TxProofVerifyFailError, err), -1
+		return nil, err
+	}
+	if tx.GetMetadata() != nil {
+		if hasPrivacy {
+			return nil, errors.New("Metadata can not exist in privacy tx")
 		}
-		if !ok {
-			Logger.log.Errorf("FAILED VERIFICATION BATCH PAYMENT PROOF VER 1 %d", index)
-			return false, NewTransactionErr(TxProofVerifyFailError, fmt.Errorf("FAILED VERIFICATION BATCH PAYMENT PROOF %d", index)), index
+		validateMetadata := tx.GetMetadata().ValidateMetadataByItself()
+		if !validateMetadata {
+			return nil, NewTransactionErr(UnexpectedError, errors.New("Metadata is invalid"))
+		}
+	}
+
+	rangeProofs := make([]*aggregaterange.AggregatedRangeProof, 0)
+	if hasPrivacy && tx.GetProof() != nil {
+		rangeProofs = append(rangeProofs, tx.GetProof().GetAggregatedRangeProof())
+	}
+	// range proof of pToken is skipped by ValidateTransaction in batch too
+	if tokenTx, ok := tx.(*TxCustomTokenPrivacy); ok && tokenTx.TxPrivacyTokenData.Type != CustomTokenInit {
+		txNormal := tokenTx.TxPrivacyTokenData.TxNormal
+		if txNormal.IsPrivacy() {
+			rangeProofs = append(rangeProofs, txNormal.Proof.GetAggregatedRangeProof())
 		}
-		return true, nil, -1
 	}
+	return rangeProofs, nil
 }
 
+// verifyRangeProofs verifies range proofs in batch, return indexes of invalid proofs and the error of the first one.
+// Before new zkp check point, a proof is valid if it passes either the old or the new verification
+func verifyRangeProofs(proofs []*aggregaterange.AggregatedRangeProof, isNewZKP bool) ([]int, error) {
+	if len(proofs) == 0 {
+		return nil, nil
+	}
+	if !isNewZKP {
+		if ok, _, _ := aggregaterange.VerifyBatchOld(proofs); ok {
+			return nil, nil
+		}
+	}
+	invalid, err := aggregaterange.VerifyBatchFindInvalid(proofs)
+	if isNewZKP {
+		return invalid, err
+	}
+	invalidOld := make([]int, 0)
+	for _, i := range invalid {
+		if valid, _ := proofs[i].VerifyOld(); !valid {
+			invalidOld = append(invalidOld, i)
+		}
+	}
+	return invalidOld, err
+}
+
+func copyStateDB(stateDB *statedb.StateDB) *statedb.StateDB {
+	if stateDB == nil {
+		return nil
+	}
+	return stateDB.Copy()
+}
@@ -0,0 +1,131 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
+	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/privacy"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBatchTestTx mints a coin to a new sender and spends it in a privacy tx
+func newBatchTestTx(t *testing.T, stateDB *statedb.StateDB) *Tx {
+	senderSK := privacy.GeneratePrivateKey(privacy.RandomScalar().ToBytesS())
+	senderAddress := privacy.GeneratePaymentAddress(senderSK)
+	receiverAddress := privacy.GeneratePaymentAddress(privacy.GeneratePrivateKey(privacy.RandomScalar().ToBytesS()))
+	shardID := common.GetShardIDFromLastByte(senderAddress.Pk[len(senderAddress.Pk)-1])
+
+	coinBaseTx := new(Tx)
+	err := coinBaseTx.InitTxSalary(1000, &senderAddress, &senderSK, stateDB, nil)
+	assert.Equal(t, nil, err)
+	err = statedb.StoreCommitments(stateDB, common.PRVCoinID, senderAddress.Pk,
+		[][]byte{coinBaseTx.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, shardID)
+	assert.Equal(t, nil, err)
+
+	inputCoins := ConvertOutputCoinToInputCoin(coinBaseTx.Proof.GetOutputCoins())
+	serialNumber := new(privacy.Point).Derive(privacy.PedCom.G[privacy.PedersenPrivateKeyIndex],
+		new(privacy.Scalar).FromBytesS(senderSK),
+		inputCoins[0].CoinDetails.GetSNDerivator())
+	inputCoins[0].CoinDetails.SetSerialNumber(serialNumber)
+
+	tx := new(Tx)
+	err = tx.Init(NewTxPrivacyInitParams(&senderSK,
+		[]*privacy.PaymentInfo{{PaymentAddress: receiverAddress, Amount: 5}},
+		inputCoins, 1, true, stateDB, nil, nil, []byte{}))
+	assert.Equal(t, nil, err)
+	return tx
+}
+
+// breakSignature makes the signature of tx invalid
+func breakSignature(tx *Tx) {
+	tx.Sig[len(tx.Sig)-1] ^= 1
+}
+
+// breakRangeProof makes the range proof of tx invalid and signs tx again, so only the batch of range proofs catches it
+func breakRangeProof(t *testing.T, tx *Tx) {
+	rangeProof := tx.Proof.GetAggregatedRangeProof()
+	rangeProofBytes := rangeProof.Bytes()
+	// tHat follows the value commitments and a, s, t1, t2, tauX
+	tHatOffset := 1 + int(rangeProofBytes[0])*privacy.Ed25519KeySize + 5*privacy.Ed25519KeySize
+	rangeProofBytes[tHatOffset] ^= 1
+	err := rangeProof.SetBytes(rangeProofBytes)
+	assert.Equal(t, nil, err)
+
+	tx.Sig = nil
+	tx.cachedHash = nil
+	err = tx.signTx()
+	assert.Equal(t, nil, err)
+}
+
+func TestBatchTransactionValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakTxs  func(t *testing.T, txs []*Tx)
+		wantValid bool
+		wantIndex int
+		wantErr   string
+	}{
+		{
+			name:      "valid txs",
+			breakTxs:  func(t *testing.T, txs []*Tx) {},
+			wantValid: true,
+			wantIndex: -1,
+		},
+		{
+			name: "invalid signature",
+			breakTxs: func(t *testing.T, txs []*Tx) {
+				breakSignature(txs[1])
+			},
+			wantValid: false,
+			wantIndex: 1,
+		},
+		{
+			name: "invalid signatures, the first one is reported",
+			breakTxs: func(t *testing.T, txs []*Tx) {
+				breakSignature(txs[2])
+				breakSignature(txs[1])
+			},
+			wantValid: false,
+			wantIndex: 1,
+		},
+		{
+			name: "invalid range proof",
+			breakTxs: func(t *testing.T, txs []*Tx) {
+				breakRangeProof(t, txs[2])
+			},
+			wantValid: false,
+			wantIndex: 2,
+			wantErr:   "invalid range proofs of txs at indexes [2]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateDB, err := statedb.NewWithPrefixTrie(common.EmptyRoot, wrapperDB)
+			assert.Equal(t, nil, err)
+			txs := []*Tx{newBatchTestTx(t, stateDB), newBatchTestTx(t, stateDB), newBatchTestTx(t, stateDB)}
+			// state of a view is committed, workers read it from copies of the trie
+			_, err = stateDB.Commit(true)
+			assert.Equal(t, nil, err)
+			tt.breakTxs(t, txs)
+			batchTxs := []metadata.Transaction{}
+			for _, tx := range txs {
+				batchTxs = append(batchTxs, tx)
+			}
+
+			for _, workers := range []int{1, 4} {
+				batch := NewBatchTransaction(batchTxs)
+				batch.SetWorkers(workers)
+				boolParams := map[string]bool{"isNewTransaction": false, "isBatch": true, "isNewZKP": true}
+				valid, err, index := batch.Validate(stateDB, stateDB, boolParams)
+				assert.Equal(t, tt.wantValid, valid, "workers %v", workers)
+				assert.Equal(t, tt.wantIndex, index, "workers %v", workers)
+				assert.Equal(t, tt.wantValid, err == nil, "workers %v: %v", workers, err)
+				if tt.wantErr != "" {
+					assert.Contains(t, err.Error(), tt.wantErr, "workers %v", workers)
+				}
+			}
+		})
+	}
+}
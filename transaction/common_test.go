@@ -3,12 +3,14 @@ package transaction
 import (
 	"fmt"
 	"github.com/incognitochain/incognito-chain/common"
+	"github.com/incognitochain/incognito-chain/dataaccessobject/statedb"
 	"github.com/incognitochain/incognito-chain/incdb"
 	_ "github.com/incognitochain/incognito-chain/incdb/lvdb"
-	"github.com/incognitochain/incognito-chain/metadata"
+	"github.com/incognitochain/incognito-chain/metadata/mocks"
 	"github.com/incognitochain/incognito-chain/privacy"
 	"github.com/incognitochain/incognito-chain/wallet"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"io/ioutil"
 	"log"
 	"os"
@@ -79,7 +81,7 @@ func TestRandomCommitmentsProcess(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	rawdb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx1.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
+	statedb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx1.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
 
 	in1 := ConvertOutputCoinToInputCoin(tx1.Proof.GetOutputCoins())
 
@@ -93,10 +95,10 @@ func TestRandomCommitmentsProcess(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	rawdb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx2.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
+	statedb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx2.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
 	tx3 := &Tx{}
 	err = tx3.InitTxSalary(5, &paymentAddress, &key.KeySet.PrivateKey, db, nil)
-	rawdb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx3.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
+	statedb.StoreCommitments(db, common.Hash{}, paymentAddress.Pk, [][]byte{tx3.Proof.GetOutputCoins()[0].CoinDetails.GetCoinCommitment().ToBytesS()}, 0)
 	in2 := ConvertOutputCoinToInputCoin(tx2.Proof.GetOutputCoins())
 	in := append(in1, in2...)
 
@@ -105,30 +107,48 @@ func TestRandomCommitmentsProcess(t *testing.T) {
 	assert.Equal(t, 16, len(cmm))
 	assert.Equal(t, 2, len(myIndexs))
 
-	rawdb.CleanCommitments(db)
-	cmmIndexs1, myCommIndex1, cmm1 := RandomCommitmentsProcess(NewRandomCommitmentsProcessParam(in, 0, db, 0, &common.Hash{}))
+	emptyDB, err := statedb.NewWithPrefixTrie(common.EmptyRoot, wrapperDB)
+	assert.Equal(t, nil, err)
+	cmmIndexs1, myCommIndex1, cmm1 := RandomCommitmentsProcess(NewRandomCommitmentsProcessParam(in, 0, emptyDB, 0, &common.Hash{}))
 	assert.Equal(t, 0, len(cmmIndexs1))
 	assert.Equal(t, 0, len(myCommIndex1))
 	assert.Equal(t, 0, len(cmm1))
 }
 
-var db incdb.Database
+var (
+	wrapperDB statedb.DatabaseAccessWarper
+	db        *statedb.StateDB
+)
+
 var _ = func() (_ struct{}) {
 	dbPath, err := ioutil.TempDir(os.TempDir(), "test_")
 	if err != nil {
 		log.Fatalf("failed to create temp dir: %+v", err)
 	}
 	log.Println(dbPath)
-	db, err = incdb.Open("leveldb", dbPath)
+	diskDB, err := incdb.Open("leveldb", dbPath)
 	if err != nil {
 		log.Fatalf("could not open db path: %s, %+v", dbPath, err)
 	}
+	wrapperDB = statedb.NewDatabaseAccessWarper(diskDB)
+	db, err = statedb.NewWithPrefixTrie(common.EmptyRoot, wrapperDB)
+	if err != nil {
+		log.Fatalf("could not open state db: %+v", err)
+	}
 	incdb.Logger.Init(common.NewBackend(nil).Logger("db", true))
 	Logger.Init(common.NewBackend(nil).Logger("tx", true))
 	privacy.Logger.Init(common.NewBackend(nil).Logger("privacy", true))
 	return
 }()
 
+// newTestChainRetriever returns a chain without fixed randomness for commitments of shard ID
+func newTestChainRetriever() *mocks.ChainRetriever {
+	chainRetriever := &mocks.ChainRetriever{}
+	chainRetriever.On("GetFixedRandomForShardIDCommitment", mock.Anything).Return(nil)
+	chainRetriever.On("GetBurningAddress", mock.Anything).Return("")
+	return chainRetriever
+}
+
 func TestBuildCoinbaseTxByCoinID(t *testing.T) {
 	key, err := wallet.Base58CheckDeserialize("112t8rnXCqbbNYBquntyd6EvDT4WiDDQw84ZSRDKmazkqrzi6w8rWyCVt7QEZgAiYAV4vhJiX7V9MCfuj4hGLoDN7wdU1LoWGEFpLs59X7K3")
 	assert.Equal(t, nil, err)
@@ -136,13 +156,13 @@ func TestBuildCoinbaseTxByCoinID(t *testing.T) {
 	assert.Equal(t, nil, err)
 	paymentAddress := key.KeySet.PaymentAddress
 
-	tx, err := BuildCoinBaseTxByCoinID(NewBuildCoinBaseTxByCoinIDParams(&paymentAddress, 10, &key.KeySet.PrivateKey, db, nil, common.Hash{}, NormalCoinType, "PRV", 0))
+	tx, err := BuildCoinBaseTxByCoinID(NewBuildCoinBaseTxByCoinIDParams(&paymentAddress, 10, &key.KeySet.PrivateKey, db, nil, common.Hash{}, NormalCoinType, "PRV", 0, db))
 	assert.Equal(t, nil, err)
 	assert.NotEqual(t, nil, tx)
 	//assert.Equal(t, uint64(10), tx.(*Tx).Proof.GetOutputCoins()[0].CoinDetails.GetValue())
 	assert.Equal(t, common.PRVCoinID.String(), tx.GetTokenID().String())
 
-	txCustomTokenPrivacy, err := BuildCoinBaseTxByCoinID(NewBuildCoinBaseTxByCoinIDParams(&paymentAddress, 10, &key.KeySet.PrivateKey, db, nil, common.Hash{2}, CustomTokenPrivacyType, "Custom Token", 0))
+	txCustomTokenPrivacy, err := BuildCoinBaseTxByCoinID(NewBuildCoinBaseTxByCoinIDParams(&paymentAddress, 10, &key.KeySet.PrivateKey, db, nil, common.Hash{2}, CustomTokenPrivacyType, "Custom Token", 0, db))
 	assert.Equal(t, nil, err)
 	assert.NotEqual(t, nil, tx)
 	//assert.Equal(t, uint64(10), txCustomTokenPrivacy.(*TxCustomTokenPrivacy).TxPrivacyTokenData.TxNormal.Proof.GetOutputCoins()[0].CoinDetails.GetValue())
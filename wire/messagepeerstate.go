@@ -27,6 +27,7 @@ type MessagePeerState struct {
 	Timestamp             int64
 	SenderID              string
 	SenderMiningPublicKey string
+	SenderAddrs           []string // libp2p addresses to stream blocks directly from sender
 }
 
 func (msg *MessagePeerState) Hash() string {